@@ -3,12 +3,16 @@ package v2
 import (
 	"crypto/x509"
 	"errors"
+	"fmt"
 	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/kubev2v/assisted-migration-agent/internal/models"
 	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	"github.com/kubev2v/assisted-migration-agent/pkg/filter"
 )
 
 // CredsFromAPI converts a VcenterCredentials API type to models.Credentials.
@@ -153,6 +157,10 @@ func NewVirtualMachineDetailFromModel(vm models.VM) VirtualMachineDetail {
 	details.Template = &vm.IsTemplate
 	details.Migratable = &vm.IsMigratable
 	details.MigrationExcluded = &vm.MigrationExcluded
+	if vm.Exclusion != nil {
+		e := NewMigrationExclusionFromModel(*vm.Exclusion)
+		details.Exclusion = &e
+	}
 	if vm.InspectionStatus.State != models.InspectionStateNotStarted {
 		s := NewInspectionStatus(vm.InspectionStatus)
 		details.InspectionStatus = &s
@@ -162,6 +170,9 @@ func NewVirtualMachineDetailFromModel(vm models.VM) VirtualMachineDetail {
 	if len(vm.Labels) > 0 {
 		details.Labels = &vm.Labels
 	}
+	if len(vm.RuleLabels) > 0 {
+		details.RuleLabels = &vm.RuleLabels
+	}
 
 	for _, d := range vm.Disks {
 		capacityBytes := d.Capacity * 1024 * 1024
@@ -266,13 +277,69 @@ func NewVirtualMachineDetailFromModel(vm models.VM) VirtualMachineDetail {
 	return details
 }
 
-func NewCollectionFromDatabase(db *store.Database) Collection {
+func NewCollectionFromDatabase(db *store.Database, meta models.CollectionMetadata) Collection {
 	name := strings.TrimSuffix(db.Path, filepath.Ext(db.Path))
-	return Collection{
+	c := Collection{
 		Id:        db.ID,
 		Name:      name,
 		CreatedAt: db.CreatedAt,
+		Pinned:    meta.Pinned,
+	}
+	if db.VCenter != "" {
+		c.Vcenter = &db.VCenter
+	}
+	if meta.DisplayName != "" {
+		c.DisplayName = &meta.DisplayName
+	}
+	if meta.Notes != "" {
+		c.Notes = &meta.Notes
+	}
+	if db.Imported {
+		c.Imported = &db.Imported
+	}
+	if db.Signer != "" {
+		c.Signer = &db.Signer
 	}
+	return c
+}
+
+// NewCollectionTrendsResponse converts trend points to the V2 API type.
+func NewCollectionTrendsResponse(points []models.TrendPoint) CollectionTrendsResponse {
+	resp := CollectionTrendsResponse{Points: make([]CollectionTrendPoint, 0, len(points))}
+	for _, p := range points {
+		point := CollectionTrendPoint{
+			CollectionId:       p.CollectionID,
+			CreatedAt:          p.CreatedAt,
+			Imported:           p.Imported,
+			TotalVMs:           p.TotalVMs,
+			Migratable:         p.Migratable,
+			NonMigratable:      p.NonMigratable,
+			ConcernsByCategory: p.ConcernsByCategory,
+			Cpus:               p.CPUs,
+			MemoryMiB:          p.MemoryMiB,
+			StorageMiB:         p.StorageMiB,
+			Clusters:           p.Clusters,
+		}
+		if p.VCenter != "" {
+			point.Vcenter = &p.VCenter
+		}
+		resp.Points = append(resp.Points, point)
+	}
+	return resp
+}
+
+// NewCollectionMetadataUpdate converts an UpdateCollectionRequest to a models.CollectionMetadataUpdate.
+func NewCollectionMetadataUpdate(req UpdateCollectionRequest) models.CollectionMetadataUpdate {
+	upd := models.CollectionMetadataUpdate{
+		DisplayName: req.DisplayName,
+		Notes:       req.Notes,
+		Pinned:      req.Pinned,
+	}
+	if upd.DisplayName != nil {
+		name := strings.TrimSpace(*upd.DisplayName)
+		upd.DisplayName = &name
+	}
+	return upd
 }
 
 // NewCollectorStatus converts a models.CollectorStatus to a v2 CollectorStatus.
@@ -299,6 +366,11 @@ func NewCollectorStatus(status models.CollectorStatus) CollectorStatus {
 		c.Status = CollectorStatusStatusConnecting
 	}
 
+	if status.Mode != "" {
+		mode := CollectionMode(status.Mode)
+		c.Mode = &mode
+	}
+
 	if status.Error != nil {
 		e := status.Error.Error()
 		c.Error = &e
@@ -351,6 +423,14 @@ func NewRightsizingClusterUtilizationFromModel(c models.RightsizingClusterUtiliz
 		TotalProvisionedCpus:     int(c.TotalProvisionedCpus),
 		TotalProvisionedMemoryMb: int(c.TotalProvisionedMemoryMb),
 		TotalProvisionedDiskKb:   c.TotalProvisionedDiskKb,
+		NetAvg:                   c.NetAvg,
+		NetP95:                   c.NetP95,
+		NetMax:                   c.NetMax,
+		IopsAvg:                  c.IopsAvg,
+		IopsP95:                  c.IopsP95,
+		IopsMax:                  c.IopsMax,
+		ReadLatencyP95:           c.ReadLatencyP95,
+		WriteLatencyP95:          c.WriteLatencyP95,
 	}
 }
 
@@ -372,6 +452,16 @@ func NewVmUtilizationDetailsFromModel(d models.VmUtilizationDetails) VmUtilizati
 		MemLatest:           d.MemLatest,
 		Disk:                d.Disk,
 		Confidence:          d.Confidence,
+		NetAvg:              d.NetAvg,
+		NetP95:              d.NetP95,
+		NetMax:              d.NetMax,
+		NetRxP95:            d.NetRxP95,
+		NetTxP95:            d.NetTxP95,
+		IopsAvg:             d.IopsAvg,
+		IopsP95:             d.IopsP95,
+		IopsMax:             d.IopsMax,
+		ReadLatencyP95:      d.ReadLatencyP95,
+		WriteLatencyP95:     d.WriteLatencyP95,
 	}
 }
 
@@ -589,6 +679,7 @@ func NewCollectionComparisonSummaryFromModel(s models.ComparisonSummary) Collect
 	return CollectionComparisonSummary{
 		Collections: []CollectionAggregate{collectionAggregateFromModel(s.A), collectionAggregateFromModel(s.B)},
 		Diff: struct {
+			Changed       int                 `json:"changed"`
 			Clusters      ComparisonDiffEntry `json:"clusters"`
 			Migratable    ComparisonDiffEntry `json:"migratable"`
 			NonMigratable ComparisonDiffEntry `json:"nonMigratable"`
@@ -598,6 +689,7 @@ func NewCollectionComparisonSummaryFromModel(s models.ComparisonSummary) Collect
 			Migratable:    comparisonDiffEntry(s.Migratable),
 			NonMigratable: comparisonDiffEntry(s.NonMigratable),
 			Clusters:      comparisonDiffEntryDeltaOnly(s.Clusters),
+			Changed:       s.Changed,
 		},
 	}
 }
@@ -616,9 +708,950 @@ func NewCollectionComparisonDiffFromModel(d models.ComparisonDiff) CollectionCom
 			VmIds:     vmIds,
 		}
 	}
-	return CollectionComparisonDiff{
+	diff := CollectionComparisonDiff{
 		Dimension: CollectionComparisonDiffDimension(d.Dimension),
 		OnlyInA:   toPage(d.OnlyInA),
 		OnlyInB:   toPage(d.OnlyInB),
 	}
+	if d.Changed != nil {
+		changed := comparisonChangedPageFromModel(*d.Changed)
+		diff.Changed = &changed
+	}
+	if d.Infrastructure != nil {
+		infra := comparisonInfraPageFromModel(d.Dimension, *d.Infrastructure)
+		diff.Infrastructure = &infra
+	}
+	return diff
+}
+
+func comparisonInfraPageFromModel(dimension models.ComparisonDimension, p models.ComparisonInfraPage) ComparisonInfraPage {
+	// Only the capacity fields of the entity kind are reported.
+	toState := func(e *models.InfraEntity) *InfraEntityState {
+		if e == nil {
+			return nil
+		}
+		state := &InfraEntityState{VmCount: len(e.VMIDs)}
+		switch dimension {
+		case models.DimensionClusters:
+			state.Hosts = &e.Hosts
+			state.CpuCores = &e.CPUCores
+			state.MemoryMiB = &e.MemoryMiB
+		case models.DimensionHosts:
+			state.CpuCores = &e.CPUCores
+			state.MemoryMiB = &e.MemoryMiB
+		case models.DimensionDatastores:
+			state.CapacityMiB = &e.CapacityMiB
+			state.FreeMiB = &e.FreeMiB
+		}
+		return state
+	}
+	nonNil := func(ids []string) []string {
+		if ids == nil {
+			return []string{}
+		}
+		return ids
+	}
+
+	entities := make([]InfraEntityChange, 0, len(p.Entities))
+	for _, e := range p.Entities {
+		entities = append(entities, InfraEntityChange{
+			Name:       e.Name,
+			Change:     InfraEntityChangeChange(e.Change),
+			Before:     toState(e.Before),
+			After:      toState(e.After),
+			VmsAdded:   nonNil(e.VMsAdded),
+			VmsRemoved: nonNil(e.VMsRemoved),
+		})
+	}
+	return ComparisonInfraPage{
+		Total:     p.Total,
+		Page:      p.Page,
+		PageCount: p.PageCount,
+		Entities:  entities,
+	}
+}
+
+func comparisonChangedPageFromModel(p models.ComparisonChangedPage) ComparisonChangedPage {
+	vms := make([]VMChange, 0, len(p.VMs))
+	for _, vm := range p.VMs {
+		changes := make([]VMFieldChange, 0, len(vm.Changes))
+		for _, c := range vm.Changes {
+			changes = append(changes, VMFieldChange{Field: VMFieldChangeField(c.Field), Before: c.Before, After: c.After})
+		}
+		vms = append(vms, VMChange{VmId: vm.VMID, Name: vm.Name, Changes: changes})
+	}
+	return ComparisonChangedPage{
+		Total:     p.Total,
+		Page:      p.Page,
+		PageCount: p.PageCount,
+		Vms:       vms,
+	}
+}
+
+// NewCollectionScheduleFromModel converts a models.CollectionSchedule to the V2 API type.
+func NewCollectionScheduleFromModel(s models.CollectionSchedule) CollectionSchedule {
+	sch := CollectionSchedule{
+		Id:        s.ID,
+		Name:      s.Name,
+		CatchUp:   CollectionScheduleCatchUp(s.CatchUp),
+		Vcenter:   s.Profile,
+		Mode:      CollectionMode(s.Mode),
+		Paused:    s.Paused,
+		NextRunAt: s.NextRunAt,
+		LastRunAt: s.LastRunAt,
+		CreatedAt: s.CreatedAt,
+	}
+	if s.Cron != "" {
+		sch.Cron = &s.Cron
+	} else {
+		interval := int64(s.Interval / time.Second)
+		sch.IntervalSeconds = &interval
+	}
+	return sch
+}
+
+// NewCollectionScheduleFromAPI converts a create request to a models.CollectionSchedule.
+func NewCollectionScheduleFromAPI(req CreateCollectionScheduleRequest) models.CollectionSchedule {
+	sch := models.CollectionSchedule{Name: req.Name}
+	if req.Cron != nil {
+		sch.Cron = *req.Cron
+	}
+	if req.IntervalSeconds != nil {
+		sch.Interval = time.Duration(*req.IntervalSeconds) * time.Second
+	}
+	if req.CatchUp != nil {
+		sch.CatchUp = models.CatchUpPolicy(*req.CatchUp)
+	}
+	if req.Vcenter != nil {
+		sch.Profile = *req.Vcenter
+	}
+	if req.Mode != nil {
+		sch.Mode = models.CollectionMode(*req.Mode)
+	}
+	if req.Paused != nil {
+		sch.Paused = *req.Paused
+	}
+	return sch
+}
+
+// NewCollectionScheduleRunFromModel converts a models.ScheduleRun to the V2 API type.
+func NewCollectionScheduleRunFromModel(r models.ScheduleRun) CollectionScheduleRun {
+	run := CollectionScheduleRun{
+		Id:          r.ID,
+		ScheduledAt: r.ScheduledAt,
+		TriggeredAt: r.TriggeredAt,
+		Status:      CollectionScheduleRunStatus(r.Status),
+		CatchUp:     r.CatchUp,
+	}
+	if r.Error != "" {
+		run.Error = &r.Error
+	}
+	return run
+}
+
+// NewCredentialProfileFromModel converts a models.CredentialProfile to the V2 API type.
+func NewCredentialProfileFromModel(p models.CredentialProfile) CredentialProfile {
+	return CredentialProfile{
+		Name:     p.Name,
+		Url:      p.URL,
+		Username: p.Username,
+	}
+}
+
+// NewFilterValidationResultFromModel converts a models.FilterValidation to the V2 API type.
+func NewFilterValidationResultFromModel(v models.FilterValidation) FilterValidationResult {
+	result := FilterValidationResult{Valid: v.Valid, Issues: make([]FilterIssue, 0, len(v.Issues))}
+	for _, i := range v.Issues {
+		issue := FilterIssue{
+			Kind:    FilterIssueKind(i.Kind),
+			Offset:  i.Offset,
+			Message: i.Message,
+		}
+		if i.Field != "" {
+			issue.Field = &i.Field
+		}
+		if len(i.Suggestions) > 0 {
+			issue.Suggestions = &i.Suggestions
+		}
+		result.Issues = append(result.Issues, issue)
+	}
+	return result
+}
+
+// NewFilterExplanationFromModel converts a models.FilterExplanation to the V2 API type.
+func NewFilterExplanationFromModel(e models.FilterExplanation) FilterExplanation {
+	args := e.Args
+	if args == nil {
+		args = []any{}
+	}
+	return FilterExplanation{
+		Ast:        filterNodeFromModel(e.Tree),
+		Sql:        e.SQL,
+		Args:       args,
+		MatchedVms: e.MatchedVMs,
+	}
+}
+
+func filterNodeFromModel(n filter.Node) FilterNode {
+	node := FilterNode{Type: n.Type}
+	if n.Operator != "" {
+		node.Operator = &n.Operator
+	}
+	if n.Field != "" {
+		node.Field = &n.Field
+	}
+	if n.Value != "" {
+		node.Value = &n.Value
+	}
+	if n.Values != nil {
+		node.Values = &n.Values
+	}
+	if len(n.Children) > 0 {
+		children := make([]FilterNode, 0, len(n.Children))
+		for _, c := range n.Children {
+			children = append(children, filterNodeFromModel(c))
+		}
+		node.Children = &children
+	}
+	return node
+}
+
+// NewFilterFieldsResponse converts filter fields to the V2 API type.
+func NewFilterFieldsResponse(fields []models.FilterField) FilterFieldsResponse {
+	resp := FilterFieldsResponse{Fields: make([]FilterField, 0, len(fields))}
+	for _, f := range fields {
+		field := FilterField{
+			Name:     f.Name,
+			Type:     FilterFieldType(f.Type),
+			Units:    f.Units,
+			Examples: f.Examples,
+		}
+		if field.Units == nil {
+			field.Units = []string{}
+		}
+		if field.Examples == nil {
+			field.Examples = []string{}
+		}
+		if len(f.Aliases) > 0 {
+			field.Aliases = &f.Aliases
+		}
+		if f.Collection != "" {
+			field.Collection = &f.Collection
+		}
+		resp.Fields = append(resp.Fields, field)
+	}
+	return resp
+}
+
+// NewSavedFilterFromModel converts a models.SavedFilter to the V2 API type.
+func NewSavedFilterFromModel(f models.SavedFilter) SavedFilter {
+	return SavedFilter{
+		Name:        f.Name,
+		Description: f.Description,
+		Expression:  f.Expression,
+		Owner:       f.Owner,
+		CreatedAt:   f.CreatedAt,
+		UpdatedAt:   f.UpdatedAt,
+	}
+}
+
+// NewSavedFilterFromAPI converts a create request to a models.SavedFilter.
+func NewSavedFilterFromAPI(req CreateSavedFilterRequest) models.SavedFilter {
+	f := models.SavedFilter{Name: req.Name, Expression: req.Expression}
+	if req.Description != nil {
+		f.Description = *req.Description
+	}
+	if req.Owner != nil {
+		f.Owner = *req.Owner
+	}
+	return f
+}
+
+// NewSavedFilterUpdateFromAPI converts an update request to a models.SavedFilterUpdate.
+func NewSavedFilterUpdateFromAPI(req UpdateSavedFilterRequest) models.SavedFilterUpdate {
+	return models.SavedFilterUpdate{
+		Description: req.Description,
+		Expression:  req.Expression,
+		Owner:       req.Owner,
+	}
+}
+
+// NewLabelRuleFromModel converts a models.LabelRule to the V2 API type.
+func NewLabelRuleFromModel(r models.LabelRule) LabelRule {
+	return LabelRule{
+		Name:       r.Name,
+		Label:      r.Label,
+		Expression: r.Expression,
+		Priority:   r.Priority,
+		CreatedAt:  r.CreatedAt,
+		UpdatedAt:  r.UpdatedAt,
+	}
+}
+
+// NewLabelRuleFromAPI converts a create request to a models.LabelRule.
+func NewLabelRuleFromAPI(req CreateLabelRuleRequest) models.LabelRule {
+	r := models.LabelRule{Name: req.Name, Label: req.Label, Expression: req.Expression}
+	if req.Priority != nil {
+		r.Priority = *req.Priority
+	}
+	return r
+}
+
+// NewLabelRuleUpdateFromAPI converts an update request to a models.LabelRuleUpdate.
+func NewLabelRuleUpdateFromAPI(req UpdateLabelRuleRequest) models.LabelRuleUpdate {
+	return models.LabelRuleUpdate{
+		Label:      req.Label,
+		Expression: req.Expression,
+		Priority:   req.Priority,
+	}
+}
+
+// NewLabelFromModel converts a models.Label to the V2 API type.
+func NewLabelFromModel(l models.Label) Label {
+	return Label{
+		Name:        l.Name,
+		Color:       l.Color,
+		Description: l.Description,
+		Category:    l.Category,
+		CreatedBy:   l.CreatedBy,
+		CreatedAt:   l.CreatedAt,
+		UpdatedAt:   l.UpdatedAt,
+	}
+}
+
+// NewLabelFromAPI converts a create request to a models.Label.
+func NewLabelFromAPI(req CreateLabelRequest) models.Label {
+	l := models.Label{Name: req.Name}
+	if req.Color != nil {
+		l.Color = *req.Color
+	}
+	if req.Description != nil {
+		l.Description = *req.Description
+	}
+	if req.Category != nil {
+		l.Category = *req.Category
+	}
+	if req.CreatedBy != nil {
+		l.CreatedBy = *req.CreatedBy
+	}
+	return l
+}
+
+// NewLabelUpdateFromAPI converts an update request to a models.LabelUpdate.
+func NewLabelUpdateFromAPI(req UpdateLabelRequest) models.LabelUpdate {
+	return models.LabelUpdate{
+		Color:       req.Color,
+		Description: req.Description,
+		Category:    req.Category,
+	}
+}
+
+// NewLabelChangeFromModel converts a models.LabelChange to the V2 API type.
+func NewLabelChangeFromModel(c models.LabelChange) LabelChange {
+	return LabelChange{
+		Label:  c.Label,
+		Action: LabelChangeAction(c.Action),
+		Source: LabelChangeSource(c.Source),
+		Actor:  c.Actor,
+		At:     c.At,
+	}
+}
+
+// NewVMImportReportFromModel converts a models.VMImportReport to the V2 API type.
+func NewVMImportReportFromModel(r models.VMImportReport) VMImportReport {
+	convert := func(rows []models.VMImportRow) []VMImportRow {
+		result := make([]VMImportRow, 0, len(rows))
+		for _, row := range rows {
+			apiRow := VMImportRow{
+				Row:               row.Row,
+				Key:               row.Key,
+				VmIds:             row.VMIDs,
+				MigrationExcluded: row.MigrationExcluded,
+			}
+			if apiRow.VmIds == nil {
+				apiRow.VmIds = []string{}
+			}
+			if row.KeyType != "" {
+				keyType := VMImportRowKeyType(row.KeyType)
+				apiRow.KeyType = &keyType
+			}
+			if row.Labels != nil {
+				labels := row.Labels
+				apiRow.Labels = &labels
+			}
+			result = append(result, apiRow)
+		}
+		return result
+	}
+	return VMImportReport{
+		Applied:   r.Applied,
+		Matched:   convert(r.Matched),
+		Unmatched: convert(r.Unmatched),
+		Ambiguous: convert(r.Ambiguous),
+	}
+}
+
+// NewMigrationExclusionFromModel converts the details of a migration exclusion to the API type.
+func NewMigrationExclusionFromModel(e models.MigrationExclusion) MigrationExclusion {
+	return MigrationExclusion{
+		Reason:        MigrationExclusionReason(e.Reason),
+		Justification: e.Justification,
+		ExcludedBy:    e.ExcludedBy,
+		ExcludedAt:    e.ExcludedAt,
+		ExpiresAt:     e.ExpiresAt,
+	}
+}
+
+// NewMigrationExclusionFromAPI converts a migration exclusion request to a models.MigrationExclusion.
+func NewMigrationExclusionFromAPI(req MigrationExclusionRequest) models.MigrationExclusion {
+	e := models.MigrationExclusion{
+		Reason:    models.MigrationExclusionReason(req.Reason),
+		ExpiresAt: req.ExpiresAt,
+	}
+	if req.Justification != nil {
+		e.Justification = *req.Justification
+	}
+	if req.ExcludedBy != nil {
+		e.ExcludedBy = *req.ExcludedBy
+	}
+	return e
+}
+
+// NewWaveFromModel converts a models.Wave to the V2 API type.
+func NewWaveFromModel(w models.Wave) Wave {
+	wave := Wave{
+		Name:        w.Name,
+		Position:    w.Position,
+		WindowStart: w.WindowStart,
+		WindowEnd:   w.WindowEnd,
+		GroupIds:    make([]string, 0, len(w.GroupIDs)),
+		VmIds:       w.VMIDs,
+		CreatedAt:   w.CreatedAt,
+		UpdatedAt:   w.UpdatedAt,
+	}
+	if w.Description != "" {
+		wave.Description = &w.Description
+	}
+	if w.ForecastPair != "" {
+		wave.ForecastPair = &w.ForecastPair
+	}
+	for _, id := range w.GroupIDs {
+		wave.GroupIds = append(wave.GroupIds, id.String())
+	}
+	if wave.VmIds == nil {
+		wave.VmIds = []string{}
+	}
+	return wave
+}
+
+// NewWaveFromAPI converts a create request to a models.Wave.
+func NewWaveFromAPI(req CreateWaveRequest) (models.Wave, error) {
+	w := models.Wave{
+		Name:        req.Name,
+		WindowStart: req.WindowStart,
+		WindowEnd:   req.WindowEnd,
+	}
+	if req.Description != nil {
+		w.Description = *req.Description
+	}
+	if req.Position != nil {
+		w.Position = *req.Position
+	}
+	if req.ForecastPair != nil {
+		w.ForecastPair = *req.ForecastPair
+	}
+	if req.GroupIds != nil {
+		ids, err := parseGroupIDs(*req.GroupIds)
+		if err != nil {
+			return models.Wave{}, err
+		}
+		w.GroupIDs = ids
+	}
+	if req.VmIds != nil {
+		w.VMIDs = *req.VmIds
+	}
+	return w, nil
+}
+
+// NewWaveUpdateFromAPI converts an update request to a models.WaveUpdate.
+func NewWaveUpdateFromAPI(req UpdateWaveRequest) (models.WaveUpdate, error) {
+	update := models.WaveUpdate{
+		Description:  req.Description,
+		Position:     req.Position,
+		WindowStart:  req.WindowStart,
+		WindowEnd:    req.WindowEnd,
+		ForecastPair: req.ForecastPair,
+		VMIDs:        req.VmIds,
+	}
+	if req.GroupIds != nil {
+		ids, err := parseGroupIDs(*req.GroupIds)
+		if err != nil {
+			return models.WaveUpdate{}, err
+		}
+		update.GroupIDs = &ids
+	}
+	return update, nil
+}
+
+// NewWavePlanFromModel converts a models.WavePlan to the V2 API type.
+func NewWavePlanFromModel(p models.WavePlan) WavePlan {
+	plan := WavePlan{CollectionId: p.CollectionID, Waves: make([]WaveSummary, 0, len(p.Waves))}
+	for _, s := range p.Waves {
+		summary := WaveSummary{
+			Wave:    NewWaveFromModel(s.Wave),
+			Ready:   s.Ready(),
+			VmCount: s.VMCount,
+			DiskMB:  s.DiskMB,
+			Readiness: WaveReadiness{
+				Ready:    s.Readiness.Ready,
+				Review:   s.Readiness.Review,
+				Blocked:  s.Readiness.Blocked,
+				Excluded: s.Readiness.Excluded,
+			},
+			WindowCapacityMB: s.WindowCapacityMB,
+			Issues:           make([]WaveIssue, 0, len(s.Issues)),
+		}
+		if s.Transfer != nil {
+			summary.Transfer = &EstimateRange{
+				BestCase:  s.Transfer.BestCase.String(),
+				Expected:  s.Transfer.Expected.String(),
+				WorstCase: s.Transfer.WorstCase.String(),
+			}
+		}
+		for _, i := range s.Issues {
+			issue := WaveIssue{Kind: WaveIssueKind(i.Kind), Message: i.Message}
+			if i.VMID != "" {
+				issue.VmId = &i.VMID
+			}
+			if i.GroupID != nil {
+				groupID := i.GroupID.String()
+				issue.GroupId = &groupID
+			}
+			if i.OtherWave != "" {
+				issue.OtherWave = &i.OtherWave
+			}
+			summary.Issues = append(summary.Issues, issue)
+		}
+		plan.Waves = append(plan.Waves, summary)
+	}
+	return plan
+}
+
+// parseGroupIDs parses the group IDs of a wave request.
+func parseGroupIDs(ids []string) ([]uuid.UUID, error) {
+	out := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		gid, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid group ID %q", id)
+		}
+		out = append(out, gid)
+	}
+	return out, nil
+}
+
+// NewMTVMappingsFromModel converts models.MTVMappings to the V2 API type.
+func NewMTVMappingsFromModel(m models.MTVMappings) MTVMappings {
+	out := MTVMappings{
+		Networks: make([]MTVNetworkMapping, 0, len(m.Networks)),
+		Storage:  make([]MTVStorageMapping, 0, len(m.Storage)),
+	}
+	for _, n := range m.Networks {
+		mapping := MTVNetworkMapping{Source: n.Source, Type: MTVNetworkMappingType(n.Type)}
+		if n.Namespace != "" {
+			mapping.Namespace = &n.Namespace
+		}
+		if n.Name != "" {
+			mapping.Name = &n.Name
+		}
+		out.Networks = append(out.Networks, mapping)
+	}
+	for _, s := range m.Storage {
+		out.Storage = append(out.Storage, MTVStorageMapping{Source: s.Source, StorageClass: s.StorageClass})
+	}
+	return out
+}
+
+// NewMTVMappingsFromAPI converts MTV mapping tables to models.MTVMappings.
+func NewMTVMappingsFromAPI(m MTVMappings) models.MTVMappings {
+	out := models.MTVMappings{
+		Networks: make([]models.MTVNetworkMapping, 0, len(m.Networks)),
+		Storage:  make([]models.MTVStorageMapping, 0, len(m.Storage)),
+	}
+	for _, n := range m.Networks {
+		mapping := models.MTVNetworkMapping{Source: n.Source, Type: models.MTVNetworkType(n.Type)}
+		if n.Namespace != nil {
+			mapping.Namespace = *n.Namespace
+		}
+		if n.Name != nil {
+			mapping.Name = *n.Name
+		}
+		out.Networks = append(out.Networks, mapping)
+	}
+	for _, s := range m.Storage {
+		out.Storage = append(out.Storage, models.MTVStorageMapping{Source: s.Source, StorageClass: s.StorageClass})
+	}
+	return out
+}
+
+// NewMTVPlanOptionsFromAPI converts the query parameters of the MTV plan endpoint to models.MTVPlanOptions.
+func NewMTVPlanOptionsFromAPI(params GetLatestGroupMTVPlanParams) models.MTVPlanOptions {
+	var opts models.MTVPlanOptions
+	if params.Namespace != nil {
+		opts.Namespace = *params.Namespace
+	}
+	if params.TargetNamespace != nil {
+		opts.TargetNamespace = *params.TargetNamespace
+	}
+	if params.SourceProvider != nil {
+		opts.SourceProvider = *params.SourceProvider
+	}
+	if params.DestinationProvider != nil {
+		opts.DestinationProvider = *params.DestinationProvider
+	}
+	if params.Vcenter != nil {
+		opts.VCenter = *params.Vcenter
+	}
+	return opts
+}
+
+// NewSizingRequestFromAPI converts a sizing request to models.SizingRequest.
+func NewSizingRequestFromAPI(req SizingRequest) models.SizingRequest {
+	out := models.SizingRequest{
+		GroupID:     req.GroupId,
+		HeadroomPct: req.HeadroomPct,
+		Profiles:    make([]models.NodeProfile, 0, len(req.Profiles)),
+	}
+	if req.Source != nil {
+		out.Source = models.SizingSource(*req.Source)
+	}
+	if req.Vcenter != nil {
+		out.VCenter = *req.Vcenter
+	}
+	for _, p := range req.Profiles {
+		profile := models.NodeProfile{Name: p.Name, Cores: p.Cores, MemoryMB: p.MemoryMB}
+		if p.CpuOvercommit != nil {
+			profile.CPUOvercommit = *p.CpuOvercommit
+		}
+		if p.MemoryOvercommit != nil {
+			profile.MemoryOvercommit = *p.MemoryOvercommit
+		}
+		if p.ReservedCores != nil {
+			profile.ReservedCores = *p.ReservedCores
+		}
+		if p.ReservedMemoryMB != nil {
+			profile.ReservedMemoryMB = *p.ReservedMemoryMB
+		}
+		out.Profiles = append(out.Profiles, profile)
+	}
+	return out
+}
+
+// NewSizingResultFromModel converts a models.SizingResult to the API type.
+func NewSizingResultFromModel(r models.SizingResult) SizingResult {
+	placements := func(vms []models.SizingPlacement) []SizingPlacement {
+		out := make([]SizingPlacement, 0, len(vms))
+		for _, vm := range vms {
+			out = append(out, SizingPlacement{VmId: vm.VMID, Name: vm.Name, Cpu: vm.CPU, MemoryMB: vm.MemoryMB})
+		}
+		return out
+	}
+
+	out := SizingResult{
+		Id:                       r.ID,
+		GroupId:                  r.GroupID,
+		Source:                   SizingSource(r.Source),
+		HeadroomPct:              r.HeadroomPct,
+		VmCount:                  r.VMCount,
+		ProvisionedFallbackCount: r.ProvisionedFallbackCount,
+		Profiles:                 make([]SizingProfileResult, 0, len(r.Profiles)),
+		CreatedAt:                r.CreatedAt,
+	}
+	for _, pr := range r.Profiles {
+		p := pr.Profile
+		result := SizingProfileResult{
+			Profile: NodeProfile{
+				Name:             p.Name,
+				Cores:            p.Cores,
+				MemoryMB:         p.MemoryMB,
+				CpuOvercommit:    &p.CPUOvercommit,
+				MemoryOvercommit: &p.MemoryOvercommit,
+				ReservedCores:    &p.ReservedCores,
+				ReservedMemoryMB: &p.ReservedMemoryMB,
+			},
+			AllocatableCpu:      p.AllocatableCPU(),
+			AllocatableMemoryMB: p.AllocatableMemoryMB(),
+			NodeCount:           pr.NodeCount,
+			Nodes:               make([]SizingNode, 0, len(pr.Nodes)),
+			Unplaced:            placements(pr.Unplaced),
+			StrandedCpu:         pr.StrandedCPU,
+			StrandedMemoryMB:    pr.StrandedMemoryMB,
+		}
+		for _, n := range pr.Nodes {
+			result.Nodes = append(result.Nodes, SizingNode{
+				Index:            n.Index,
+				Vms:              placements(n.VMs),
+				UsedCpu:          n.UsedCPU,
+				UsedMemoryMB:     n.UsedMemoryMB,
+				StrandedCpu:      n.StrandedCPU,
+				StrandedMemoryMB: n.StrandedMemoryMB,
+			})
+		}
+		out.Profiles = append(out.Profiles, result)
+	}
+	return out
+}
+
+// NewRightsizingPolicyFromAPI converts an API RightsizingPolicy to the model.
+func NewRightsizingPolicyFromAPI(p RightsizingPolicy) models.RightsizingPolicy {
+	out := models.RightsizingPolicy{
+		Name:        p.Name,
+		Percentile:  string(p.Percentile),
+		HeadroomPct: p.HeadroomPct,
+	}
+	if p.Description != nil {
+		out.Description = *p.Description
+	}
+	if p.MinCpus != nil {
+		out.MinCPUs = *p.MinCpus
+	}
+	if p.MaxCpus != nil {
+		out.MaxCPUs = *p.MaxCpus
+	}
+	if p.MinMemoryMB != nil {
+		out.MinMemoryMB = *p.MinMemoryMB
+	}
+	if p.MaxMemoryMB != nil {
+		out.MaxMemoryMB = *p.MaxMemoryMB
+	}
+	if p.RoundToSockets != nil {
+		out.RoundToSockets = *p.RoundToSockets
+	}
+	if p.MemoryStepMB != nil {
+		out.MemoryStepMB = *p.MemoryStepMB
+	}
+	return out
+}
+
+// NewRightsizingPolicyFromModel converts a models.RightsizingPolicy to the API type. The
+// built-in default policy has no timestamps.
+func NewRightsizingPolicyFromModel(p models.RightsizingPolicy) RightsizingPolicy {
+	out := RightsizingPolicy{
+		Name:           p.Name,
+		Description:    &p.Description,
+		Percentile:     RightsizingPolicyPercentile(p.Percentile),
+		HeadroomPct:    p.HeadroomPct,
+		MinCpus:        &p.MinCPUs,
+		MaxCpus:        &p.MaxCPUs,
+		MinMemoryMB:    &p.MinMemoryMB,
+		MaxMemoryMB:    &p.MaxMemoryMB,
+		RoundToSockets: &p.RoundToSockets,
+		MemoryStepMB:   &p.MemoryStepMB,
+	}
+	if !p.CreatedAt.IsZero() {
+		out.CreatedAt = &p.CreatedAt
+		out.UpdatedAt = &p.UpdatedAt
+	}
+	return out
+}
+
+// NewRightsizingRecommendationFromModel converts a models.RightsizingRecommendation to the API type.
+func NewRightsizingRecommendationFromModel(r models.RightsizingRecommendation) RightsizingRecommendation {
+	return RightsizingRecommendation{
+		VmId:                r.VMID,
+		Name:                r.Name,
+		Cluster:             r.Cluster,
+		ProvisionedCpus:     r.ProvisionedCPUs,
+		RecommendedCpus:     r.RecommendedCPUs,
+		SavedCpus:           r.SavedCPUs,
+		ProvisionedMemoryMB: r.ProvisionedMemoryMB,
+		RecommendedMemoryMB: r.RecommendedMemoryMB,
+		SavedMemoryMB:       r.SavedMemoryMB,
+		ConfidencePct:       r.ConfidencePct,
+		Confidence:          RightsizingRecommendationConfidence(r.Confidence),
+	}
+}
+
+func newRightsizingRecommendationSummaryFromModel(s models.RightsizingRecommendationSummary) RightsizingRecommendationSummary {
+	return RightsizingRecommendationSummary{
+		VmCount:             s.VMCount,
+		ProvisionedCpus:     s.ProvisionedCPUs,
+		RecommendedCpus:     s.RecommendedCPUs,
+		SavedCpus:           s.SavedCPUs,
+		ProvisionedMemoryMB: s.ProvisionedMemoryMB,
+		RecommendedMemoryMB: s.RecommendedMemoryMB,
+		SavedMemoryMB:       s.SavedMemoryMB,
+		HighConfidence:      s.HighConfidence,
+		MediumConfidence:    s.MediumConfidence,
+		LowConfidence:       s.LowConfidence,
+	}
+}
+
+// NewRightsizingRecommendationsFromModel converts a models.RightsizingRecommendations to the API type.
+func NewRightsizingRecommendationsFromModel(r models.RightsizingRecommendations) RightsizingRecommendations {
+	out := RightsizingRecommendations{
+		ReportId: r.ReportID,
+		Policy:   NewRightsizingPolicyFromModel(r.Policy),
+		Vms:      make([]RightsizingRecommendation, 0, len(r.VMs)),
+		Summary:  newRightsizingRecommendationSummaryFromModel(r.Summary),
+	}
+	for _, vm := range r.VMs {
+		out.Vms = append(out.Vms, NewRightsizingRecommendationFromModel(vm))
+	}
+	return out
+}
+
+// NewRightsizingClusterRecommendationsListFromModel converts per-cluster recommendation totals
+// to the API type.
+func NewRightsizingClusterRecommendationsListFromModel(reportID string, policy models.RightsizingPolicy, clusters []models.RightsizingClusterRecommendations) RightsizingClusterRecommendationsList {
+	out := RightsizingClusterRecommendationsList{
+		ReportId: reportID,
+		Policy:   NewRightsizingPolicyFromModel(policy),
+		Clusters: make([]RightsizingClusterRecommendations, 0, len(clusters)),
+	}
+	for _, c := range clusters {
+		out.Clusters = append(out.Clusters, RightsizingClusterRecommendations{
+			Cluster: c.Cluster,
+			Summary: newRightsizingRecommendationSummaryFromModel(c.Summary),
+		})
+	}
+	return out
+}
+
+// NewRightsizingAccumulationFromAPI converts an API RightsizingAccumulation to the model.
+func NewRightsizingAccumulationFromAPI(a RightsizingAccumulation) models.RightsizingAccumulation {
+	return models.RightsizingAccumulation{
+		Enabled:            a.Enabled,
+		Interval:           time.Duration(a.IntervalSeconds) * time.Second,
+		RawRetention:       time.Duration(a.RawRetentionSeconds) * time.Second,
+		DownsampleInterval: time.Duration(a.DownsampleIntervalSeconds) * time.Second,
+		Downsample:         models.DownsampleFunc(a.Downsample),
+		Retention:          time.Duration(a.RetentionSeconds) * time.Second,
+	}
+}
+
+// NewRightsizingAccumulationStatusFromModel converts a models.RightsizingAccumulationStatus to the API type.
+func NewRightsizingAccumulationStatusFromModel(s models.RightsizingAccumulationStatus) RightsizingAccumulationStatus {
+	out := RightsizingAccumulationStatus{
+		Enabled:                   s.Enabled,
+		IntervalSeconds:           int64(s.Interval / time.Second),
+		RawRetentionSeconds:       int64(s.RawRetention / time.Second),
+		DownsampleIntervalSeconds: int64(s.DownsampleInterval / time.Second),
+		Downsample:                RightsizingAccumulationStatusDownsample(s.Downsample),
+		RetentionSeconds:          int64(s.Retention / time.Second),
+		LastRunAt:                 s.LastRunAt,
+		LastSampleCount:           s.LastSampleCount,
+		SampleCount:               s.SampleCount,
+		OldestSampleAt:            s.OldestSampleAt,
+		NewestSampleAt:            s.NewestSampleAt,
+	}
+	if s.LastError != "" {
+		out.LastError = &s.LastError
+	}
+	return out
+}
+
+// NewRightsizingReportSummaryFromModel converts a models.RightsizingReportSummary to the API type.
+func NewRightsizingReportSummaryFromModel(r models.RightsizingReportSummary) RightsizingReportSummary {
+	return RightsizingReportSummary{
+		Id:                  r.ID,
+		Vcenter:             r.VCenter,
+		WindowStart:         r.WindowStart,
+		WindowEnd:           r.WindowEnd,
+		IntervalId:          r.IntervalID,
+		ExpectedSampleCount: r.ExpectedSampleCount,
+		CreatedAt:           r.CreatedAt,
+	}
+}
+
+// NewVmUtilizationSeriesFromModel converts a models.VmUtilizationSeries to the API type.
+func NewVmUtilizationSeriesFromModel(s models.VmUtilizationSeries) VmUtilizationSeries {
+	out := VmUtilizationSeries{
+		ReportId:      s.ReportID,
+		Moid:          s.MOID,
+		IntervalId:    s.IntervalID,
+		BucketSeconds: int(s.Bucket / time.Second),
+		WindowStart:   s.WindowStart,
+		WindowEnd:     s.WindowEnd,
+		Metrics:       make([]RightsizingMetricSeries, 0, len(s.Metrics)),
+	}
+	for _, m := range s.Metrics {
+		series := RightsizingMetricSeries{
+			MetricKey: m.MetricKey,
+			Points:    make([]RightsizingSeriesPoint, 0, len(m.Points)),
+		}
+		for _, p := range m.Points {
+			series.Points = append(series.Points, RightsizingSeriesPoint{
+				Timestamp:   p.Timestamp,
+				SampleCount: p.SampleCount,
+				Average:     p.Average,
+				Min:         p.Min,
+				Max:         p.Max,
+			})
+		}
+		out.Metrics = append(out.Metrics, series)
+	}
+	return out
+}
+
+// NewWasteThresholdsFromAPI converts an API WasteThresholds to the model.
+func NewWasteThresholdsFromAPI(t WasteThresholds) models.WasteThresholds {
+	return models.WasteThresholds{
+		IdleCPUP95Pct:     t.IdleCpuP95Pct,
+		IdleNetP95KBps:    t.IdleNetP95Kbps,
+		PoweredOffDays:    t.PoweredOffDays,
+		OrphanedIOPSP95:   t.OrphanedIopsP95,
+		OversizedSavedPct: t.OversizedSavedPct,
+	}
+}
+
+// NewWasteThresholdsFromModel converts a models.WasteThresholds to the API type.
+func NewWasteThresholdsFromModel(t models.WasteThresholds) WasteThresholds {
+	return WasteThresholds{
+		IdleCpuP95Pct:     t.IdleCPUP95Pct,
+		IdleNetP95Kbps:    t.IdleNetP95KBps,
+		PoweredOffDays:    t.PoweredOffDays,
+		OrphanedIopsP95:   t.OrphanedIOPSP95,
+		OversizedSavedPct: t.OversizedSavedPct,
+		UpdatedAt:         t.UpdatedAt,
+	}
+}
+
+// NewWasteAnalysisFromModel converts a models.WasteAnalysis to the API type.
+func NewWasteAnalysisFromModel(a models.WasteAnalysis) WasteAnalysis {
+	out := WasteAnalysis{
+		Thresholds:        NewWasteThresholdsFromModel(a.Thresholds),
+		AnalyzedAt:        a.AnalyzedAt,
+		Vms:               make([]VMWaste, 0, len(a.VMs)),
+		Summary:           make([]WasteClassSummary, 0, len(a.Summary)),
+		PoweredOffUnknown: a.PoweredOffUnknown,
+	}
+	if a.ReportID != "" {
+		out.ReportId = &a.ReportID
+	}
+	for _, vm := range a.VMs {
+		evidence := vm.Evidence
+		if evidence == nil {
+			evidence = map[string]interface{}{}
+		}
+		out.Vms = append(out.Vms, VMWaste{
+			VmId:                vm.VMID,
+			Name:                vm.Name,
+			Cluster:             vm.Cluster,
+			PowerState:          vm.PowerState,
+			ProvisionedCpus:     vm.ProvisionedCPUs,
+			ProvisionedMemoryMb: vm.ProvisionedMemoryMB,
+			Class:               VMWasteClass(vm.Class),
+			Evidence:            evidence,
+		})
+	}
+	for _, s := range a.Summary {
+		out.Summary = append(out.Summary, WasteClassSummary{
+			Class:               WasteClassSummaryClass(s.Class),
+			VmCount:             s.VMCount,
+			ProvisionedCpus:     s.ProvisionedCPUs,
+			ProvisionedMemoryMb: s.ProvisionedMemoryMB,
+		})
+	}
+	return out
 }
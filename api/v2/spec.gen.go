@@ -29,16 +29,49 @@ type ServerInterface interface {
 	SetAgentMode(c *gin.Context)
 	// List all collections
 	// (GET /collections)
-	ListCollections(c *gin.Context)
+	ListCollections(c *gin.Context, params ListCollectionsParams)
 	// Compare two collections — returns aggregates and diffs
 	// (GET /collections/compare/{aId}/{bId})
-	CompareCollections(c *gin.Context, aId string, bId string)
+	CompareCollections(c *gin.Context, aId string, bId string, params CompareCollectionsParams)
 	// Drill down — VM IDs that differ between two collections for a given dimension
 	// (GET /collections/compare/{aId}/{bId}/{dimension})
 	CompareCollectionsDiff(c *gin.Context, aId string, bId string, dimension CompareCollectionsDiffParamsDimension, params CompareCollectionsDiffParams)
+	// Import a collection bundle exported by an agent
+	// (POST /collections/import)
+	ImportCollectionBundle(c *gin.Context)
+	// List recurring collection schedules
+	// (GET /collections/schedules)
+	ListCollectionSchedules(c *gin.Context)
+	// Create a recurring collection schedule
+	// (POST /collections/schedules)
+	CreateCollectionSchedule(c *gin.Context)
+	// Delete a collection schedule and its run history
+	// (DELETE /collections/schedules/{scheduleId})
+	DeleteCollectionSchedule(c *gin.Context, scheduleId string)
+	// Get a collection schedule
+	// (GET /collections/schedules/{scheduleId})
+	GetCollectionSchedule(c *gin.Context, scheduleId string)
+	// Pause or resume a collection schedule
+	// (PATCH /collections/schedules/{scheduleId})
+	UpdateCollectionSchedule(c *gin.Context, scheduleId string)
+	// List the run history of a collection schedule
+	// (GET /collections/schedules/{scheduleId}/runs)
+	ListCollectionScheduleRuns(c *gin.Context, scheduleId string)
+	// Readiness metrics of every collection as a time series
+	// (GET /collections/trends)
+	GetCollectionTrends(c *gin.Context, params GetCollectionTrendsParams)
+	// Delete a collection and its database file
+	// (DELETE /collections/{id})
+	DeleteCollection(c *gin.Context, id string)
+	// Rename, annotate, pin or unpin a collection
+	// (PATCH /collections/{id})
+	UpdateCollection(c *gin.Context, id string)
 	// List detected applications in a collection
 	// (GET /collections/{id}/applications)
 	ListApplications(c *gin.Context, id string)
+	// Export a collection as a signed bundle
+	// (GET /collections/{id}/bundle)
+	ExportCollectionBundle(c *gin.Context, id string)
 	// Get latest cluster utilization by cluster ID
 	// (GET /collections/{id}/clusters/{clusterId}/utilization)
 	GetClusterUtilization(c *gin.Context, id string, clusterId string)
@@ -69,6 +102,9 @@ type ServerInterface interface {
 	// Get utilization breakdown for a specific VM
 	// (GET /collections/{id}/virtualmachines/{vmId}/utilization)
 	GetVMUtilization(c *gin.Context, id string, vmId string)
+	// Get the metric time series of a VM
+	// (GET /collections/{id}/virtualmachines/{vmId}/utilization/series)
+	GetVMUtilizationSeries(c *gin.Context, id string, vmId string, params GetVMUtilizationSeriesParams)
 	// Stop collector
 	// (DELETE /collector)
 	StopCollector(c *gin.Context)
@@ -77,7 +113,7 @@ type ServerInterface interface {
 	GetCollectorStatus(c *gin.Context)
 	// Start a collection
 	// (POST /collector)
-	StartCollector(c *gin.Context)
+	StartCollector(c *gin.Context, params StartCollectorParams)
 	// Start a collection from RVTools files
 	// (POST /collector/rvtools)
 	StartRvtoolsCollector(c *gin.Context)
@@ -93,6 +129,42 @@ type ServerInterface interface {
 	// Check vSphere operation capabilities
 	// (GET /credentials/capabilities)
 	GetCredentialCapabilities(c *gin.Context)
+	// List credential profiles
+	// (GET /credentials/profiles)
+	ListCredentialProfiles(c *gin.Context)
+	// Delete a credential profile
+	// (DELETE /credentials/profiles/{name})
+	DeleteCredentialProfile(c *gin.Context, name string)
+	// Get a credential profile
+	// (GET /credentials/profiles/{name})
+	GetCredentialProfile(c *gin.Context, name string)
+	// Store credentials of a named profile
+	// (PUT /credentials/profiles/{name})
+	PutCredentialProfile(c *gin.Context, name string)
+	// Explain a VM filter expression
+	// (POST /filters/explain)
+	ExplainFilter(c *gin.Context)
+	// List the fields of the VM filter DSL
+	// (GET /filters/fields)
+	GetFilterFields(c *gin.Context)
+	// List saved filters
+	// (GET /filters/saved)
+	ListSavedFilters(c *gin.Context)
+	// Save a named VM filter expression
+	// (POST /filters/saved)
+	CreateSavedFilter(c *gin.Context)
+	// Delete a saved filter
+	// (DELETE /filters/saved/{name})
+	DeleteSavedFilter(c *gin.Context, name string)
+	// Get a saved filter
+	// (GET /filters/saved/{name})
+	GetSavedFilter(c *gin.Context, name string)
+	// Update a saved filter
+	// (PATCH /filters/saved/{name})
+	UpdateSavedFilter(c *gin.Context, name string)
+	// Validate a VM filter expression
+	// (POST /filters/validate)
+	ValidateFilter(c *gin.Context)
 	// Cancel benchmark
 	// (DELETE /forecaster)
 	StopForecaster(c *gin.Context)
@@ -135,6 +207,9 @@ type ServerInterface interface {
 	// Update group in the latest collection
 	// (PATCH /groups/{groupId})
 	UpdateLatestGroup(c *gin.Context, groupId string)
+	// Generate the Forklift/MTV manifests migrating the VMs of a group
+	// (GET /groups/{groupId}/mtv-plan)
+	GetLatestGroupMTVPlan(c *gin.Context, groupId string, params GetLatestGroupMTVPlanParams)
 	// Stop inspector
 	// (DELETE /inspector)
 	StopInspection(c *gin.Context)
@@ -152,7 +227,82 @@ type ServerInterface interface {
 	PutInspectorVddk(c *gin.Context)
 	// Get inventory from the latest collection
 	// (GET /inventory)
-	GetLatestInventory(c *gin.Context)
+	GetLatestInventory(c *gin.Context, params GetLatestInventoryParams)
+	// List label rules
+	// (GET /label-rules)
+	ListLabelRules(c *gin.Context)
+	// Create a rule labeling the VMs matching a filter expression
+	// (POST /label-rules)
+	CreateLabelRule(c *gin.Context)
+	// Delete a label rule
+	// (DELETE /label-rules/{name})
+	DeleteLabelRule(c *gin.Context, name string)
+	// Get a label rule
+	// (GET /label-rules/{name})
+	GetLabelRule(c *gin.Context, name string)
+	// Update a label rule
+	// (PATCH /label-rules/{name})
+	UpdateLabelRule(c *gin.Context, name string)
+	// List label definitions
+	// (GET /labels)
+	ListLabels(c *gin.Context)
+	// Define a label
+	// (POST /labels)
+	CreateLabel(c *gin.Context)
+	// Delete a label definition
+	// (DELETE /labels/{name})
+	DeleteLabel(c *gin.Context, name string)
+	// Get a label definition
+	// (GET /labels/{name})
+	GetLabel(c *gin.Context, name string)
+	// Update a label definition
+	// (PATCH /labels/{name})
+	UpdateLabel(c *gin.Context, name string)
+	// Get the network and datastore mapping tables used to generate MTV manifests
+	// (GET /mtv/mappings)
+	GetMTVMappings(c *gin.Context)
+	// Replace the network and datastore mapping tables used to generate MTV manifests
+	// (PUT /mtv/mappings)
+	ReplaceMTVMappings(c *gin.Context)
+	// Get the rolling accumulation of rightsizing samples
+	// (GET /rightsizing/accumulation)
+	GetRightsizingAccumulation(c *gin.Context)
+	// Configure the rolling accumulation of rightsizing samples
+	// (PUT /rightsizing/accumulation)
+	UpdateRightsizingAccumulation(c *gin.Context)
+	// Compute a rightsizing report from the accumulated samples
+	// (POST /rightsizing/accumulation/reports)
+	CreateAccumulatedRightsizingReport(c *gin.Context)
+	// Pull and accumulate rightsizing samples now
+	// (POST /rightsizing/accumulation/run)
+	RunRightsizingAccumulation(c *gin.Context)
+	// List rightsizing policies
+	// (GET /rightsizing/policies)
+	ListRightsizingPolicies(c *gin.Context)
+	// Create a rightsizing policy
+	// (POST /rightsizing/policies)
+	CreateRightsizingPolicy(c *gin.Context)
+	// Delete a rightsizing policy
+	// (DELETE /rightsizing/policies/{name})
+	DeleteRightsizingPolicy(c *gin.Context, name string)
+	// Get a rightsizing policy
+	// (GET /rightsizing/policies/{name})
+	GetRightsizingPolicy(c *gin.Context, name string)
+	// Replace a rightsizing policy
+	// (PUT /rightsizing/policies/{name})
+	UpdateRightsizingPolicy(c *gin.Context, name string)
+	// Recommend VM sizes from the latest rightsizing report
+	// (GET /rightsizing/recommendations)
+	ListLatestRecommendations(c *gin.Context, params ListLatestRecommendationsParams)
+	// Total VM recommendations per cluster
+	// (GET /rightsizing/recommendations/clusters)
+	ListLatestClusterRecommendations(c *gin.Context, params ListLatestClusterRecommendationsParams)
+	// Get the latest target cluster sizing simulation
+	// (GET /sizing)
+	GetLatestSizing(c *gin.Context, params GetLatestSizingParams)
+	// Simulate the target cluster sizing of the latest collection
+	// (POST /sizing)
+	RunSizing(c *gin.Context)
 	// Get agent version information
 	// (GET /version)
 	GetVersion(c *gin.Context)
@@ -162,15 +312,27 @@ type ServerInterface interface {
 	// Batch update migration exclusion for multiple VMs in the latest collection
 	// (POST /virtualmachines/batch-update-exclusion)
 	BatchUpdateLatestVMExclusion(c *gin.Context)
+	// Export the migration exclusion of the VMs of the latest collection
+	// (GET /virtualmachines/batch-update-exclusion/export)
+	ExportLatestVMExclusion(c *gin.Context, params ExportLatestVMExclusionParams)
+	// Set the migration exclusion of the VMs of the latest collection from a CSV or XLSX file
+	// (POST /virtualmachines/batch-update-exclusion/import)
+	ImportLatestVMExclusion(c *gin.Context, params ImportLatestVMExclusionParams)
 	// Get available filter option values from the latest collection
 	// (GET /virtualmachines/filter-options)
 	GetLatestVMFilterOptions(c *gin.Context)
 	// Get all distinct labels with counts from the latest collection
 	// (GET /virtualmachines/labels)
 	GetLatestVMLabels(c *gin.Context)
+	// Export the labels of the VMs of the latest collection
+	// (GET /virtualmachines/labels/export)
+	ExportLatestVMLabels(c *gin.Context, params ExportLatestVMLabelsParams)
+	// Add the labels of a CSV or XLSX file to the VMs of the latest collection
+	// (POST /virtualmachines/labels/import)
+	ImportLatestVMLabels(c *gin.Context, params ImportLatestVMLabelsParams)
 	// Remove a label from all VMs in the latest collection
 	// (DELETE /virtualmachines/labels/{label})
-	DeleteLatestLabelGlobally(c *gin.Context, label string)
+	DeleteLatestLabelGlobally(c *gin.Context, label string, params DeleteLatestLabelGloballyParams)
 	// Add or remove a label from multiple VMs in the latest collection
 	// (PATCH /virtualmachines/labels/{label})
 	UpdateLatestLabelVMs(c *gin.Context, label string)
@@ -180,9 +342,48 @@ type ServerInterface interface {
 	// Update VirtualMachine properties in the latest collection
 	// (PATCH /virtualmachines/{vmId})
 	UpdateLatestVirtualMachine(c *gin.Context, vmId string)
+	// Get the label history of a VM from the latest collection
+	// (GET /virtualmachines/{vmId}/labels/history)
+	GetLatestVMLabelHistory(c *gin.Context, vmId string)
+	// Recommend the size of a VM from the latest rightsizing report
+	// (GET /virtualmachines/{vmId}/recommendation)
+	GetLatestVMRecommendation(c *gin.Context, vmId string, params GetLatestVMRecommendationParams)
 	// Get utilization breakdown for a specific VM from the latest collection
 	// (GET /virtualmachines/{vmId}/utilization)
 	GetLatestVMUtilization(c *gin.Context, vmId string)
+	// Get the metric time series of a VM from the latest collection
+	// (GET /virtualmachines/{vmId}/utilization/series)
+	GetLatestVMUtilizationSeries(c *gin.Context, vmId string, params GetLatestVMUtilizationSeriesParams)
+	// Get the waste analysis of the latest collection
+	// (GET /waste)
+	GetLatestWasteAnalysis(c *gin.Context, params GetLatestWasteAnalysisParams)
+	// Analyze the VMs of the latest collection for waste
+	// (POST /waste)
+	AnalyzeWaste(c *gin.Context, params AnalyzeWasteParams)
+	// Get the thresholds of the waste analysis
+	// (GET /waste/thresholds)
+	GetWasteThresholds(c *gin.Context)
+	// Configure the thresholds of the waste analysis
+	// (PUT /waste/thresholds)
+	UpdateWasteThresholds(c *gin.Context)
+	// List migration waves
+	// (GET /waves)
+	ListWaves(c *gin.Context)
+	// Create a migration wave
+	// (POST /waves)
+	CreateWave(c *gin.Context)
+	// Plan the migration waves against the latest collection
+	// (GET /waves/plan)
+	GetWavePlan(c *gin.Context, params GetWavePlanParams)
+	// Delete a migration wave
+	// (DELETE /waves/{name})
+	DeleteWave(c *gin.Context, name string)
+	// Get a migration wave
+	// (GET /waves/{name})
+	GetWave(c *gin.Context, name string)
+	// Update a migration wave
+	// (PATCH /waves/{name})
+	UpdateWave(c *gin.Context, name string)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -223,6 +424,19 @@ func (siw *ServerInterfaceWrapper) SetAgentMode(c *gin.Context) {
 // ListCollections operation middleware
 func (siw *ServerInterfaceWrapper) ListCollections(c *gin.Context) {
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCollectionsParams
+
+	// ------------- Optional query parameter "vcenter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "vcenter", c.Request.URL.Query(), &params.Vcenter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vcenter: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -230,7 +444,7 @@ func (siw *ServerInterfaceWrapper) ListCollections(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListCollections(c)
+	siw.Handler.ListCollections(c, params)
 }
 
 // CompareCollections operation middleware
@@ -256,6 +470,17 @@ func (siw *ServerInterfaceWrapper) CompareCollections(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CompareCollectionsParams
+
+	// ------------- Optional query parameter "byExpression" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "byExpression", c.Request.URL.Query(), &params.ByExpression)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter byExpression: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -263,7 +488,7 @@ func (siw *ServerInterfaceWrapper) CompareCollections(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CompareCollections(c, aId, bId)
+	siw.Handler.CompareCollections(c, aId, bId, params)
 }
 
 // CompareCollectionsDiff operation middleware
@@ -317,6 +542,14 @@ func (siw *ServerInterfaceWrapper) CompareCollectionsDiff(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "byExpression" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "byExpression", c.Request.URL.Query(), &params.ByExpression)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter byExpression: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -327,6 +560,231 @@ func (siw *ServerInterfaceWrapper) CompareCollectionsDiff(c *gin.Context) {
 	siw.Handler.CompareCollectionsDiff(c, aId, bId, dimension, params)
 }
 
+// ImportCollectionBundle operation middleware
+func (siw *ServerInterfaceWrapper) ImportCollectionBundle(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ImportCollectionBundle(c)
+}
+
+// ListCollectionSchedules operation middleware
+func (siw *ServerInterfaceWrapper) ListCollectionSchedules(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListCollectionSchedules(c)
+}
+
+// CreateCollectionSchedule operation middleware
+func (siw *ServerInterfaceWrapper) CreateCollectionSchedule(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateCollectionSchedule(c)
+}
+
+// DeleteCollectionSchedule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCollectionSchedule(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", c.Param("scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scheduleId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteCollectionSchedule(c, scheduleId)
+}
+
+// GetCollectionSchedule operation middleware
+func (siw *ServerInterfaceWrapper) GetCollectionSchedule(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", c.Param("scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scheduleId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCollectionSchedule(c, scheduleId)
+}
+
+// UpdateCollectionSchedule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCollectionSchedule(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", c.Param("scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scheduleId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateCollectionSchedule(c, scheduleId)
+}
+
+// ListCollectionScheduleRuns operation middleware
+func (siw *ServerInterfaceWrapper) ListCollectionScheduleRuns(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", c.Param("scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scheduleId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListCollectionScheduleRuns(c, scheduleId)
+}
+
+// GetCollectionTrends operation middleware
+func (siw *ServerInterfaceWrapper) GetCollectionTrends(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCollectionTrendsParams
+
+	// ------------- Optional query parameter "vcenter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "vcenter", c.Request.URL.Query(), &params.Vcenter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vcenter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "byExpression" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "byExpression", c.Request.URL.Query(), &params.ByExpression)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter byExpression: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "groupId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "groupId", c.Request.URL.Query(), &params.GroupId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter groupId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCollectionTrends(c, params)
+}
+
+// DeleteCollection operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCollection(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteCollection(c, id)
+}
+
+// UpdateCollection operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCollection(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateCollection(c, id)
+}
+
 // ListApplications operation middleware
 func (siw *ServerInterfaceWrapper) ListApplications(c *gin.Context) {
 
@@ -351,6 +809,30 @@ func (siw *ServerInterfaceWrapper) ListApplications(c *gin.Context) {
 	siw.Handler.ListApplications(c, id)
 }
 
+// ExportCollectionBundle operation middleware
+func (siw *ServerInterfaceWrapper) ExportCollectionBundle(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ExportCollectionBundle(c, id)
+}
+
 // GetClusterUtilization operation middleware
 func (siw *ServerInterfaceWrapper) GetClusterUtilization(c *gin.Context) {
 
@@ -417,6 +899,14 @@ func (siw *ServerInterfaceWrapper) ExportCollection(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "byExpression" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "byExpression", c.Request.URL.Query(), &params.ByExpression)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter byExpression: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -735,47 +1225,55 @@ func (siw *ServerInterfaceWrapper) GetVMUtilization(c *gin.Context) {
 	siw.Handler.GetVMUtilization(c, id, vmId)
 }
 
-// StopCollector operation middleware
-func (siw *ServerInterfaceWrapper) StopCollector(c *gin.Context) {
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+// GetVMUtilizationSeries operation middleware
+func (siw *ServerInterfaceWrapper) GetVMUtilizationSeries(c *gin.Context) {
 
-	siw.Handler.StopCollector(c)
-}
+	var err error
 
-// GetCollectorStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetCollectorStatus(c *gin.Context) {
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	siw.Handler.GetCollectorStatus(c)
-}
+	// ------------- Path parameter "vmId" -------------
+	var vmId string
 
-// StartCollector operation middleware
-func (siw *ServerInterfaceWrapper) StartCollector(c *gin.Context) {
+	err = runtime.BindStyledParameterWithOptions("simple", "vmId", c.Param("vmId"), &vmId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vmId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVMUtilizationSeriesParams
+
+	// ------------- Optional query parameter "reportId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reportId", c.Request.URL.Query(), &params.ReportId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter reportId: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	siw.Handler.StartCollector(c)
-}
+	// ------------- Optional query parameter "bucketSeconds" -------------
 
-// StartRvtoolsCollector operation middleware
-func (siw *ServerInterfaceWrapper) StartRvtoolsCollector(c *gin.Context) {
+	err = runtime.BindQueryParameter("form", true, false, "bucketSeconds", c.Request.URL.Query(), &params.BucketSeconds)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter bucketSeconds: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "metric" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "metric", c.Request.URL.Query(), &params.Metric)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter metric: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -784,11 +1282,11 @@ func (siw *ServerInterfaceWrapper) StartRvtoolsCollector(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.StartRvtoolsCollector(c)
+	siw.Handler.GetVMUtilizationSeries(c, id, vmId, params)
 }
 
-// DeleteCredentials operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCredentials(c *gin.Context) {
+// StopCollector operation middleware
+func (siw *ServerInterfaceWrapper) StopCollector(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -797,11 +1295,11 @@ func (siw *ServerInterfaceWrapper) DeleteCredentials(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteCredentials(c)
+	siw.Handler.StopCollector(c)
 }
 
-// GetCredentials operation middleware
-func (siw *ServerInterfaceWrapper) GetCredentials(c *gin.Context) {
+// GetCollectorStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetCollectorStatus(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -810,11 +1308,32 @@ func (siw *ServerInterfaceWrapper) GetCredentials(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetCredentials(c)
+	siw.Handler.GetCollectorStatus(c)
 }
 
-// PutCredentials operation middleware
-func (siw *ServerInterfaceWrapper) PutCredentials(c *gin.Context) {
+// StartCollector operation middleware
+func (siw *ServerInterfaceWrapper) StartCollector(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StartCollectorParams
+
+	// ------------- Optional query parameter "vcenter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "vcenter", c.Request.URL.Query(), &params.Vcenter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vcenter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mode", c.Request.URL.Query(), &params.Mode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter mode: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -823,11 +1342,11 @@ func (siw *ServerInterfaceWrapper) PutCredentials(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PutCredentials(c)
+	siw.Handler.StartCollector(c, params)
 }
 
-// GetCredentialCapabilities operation middleware
-func (siw *ServerInterfaceWrapper) GetCredentialCapabilities(c *gin.Context) {
+// StartRvtoolsCollector operation middleware
+func (siw *ServerInterfaceWrapper) StartRvtoolsCollector(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -836,11 +1355,11 @@ func (siw *ServerInterfaceWrapper) GetCredentialCapabilities(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetCredentialCapabilities(c)
+	siw.Handler.StartRvtoolsCollector(c)
 }
 
-// StopForecaster operation middleware
-func (siw *ServerInterfaceWrapper) StopForecaster(c *gin.Context) {
+// DeleteCredentials operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCredentials(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -849,11 +1368,11 @@ func (siw *ServerInterfaceWrapper) StopForecaster(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.StopForecaster(c)
+	siw.Handler.DeleteCredentials(c)
 }
 
-// GetForecasterStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetForecasterStatus(c *gin.Context) {
+// GetCredentials operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentials(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -862,11 +1381,11 @@ func (siw *ServerInterfaceWrapper) GetForecasterStatus(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetForecasterStatus(c)
+	siw.Handler.GetCredentials(c)
 }
 
-// StartForecaster operation middleware
-func (siw *ServerInterfaceWrapper) StartForecaster(c *gin.Context) {
+// PutCredentials operation middleware
+func (siw *ServerInterfaceWrapper) PutCredentials(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -875,11 +1394,11 @@ func (siw *ServerInterfaceWrapper) StartForecaster(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.StartForecaster(c)
+	siw.Handler.PutCredentials(c)
 }
 
-// PostForecasterPairCapabilities operation middleware
-func (siw *ServerInterfaceWrapper) PostForecasterPairCapabilities(c *gin.Context) {
+// GetCredentialCapabilities operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentialCapabilities(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -888,11 +1407,11 @@ func (siw *ServerInterfaceWrapper) PostForecasterPairCapabilities(c *gin.Context
 		}
 	}
 
-	siw.Handler.PostForecasterPairCapabilities(c)
+	siw.Handler.GetCredentialCapabilities(c)
 }
 
-// GetForecasterDatastores operation middleware
-func (siw *ServerInterfaceWrapper) GetForecasterDatastores(c *gin.Context) {
+// ListCredentialProfiles operation middleware
+func (siw *ServerInterfaceWrapper) ListCredentialProfiles(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -901,11 +1420,11 @@ func (siw *ServerInterfaceWrapper) GetForecasterDatastores(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetForecasterDatastores(c)
+	siw.Handler.ListCredentialProfiles(c)
 }
 
-// StopForecasterPair operation middleware
-func (siw *ServerInterfaceWrapper) StopForecasterPair(c *gin.Context) {
+// DeleteCredentialProfile operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCredentialProfile(c *gin.Context) {
 
 	var err error
 
@@ -925,22 +1444,20 @@ func (siw *ServerInterfaceWrapper) StopForecasterPair(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.StopForecasterPair(c, name)
+	siw.Handler.DeleteCredentialProfile(c, name)
 }
 
-// GetForecasterRuns operation middleware
-func (siw *ServerInterfaceWrapper) GetForecasterRuns(c *gin.Context) {
+// GetCredentialProfile operation middleware
+func (siw *ServerInterfaceWrapper) GetCredentialProfile(c *gin.Context) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetForecasterRunsParams
-
-	// ------------- Optional query parameter "pairName" -------------
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindQueryParameter("form", true, false, "pairName", c.Request.URL.Query(), &params.PairName)
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pairName: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -951,20 +1468,20 @@ func (siw *ServerInterfaceWrapper) GetForecasterRuns(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetForecasterRuns(c, params)
+	siw.Handler.GetCredentialProfile(c, name)
 }
 
-// DeleteForecasterRun operation middleware
-func (siw *ServerInterfaceWrapper) DeleteForecasterRun(c *gin.Context) {
+// PutCredentialProfile operation middleware
+func (siw *ServerInterfaceWrapper) PutCredentialProfile(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id int64
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -975,31 +1492,11 @@ func (siw *ServerInterfaceWrapper) DeleteForecasterRun(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteForecasterRun(c, id)
+	siw.Handler.PutCredentialProfile(c, name)
 }
 
-// GetForecasterStats operation middleware
-func (siw *ServerInterfaceWrapper) GetForecasterStats(c *gin.Context) {
-
-	var err error
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetForecasterStatsParams
-
-	// ------------- Required query parameter "pairName" -------------
-
-	if paramValue := c.Query("pairName"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument pairName is required, but not found"), http.StatusBadRequest)
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "pairName", c.Request.URL.Query(), &params.PairName)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pairName: %w", err), http.StatusBadRequest)
-		return
-	}
+// ExplainFilter operation middleware
+func (siw *ServerInterfaceWrapper) ExplainFilter(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1008,40 +1505,24 @@ func (siw *ServerInterfaceWrapper) GetForecasterStats(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetForecasterStats(c, params)
+	siw.Handler.ExplainFilter(c)
 }
 
-// ListLatestGroups operation middleware
-func (siw *ServerInterfaceWrapper) ListLatestGroups(c *gin.Context) {
-
-	var err error
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListLatestGroupsParams
-
-	// ------------- Optional query parameter "byName" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "byName", c.Request.URL.Query(), &params.ByName)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter byName: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Optional query parameter "page" -------------
+// GetFilterFields operation middleware
+func (siw *ServerInterfaceWrapper) GetFilterFields(c *gin.Context) {
 
-	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// ------------- Optional query parameter "pageSize" -------------
+	siw.Handler.GetFilterFields(c)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "pageSize", c.Request.URL.Query(), &params.PageSize)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pageSize: %w", err), http.StatusBadRequest)
-		return
-	}
+// ListSavedFilters operation middleware
+func (siw *ServerInterfaceWrapper) ListSavedFilters(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1050,11 +1531,11 @@ func (siw *ServerInterfaceWrapper) ListLatestGroups(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListLatestGroups(c, params)
+	siw.Handler.ListSavedFilters(c)
 }
 
-// CreateLatestGroup operation middleware
-func (siw *ServerInterfaceWrapper) CreateLatestGroup(c *gin.Context) {
+// CreateSavedFilter operation middleware
+func (siw *ServerInterfaceWrapper) CreateSavedFilter(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1063,20 +1544,20 @@ func (siw *ServerInterfaceWrapper) CreateLatestGroup(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateLatestGroup(c)
+	siw.Handler.CreateSavedFilter(c)
 }
 
-// DeleteLatestGroup operation middleware
-func (siw *ServerInterfaceWrapper) DeleteLatestGroup(c *gin.Context) {
+// DeleteSavedFilter operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSavedFilter(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "groupId" -------------
-	var groupId string
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "groupId", c.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter groupId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1087,47 +1568,20 @@ func (siw *ServerInterfaceWrapper) DeleteLatestGroup(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteLatestGroup(c, groupId)
+	siw.Handler.DeleteSavedFilter(c, name)
 }
 
-// GetLatestGroup operation middleware
-func (siw *ServerInterfaceWrapper) GetLatestGroup(c *gin.Context) {
+// GetSavedFilter operation middleware
+func (siw *ServerInterfaceWrapper) GetSavedFilter(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "groupId" -------------
-	var groupId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "groupId", c.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter groupId: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetLatestGroupParams
-
-	// ------------- Optional query parameter "sort" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Optional query parameter "page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Optional query parameter "pageSize" -------------
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindQueryParameter("form", true, false, "pageSize", c.Request.URL.Query(), &params.PageSize)
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pageSize: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1138,20 +1592,20 @@ func (siw *ServerInterfaceWrapper) GetLatestGroup(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetLatestGroup(c, groupId, params)
+	siw.Handler.GetSavedFilter(c, name)
 }
 
-// UpdateLatestGroup operation middleware
-func (siw *ServerInterfaceWrapper) UpdateLatestGroup(c *gin.Context) {
+// UpdateSavedFilter operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSavedFilter(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "groupId" -------------
-	var groupId string
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "groupId", c.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter groupId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1162,11 +1616,11 @@ func (siw *ServerInterfaceWrapper) UpdateLatestGroup(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateLatestGroup(c, groupId)
+	siw.Handler.UpdateSavedFilter(c, name)
 }
 
-// StopInspection operation middleware
-func (siw *ServerInterfaceWrapper) StopInspection(c *gin.Context) {
+// ValidateFilter operation middleware
+func (siw *ServerInterfaceWrapper) ValidateFilter(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1175,22 +1629,1229 @@ func (siw *ServerInterfaceWrapper) StopInspection(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.StopInspection(c)
+	siw.Handler.ValidateFilter(c)
 }
 
-// GetInspectorStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetInspectorStatus(c *gin.Context) {
+// StopForecaster operation middleware
+func (siw *ServerInterfaceWrapper) StopForecaster(c *gin.Context) {
 
-	var err error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.StopForecaster(c)
+}
+
+// GetForecasterStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetForecasterStatus(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetForecasterStatus(c)
+}
+
+// StartForecaster operation middleware
+func (siw *ServerInterfaceWrapper) StartForecaster(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.StartForecaster(c)
+}
+
+// PostForecasterPairCapabilities operation middleware
+func (siw *ServerInterfaceWrapper) PostForecasterPairCapabilities(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostForecasterPairCapabilities(c)
+}
+
+// GetForecasterDatastores operation middleware
+func (siw *ServerInterfaceWrapper) GetForecasterDatastores(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetForecasterDatastores(c)
+}
+
+// StopForecasterPair operation middleware
+func (siw *ServerInterfaceWrapper) StopForecasterPair(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.StopForecasterPair(c, name)
+}
+
+// GetForecasterRuns operation middleware
+func (siw *ServerInterfaceWrapper) GetForecasterRuns(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetForecasterRunsParams
+
+	// ------------- Optional query parameter "pairName" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pairName", c.Request.URL.Query(), &params.PairName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pairName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetForecasterRuns(c, params)
+}
+
+// DeleteForecasterRun operation middleware
+func (siw *ServerInterfaceWrapper) DeleteForecasterRun(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteForecasterRun(c, id)
+}
+
+// GetForecasterStats operation middleware
+func (siw *ServerInterfaceWrapper) GetForecasterStats(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetForecasterStatsParams
+
+	// ------------- Required query parameter "pairName" -------------
+
+	if paramValue := c.Query("pairName"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument pairName is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "pairName", c.Request.URL.Query(), &params.PairName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pairName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetForecasterStats(c, params)
+}
+
+// ListLatestGroups operation middleware
+func (siw *ServerInterfaceWrapper) ListLatestGroups(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLatestGroupsParams
+
+	// ------------- Optional query parameter "byName" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "byName", c.Request.URL.Query(), &params.ByName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter byName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", c.Request.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pageSize: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListLatestGroups(c, params)
+}
+
+// CreateLatestGroup operation middleware
+func (siw *ServerInterfaceWrapper) CreateLatestGroup(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateLatestGroup(c)
+}
+
+// DeleteLatestGroup operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLatestGroup(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", c.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter groupId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteLatestGroup(c, groupId)
+}
+
+// GetLatestGroup operation middleware
+func (siw *ServerInterfaceWrapper) GetLatestGroup(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", c.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter groupId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLatestGroupParams
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", c.Request.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pageSize: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetLatestGroup(c, groupId, params)
+}
+
+// UpdateLatestGroup operation middleware
+func (siw *ServerInterfaceWrapper) UpdateLatestGroup(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", c.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter groupId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateLatestGroup(c, groupId)
+}
+
+// GetLatestGroupMTVPlan operation middleware
+func (siw *ServerInterfaceWrapper) GetLatestGroupMTVPlan(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", c.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter groupId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLatestGroupMTVPlanParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", c.Request.URL.Query(), &params.Namespace)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter namespace: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "targetNamespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "targetNamespace", c.Request.URL.Query(), &params.TargetNamespace)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter targetNamespace: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sourceProvider" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sourceProvider", c.Request.URL.Query(), &params.SourceProvider)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sourceProvider: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "destinationProvider" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "destinationProvider", c.Request.URL.Query(), &params.DestinationProvider)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter destinationProvider: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "vcenter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "vcenter", c.Request.URL.Query(), &params.Vcenter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vcenter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetLatestGroupMTVPlan(c, groupId, params)
+}
+
+// StopInspection operation middleware
+func (siw *ServerInterfaceWrapper) StopInspection(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.StopInspection(c)
+}
+
+// GetInspectorStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetInspectorStatus(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetInspectorStatusParams
+
+	// ------------- Optional query parameter "includeVddk" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeVddk", c.Request.URL.Query(), &params.IncludeVddk)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter includeVddk: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetInspectorStatus(c, params)
+}
+
+// StartInspection operation middleware
+func (siw *ServerInterfaceWrapper) StartInspection(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.StartInspection(c)
+}
+
+// GetInspectorVddkStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetInspectorVddkStatus(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetInspectorVddkStatus(c)
+}
+
+// PutInspectorVddk operation middleware
+func (siw *ServerInterfaceWrapper) PutInspectorVddk(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutInspectorVddk(c)
+}
+
+// GetLatestInventory operation middleware
+func (siw *ServerInterfaceWrapper) GetLatestInventory(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLatestInventoryParams
+
+	// ------------- Optional query parameter "vcenter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "vcenter", c.Request.URL.Query(), &params.Vcenter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vcenter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetLatestInventory(c, params)
+}
+
+// ListLabelRules operation middleware
+func (siw *ServerInterfaceWrapper) ListLabelRules(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListLabelRules(c)
+}
+
+// CreateLabelRule operation middleware
+func (siw *ServerInterfaceWrapper) CreateLabelRule(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateLabelRule(c)
+}
+
+// DeleteLabelRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLabelRule(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteLabelRule(c, name)
+}
+
+// GetLabelRule operation middleware
+func (siw *ServerInterfaceWrapper) GetLabelRule(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetLabelRule(c, name)
+}
+
+// UpdateLabelRule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateLabelRule(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateLabelRule(c, name)
+}
+
+// ListLabels operation middleware
+func (siw *ServerInterfaceWrapper) ListLabels(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListLabels(c)
+}
+
+// CreateLabel operation middleware
+func (siw *ServerInterfaceWrapper) CreateLabel(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateLabel(c)
+}
+
+// DeleteLabel operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLabel(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteLabel(c, name)
+}
+
+// GetLabel operation middleware
+func (siw *ServerInterfaceWrapper) GetLabel(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetLabel(c, name)
+}
+
+// UpdateLabel operation middleware
+func (siw *ServerInterfaceWrapper) UpdateLabel(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateLabel(c, name)
+}
+
+// GetMTVMappings operation middleware
+func (siw *ServerInterfaceWrapper) GetMTVMappings(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetMTVMappings(c)
+}
+
+// ReplaceMTVMappings operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceMTVMappings(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ReplaceMTVMappings(c)
+}
+
+// GetRightsizingAccumulation operation middleware
+func (siw *ServerInterfaceWrapper) GetRightsizingAccumulation(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetRightsizingAccumulation(c)
+}
+
+// UpdateRightsizingAccumulation operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRightsizingAccumulation(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateRightsizingAccumulation(c)
+}
+
+// CreateAccumulatedRightsizingReport operation middleware
+func (siw *ServerInterfaceWrapper) CreateAccumulatedRightsizingReport(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateAccumulatedRightsizingReport(c)
+}
+
+// RunRightsizingAccumulation operation middleware
+func (siw *ServerInterfaceWrapper) RunRightsizingAccumulation(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RunRightsizingAccumulation(c)
+}
+
+// ListRightsizingPolicies operation middleware
+func (siw *ServerInterfaceWrapper) ListRightsizingPolicies(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListRightsizingPolicies(c)
+}
+
+// CreateRightsizingPolicy operation middleware
+func (siw *ServerInterfaceWrapper) CreateRightsizingPolicy(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateRightsizingPolicy(c)
+}
+
+// DeleteRightsizingPolicy operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRightsizingPolicy(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteRightsizingPolicy(c, name)
+}
+
+// GetRightsizingPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetRightsizingPolicy(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetRightsizingPolicy(c, name)
+}
+
+// UpdateRightsizingPolicy operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRightsizingPolicy(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateRightsizingPolicy(c, name)
+}
+
+// ListLatestRecommendations operation middleware
+func (siw *ServerInterfaceWrapper) ListLatestRecommendations(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLatestRecommendationsParams
+
+	// ------------- Optional query parameter "policy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "policy", c.Request.URL.Query(), &params.Policy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter policy: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "groupId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "groupId", c.Request.URL.Query(), &params.GroupId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter groupId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListLatestRecommendations(c, params)
+}
+
+// ListLatestClusterRecommendations operation middleware
+func (siw *ServerInterfaceWrapper) ListLatestClusterRecommendations(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLatestClusterRecommendationsParams
+
+	// ------------- Optional query parameter "policy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "policy", c.Request.URL.Query(), &params.Policy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter policy: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListLatestClusterRecommendations(c, params)
+}
+
+// GetLatestSizing operation middleware
+func (siw *ServerInterfaceWrapper) GetLatestSizing(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLatestSizingParams
+
+	// ------------- Optional query parameter "vcenter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "vcenter", c.Request.URL.Query(), &params.Vcenter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vcenter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetLatestSizing(c, params)
+}
+
+// RunSizing operation middleware
+func (siw *ServerInterfaceWrapper) RunSizing(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RunSizing(c)
+}
+
+// GetVersion operation middleware
+func (siw *ServerInterfaceWrapper) GetVersion(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetVersion(c)
+}
+
+// ListLatestVirtualMachines operation middleware
+func (siw *ServerInterfaceWrapper) ListLatestVirtualMachines(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLatestVirtualMachinesParams
+
+	// ------------- Optional query parameter "vcenter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "vcenter", c.Request.URL.Query(), &params.Vcenter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vcenter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "byExpression" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "byExpression", c.Request.URL.Query(), &params.ByExpression)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter byExpression: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", c.Request.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pageSize: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListLatestVirtualMachines(c, params)
+}
+
+// BatchUpdateLatestVMExclusion operation middleware
+func (siw *ServerInterfaceWrapper) BatchUpdateLatestVMExclusion(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.BatchUpdateLatestVMExclusion(c)
+}
+
+// ExportLatestVMExclusion operation middleware
+func (siw *ServerInterfaceWrapper) ExportLatestVMExclusion(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportLatestVMExclusionParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ExportLatestVMExclusion(c, params)
+}
+
+// ImportLatestVMExclusion operation middleware
+func (siw *ServerInterfaceWrapper) ImportLatestVMExclusion(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportLatestVMExclusionParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dryRun: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ImportLatestVMExclusion(c, params)
+}
+
+// GetLatestVMFilterOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetLatestVMFilterOptions(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetLatestVMFilterOptions(c)
+}
+
+// GetLatestVMLabels operation middleware
+func (siw *ServerInterfaceWrapper) GetLatestVMLabels(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetLatestVMLabels(c)
+}
+
+// ExportLatestVMLabels operation middleware
+func (siw *ServerInterfaceWrapper) ExportLatestVMLabels(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportLatestVMLabelsParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ExportLatestVMLabels(c, params)
+}
+
+// ImportLatestVMLabels operation middleware
+func (siw *ServerInterfaceWrapper) ImportLatestVMLabels(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportLatestVMLabelsParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dryRun: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", c.Request.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter actor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ImportLatestVMLabels(c, params)
+}
+
+// DeleteLatestLabelGlobally operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLatestLabelGlobally(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "label" -------------
+	var label string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "label", c.Param("label"), &label, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter label: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetInspectorStatusParams
+	var params DeleteLatestLabelGloballyParams
 
-	// ------------- Optional query parameter "includeVddk" -------------
+	// ------------- Optional query parameter "actor" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "includeVddk", c.Request.URL.Query(), &params.IncludeVddk)
+	err = runtime.BindQueryParameter("form", true, false, "actor", c.Request.URL.Query(), &params.Actor)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter includeVddk: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter actor: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1201,11 +2862,22 @@ func (siw *ServerInterfaceWrapper) GetInspectorStatus(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetInspectorStatus(c, params)
+	siw.Handler.DeleteLatestLabelGlobally(c, label, params)
 }
 
-// StartInspection operation middleware
-func (siw *ServerInterfaceWrapper) StartInspection(c *gin.Context) {
+// UpdateLatestLabelVMs operation middleware
+func (siw *ServerInterfaceWrapper) UpdateLatestLabelVMs(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "label" -------------
+	var label string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "label", c.Param("label"), &label, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter label: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1214,11 +2886,22 @@ func (siw *ServerInterfaceWrapper) StartInspection(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.StartInspection(c)
+	siw.Handler.UpdateLatestLabelVMs(c, label)
 }
 
-// GetInspectorVddkStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetInspectorVddkStatus(c *gin.Context) {
+// GetLatestVirtualMachine operation middleware
+func (siw *ServerInterfaceWrapper) GetLatestVirtualMachine(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "vmId" -------------
+	var vmId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vmId", c.Param("vmId"), &vmId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vmId: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1227,11 +2910,22 @@ func (siw *ServerInterfaceWrapper) GetInspectorVddkStatus(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetInspectorVddkStatus(c)
+	siw.Handler.GetLatestVirtualMachine(c, vmId)
 }
 
-// PutInspectorVddk operation middleware
-func (siw *ServerInterfaceWrapper) PutInspectorVddk(c *gin.Context) {
+// UpdateLatestVirtualMachine operation middleware
+func (siw *ServerInterfaceWrapper) UpdateLatestVirtualMachine(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "vmId" -------------
+	var vmId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vmId", c.Param("vmId"), &vmId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vmId: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1240,11 +2934,22 @@ func (siw *ServerInterfaceWrapper) PutInspectorVddk(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PutInspectorVddk(c)
+	siw.Handler.UpdateLatestVirtualMachine(c, vmId)
 }
 
-// GetLatestInventory operation middleware
-func (siw *ServerInterfaceWrapper) GetLatestInventory(c *gin.Context) {
+// GetLatestVMLabelHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetLatestVMLabelHistory(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "vmId" -------------
+	var vmId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vmId", c.Param("vmId"), &vmId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vmId: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1253,11 +2958,33 @@ func (siw *ServerInterfaceWrapper) GetLatestInventory(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetLatestInventory(c)
+	siw.Handler.GetLatestVMLabelHistory(c, vmId)
 }
 
-// GetVersion operation middleware
-func (siw *ServerInterfaceWrapper) GetVersion(c *gin.Context) {
+// GetLatestVMRecommendation operation middleware
+func (siw *ServerInterfaceWrapper) GetLatestVMRecommendation(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "vmId" -------------
+	var vmId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vmId", c.Param("vmId"), &vmId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vmId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLatestVMRecommendationParams
+
+	// ------------- Optional query parameter "policy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "policy", c.Request.URL.Query(), &params.Policy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter policy: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1266,46 +2993,71 @@ func (siw *ServerInterfaceWrapper) GetVersion(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetVersion(c)
+	siw.Handler.GetLatestVMRecommendation(c, vmId, params)
 }
 
-// ListLatestVirtualMachines operation middleware
-func (siw *ServerInterfaceWrapper) ListLatestVirtualMachines(c *gin.Context) {
+// GetLatestVMUtilization operation middleware
+func (siw *ServerInterfaceWrapper) GetLatestVMUtilization(c *gin.Context) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListLatestVirtualMachinesParams
+	// ------------- Path parameter "vmId" -------------
+	var vmId string
 
-	// ------------- Optional query parameter "byExpression" -------------
+	err = runtime.BindStyledParameterWithOptions("simple", "vmId", c.Param("vmId"), &vmId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vmId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "byExpression", c.Request.URL.Query(), &params.ByExpression)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetLatestVMUtilization(c, vmId)
+}
+
+// GetLatestVMUtilizationSeries operation middleware
+func (siw *ServerInterfaceWrapper) GetLatestVMUtilizationSeries(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "vmId" -------------
+	var vmId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vmId", c.Param("vmId"), &vmId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter byExpression: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vmId: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "sort" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLatestVMUtilizationSeriesParams
 
-	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	// ------------- Optional query parameter "reportId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reportId", c.Request.URL.Query(), &params.ReportId)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter reportId: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Optional query parameter "bucketSeconds" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	err = runtime.BindQueryParameter("form", true, false, "bucketSeconds", c.Request.URL.Query(), &params.BucketSeconds)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter bucketSeconds: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "pageSize" -------------
+	// ------------- Optional query parameter "metric" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "pageSize", c.Request.URL.Query(), &params.PageSize)
+	err = runtime.BindQueryParameter("form", true, false, "metric", c.Request.URL.Query(), &params.Metric)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pageSize: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter metric: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1316,11 +3068,32 @@ func (siw *ServerInterfaceWrapper) ListLatestVirtualMachines(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListLatestVirtualMachines(c, params)
+	siw.Handler.GetLatestVMUtilizationSeries(c, vmId, params)
 }
 
-// BatchUpdateLatestVMExclusion operation middleware
-func (siw *ServerInterfaceWrapper) BatchUpdateLatestVMExclusion(c *gin.Context) {
+// GetLatestWasteAnalysis operation middleware
+func (siw *ServerInterfaceWrapper) GetLatestWasteAnalysis(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLatestWasteAnalysisParams
+
+	// ------------- Optional query parameter "class" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "class", c.Request.URL.Query(), &params.Class)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter class: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "vcenter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "vcenter", c.Request.URL.Query(), &params.Vcenter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vcenter: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1329,11 +3102,24 @@ func (siw *ServerInterfaceWrapper) BatchUpdateLatestVMExclusion(c *gin.Context)
 		}
 	}
 
-	siw.Handler.BatchUpdateLatestVMExclusion(c)
+	siw.Handler.GetLatestWasteAnalysis(c, params)
 }
 
-// GetLatestVMFilterOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetLatestVMFilterOptions(c *gin.Context) {
+// AnalyzeWaste operation middleware
+func (siw *ServerInterfaceWrapper) AnalyzeWaste(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AnalyzeWasteParams
+
+	// ------------- Optional query parameter "vcenter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "vcenter", c.Request.URL.Query(), &params.Vcenter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vcenter: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1342,11 +3128,11 @@ func (siw *ServerInterfaceWrapper) GetLatestVMFilterOptions(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetLatestVMFilterOptions(c)
+	siw.Handler.AnalyzeWaste(c, params)
 }
 
-// GetLatestVMLabels operation middleware
-func (siw *ServerInterfaceWrapper) GetLatestVMLabels(c *gin.Context) {
+// GetWasteThresholds operation middleware
+func (siw *ServerInterfaceWrapper) GetWasteThresholds(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1355,23 +3141,38 @@ func (siw *ServerInterfaceWrapper) GetLatestVMLabels(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetLatestVMLabels(c)
+	siw.Handler.GetWasteThresholds(c)
 }
 
-// DeleteLatestLabelGlobally operation middleware
-func (siw *ServerInterfaceWrapper) DeleteLatestLabelGlobally(c *gin.Context) {
+// UpdateWasteThresholds operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWasteThresholds(c *gin.Context) {
 
-	var err error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// ------------- Path parameter "label" -------------
-	var label string
+	siw.Handler.UpdateWasteThresholds(c)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "label", c.Param("label"), &label, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter label: %w", err), http.StatusBadRequest)
-		return
+// ListWaves operation middleware
+func (siw *ServerInterfaceWrapper) ListWaves(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
+	siw.Handler.ListWaves(c)
+}
+
+// CreateWave operation middleware
+func (siw *ServerInterfaceWrapper) CreateWave(c *gin.Context) {
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1379,20 +3180,22 @@ func (siw *ServerInterfaceWrapper) DeleteLatestLabelGlobally(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteLatestLabelGlobally(c, label)
+	siw.Handler.CreateWave(c)
 }
 
-// UpdateLatestLabelVMs operation middleware
-func (siw *ServerInterfaceWrapper) UpdateLatestLabelVMs(c *gin.Context) {
+// GetWavePlan operation middleware
+func (siw *ServerInterfaceWrapper) GetWavePlan(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "label" -------------
-	var label string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWavePlanParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "label", c.Param("label"), &label, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// ------------- Optional query parameter "vcenter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "vcenter", c.Request.URL.Query(), &params.Vcenter)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter label: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vcenter: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1403,20 +3206,20 @@ func (siw *ServerInterfaceWrapper) UpdateLatestLabelVMs(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateLatestLabelVMs(c, label)
+	siw.Handler.GetWavePlan(c, params)
 }
 
-// GetLatestVirtualMachine operation middleware
-func (siw *ServerInterfaceWrapper) GetLatestVirtualMachine(c *gin.Context) {
+// DeleteWave operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWave(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "vmId" -------------
-	var vmId string
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "vmId", c.Param("vmId"), &vmId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vmId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1427,20 +3230,20 @@ func (siw *ServerInterfaceWrapper) GetLatestVirtualMachine(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetLatestVirtualMachine(c, vmId)
+	siw.Handler.DeleteWave(c, name)
 }
 
-// UpdateLatestVirtualMachine operation middleware
-func (siw *ServerInterfaceWrapper) UpdateLatestVirtualMachine(c *gin.Context) {
+// GetWave operation middleware
+func (siw *ServerInterfaceWrapper) GetWave(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "vmId" -------------
-	var vmId string
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "vmId", c.Param("vmId"), &vmId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vmId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1451,20 +3254,20 @@ func (siw *ServerInterfaceWrapper) UpdateLatestVirtualMachine(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateLatestVirtualMachine(c, vmId)
+	siw.Handler.GetWave(c, name)
 }
 
-// GetLatestVMUtilization operation middleware
-func (siw *ServerInterfaceWrapper) GetLatestVMUtilization(c *gin.Context) {
+// UpdateWave operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWave(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "vmId" -------------
-	var vmId string
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "vmId", c.Param("vmId"), &vmId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter vmId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1475,7 +3278,7 @@ func (siw *ServerInterfaceWrapper) GetLatestVMUtilization(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetLatestVMUtilization(c, vmId)
+	siw.Handler.UpdateWave(c, name)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -1510,7 +3313,18 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/collections", wrapper.ListCollections)
 	router.GET(options.BaseURL+"/collections/compare/:aId/:bId", wrapper.CompareCollections)
 	router.GET(options.BaseURL+"/collections/compare/:aId/:bId/:dimension", wrapper.CompareCollectionsDiff)
+	router.POST(options.BaseURL+"/collections/import", wrapper.ImportCollectionBundle)
+	router.GET(options.BaseURL+"/collections/schedules", wrapper.ListCollectionSchedules)
+	router.POST(options.BaseURL+"/collections/schedules", wrapper.CreateCollectionSchedule)
+	router.DELETE(options.BaseURL+"/collections/schedules/:scheduleId", wrapper.DeleteCollectionSchedule)
+	router.GET(options.BaseURL+"/collections/schedules/:scheduleId", wrapper.GetCollectionSchedule)
+	router.PATCH(options.BaseURL+"/collections/schedules/:scheduleId", wrapper.UpdateCollectionSchedule)
+	router.GET(options.BaseURL+"/collections/schedules/:scheduleId/runs", wrapper.ListCollectionScheduleRuns)
+	router.GET(options.BaseURL+"/collections/trends", wrapper.GetCollectionTrends)
+	router.DELETE(options.BaseURL+"/collections/:id", wrapper.DeleteCollection)
+	router.PATCH(options.BaseURL+"/collections/:id", wrapper.UpdateCollection)
 	router.GET(options.BaseURL+"/collections/:id/applications", wrapper.ListApplications)
+	router.GET(options.BaseURL+"/collections/:id/bundle", wrapper.ExportCollectionBundle)
 	router.GET(options.BaseURL+"/collections/:id/clusters/:clusterId/utilization", wrapper.GetClusterUtilization)
 	router.GET(options.BaseURL+"/collections/:id/export", wrapper.ExportCollection)
 	router.GET(options.BaseURL+"/collections/:id/groups", wrapper.ListGroups)
@@ -1521,6 +3335,7 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/collections/:id/virtualmachines/labels", wrapper.GetVMLabels)
 	router.GET(options.BaseURL+"/collections/:id/virtualmachines/:vmId", wrapper.GetVirtualMachine)
 	router.GET(options.BaseURL+"/collections/:id/virtualmachines/:vmId/utilization", wrapper.GetVMUtilization)
+	router.GET(options.BaseURL+"/collections/:id/virtualmachines/:vmId/utilization/series", wrapper.GetVMUtilizationSeries)
 	router.DELETE(options.BaseURL+"/collector", wrapper.StopCollector)
 	router.GET(options.BaseURL+"/collector", wrapper.GetCollectorStatus)
 	router.POST(options.BaseURL+"/collector", wrapper.StartCollector)
@@ -1529,6 +3344,18 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/credentials", wrapper.GetCredentials)
 	router.PUT(options.BaseURL+"/credentials", wrapper.PutCredentials)
 	router.GET(options.BaseURL+"/credentials/capabilities", wrapper.GetCredentialCapabilities)
+	router.GET(options.BaseURL+"/credentials/profiles", wrapper.ListCredentialProfiles)
+	router.DELETE(options.BaseURL+"/credentials/profiles/:name", wrapper.DeleteCredentialProfile)
+	router.GET(options.BaseURL+"/credentials/profiles/:name", wrapper.GetCredentialProfile)
+	router.PUT(options.BaseURL+"/credentials/profiles/:name", wrapper.PutCredentialProfile)
+	router.POST(options.BaseURL+"/filters/explain", wrapper.ExplainFilter)
+	router.GET(options.BaseURL+"/filters/fields", wrapper.GetFilterFields)
+	router.GET(options.BaseURL+"/filters/saved", wrapper.ListSavedFilters)
+	router.POST(options.BaseURL+"/filters/saved", wrapper.CreateSavedFilter)
+	router.DELETE(options.BaseURL+"/filters/saved/:name", wrapper.DeleteSavedFilter)
+	router.GET(options.BaseURL+"/filters/saved/:name", wrapper.GetSavedFilter)
+	router.PATCH(options.BaseURL+"/filters/saved/:name", wrapper.UpdateSavedFilter)
+	router.POST(options.BaseURL+"/filters/validate", wrapper.ValidateFilter)
 	router.DELETE(options.BaseURL+"/forecaster", wrapper.StopForecaster)
 	router.GET(options.BaseURL+"/forecaster", wrapper.GetForecasterStatus)
 	router.POST(options.BaseURL+"/forecaster", wrapper.StartForecaster)
@@ -1543,222 +3370,513 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.DELETE(options.BaseURL+"/groups/:groupId", wrapper.DeleteLatestGroup)
 	router.GET(options.BaseURL+"/groups/:groupId", wrapper.GetLatestGroup)
 	router.PATCH(options.BaseURL+"/groups/:groupId", wrapper.UpdateLatestGroup)
+	router.GET(options.BaseURL+"/groups/:groupId/mtv-plan", wrapper.GetLatestGroupMTVPlan)
 	router.DELETE(options.BaseURL+"/inspector", wrapper.StopInspection)
 	router.GET(options.BaseURL+"/inspector", wrapper.GetInspectorStatus)
 	router.POST(options.BaseURL+"/inspector", wrapper.StartInspection)
 	router.GET(options.BaseURL+"/inspector/vddk", wrapper.GetInspectorVddkStatus)
 	router.PUT(options.BaseURL+"/inspector/vddk", wrapper.PutInspectorVddk)
 	router.GET(options.BaseURL+"/inventory", wrapper.GetLatestInventory)
+	router.GET(options.BaseURL+"/label-rules", wrapper.ListLabelRules)
+	router.POST(options.BaseURL+"/label-rules", wrapper.CreateLabelRule)
+	router.DELETE(options.BaseURL+"/label-rules/:name", wrapper.DeleteLabelRule)
+	router.GET(options.BaseURL+"/label-rules/:name", wrapper.GetLabelRule)
+	router.PATCH(options.BaseURL+"/label-rules/:name", wrapper.UpdateLabelRule)
+	router.GET(options.BaseURL+"/labels", wrapper.ListLabels)
+	router.POST(options.BaseURL+"/labels", wrapper.CreateLabel)
+	router.DELETE(options.BaseURL+"/labels/:name", wrapper.DeleteLabel)
+	router.GET(options.BaseURL+"/labels/:name", wrapper.GetLabel)
+	router.PATCH(options.BaseURL+"/labels/:name", wrapper.UpdateLabel)
+	router.GET(options.BaseURL+"/mtv/mappings", wrapper.GetMTVMappings)
+	router.PUT(options.BaseURL+"/mtv/mappings", wrapper.ReplaceMTVMappings)
+	router.GET(options.BaseURL+"/rightsizing/accumulation", wrapper.GetRightsizingAccumulation)
+	router.PUT(options.BaseURL+"/rightsizing/accumulation", wrapper.UpdateRightsizingAccumulation)
+	router.POST(options.BaseURL+"/rightsizing/accumulation/reports", wrapper.CreateAccumulatedRightsizingReport)
+	router.POST(options.BaseURL+"/rightsizing/accumulation/run", wrapper.RunRightsizingAccumulation)
+	router.GET(options.BaseURL+"/rightsizing/policies", wrapper.ListRightsizingPolicies)
+	router.POST(options.BaseURL+"/rightsizing/policies", wrapper.CreateRightsizingPolicy)
+	router.DELETE(options.BaseURL+"/rightsizing/policies/:name", wrapper.DeleteRightsizingPolicy)
+	router.GET(options.BaseURL+"/rightsizing/policies/:name", wrapper.GetRightsizingPolicy)
+	router.PUT(options.BaseURL+"/rightsizing/policies/:name", wrapper.UpdateRightsizingPolicy)
+	router.GET(options.BaseURL+"/rightsizing/recommendations", wrapper.ListLatestRecommendations)
+	router.GET(options.BaseURL+"/rightsizing/recommendations/clusters", wrapper.ListLatestClusterRecommendations)
+	router.GET(options.BaseURL+"/sizing", wrapper.GetLatestSizing)
+	router.POST(options.BaseURL+"/sizing", wrapper.RunSizing)
 	router.GET(options.BaseURL+"/version", wrapper.GetVersion)
 	router.GET(options.BaseURL+"/virtualmachines", wrapper.ListLatestVirtualMachines)
 	router.POST(options.BaseURL+"/virtualmachines/batch-update-exclusion", wrapper.BatchUpdateLatestVMExclusion)
+	router.GET(options.BaseURL+"/virtualmachines/batch-update-exclusion/export", wrapper.ExportLatestVMExclusion)
+	router.POST(options.BaseURL+"/virtualmachines/batch-update-exclusion/import", wrapper.ImportLatestVMExclusion)
 	router.GET(options.BaseURL+"/virtualmachines/filter-options", wrapper.GetLatestVMFilterOptions)
 	router.GET(options.BaseURL+"/virtualmachines/labels", wrapper.GetLatestVMLabels)
+	router.GET(options.BaseURL+"/virtualmachines/labels/export", wrapper.ExportLatestVMLabels)
+	router.POST(options.BaseURL+"/virtualmachines/labels/import", wrapper.ImportLatestVMLabels)
 	router.DELETE(options.BaseURL+"/virtualmachines/labels/:label", wrapper.DeleteLatestLabelGlobally)
 	router.PATCH(options.BaseURL+"/virtualmachines/labels/:label", wrapper.UpdateLatestLabelVMs)
 	router.GET(options.BaseURL+"/virtualmachines/:vmId", wrapper.GetLatestVirtualMachine)
 	router.PATCH(options.BaseURL+"/virtualmachines/:vmId", wrapper.UpdateLatestVirtualMachine)
+	router.GET(options.BaseURL+"/virtualmachines/:vmId/labels/history", wrapper.GetLatestVMLabelHistory)
+	router.GET(options.BaseURL+"/virtualmachines/:vmId/recommendation", wrapper.GetLatestVMRecommendation)
 	router.GET(options.BaseURL+"/virtualmachines/:vmId/utilization", wrapper.GetLatestVMUtilization)
+	router.GET(options.BaseURL+"/virtualmachines/:vmId/utilization/series", wrapper.GetLatestVMUtilizationSeries)
+	router.GET(options.BaseURL+"/waste", wrapper.GetLatestWasteAnalysis)
+	router.POST(options.BaseURL+"/waste", wrapper.AnalyzeWaste)
+	router.GET(options.BaseURL+"/waste/thresholds", wrapper.GetWasteThresholds)
+	router.PUT(options.BaseURL+"/waste/thresholds", wrapper.UpdateWasteThresholds)
+	router.GET(options.BaseURL+"/waves", wrapper.ListWaves)
+	router.POST(options.BaseURL+"/waves", wrapper.CreateWave)
+	router.GET(options.BaseURL+"/waves/plan", wrapper.GetWavePlan)
+	router.DELETE(options.BaseURL+"/waves/:name", wrapper.DeleteWave)
+	router.GET(options.BaseURL+"/waves/:name", wrapper.GetWave)
+	router.PATCH(options.BaseURL+"/waves/:name", wrapper.UpdateWave)
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y963LbuLYg/CoofeerbdeWb7n0nM6p/mHL6cS148RlO95Ts5NJQeSShGMS4AZA2epM",
-	"quYh5gnnSaZwI0ESvEiWnew++dOdRCCwblhYWDd8HUUszRgFKsXo1deRiBaQYv3H4zlQec5iuIR/5iCk",
-	"+reMswy4JKBHpCwG9X+geTp69Y9RxCiFSEI8Go9iIsq/fh6P5CqD0auRkJzQ+Wg8ut9jOCN7EYthDnQP",
-	"7iXHexLP9cRTQmM17NWIwz9zwiEeMwps9lsxJarM/+3bt3ExVEGiIStXZdP/hEiOvo0NUlcSy1w08YkY",
-	"FSyBiZmXMNocApwzrv4Qg4g4ycyoUfkJ0iOQ/3Md+W/jkSggqM2Tcw5UIgsJisp57SfjDamtvtpbYk5x",
-	"qjD5hwK5iqyhysSbtWXIaWWxOuktnCHiO3mp4nyN+RwkUj+iGeNILgBhxabt4epxXQm0j2Ptpxpu4xFf",
-	"SsYS/dtriqeJQrKOweXNNWOJwQDsoAKuKWMJYDoKiug4IHNBsc2yhERY/f6OCHkJImNUQFM+cTlQ/51I",
-	"SPUf/o3DbPRq9P8dlPv9wG72A2/2D0vgSwJ3alELBeYcrxrgVxbqAbmYtAFuhY51snoz9O0nxenuCfSI",
-	"wJfLdMJyKpsfv8/TKXDEZujmXCCeU0roHMkFEcjDvZySUAlz4GbOjWh/c95LdYtFlRoOBbNwDy9uzptc",
-	"IAGZvjlHZ6fDSX1z3kLhGgJE7Qw9MgTnCZbR4mMWYwmv76MkF4TR9tOHzLlGSQ+NQxuzmERrT0CSIQFS",
-	"axmcJIqxgX2qyHgWixaSCDVJrkEcjUsWN8hUYeP6x11K6G9H45gsYVyQr3HKGTjHAUoEiQs0WqSY317m",
-	"gYMt4oAlxMea0DPGUyxHr0YKzT1JwlsnJuL2ivwBb6YeBbxtEOcGqiuIqpOyfJp4M1K909QXxenaWMuI",
-	"aDEFofKXF8G9RySYVcMwpSAXLA4ukWHC31vhbv7IITtdGx8BQknf2VDgBct5BKdYYiEZD0Mi9XHZM2bB",
-	"WT5fZLk8P8nEIGBD+7QE36NOE8omTD4bKnJSFYoGoAV/xp48hmR5gjM8JQmRq1Zbzo2wf69bekkCkTTC",
-	"1qWeP2QWj3JFtf6McYiwkLDpBISKbHMAaswqsfEnrkDZJGJ9Dp9eQZInuZrpd8Ay5yGaxlxULKQZzhM5",
-	"ejXDiYBxTZX+fQFyARydXl6hnVOiJHeaK7P+Eox0oatoAXGeAN9FRDirytqHRKDIQBNU3zHX5loFitF7",
-	"Rusn56uRWh7nkqXGRqiYoOUKzgj9PU+SFTo247WJd4G5JLj+r+eY5jgZjc2anwOac4FbbUlHmeVVtgAO",
-	"6O0x2nlL5gt0vMQksRLQSRO0V+AUadg4CIm5FNqQUWdhzpdkqayZBRNSIDxTX2H9NzTDJMk5BAmrNjee",
-	"w+kGjL4yn2qGr8fPb+2y+FGShPyBwze1iNEZiYFGAWtFaSoUsSVomMqRKAMeAZXqX3cO944OD3fHKMJJ",
-	"lCeKtwgLtJxcfNy7AzJfqH9wc5jNVtOwKb4nqRKdo8NDdUhT87fDwEERZfkXvJwHTFgL4+TiI8pLdAOA",
-	"bgOEFN83QTg3czwRCNmvL5sg/PpSLtx6JHkKaqSQdjMkhZTx1RNA0cmTJ4NiEFueAJr6qWX3TSk7pSCX",
-	"TCxRKEk69hVE8Lwzh2pYt/jGckPhUXN+FN+jOyyQ/aSCfZdxHbqTlSAhBbgkM+KrzL47mvf5mne1XnOs",
-	"mPl4PucwV5ejJtGM0hZdV+2YCEloJFExOGQm/+jkN/cxdcJ14VqO0gfzDmVowok+tNWRFAGnYjeIP2X0",
-	"fNASlNG9+jJYogSwkIhRaCwYXk8yiRN1WW767tQviFZcJaTOgMCcIUkrueqtWCFmHfNxKVPdUjlhaYY5",
-	"EYyektksYLqSFKgIOqGuF4CKn9EUlNkU6em0NDnbUAMcgNYjf9ASZDRZndHjvltAFYELZXEUH59s8nGN",
-	"ASUBSpDK+YcS9ypPU8xXrdct55SsnahOZQhtDk6ZXHjCI/bRGY3hHh0qu/EY7UyxgIRQ2B0jon84Uj+c",
-	"7PvemG5qNHXVN30CnZnPn+kDqPxL1SGnrrNGhBqbDjiJkPoVuDpVBNo5QSmhuUDHu+iOyAUSqzQFqYYJ",
-	"kHtqKIpYTqVQ52QpZvuF+kMLLBBlyPLkwHJEIduuXIfLwmsq+aqpsTaYoKGSNpjDVzNrf14T6C0okPAl",
-	"W8uwFYLufdHtqK9tiTVFt9dR7E/fASbjbf6TlkDXax3dSkEIZdLdqdPWBHz0rU5/0xnucvqSA45XxgjT",
-	"cQ8duXFA1/6CzJ4R2gvFReVno4T1ukPDXhXEzX8vLTTBHyc+iC0jPLhrI84N7F1DzH8vCtS61rBxucCA",
-	"14YIa4TjQvsoEKFJJA7coAs152u5fXTBBJFkqe4CmAp0ohVYyjjsBy0L7/ir21o5lc6iEFgSMVsV8Zfy",
-	"PCYUHaNpLhFlUv3lpGOVk4escuKvctxv0Riy9VNdn8YNomf2X8PBYfWrtbmC6KrfW4JadXtNDRXtNt8Q",
-	"g0/HRLTNRwRKiJAtIbGukAqznytw9tHrNJMrpPWa0TAaYbiPAGIzRmO3Pzz+EjwdRoZSI59gDtAg47R9",
-	"+oazPGuNSdXimSm+fwd0LhejVy8PDzdOgmCpwjOTq3GK7397eXioEZqRxPqfU0LdMkcPz7TQoSe9grtG",
-	"emi4+/m219OYHWnMwiFPi20LY/R1DCdth1nOA6K8nACVwNHHy3fmwlJMI9AUEqb0AAudZrkAHr5huymL",
-	"EaGAM05Ih9/XhwJzQDqgEhdeaQ4y5xRiBfV+f5KBQtwD2K0eomIlolS/Honbs3DQbMYBJjjDEZGrNyfh",
-	"mNsC8/gOcziOIkiAq0veOVv6kSvPx7xgQp4F6HNW3LiV2lGk0O7qO+0kN/raIaBMESwljhb6tKR5khir",
-	"VPIcQtd1FkPSEvVjkkUsudY/fA0ZNtqtfcYmjM7IPC9Dj1123FX4K6d1++gp26BZAo2D8dO6+lO/Nhdr",
-	"cLOYcexEoJ2ZNWI5qnZK2ilITJL+2N1QLT/WX2rgpwMjtArjwYMpxqewJNG6UNG2qLIVn2M1sGWD2SHn",
-	"rTJqB9y08b6Ul/rR+/vVGL1X/7m5YckYMY4+XL99fdnrF/Q1W4XkBTk7uX6BCW89QNWmDiLRTsOtxMzD",
-	"KPZHuoOYQgIS3uEpJG8SNsVJsupI2JrNjFnfk4AkF1iiBY616kvU3IhDypZ+qpmnI/SIsDfLfKznu8Oi",
-	"OUsLScyM4xLgEOqvhSQplnCJaciunYKQEyxCETmrBJFZHe3A/nwffRodLZ4fpp9Gu6GTFO6zFtK1zfZs",
-	"cfSybbY7xtcF7vniRct0NdoVeHtA+yuGSPm7Dd6r7dKesJpmStbiy5yK8GHRnlLTutV6E2FOVhLEtbsg",
-	"DMhrKT76mCUM21StLeXD6Mwu37WQgTExzbLYWqU2gU97DizRtPKi6hjrcCIMzbhR1GjjQuBKDuun1FSZ",
-	"7S/ZJT5KdEKSQ359+Y7dmfvDgKNPjf+YZYPHg5AXwI+ue53TVY1hHLGDk5bGoxQwXWt4TNb7gKwzunPn",
-	"CKz4V1zMA9Iu41NYbpqx5UuTt5JHogr6JWolySsgjD0Z8fnv87ZL8KBVaylIhzs9A3owYGI1tIBzMLp9",
-	"/7lPR/u7Mryl9NV/O5mTXWnPH/QfcILmar2+zOfSC1Cd5Xf97wjuM26y+NBOdjs/MMPR6dW7XX0YaUkZ",
-	"vRrZ0P2n/PDwOfyG/v3NCcI0LlKKfkN/yTiL/zI0VPqRkn/mYDHYJFb9xuBORJbgVWvytsnDXYP0HRHu",
-	"DgeDBqbbna8xHS7URpQCcuw8fz1evQ5/Xc/hYwEdt/vAWinQg/1gnAldApXMeJu7vjgrBj4KZdZL1L8h",
-	"XOY4OcfRglDodTEakpgl1iW2uhq9B3nH+G3IxahuoIHrgvkAmd/1lkGEChKDvjLM9X0rtH2zgLPlAuE4",
-	"VoojmN6Ao0BG0vHEfYOwQAKAoumqe2la4hjGRSMx4yztnifjMCOFi7JtMjMKJXoY2pmcnV5WkpEIlc+f",
-	"hT37DRa9JUKyOcepWS5TR5S+iRgXU41j2ERQ6g6DhkunVAMpoTc4yaHNUIBswFYvJrFfjA0kIZF7y0KX",
-	"8SjLJ4xDZ8bO5OIjivSgVk+bn7mT5VcsugXZO6eww4bM2nEClWdPmWuqLz4hudZn4HkgTqTI47LbCEXn",
-	"JzW50deXfjjTXidOynRufJ5ljMuu9GCXT7s8ZyYhSSDhvirCKdpDuqOAv1oJCel+4Vhb7bsVz6sr7oYL",
-	"YtqdS8vBIG8Man2J3X6/d+G3bPdCntEZx+1ZahfA1eUr0g79NXdvlOUflsAnLE2JTCEUiFMirsZExRh0",
-	"iSVh+2hSSTfWBwc6ThKmFYxOPxboAJk43MViJXT61sTuwAF3lMJNPvzoK2+hAWQV5y7ULUEZ59anFcfE",
-	"2LAXFdq2Uq7kis5IHwyYVlstMCkO2jzxsJJeRx3rrd/H08vjc6ckNmGt/dTx1v4Vm7T/BIZx1x6pw0no",
-	"7IwA1iY+UMnarNOwxdoqd47osMneOl4HDbOtsS8U+zVLN4XXI2Blp4QViK60KcqiQ6aaxCQJHHVv8xTT",
-	"PXVF1XmZdhzCU5ZLE4u0oX5SrIHc/bTpBl0rUwd6E3U6Mg8C4DS9bpt62oLpOnUiaz5dFGsFf74sAAj+",
-	"PPGgCg8oQQ3+3pFpA12S0p5s1UL24rsGtXu9G13E9POGwKU+BX9zs6sdGce3vVekOL71NP46BPJuhLXa",
-	"5KGXRVM27F0Z63f94pdOCE6tsR60Cvzit85Evdrwb0WiYa1kacAk/hf68mzNlu6LsxqkuFbaL52MM3kL",
-	"3pW4c/R5k7fmmmuAC9JXiBzECQd8G7O7QEkFjpdEWDZ3xcF0Hm0lh/3YfomIWqOlYMCkua8/eZEg3z55",
-	"i/7tm9no5/ZpCTXHfdBH2Df5WflxxxJ3mOv9vfb0fzcftk5dT0Z15C+XrOI3LtnfPB5KITp3de5amgIy",
-	"JAQI4YyzxuEWtfuISDj4XkRRB4ZGy/XdaiE02l07S3FHZLRYLwDuwvvubBAS0xhz2yrFVdaqv7npx6Oc",
-	"FlewYMhrmWDakpCwTEWrsy2cZ9La7iFU29xMRO6rlLUZUn7i1AIvAYl8NiMR0RmTnCxJApVcR+96mxIh",
-	"CJ1flKMai11lEJEZiYq63HJKE0rHHJCdZ/O8RIdriFgXmPAuOm2eNNMdtXqM9Ip1I599telV2rQml6wX",
-	"eAomrPRxsD16dMFZBCJg/IUDIIo8LtMts5+GNirwcN3Sjfmhd4pQ4ksI/EsyX0hB/iB0bg2TjtqG8t7W",
-	"ReDmlDVbh4NSUV+CyrkGdzm0MLUGotFdRG7GfGk5H9zPrbq5WoQ+JM5eFoIPHG3rgweOtnW8Q/xERNwO",
-	"jqqnawDtFTUPHD0caH25/5JxtiRK+iH+EmV5lw+iMlah/OV2uvFaxmXzJZ22OTW+RANPTk/ualLmTTN+",
-	"UPmz5m9FQlvJ141rFyVDW/BKYi7LLIFWbR0xatwg0aqlv1BP/6GiB41oixtu4zgoawWPNj4bNElKX0Mr",
-	"SdqrKM7ZJcxceyrrpvlB+lOFEW7Lm27IwByE+u16wUEsWFLtOfL8sN5w5B2WSmKQdOMRoSglSUIERIzG",
-	"Ak1hxWiM7hYkWuiz0YZCbMUJEbr7IolBW5UGAG0/tzQpeBm8cTYBb/akKdq0NBrTnLtONOU8HkauI4m5",
-	"Ojm7358tNS1oQqY9bNq65ezgA5owKjlLgi1cYt+Ga9jYthHEh9kF4Nvrou1TBYxfG9y8MF/pUiXAt6js",
-	"FzWkaURnCNf4i+rlPCmhfsziaPznKfDxFnmaCp/agtUSnxZ+dHgCdU/QWiu1PCdxMKlhgxSTVn/huFj6",
-	"cyvcOtv75ly0qm4cB5ssan2NYz+tWxcdbVtxl7yo62yFdWrLcYLQmZ89AGecpU8KYkhcnMuypZllb95X",
-	"iJU35yZrzmTgieFdVuu1sbaJSQzSNCvGtUakYg0XwbijccpprV3KJpObxh8TLGHOeEjLeauYsSgqB2+w",
-	"lN4qQ5ZJzMB1loirgcc2vhSj1iZY2E530UK3dB3XEJnH/S10b87N911dBHLanUtTOHABRwu7gXeEdi7w",
-	"GDjCwtLZmD+7o7UC40kfL+3cNp6Z6PSZXMDmFE9KimrUw3RzbQQ7Ag4LP4WrM8mgGNidTKh/+p3xah+M",
-	"IeP+TuTCutRF9zfvmeyevqXQOQBbLyBtq4Yp3lqdck/kqujwaM2mtgSRLja42941Ae4azXwbB+TOLeSk",
-	"f7pCRYNcVMKEElhCMkZAOYkWEJtdYpIy1FpIkD90Q0AzcB9d5RlwATEIFHvLnKwmxZxeRW5JGz+Lrjus",
-	"1pRae8stV1DYPzkFccSZ0Fjf7nkElAS4ULs5dpmeYIo5dBt8OifUNn27JidjdHS498z86dnh3kvzp5eH",
-	"f70mJ7v7n2iIcAZz6zDZkHJvTh7wsSPWlgkeRPR6lXUnT/UtpCboWSQos+slbDXzcB64AdHO4W8fy2jU",
-	"GB399hqL1Rg9++0cYpKnY/T8t7eYx2P04re/L4iENwlbwu6oH8Us72NeCL+Bm2Fy8VFvADTNdaKqKQoc",
-	"o0+jw70Xn0bqDy/3/t384de9o1/Mn47+297zZ+aPz5/9tVI62IbGuXa0PSImZoF+ZEI4PN/7xf7+y8u9",
-	"o2cW36Nnv+49e2mHP3v5yzBE35Oo2O3bRHO6Qu/PJqbRloeYBdUCafEx/3vRBjBpZjF0Xi5rw795rdr9",
-	"836Q/7EW/A6VmXsE3EDjUf+UvwQs1mlN1Q8dEw/VNA12MHFGZ2xTpWm/DunKbGv5rBynGx9BfbbmIENz",
-	"bStTDbtaYA7xKRG3YmBd+hKqGSJCz4BskGEdK7Xans3ZTo6SxanumwdVhrVIcmjvBU1Zc4krm8oEm8tH",
-	"wAMZwBevz/eARiyGGE2OkRpEZuqKB2ia0zgxPt0lcOL6TAFyPWOu3135H+yj81zmun83mFcslmCbCN6S",
-	"7DoR+6OHO+Y0JzIsxB3jVcda8Y/jR+suZPFo6+GqtnydKIZ0zpNChKZFBrEiljDtuaagn/iQTM+AcMLo",
-	"XBdBGZ6h//u//4+R2YilU0LNTKarjkAvDg/3kV5ekUhC/AqRmfuSCISnAqjN0PVeZrolmdCgVsDbmeLo",
-	"9g7zWJiGoZLY5u3/UZ1Ux82UxNSmNZOBmTkXRl4U4B49FDaWjogCxCUJTH+sph++rxWSn2aeczJ6OMfV",
-	"it9qbZMeR6b6mh8VQv1Z7fNqsmqzV8XKav8BfQrSONAdO41fIpGnLgMjt60PkMR8ipNkrWyO6wWgFMto",
-	"oaTApSBNEp3c5D7qS+0oxylwg6rPjHCHaq3Gk0hT/BAo1iV6O6VEoqu3x8FCXfKm/fOPZ2jeO0MrafQD",
-	"XhsQocSnCl6QMNXaz66El1rivOeXDRaCV0qMmo8UeF7Krhd42vr0lX6M1qq1pjCXpczNjCShpNkMMEG/",
-	"m3Mjl2u6goMvTlWIjM5OFdBWM4VjPC5ePrHO1b6HvLzyBddgu2islijbQaJMyYeQECMOIk9kS2pus/Kj",
-	"O8hUG++uEv0Q6xsEmrGcepHWmji29v8JMPGjAL4Xw4xQiJ1ztpz33Gdid0www1ICV1N++nQVYs9WgkD1",
-	"wKErxQrUH5tb7LrC3tWq/VoZEMRo75pwEuG3b1cEPL++acktDb5SNiTw7jYYEcYEVIeHLoYu5gyu2PJA",
-	"WxWBNo2iiJ8Ei1G6qYFR8WXQ6iiT7L4MeJileI9lbw+ZZiYm5QkdINc/6Uvl3++Rko9BFXIVUNrePKk/",
-	"MIIlSvE92vn/d//DGYG6ooqyyjBdX70RFMH3NQJQZL++fCQoXL5fw6FyW5n8cRZvf+ik+aTIo/Ki9a2T",
-	"MCDbZYc97M5Oh7S/dDZ74ESw7UtFS/9S++VVuOjMzWuKBa2/TN+vIf5Ayz/OZmMkcpEBjSsl2d0d9XS6",
-	"RolnDZgyd7diGnmGTnEAVE7QfputtdXkppZbVHkUFzqfBLakLB6WHVdeLx4jxrMFpupPhOIoAiHINIHd",
-	"8LIcxAVw00UhrDL0GB25Whoa2GYKQ5pdaJfL8WxGqA0N1BwcRcH5xUeTbRg8DTJClX1RzWQZsHagkL6z",
-	"kbXDb3LxUQzD7qEGd6D95/CmMaZ5aDBvwbnaNplVKe7AnNrEuGYJcEwj6H1w7nc1HBXjvRS/4Ik+Izy9",
-	"wxxCnafML0h9hHamhAnEOIIZCUr0jCVxiBtFZhYyIxAH23E+NIvuDXMWTLHSsOjf0YernmZUetj7cEcq",
-	"N8MsT5JWAZl7vXvWaAfld/xp6WcQqPC++u/ENM7oJI0a0YWS+r0NnXU6qzQVwcDr23ri7qdJqxua2NqN",
-	"LDu2PY9aCJVxkmK+Qt3dkcyVbcO93Bo9+ZPf50JNd86LfjspzLFxxw3S8V13uvJu1RDXCFM0BXu5atF6",
-	"P8pt7tRrjOcMwjavgN9vS0iI3970ngVmoDtenSHbcyJQEm0q9u/PJsHCxCKq0/EYhhrjLKwNzFSd4K1M",
-	"rlDm4zsiTO65G1KmdLrGRdU91oWyKwAMdq/UKfYfRYgpLv8+YlTkKeiChtBuaPFwtN/oO/ZC/41eMpYI",
-	"2yCi1LnhBewZfK0+UVOXHUICfXRZItrmq85DhcRJggsLOw+q43x4w4Wb1KsFPLW9W9QUecsxuAQudIQu",
-	"bxyJWAgypyYzquMQfJIbX0fTS/8m5u225vXGs8UblxBPiTtL1mqDIfcy182wei+7JTTU19v0NjSGZRRz",
-	"lo7RLGFZthqjXEzHSAAnOBmjDHOcJJCE76V9MFlPSC0eFJLIk1xYaEQkyFhJwBgJLPEY0WXacoWzDfNb",
-	"nC3u5zW3+YwkwRT+078h9RPKsFy4pxkDlToleLewarX5dDzhFlY6EG0naxw7Q07oohSqgb5+g3vHueGp",
-	"NK/8afVN5Ze2f6eMlj8Fqc7jtEsDEmF03iW+Q1bKznGWVbSU/xK2Tm/oVqmaWES4VIgpyDsAitI8kSRL",
-	"6oQTA5++bjOGbQwklKugU85X3Q0uakfOgnGbu+2UWpGvYCMn4QaKutlUf/2FGzguoXOwfF4DZ3cB6HF2",
-	"C1R+YsM6ovmAog0IbWq4NxgRSmTvwyzc1sTnoCv2m5QtVf5etFQ5q7RUOS5bqry2/b4+KOEc2CwqAJqt",
-	"Xlh5i3eMKuHqGFQFuWOgh03HKIdoxxBLg74u3Ob8h9jvv23ybyJGJdxL3R+bQ8TSFGiM3cOQG2yxwU9v",
-	"eJvFn6x/y3T3rv4Xem2utq+LlAilmGy5ZPD1uZqqfaSez8umRl+n73PzThTohhZDIEry/mxiH8PtOpgD",
-	"53BPJ+eNmjZ3OKP6VaCppmytolzHDbLDIUtwBOq+rcuR5vaX3ccvXqRMThNMb0MOj7ALIWhFMOcqKLwH",
-	"fR6DjXIAg2wJXYZC9fmP3d/EJGb82RuirIXlY3ZQSVlLr5thTVU2b6eyXiOVlo47dTuTmXhj6nqnBFqb",
-	"tDU1CWHS13HFk9e+9ise00O9WD63lAnVy4kaO1KfOHrUyaCssOuT0l8sTVRkSKQ67U1dujkvn4WVJHgw",
-	"h9LALejlEp87CqYehQr6B9u6bnukaMmT14tp/w4RbtEeMrkFxxUsP3fWRwSqhlueFjCFWK7cqPYyzZXr",
-	"3G6qhTLg6BJi9BZL9LfJFcJckigB9OLZ8xcvfz3yepPYnGXtOTa92b+UDQh1o+A0p0SuKv+qblQEJ18W",
-	"mMZJ+A2fAmCIw8+J5tmc4xguK3Z6oHW3+x1i9OEK2a9cYhfy2iWqn00atgkX2KGx7uOA/GG9Zr3r4hRq",
-	"xVg8Qm87gWrsiNRv9RwLm6JYFN0gkwR7fHE28jJlR8tnWgoyoDgjo1ej5/uH+8+1GSoXWhAOdGMLnd1r",
-	"kgmY68h4Fo9ejd6A1BNfOe8qt3cI/fGzw0NrAkg7iVfQfvCfwtDZmNJ9hra/jMY5lOMrilDdS7N0PWAs",
-	"gVOcIAF8Cdw2udaVBk5NKIwQ9icbj4xp9A+zhr4XZjbaWiXGlSWGbuNjGAlCnrB4tV0qqPmL9lJVkZE8",
-	"h2/fjwu6KVG0wHQOseLCizAX9BPDiJcdsl4c/hpMj5klxOipjdk50cBYjqaGMXV+fhuPDiL7+r6taAsK",
-	"u7ojT7xxj0jncpnKxTxAcheA8hF4CMH0fDhJKhOWNPPxb1BOY4I5HHzFZ/G3g6/Ts/hbKzXNK/dQJWiG",
-	"OU7BNOf4R8OVjgUkOg5bfIN0XIJQXY8kFy5s8WqE9VPE1Y0x9ojf0LtN6XOP8CPTbnTIqtM1V/38JCJU",
-	"olJUfzflyMPXCoPxZWXA9zzM8XzOYa6sUu3dislsJswOfhFIPyHmwfLyc8qkSVV/2KY2ooPkHfPFVNc6",
-	"uUKtIKAPkOODrzFJgapzcx2ZPiWz2X89uR4Hq5NAchIpK8iSN7xWQebOFZ3Z6NxnqV8TShndS0PtSNoB",
-	"vCh9mGjnaG+KBcS7++hYbT8dUC8iSclKocBosjqjx1q2zJ9P9h0+/8xBe2AtQtazV8JeZIMceT3pjkJW",
-	"fdeFQSdXKvtau1/VHwSJoQMGmx1bwtG59lOrJr1RAnrpAs8J1a/fWJS1aa22M/AiYiYXTWXgUs7mZAkU",
-	"lVLVZ5YUI9FSP4321MrtlJMkQTG7o1qfdWEdwBg38B2q876S+NtBvW1Zqx107A/s0W+TfgVDfpxz08Ns",
-	"qO0V6uLWfipOti0xGowgDIhQhD0Z8Y3fSmuxsDS43mUHX+2f1DlYS5dpuxYGemc/uZg09KerizW9cN0j",
-	"7TFLMaF70dGz559Gu4hxNAcKOsWvaJvXBlFBmE7AytTJ/7njVvv0Kf7r/7Kf7/3jcO9XvDf7/PXol2+7",
-	"/xbwCzymxHc0bg8ZipYi9QKW8hVOWyhZvO2EeLkAMm3Ye7Vw2d4aWQ/bOttpjCjz19drjhVnizaIW9l5",
-	"b0AW2AbIMl1V5cftPY/gbVsP7jWZ2jbYa/3zxN/Y33tvTVia4j0BCg5FdIMBEhHLwGsXxJbAlwTuxstU",
-	"jE2d8KfR7j46NUaR7idajvo0arOq9LyjtSD8kMssl1aeXqE/SIZ2Jlc3OvvJqsr/cXaBMI8WZAlaEdwn",
-	"4h7tvL6PIEF3jN9OGbs19yLTwgRAGtNLQbPbAqoV4KAJOPqDZJ4X1PxNrRqyWtdTAksa77MM6H2aGAjE",
-	"HpvNSAQxi/IUqNwXGQccayzSZF//v6o1Cuf2lFCsUWr6K/0l/zBPBa8zQUO/eCzQiRSY6IeESkYxjqoM",
-	"6VUmRlae7Dw2m9O3DLV+xEIj4ePXRGUta62sw2+10964x7y/t3qwr83bzgBTm5i+E2EBe4QKZaxKot/k",
-	"mZpJTOpG256artzrMMNBqN3xdDqEyfh+smubRd9d29a5rRXLPztsbWz+1Be55rP3HVaylda+zWqSdNTx",
-	"wUGUN7YnM6Rxkjg2tVvPdlt17suDr/r/XT7QN2A26A+wPzUcrbNbTB62xJXSijMCSWwbDseEW6wK80Ct",
-	"9wqLyLTZs9bTKzWPthJurIjoOTA3XQrGyC8RHjubS9fQ6nD42D6VO7bdMsYoyvKPAs/BjLF/5Di1f4qy",
-	"/Hg5158dL+fKBIF73UzA6+isoLQE0vCpAxvus0QnLtsHaEN2izLrfLINb4ki5Cpx9sSoW73NmFYwtnvV",
-	"U2o4jc5GCu77arEuDWb2hnuDV4tuPTV7gI5i9vTb4t3DzDddobNTAxaRIpQ1PkhrVV5EaFNXZ96bB38i",
-	"p4//yEMgNKJR0W7gYtggfhfjt8jzqAmN9fwFTyqHmX4YL8x4m5SaermvrfZkU7h+EMNyuvJNhjaj8bU/",
-	"5OfR9fPo+hc/ujqS+Dss8eDh1Z+pgbyt/rQ2eT2rv90wr6M2TOUdmEvHHsu6Ix9vQNZegPlzHYNtz9sE",
-	"ZMmqXUexJ5MHnZ+1xCQxfdV8KEzATjxcGsoagnYpKN5t+VOxv/aaTEiHmNIJW+ekH1h5Wt4nOjG38naM",
-	"D8yDmf91mfZc2RtlM9/bBGr0xQwvoxD7cWQt1HwrIG813OKy6H6A/V1vabg1KWyBakvCNzTGenP+Y4VX",
-	"a1RxL47+K0hjsLFDQBrPq3HP4dJYyJ6Sy0D/v0pPnoeKZyUI6R4MsLdE4d6p131JBskrs5n+CZgmIbW0",
-	"Z8mySTGwwaR2sjD9Mn+WwcP2o1ofRR4AtQgKM7W8rYkKbtTj57DXl2r3NTC+rVz2qD5hG31actol5rKD",
-	"u8++A3FsOxddD/HwJPMytTXhgGPdVSPjbM5tJ54HyCXmsu2e4tO+stMO+FJ3uNFpI+0suTSjqpxpqzcw",
-	"DSUwlwfq/r2n241W+FIt/tFh1sr9vjeM21P2bGYM1670FS/8qBL2ntl4tHud4YlkTI0+CtQn3ZimR7o7",
-	"ChH6vHG9uPrk0qTuuBkMszpEtfruSXks1AumUrYEoQ123UomLtoTeTOUWUNKKqdYwP5oXBP4U72C/9zK",
-	"oEPGW8TA+MC8TD2HQyWqQFOQyvtX78ypE8ZkqDdmQilIrG2BnY+X73Ra8e4+eg9LEw5lAvRbety0dOPI",
-	"PVaxj64XuvNanDFCJYoZGP5z0EoQS6iQHM8xoUK6VyKaBFenYhe1t3giFst07MmSQOWZGDS33rMKnobA",
-	"Dz5Bm3xqnqQ1vhfPlldsR8sL0cWMMQIa8VUmCz+zuDXZ6OgWVmOdb64BEq5VU8IinBS7B2HTcRdHOpvC",
-	"BxrkPjrW+RXqoKASXXy8RmwJ/I4TBZX6LOOwJCwXySog6E1BucgbgrL9mrfAc0tPXPW2npQK5HZdXLJL",
-	"i+Ezo7i/C0wRhwBE3TmY3ueMo5xywNFC+91cw7oH2u0cQmdC68aqnT4HEc6wfjGJVKJWNSIsILoVxZM4",
-	"GSdLksAcbL58kqBCpgXaKeywsXsLRP1xxjhEWEjgu/adpcDuQFeEzhNAidp4brlIrW7SUfXVat6jbSc+",
-	"So8p0m6dVYf4FGOsxtOxkQL4J1TDmocFTQsIUFSlVrvUlAzsMlnUTdLoQdt/Ek2BRosU89t9dGxMtz0v",
-	"rTinJpKXcdDAx377vLAto5b4vQTmEa3dcpV2Dp849FCEaQQJxLpBom5uZwrpTNm6xryL3wWd/OadD+K4",
-	"hqec1+OuR75eG8u+Kl4gZbrAFoWNGSa8MMSdNye4QxvUfMS9OYRzrvdWKdjbcBhcsCQJTNlK+yzYdVxf",
-	"MATCYkWjkoNqOymNy6g2XlOl/Is2k0hxQoS2C+aytl+2b2HUVlmrtv57bdihDhBPE4+dYaJf31bsH5tr",
-	"BOEoISmRCO4jgK6r7DFluuqtst/dpXYb+95cTnu3fVWnNwyBsFxOWJrlytK9W5BogdhsljAcq1vXgtmc",
-	"jBlg/bKGltTCU2peXt2z9bI1oUXGOtfPMdJYfWYboDhLC88BabcIkixjCZuvUAycLF13b92ZjvHbhMzk",
-	"XiC7J2B2M+GJ6wUmvGE2bH+TVJZZPWL/iWGtsivQBBxQ7dYMAZfkQXjr9jktmLy9kvVcGpFpM1u6JLwQ",
-	"unZD15155dBh8mXfjLWSaoVYCW9U2n+kbMJZFd73x8fIPHni9bbuO0JPS2SeQlaK5VyUsV9YisrOEtIO",
-	"P6DfH8GlJmwhEb3Icoh9eg2RFq2YDr5SnMK3LoPXWFnG5C1Vei1OpEXWFmzso2sjTc5CUJwiNIfC6kNk",
-	"hswZYbSj0qpCkiSxLok+k1ht7L5Qphpj6kewcHCWxrc+GQvHfzD2aHu9PST2uN65X2+nSvj7cHu68Ui4",
-	"1wyKzljWNA/2MQh51APEKuYYZMNbVpbWQRm8PChO+xmhRCweerEzZj5GwtydM8P9ITKubl+9upDQmCxJ",
-	"nOPEN1mku+/vI5PppFv0m1wi05A/cxLWo8ku8/4UMJsppW+L/tStCYZWOB4zFj5IbxbG5mVO11GaFUHa",
-	"Qjei2nzDxUPnWHSFro1Pv8LOPm5e5hvnT/T26A+wNbBVFQReQKM7/Gmgbdv1aqotda0w0RFcZdZAXimV",
-	"17+XI2NCxfpWSoQkkWt5UbXI/yJ8IEwz9310YZ9mKqIkrj/IxzMkGYrtczlF41wkSQoIhCQpljDEKSCG",
-	"H1uSoTnIGiL9+uDHyJVxWBucA3rgesFZPl9kuY9hq6ieE6E9qg7PMsv4wZEbGQSkQyYHFNS+0+X+w8pq",
-	"f9a8/qx53XLN6/t6y5+t5NdbFjU7eHSVvralDE10pMnbJ4/kkDDr2OK9NbwRR9sVhfaCQRtyWy9H6Sl4",
-	"biiHMKJwZ2sIXTB5CONLTVktce62sqoC0ak3t1+LPMiwcmWenmnVz40tV3VaO8pMue5+bEtx/K6k/1lL",
-	"97OW7r98GfjjKo16Kfja57iyOpuaw7y08v319vZNB4PZ+qbD4VOZDrkGcOumw5blzpBxQwuiSK/pqy0o",
-	"H04bPWoHAAtOe/C1GOKXLQTJXo7UaZhlXDQYVL3U7elc00H9ju7WUtBZVuYxVboCuH/rshvqNOnZ/mfU",
-	"vER0c3r6tzKX1cqFY1zLUUDMtzdxfBu64JYPHn7+cWSgzEV9UqYqbU/qYLSxtqOEoLavHimtwn+L8buk",
-	"VQxn6gZ1JWiHMnRzLnSGn5J7k+pX5lzstgpIKUnbz5+IAYp9r/P7GVdQtkhJRRsfLNUW7OwAY0eqvfr4",
-	"yVBqlYsyehaqyfPVTddJqAfmWcJwvIW0cG+23k2YB0h5kVdJue0KnqH9G+t1OhuW6Tw5x31Gdm5VNbp1",
-	"F340DGypy3lx9Lz5ye8kASQZQwnmc0A7Kb5Hv7w4P9l9oC2lAdGoScynOEk6t+uAXk3Gcvc7Nv34/ZOa",
-	"Butj9FDyOicNuSo1eygVLyh1FGfbIY+5U8wS+gmv0DYxP/vJO62PHS0DY73qYIuLRX6NflFGBtfsGvWz",
-	"pdNPN9TPlk4/Skunxwk51Vs6DVLE4S4F9SYaUyyjxZ5xoezp53Gdrg7fhk7UeN/ZdXP+uvjqca5G3pLF",
-	"Uut7omoPkriJHst79HDOa7QteF6qRcEjrSmMrZtA+T7oNoRieIcvJwP1Pl//Ql23ts64/q5b29zA/f23",
-	"HI+KLlz/Kj2xHocz3T2xts+ag6/6/4OjvppAbxKmbjW9nWP14EqGZDWOoJf+YbKhHJoegr2igrjujbCW",
-	"gt5YQEwfBoSNYBhZUAKzsXYdFC/SeBqX0/dg9mOFjBxaDz2rDdo/7Dl9HOs2VTwgOo9zOvc33QtdJPuE",
-	"62dfvA6/yhP3xtv0EBqkbX4gsXiE/hcVcA3aD9U/NRI8Xrj5UaTMxp1rc5cu8G3rpaH9GJ1VukZXxp8t",
-	"E3tF6AdpljhMgdWedlOL6dUN73OejF6NDnBGDpbPRoo99ruvHc/Z61pM3dYrxRTPIVWsK2TCPGIeeo6t",
-	"8vqU7g0U+n5SeTc8sPGto9yrcSz7pTSm0Q3Kvvb7/xEHU1TtTeF72Vt7iyK7NdE/c1AjEY44E6bLmPW7",
-	"elM2vWI9+8+ksoTo9MZlcjcqhQMPb3qBjpJR1bdBG/kCjTcSDeOtf/eguo3KaX2BCwEHmZJdLxackBlE",
-	"qygBPb0JngbwLSNOgWc0Gz17wqLld2wah0U8HHdw7LNhh2+fv/2/AAAA//8vkK1WhhwBAA==",
+	"H4sIAAAAAAAC/+y9/XLctpYv+iqonjkV+w4ly0m8Z+JUqkaWHEe1LUcj2crcs5Xjgkh0N0YkwA2ALXVy",
+	"XXUe4jzheZJbWABIkARIttQte2fyz96xGsTHwsLCwvr4rd9nKS9KzghTcvby95lMl6TA8J+HaVoVVY4V",
+	"yc7pYqkk/Y2yxTkpuVDn5O8VkUo3KwUviVCUwEc55zfXOL35iVcC/pARmQpaKsrZ7OUM/oz4HOGmcyRx",
+	"UeZEIrUkSED3iEqk51Xpn+eCF7NkVlBGi6qYvXyezNS6JLOXM8oUWRAx+/QpmQny94oKks1e/q0ziV/r",
+	"9vz6v0iqZp+S2eGCMHXKMxJdSMEzov+fMD3m32YpZ4ykimSzZJZR2fyz6V4qQdlilszu9jgu6V7KM7Ig",
+	"bI/cKYH3FF5Ax9eUZbrZy3rKCWeEz3+ou0St/j91Vwcziy7qQmFVyf56Us4kz8mR6Rd2o9uECMFFf8+a",
+	"TxC0QP7P3cV/SmaynkGnn0oIwhSyM0Fp06/9JLkntfVXeyssGC70Sv42OzJDNDM3VDnyeo00OW4N1iW9",
+	"nWeI+I5f2mt+j8WCKKR/RHMugMWx3qbtrdXbdc3Q/ho7P3XWlszESnGew2+vGb7OSdZfwfnle85zswJi",
+	"G9XzuuY8J5jNgiyaBHguyLZlmdMU69/fUqnOiSw5k6TPn7hpCP+mihTwH/8syHz2cvZPzxpZ9swKsmde",
+	"7z+viFhRcjv7VM8CC4HXvem3BhqZct1pb7otOnb+6fcwdp70Tg93AC0CX66KI14x1f/4XVVcE6Hl8OWp",
+	"RKJijLIFUksqkbf2WV/Q6j7vRfvL01Gq21W0qeGWYAYe2YvL0/4u0ABPX56ik+PppL48jVC4swCqTwa0",
+	"DM3zFVbp8kOZYUVe36V5JSln0duHuBZjJD6lCwFr7/WpZVLrxyx0vOvPQAYTpDiSRIGswnmu2SNw2vVm",
+	"nGQyQlipO6lgobOkYZQesVvMsPmlWVD2w/MkoyuSuL/170ozzxAlgltEWLossLg5rwLXYyoIViQ7hO2a",
+	"c1FgNXs508vcUzR8ADMqby7ob+TNtUcB7zBllZnVBUnbnfLqOvd6ZHBe9Rf1Hd0bi2atLihTf/k2eIKp",
+	"ImbU8JwKopY8Cw5RYire2SPS/1GQ8njj9UgiNfedTJ285JVIyTFWWCouwjNRcOmOtFkKXi2WZaVOX5Vy",
+	"0mRDp72Zvked/iz7c/K3ocUnbaboTbTen8TjxxAvH+ESX9OcqnVUI3QtKAn9yvOcpIqLMQn0c2nX0Yyo",
+	"x59zQVIsFblvB5TJ8v4T6GxWsxq/49Ys+0Ts9uHTK0jyvNI9/UiwqkSIppmQLT1rjqtczV7OcS5J0hGl",
+	"vyyJWhKBjs8v0JNjqjn3Gp5D58RwF7pIlySrciKe6ueS1c2slqnfT2Y2QfGdCVD6WrOYveOse/++nOnh",
+	"caV4YTSNliLbjOBU2R+rPF+jQ9MeFMUzLBTF3b+eYlbhfJaYMX8NSM4ljmqkjjKri3JJBEE/HaInP9HF",
+	"Eh2uMM0tBwzSBO3Va0phboJIhYWSoA7pu7ASK7rSOtGSSyURnuuvMPwLzTHNK0GChNWHGy/I8T02+sJ8",
+	"Chu+2X5+ivPiB0Vz+hsOv/dSzuY0IywN6DxaUqGUrwjMqWmJSiJSwpT+65ODvecHB08TlOI8tW95LNHq",
+	"6OzD3i3RJgOS1X3MkoCELfCdfdMfHHgv/IPARZGW1Ue8WgQUYTvHo7MPqGqWG5joNqZQ4Lv+FE5NH480",
+	"hfK7F/0pfPdCLd14NH8MahSkGN6QghRcrB9hFoN78mizmLQtjzCb7q1lz03DOw0jN5vYLKEhaeILiOB9",
+	"Zy7VsGzxleWewGPm/qi/R7dYIvvJLJmuXJc5Xr8Lvtk+SCL2FnRFzOtYP3XbQ86SmArdtX7Vk9SkUHRO",
+	"iQh+XJRcqNCF9b6/VtcYjJsIo+uKZXn4Sgm/Sb1pxV7/jCsiw5RB8BvC17xSE+hSUsZCCzuDv3sfS4QF",
+	"QYysiECCFHxFMnStr1dFmGF2UTFjyQrcnXTBSMD++CNlCyJKQZly23hD1kgtsULwTQZ/MyTULTBr6Du8",
+	"sJU+eaExjwSBzcY5KgWf07zmoNURfBJi4OuK5sqZq6eaCnw9vqb08Gk7XCwEWWAVMJFZJUEOmXwyKhVl",
+	"qUJ149BD6xEO8IOOm3nRax1paK1NK1DtnjCOjgQFtU8rNSkRTD4Nrp9xdjppCMbZXncYrFBOsFSIM9Ib",
+	"MDye4grn2tzSFx/6F8RaJjvKosc25hShWYfX6hFbxOyuPGl4apgrj3hRYkElZ8d0Pg+w5hKzBcnGXnNN",
+	"N0fmgzO8IEbcF4TJoDFVC9j6Z3RNtOKeQj8k814nsODAavdaf3Dz9BaezOAZMEtmmXvA638wom65uJHB",
+	"Bwxlc4GlElWqKkGmr/pEf+fWzFm+PmGH07/WpG9//Oo+H3dYpyF9M6Wm/6lscVEVBRbrqKnBmfU72qQT",
+	"dhKeQtdcLf0LZx+dsIzcoQP9ZjpET66xJDll5GmCKPzwXP/wat+3RA5Toy9lP4H2dWI+/xqUr+YfbZO2",
+	"ZtP5vL+Kd1VBBE2R/pUIwlIi0ZNXqKCskujwKbqlaonkuiiI0s0kUXu6KUq18VuikoiGwfdrwY2WWCLG",
+	"kd2TZ3ZH9GKjZ2/IEVAKIglTWrp06Wxm2JJrtlM0pyTPwneIdxtNZ8HXTIl1X8Tfo4OeDL9HH75c3vjz",
+	"zjnaWOI20mjcOuUdIsuFwwdz2NfWOZMbnp1RX4/f/fA0T4N+1Z/4LcK1LpZ6SoNEBc7IPjpkiLJUkIIw",
+	"hXO/yRznuUQ6PkA7KjCaV3kODH2r9RrG9TFYUV5J/6OO9neLzThauzVus4U+OKXgKZHye/9y5sK6t21s",
+	"g4lzAEMaTlVl7E8V20eH13D4tJQzTlf3TJD73iWmZwtGzHptk33iPkl/NN20/3jid9raBWdrDFmRwa01",
+	"nTdcV0f2w4m6prSf3UvTTEVIbfiRrsgeSC+kGyBypwUg6BBPtGRWBC15JVCG13t8vldwppbI/K/90y0h",
+	"N0/30Wll99G67VbEiEvKFBErnF+QlLNM7oemFlKCHYnGXpzt7kMLvCNZPQt0TdQtIUxzG2iQ0k4rOn9N",
+	"lf1ZMsUvk2Opzis2bQt1Y6QEXSyIIBnC/kHDSpGiVJO31sVdTGM+kCbRR3VN9+iTmtzFVvmO3ClU5hhe",
+	"xP55vl3q12Nr/VSiEleSZPuTl2naB57g8Pe6a/8BXhM47MF9wNOXuw3b7J1rD3wzduICRezqRn1aUSES",
+	"YDqs9EQzblgZeL6gUhOr2REjtW9Bi1IuDmIfyRtaokzwEmR1gTDL0C2mStauD80IiKcphDSl5HvEWUqQ",
+	"dSJgJClb5ATBiveqssXfEklu/r+ZATX3kS/n9RxAyU7JxgK+Q50L01X0959hjCB9h5WEmuvuoSK4EUZV",
+	"hWaQaSwR9t3H+OS9qOzFr3dDVMaSQVY4r4w/Azw/5klp2Cd4miKhc+cES61xaB3ghpYlyRAX4EAyQkLG",
+	"b4QpvnC74uDFqd/EnjhCVrBMkzaxED5gcJKh//u//09bamui2R+/r5eqW/lUtccvq/QwKOO3TI+vKYIZ",
+	"Bx+Y1yMXyDpqXf+UaYG0EKBgWRq6IbwPU17lGZzna+Lm5J+r+i92mpoo0Nm9j9l5ZYMHL+q+hxrVww40",
+	"+tHOSB8OJ8YH71YjRxq+tXSfuOPB0AaPu9qzqPmjkemTj+awQIEjcX9Zoo/+mDiBIYan+14Qlp1xytRO",
+	"Daz1eCcPsYM6K+ar9RFWZMGNgQVnGdUf4/ysNf3+NGKLcP2C7cH+A6VuiAD90rKKGi9LwVdUK9YkA/ew",
+	"nKZUPoYNekdeG+PoO6WvppDENNYCTn8wiTR/NPO3DZyYSDDbeiOKxQ3sLSNY8NvP5idqCYm2+b7m3A0s",
+	"+X1ZYc+tz7CtzZhs/gehKeOivdTyNED8nxlB8JsVNK6/BPE8IzrchgqpNjffekJ87EqwUxtYHxexILqI",
+	"4vda/xkVREq8sPqlNQJRabIotveWbZQ1p+MIgrO12W8IvAdVxpG28w9kTM4SXmFCtn42ihPMdiPdyJHL",
+	"/O+5nU3wxyN/ipEW3rw7LU7N3IeamP89q5c2NAbJYg1eGyJskA8S9mP1j4X9azhVRv9qPX9BuaR/j4T4",
+	"d72GuqmMC8bxDpy5Pyoji1DOT/MR4syYSvVM9tHrolRrBAfSnA9YK7lLCckkqhc22XFzeWrGGj3tzgtY",
+	"mqC0hoTxFIOQbT+Q7pErHAikqz0+vsNnH51xSZW2tBUEM4legS+n4ILsB6nreQK7imJl4iI0iSVWVM7X",
+	"dTJH4xSlDB2i60rBw4gy9GpglFcPGeWVP8rhuFvakG2c6v/ox8emRlB7CHIqVeQYDWVWPPwMDadhbHRY",
+	"9ESHN65xZvcvTqZoMFNq9tr+0lpsgqRRva/XYJ3dvgCBucLY65gkSf6B+M3R18RJQSjW+GEc2O56v4I7",
+	"Dnpp4EEey2/ajtdoQ6eONjTVyXbaJFelS22H/fcM03z9QDfOdnwx6ImN7ETfHBw8vYdnxn4+e/nNwUHw",
+	"3fggd0mB794StlDLJgy1/vfD06BNRleB7354fnAAvBnzehh+6zhVjD2gtA4RZdLPduT42EfHJqgfkt10",
+	"Gxvk7z7dR2CAtf0UlVSI3FGp9keffNEEQrPoN4JXZfRcdXJOvf16cXDQHXnyDvGCglNuDZvzwm7OnOaW",
+	"jjtgAxjh87BdOC3Vrja+MW/xNcmHBF5tnAvY8HLziCyxUkSw2cvZ//qnvx3sfYf35od7P/76+18+/XPY",
+	"ra0Hzl6Fe+3wQjTZdRPqbsishiZDF0EjnoNzzHUHG08y5t99SzR5ZYIyuqBKJuirj1+Bc++rva/guqup",
+	"/7fDvf+J93472Pvu496v/xIkfikoF1StWxk+B6NXrGUns7DEX3+cjBd4RbIfgQGnnvzedEcI/QgE47c2",
+	"vHsCS00kzC94Re5NEZf7d4ZpOKN2oUWt1can6s+Pw3rwduRsjPW818T0+d9SlvHb1yybnuZsPgHv19SP",
+	"NpAj9lI+M3dpf5/DBLfNo6EclQgo0e6i/3D+NviNJCI8mvuwbpFM43I9C6/fSRQYdqFZlWMDN1qPwqP2",
+	"UjfE8HRjJtMxyhuTed2NRNck59rWwLe9J8lshXM6kGLqzwILAn6HOieTIEFUJRjJ9Kz3x1FROpvtRg9R",
+	"sZW83hFrVN6chPPz54IQnQSdUrV+8yrs71tikd1iQQ7TlOREYEWyU77yk+Q9XVmHvYe8kye1S9KpyLql",
+	"foYLYm1CbgHa4I2VwlpNnyUzVuW5cSkpUZGIDTyPAAxwxVOev4cfAg2s2+KEH+m8tUXVoBwM8f9F+Cv3",
+	"0h6jp4rNZkVYxifcd/Brf7DebtY9Jo4F4pvZIZaj6iCnHROFaT4OEzD9Jknd5K8ngkHoFU9uzDA+Jiua",
+	"knvezzH2OdQNT7KhJqdRHrUNLmN73/BL17z340WC3un/ubzkeaJtFT+//+n1+dSLxHKRR/KanIO7rpWf",
+	"qAalD/Wgtthf/1bgOcJLHAfVCK6U5MQ+RN7k/FobUwYQpuZz4wYaSZQAm9oSmzAbUOVdumMkONa+YvoR",
+	"BuZj6E+7hnu9REjing/1hENLfy0VLbAi52DN7C32mkh1hGUo+d8KQWRGR0/I/mIfXc2eL785KK5mT0M3",
+	"KbkrI6SL9fb18vmLWG+3XGw6uW+W30a669CuXrc3aX/EECnN4+v1nY6oi6ApYLEI2e1xXhGJrnnFMmcq",
+	"KnOckqV2bxssRPn33DNSB0QWlmrsFjMTfGftdYW2kpLsshiLdnDXd44VkcoPVIAujIuHeEbUcPDG3wPc",
+	"ffEfb5G2acJLpdMLZOdRwHzko/uFwVNiiAREHtwgO8JEg0PPyWLMPO0FkzsAqJy9tEES6Ko6OPiG/ID+",
+	"7c0reMM5WJEf0Fel4FkFFPxqdGEjT1yzpB8hu6rPbTnFcuMLuZWwHw04qySE8lCG/l5hq+dJWChukvGe",
+	"aCUkQYwofVf1Y3t8yQDkkyHfqA2UW5lTYpnRWO8pC3PmBs6sgYvKXcNNWCj8ohVUyNSbJbUenNjukpl9",
+	"yuJFGLOmYjQU4fIfQES11nRy2E4I2nqrxWlKSiU3WNygHmCm4tF+hMEGAndgftOfk16no3O2XcfndiJl",
+	"FZtSyMWiKalpSvV3iFqEgwQg7XQcNjSAH6Ux0EttWXOH3gBFMCSI89jbpiGuvqEsMAW5ZgrfveyJO8xs",
+	"QHKJhc77QBW7YfyWfYQpvUSM28ktIS+ASuPkvGKUwSPRtWsYJuPEZC3IqgTIXP0TnCPNZxwwsbhAFJIL",
+	"wCWiHUf7V6xe3UuE2+v31+0mqDsrsQDfP0bpOs31rPx4algxsJy3olkya818lszq3oNnx4ZKBfmez+eS",
+	"qGBwicCpgstsDls893e/e+nsI2AniSiTNCPd1WPh0IdJhrBC+nzWcw4HZchqsSAykrj8VyCfYXGU5lwC",
+	"uCJmNWXhJ9D0/XmE29YTSdB1MChuM2EBzFsTtqF+/CS+41ngIKZLmmeCsA2lg1NTutJ68FzzOVphQfXV",
+	"1L2MgtZmewICEYf2F+36vRVUKcL6zGLVSsyyxF33iY1qSfTx0P+pbRmJSdEOXnzhp55ePNIb8BJdU4bF",
+	"GvpNoOOUM4Upk4nzD+uxkmaliXcjJ1fM0SOxunCC7O2VIHt5ae4SZEHurljE/FWRiNKqCZ5TRQQYv1hW",
+	"Tw4pYsAQwt3FlWA+Bzrbr+/JuvBjnE8vtcyBK/acSLCLd3nWyPQNOdZcRAGWrQ2II7Y/0y5xowcX4Pkj",
+	"4ije+jrXiOw2U6IvlOIIodHn/Ciu56u1IvK9CzyZEGxdf/ShzDm2yLNbgvc0rn1PdyuJ8emaYbFV5Gw2",
+	"3yxpiKb/G7OU5EOBrVMBRDU1YrsQCBMlmyOEtjfbH3KIfTTrhDiHfvfiLb8lorUTcfOabv+hLCe3J1Kd",
+	"EfH8/SjeSNsqYbA1JmOw6qsKs42aZ3SzD+gmrQdPjqlrUAd8BbhdZcdkdV8AWp+bvJE8ErWW3yytIXlr",
+	"ConHI/7++3s7xHgkKrX0TDeQuH05GBC8PSnggt7duf917Pntn8rwkYJYm+0AQQ9hwf9cmlQtBA7nMTj4",
+	"JuymqyV1LBfoSXmzeGaao+OLt0/vYcr4aipkwQdG/14Ru4LhjLWwt+6NWbvB9It7bctsM9IP5KMPRPTA",
+	"ZIb9rLDS6UwNPQ5FlI5Eiw7EgY5cPnaiSTy4M0qBkdVPXjNlK8KUjX4ajsF1DXdCmc2qF1xSoYMvT7G2",
+	"g457xQ1JzBCbErsiUr0zaGKhOBbt5Qo8JMwHyPxurBf2aavfMgvdafD4BtLgT84QzjItOEJfFDjtf3J6",
+	"eOS+AVADQphBwxkYmjVrDK8FFgHZlYP9lILMaR0QFuvMtEI5NENPjk6Oz592Yma/+TocFN3bop+oVHwh",
+	"cGGGK/UVBd4O48bu7BhWuMVmMbdxIwYKyi7dYyykKJBywlGvO7FfGLy6IMv9xINRimV1xAUZ9BpoZOHU",
+	"IeCFvfnezNOyuuDpDVGjfUrbbEqvAzdQc/c00Nnw8Anxtcl5fBXCl5LKT8sN5piOz7MYdRQXHKLbjQ1v",
+	"CO3cwYOvTrkDupLuqzpTQi8UPdGTv1hLRYr92nm/3ncjnrZHfBoOko47sFeTp3zvqa6K8Tl239cuNiIe",
+	"6QAJHvGM/jMi9OOriQ7f4PSmZaXrAB3xoqCqIKEED83iuk1at0HnWFG+j45a6OlwcaDDPOcgYEy6PHqG",
+	"TH7H2XItIZn6yJ7ACW8UD7Ny6tXXvEIDi9U7d6ZfCVo5J3IzuIHeriwBWXPqxEBsReakd9DC3oeF9Cbi",
+	"GI7+2J6eH546IXGfrbWfur21/8SmikFOpu1uDUI6lYROzwis2sQgtRAuujSMaFvNyZEDOtlPbq+DitnW",
+	"ti+U1GSG7jOvR8DWSYkKkFaGWCCAZELBE68fmIXu+5rMuSD3+TKtp9JmTpxlmu1YViNx1ylhkIlikjW5",
+	"QIeAH/p9neDLGdHIoitSo5UqEyogkAsv8vw/MMwsmdlBgpCVY28/u+0JXAqJFzvIBWKeZhiucSYPs2Bp",
+	"K8iXNB6hOmanDiWFPxPjlw1lrU53Ma8KeW7X/qAp9NJzH+YItmzhEag11RH+vlBheHG7/0GYDheraFE5",
+	"0JPmOAGHPZ0I+hLVQZvLz6mg6EkNhasZ3RRr2WCsuSBhyJEfBSFIljglD1xNfb3FVN/XF/9J7cSbxbgB",
+	"+v0N4MrU5GnByTyURBNrCDoHGnDPeKKp6zXMhg72K2ZPzCBUNVRetiow2xMEZxDBYtv5dQ5spq4HLdbJ",
+	"FGxO2WbQHmQQ2aO2VoYThwPT6Ts37uvQCCJ1dIms/5ec1WMFfz6vJxD8+cibVbhBM9Xg7wMgG2SIU+Lo",
+	"LBGy19/1qD1qRB4ipg8ZQhzqSfA317s+X1l2M2qJyrIbT7HehECe4a1Nmsk2OVOysumpO3rT0eAMjq1N",
+	"JPj48kumDeaqdJo3GOOdQlcTOvG/cHj9k/SvThLx4MaZFBTP8jjY+lQGBCX4ymHcIH3Bn/xKEHyjARUD",
+	"Gmm2otJu85AA7+O7H9ovTTxN+K622F6bd16jgsU7j8jfsZ6NfI53S5m59YKumLHOT5qPB4a4xQLO98bd",
+	"/2I+jHbdYY6a/M2Q7fUlzfb3r4eGid66+PR4znIntokyCMiBMPQEQajMLV6RBEGSJ0SdUHkzSwZynTs3",
+	"N7lD8JPt7at/ej7/13+9/vYrCJCC5POBDOhNXHHbSZreumfKqe1Anm4VZA93sZl+Gw6uGT+6w9FHax0S",
+	"HHvUhZ5yOA1GV/2y5AYZH6MCqjzadyXso5cuUeXEQpOYxvCH+tHSH22DHa7TLSLpKf1J25lqg7GZgkX3",
+	"t8VXYd6HZyeJmaVu1qxCJkiCBTNa4L1w1S5181kyM83HHdR1koeLe7bTd7QHqkR3GzwWYiDZZWkaTDYc",
+	"+Tw09gh1fUdnN+xchZXLzWY2OifbaXRK5+H6BptLmE1TDBJUcinpNRQiNXGe+hJoRYUO8nkn1Vz/2dRx",
+	"J3XOSZ3OcXka7IvFw798mINu+hKcB7jH9CBLulgSqZD7Rj+JBEm5yEhmX0pkRQS2BwfmaDyGUvv9HL/3",
+	"L9QtCdcA6IK3wI3l6fkoQLrYCBy97nQCiHEspv/0/eUpLkvKFoHn0Ma24tP3l9ZcbDsNR+KAY2mTTq0z",
+	"K9ppd/saE60bLLL2zmwnYgW88ypE2h4OIWW5IEwdkzll1BWBwaioclVJlBGpKMOxKB09ENiPwqPBT1se",
+	"Mna5uTrJY5bUcLjyGc/cl8nQTOF+z8lcoYpZ3NEW7noJNcvNSmbJjC4YF0HNovu4dXdeNPD39P3lWY7Z",
+	"j1Ys+CWt17jIvTnYf/5Gy6BG02fMvkFhhMaN6TpGZcvARzmWcjz1tV5967MgFQDol3L22iCFhS6fX5br",
+	"RMdo3C65KWpRMUVzI5mxRiiE2gf6+8yBXBeu215NMddus5vRfBNRvsldSQWRwXIGtCC2GMPtkqZLG65v",
+	"l4oAUXFu8QHrAh1eOqNcs3QyKPh/VVLROU1x9BkgoLDDqKTr7YkpCNGX5+bP3ZFbBEt8ik/jgPN6lsGy",
+	"FCnPiBUw7tOGpN6xyWlKmDRpZbUjH94o4PF0F+l1JSkzEURQzSF8xgKTrDM1O9mB1nobm+E++pnla5s5",
+	"RzKEwa8CykjRGkUzsyRQDkaJyuYvhZn51Tr8oqkPhXXlzJJ/IO7tuzb2lB6g1dBdRj4DtEG3DpLHOgeD",
+	"3B3JCcRSEimdnz5gdoiGC9JsGHps4iOtGd+NFlpGPMpvJW+pSpebWR36aayYZVhkBiRECXpd2bPqum8f",
+	"4tARXeWYRfAvVoWMxl2GYU2isEY6DSoK6ZQ6B2DQPdjEWgREm+YRi7drQmcUR0wLOugzQc/NdVcxk/k2",
+	"KYWgiRKbUpmhDh2ZMkfTup6k+ed9ZhllEUEkESuSRZyq8Gd0Q0pV2y+sOUMrCD+XhF0s6VwhzbU6lWdi",
+	"0JEb9TQaYmd+ue/IMfrHrGomWqreyhBHmrxAytlRHYkWgi3WnsWBoDcLEeUjR0Eshazmc5pSUyWSrmhO",
+	"WgC/fukQfaOyxVnTqjfYRUlSLbmR0zubLo1RDQuCbD/3jyRwaw0RSydnDNHp/qhBwyk1u8CX2TQty1/a",
+	"OG2iSBSbZcUEEXvGdjCe2nJmKpXe54Vsi5wGg3GIiJi9zA+jXUyFBTyni6WS9DfKFodpWhU6yC6IyqKd",
+	"YtLmvPS8GISUMB2sjVIL4izUzoxlvtNzJjhdoutKByj7oU+rhVGSgtdoM/LJGDb0K+hZD9R8lNnhZWIN",
+	"ANTO5ZuDA7QAQ5WeLWaIqmmRHJ7o6gudUfxqUGF91OrEPBBfIFMqFS7anEhpJoV1idRp8xL49pwowiAa",
+	"IDb84cLmBRj92dIG5JxHs6Rxp20ygQeNLnhZtkbWLCTwLaq7vce95faqvzFheg2xm//bLLDaDc5XE2eB",
+	"8/zn+ezl34aFVqSb2aekb/GX6vVQwE0NVCShUGeCsCuvSvQR0GDuKSFZCDurU512qhNJqot2AmfnDnZM",
+	"UJaEZc3bzc0wyGqM3BLX7yazMbWQNv9OTlmBjd0SPM/B72DvuU15tkux9uh9Lvu1zWc2LOMcjAqEmSR+",
+	"GQ0ZCasHVVHg8ZAWb9T2cBf2++7S3JjNCCOHJrwY7SwYLik4SRUYJ1pIreI5TTehzJn5ACihX4vBJ2Hv",
+	"/W5b1uON1A8LLSVakr7Z+M2o04n0MZP8SCev5yNkjbrhpy2jE48UXMnHiOXB/Rx90qWczWlGmLFET0nm",
+	"L6uPWluZ3lprNdNbl9+9mNhao4ZNbEp5Kd2sO9ex1dd0Z+jk57MLL8h6lkzu266xIxSrwvVWEnzz0DEs",
+	"ZeJjfPfiAUMUpNhgX3Xr6fuqW0/fV0bU8F4575MNqSgr5a020XfQXw0IwcTBJm3etgedspvbHFMQnH3M",
+	"sSIsXYcH/8m53B0f6U+Q/aQ7cDFxWMiY+egV3PzoirtGEntabfU0Pt5MhfHtf2+MNB+L61im0McBY64G",
+	"dCIbkgy+eRjNwvpC6+L46EC/3fwbsdwI0Ub4Noe7OYrNEbaCtHUVRPdtmMhDW9ic64b/m+PniWhP2nnC",
+	"NcDAoR0auVFNkccLIhqEe+8uNeUr/0rCHsVY1VHz4tZZqHlmYm3sW5uLjAirzBudelOVzEx0WuXRZvLJ",
+	"UBXSvlZ2jzAlvRXaYRZFXh8LfVwSnAnOi7M08Jb4yf6ITNqZjTqqGi0IQu2pVDSdeLXhu6NgRelTLpU1",
+	"7Qun9ur3d8UAZNdFGh2EjfP4bsAi7SV9j3U92RFwoUgZGuxc9+hGq0pNsbadR2dJJ46Qp682G5qyMPF+",
+	"hPfn/clHWZx8b8H6sT36Rep6wAGA8IrvUT5cVaUvDohICVM0ZA38EOJViNOGyg/0N5AKLfMfaDb66IJg",
+	"K7/7bsAcKDQF3vMoGILhB7Mxhh1ulzwnDhahuZa+kjb3rSTC/hp0I4zHzI1IhLArxSNhWySMCK72E3Wz",
+	"Z337vdO/zY1t7t8O/keCCpLRqrCmSf2HnN9CyuWtt3PawGvBsapilsz0r78mQ+MGZd7FUnNG7Tc3UOK1",
+	"cbCuodx4t1Is0gbwAtfn/4EePu/mPorqaV6j080cmt5RjnfvNdqwewhzDUurd2SBoUburav2f3mKGNT3",
+	"LLggzsGr7c3e8uKjxGXX2EjOTdsfalIK5RTbCbSqwakai1N3d/sb4tMwvNHh/elSpcvw/r83O94XjR2u",
+	"G3O+WB61TnOfXDm/HWtiTu5Yqz/QuZjA0Jvl8k7Mz31c9utwR2Cfu9yxEVeGkAl3YhXdoiF6M8iyaH/j",
+	"EeUBy63JPZxo8T6HDqIH/35pFHB/TvPCmGAaJWgKGOp8Rcyla2rTIc5SguZVbgIGjScqclVErLLOEReq",
+	"h+XCMODuhwQ+29hpB/X7sin1Gz6hTV5ppMreIxfmAwuGm1W7Q39GLfKEt85PrxjhJf8J3Q/0s2r3RCvm",
+	"dHsnZRNbjuK6aspKhYsA0B6QznFFHVFwj41pxujCvzbvEr2k7oukIbhXVXQXGKe7SoviJsxqLDlqII7F",
+	"9WZQmf7dAif2JyA3qWK6tSSlduZnK1XJjL1JnpK3x8OZSo6SU28ar+MJZT7yqAPwAg58uLoAZRm5iwEi",
+	"CmwVkIDLwSBsAQSIeSLYvJRKOgA6AuGW095dbqz468Ef0L4UHjRiJeuVTWwdVATjn2yiU5gdOstxSiC6",
+	"eWy3za457cEtpTPN9g4GaBznlWYmIfjKUBlQ80j0nnR2Q0xo3+ZBwME41ocOEH3d3+v1CEQtJhDTRGDH",
+	"aiXghrGP4rTFJna5ie3TXSL7rQkMm+zR9EYcDR3e5ri6p4EbXf+86ZGJVTkpm6j3QZw+L0C+L/HuKbem",
+	"iBMG9emy0CUtUa5ZWrgYvwD9Z8kmNJouVpruO0wZ5hl/R932eWt7gPyJxvPacuEBnY/+RmoMOGvZd0jp",
+	"TCqCM0dDY/Jt1Tqrd6yqaDC27R5uGe359F0zfI6E1b5JZjyfXx9snIGwceXpDof38orrXMtxProwbdsv",
+	"qE6iQx2RX4sKqLGF9PbISBVE2BesHSbQa3e/Ah9Afnu+P6rtDRbRdpwWlsr30M093tyUoSbsfOTFvDFD",
+	"hO6ksAB1JqYfcZ5f4/QmYh2wBw777C2pC0OtnTq+SRcJYhhPWpyP9EZ34R2XgcI1GzPrZIMcbFWdDexv",
+	"URI21rUp423G2Du8NcewLNNkNdGuVERox4XXoiNuyrySyC3BTxFvGdabLQv6ZuAh3ZQeiUrllDMD+peu",
+	"w1e7jnXQq3oTiTehyqYFxQzIW0njKCg7Md8/v3dOB5CkQdaLkkTrijL4Bj/l52SONGqp4g6UcHr+kMbG",
+	"47ikeynPyIKwPXKnBN5T2GBRXFODLviyXk5SUPbD8ySjK5K4v80+hVTb2IJjBd97PGDr9L1fCiJ10EcL",
+	"J+Cbg6SHoWKCgZRrD6FANM+ptd6ha7LmLPPSeW2GPgJaIKodgFCMAbLBzASArwt8RwvN8M91Rm1BmfnX",
+	"iyC+Wn/ip/apXE9+hivFC2wCK7oBDZlBK2r68VaUWnDqdrK335sFLgodv1YenJ3JHOeSJCP49SfPfkZH",
+	"nCnBc00k20+D1Z/5uVc9p7b1Pv88PyP45n0dYdeaxne93TwzX2k5DmGBTWhefD8OptWFMOiITfHcaDa7",
+	"KbOqz5VBmPoe8YIqcA+bX7AgNX4GtMj2e2nqtjzOu6Bt64MkYm9BV4TZSqZGR2mUk310yAyUmqudneYE",
+	"C4moCpY4ZVwRGR4HwW8+sOr4KGpJiuA4JWUs9N54fae7kZ3+jW+/zmJBpags8l2gRMDofl2kS5JVOfH2",
+	"rZulpw0X/ckpUREEP0rr3DcdJQiOgb4Kq4IAbZFWZQpNiTLHTDYZ/qKyq2H8dn+8xoGdyq/RZdmiPfVK",
+	"Csp8PP7nPXZqW2y9dP8X/Wz/ydJd8zXsf1Lgux9eHBzAQpqyVQVlNarANgbRdwgM4UwobdiCZCcDwtqe",
+	"w9ribDYAvwoYcRMV8vuUT4qCtCb10HE+MkBUUY3Kg4W8B7yjg3MEUaglg0lg3NCd8Glk8kNHuu2IiAM/",
+	"bMJHHaS06VcHTPfyVEZnO4Cz6LAKa1jFpIFbq8unazA4B8cXmDXOIpYexbXRwOtE8R2ogs2x6mqBDn0y",
+	"OjvzszdBLUofdYrxXfXcI9GN3cxfNtkRFZ/UL3hF7j2buVeVMdjAmhg2zfbnknbG7ERObdjho3nJe0R2",
+	"YM890k7JNKNheXx5GgNttWd/ejW7U9BAY/idMYfp5alRJinzVbBX4UocJ0FRMlgOctiNYdcYpoy/nngh",
+	"llBl6+5iWvVXxj84HKhVrkExXSEVaLLfvGhkghhNJXqCJbpyoIboyenh0dOr2VOo/p0SwXQhCPNf+iX+",
+	"9IphlhkJZ0ORTSEtq1zD/snvQQwa17j3npApzrGQ7Yr9NiekqSRhbCBHXm0PfS5djRxbNKcVk9gsSW8W",
+	"TfX/udk7nE05ju1naFgTP7G7FtvunIQw/lK58hcH/7rLZTgKG7pRRJiSqzLuDwf41BRHavsfU6koSxXK",
+	"iDIxvl57ZOoCbFJDJm3VXAqOZJvcp3OzMUdGbaNkcBTLeGnT+B5Dva3he0eGsYyyyRBZu9JUbF/qVhsT",
+	"LJzK5cpDuaG7aw2ROWlzUZitT4qSC2Wi5wJsWFzTRcWrTeS87ZHfhshnYYHj+EU1WDDJkOC3Et0SQRya",
+	"cBivyLTe1gwrttUOO9vZLMSN4o+YeAQf3C5+29+rGxIBSQaraoI+fDg5hopW+j41gAu3JhbJmFuV1AEE",
+	"1+vEvo/qUHTdTsc0Ms6C+ssNWb8PYrce8bwqatCdG7JOaqNTrHMnR+Elah+kHRSezktpQ/2sB80YxqMR",
+	"/La/nreU1WYtPW/7xtF+jQT+SzsViEDXRN+MuW79PBqRL8Ob5Xj/8rRGrE8xy2gGIQXamcRQzSR6FvcX",
+	"LebjG7KeuRmFec6ImAFgBO3ikZOqTQCQkXktPQHMbch31GFwtYIh8PppYE0DxRLzMXFv+7bFl3IoTVVJ",
+	"cn/K5Y3QrWJJk5en59YbNVAdZemX9R0sPFk3HC4wDT/9yIWBqzTVYae1+4Wqpa3/IYe/ecfVcPehAoiz",
+	"4NxGJxIbNUzxX7AMlo1zYMeNdMmJUzJJ9pHP57NkxkW5xMb3xwFILOb6G0oVI6smIyRcldTkugW0/DpI",
+	"TKMgw5T1mwX8w1oD1z/WzhNouzZ345LkGcILTJlfZbghSzxvq9Gxt5jWdT05GeRBWUn++6CfIxKaVmL5",
+	"wNukMBeFQH+gttgdVetjB2tqjQax0rNDh9m5fN9TIrxMi770cgM5GXq99oCImzmhnKygDg4TFC4PkLVw",
+	"cAzCgOZlyNeEhvvooiqJkCQjEmXeMK/WR3Wf+yFW8msjDmtEfdlnXd3NCHr1j05BnAouYdU3ex4BFSVC",
+	"IoiWtTBaRCpqCy0RtqCMoCcHe88P3tNXCXp+sPe1+a+vD/ZemP96cfAv7+mrp+ap2yOcWbkNtbgn5d68",
+	"esDHjlhbJnhwoVoZlA8ZSHcwMkiQZzcrBd2v8PvAA4ieHPzwoQE3TtDzH15juU7Q1z+cQiJZgr754Scs",
+	"sgR9+8MvS6rIm5yvyNPZ+BLLamzzQuubeBh0MrCiRNh8EIme6BJYCbqaHex9ezXT//Fi79/Mf3y39/wv",
+	"5r+e/+veN1+b//zm63+5mk1YhhHFO1yJi4UeW0xoDd/s/cX+/pcXe8+/tut9/vV3e1+/sM2/fvGXaQt9",
+	"R9P6tG9zmddr9O7kCIHW6S3MTtVO0q7H/N+3sQnTfuG+Qddep7n/kvK1xmkVWNpY6iFjsEfAe0g85uuK",
+	"But9m7Pj8qGSprcdXOrSfvcVmvbrkKwst1YpX+Di3lfQ2Itl0nNl47eKbgYgCdkxlTejL1SItAWw7FZR",
+	"RAk9oKxVUHDSW6f10Kl1J0fJ+lb31YP2hkU4OXT2gqqssRY2kcchzRanRATihc5en+4RlvKMZOjoEOlG",
+	"plaDThtkmS1DtyKCztfa2qF1Jhel/P7thf/BPjqtVIV17qut7rCyRbvkDS3f57IflbO5cxR2osRS3nLR",
+	"9r3Vf9xSJEY7ehDGtesIWjUZFP1IukQxpHMmeyqBFiXJNLGkqcN+TfyyIaa+iKQZQWbP0P/93//H8GzK",
+	"i2tbmAkJoirBJPr24GAfwfDW5PYS0bn7kkoHX2timxhzhT5uaClhqq3pPdGRvLdYZDqqsCixogZb/On3",
+	"7U7h2eXKlHjdms6I6bmShl+watFDr8bSEbKYGhIwtR9GmBF5KCXI8OCH87et7AVBZw/fcT3iJ5PyJupo",
+	"n53wVEes6IG9YT1O1yi2nfrMvTN+vbbSfwp0UxaArCuyF0g2MINVqesh6G3G4hrn+UZQ7O/9aoAucf0o",
+	"p5ob3Uejbtq6nZ5uUPSZFu5S7WTKUHUUqY3xhsJxKqhCFz8dhhZW0Tfxzz+coMVoD1HSHC7uR4RmPe3p",
+	"BQlDhZbFp1hvwDDKbceY7zkAQ6tqvFMBS2zbHRb83D4wAwzTsWNQhiDBagIzQ0yIjJTA09xsGpjI38tT",
+	"w5cb+hxpKOigRWR0cqwnbSVTOMLOBc0fWS9eOJ+k0VeaLxq3/ZwLPyuo1PwhlUmL0L7qcDXqdiH88RC/",
+	"Tnv3lBifsW6lJ1kxL9y6w46hKcbM+xBvnJE5ZaSOT2j6PfU3cTiSrsRKEaG7vLq6mCUjW37fuK1u2KaL",
+	"gIiml27K7EVLh+6cIa1AUAvh1mZOKlHzJRDw9P1lpDBMwHU2LfreHTA6VN+vP2IkJqi9gJhE0cTPsQp0",
+	"MEwNjOovg1pHk0n0cQgYTss81DRAe3sWhcXAr6JnNWTbx9bf75Dmj2l5+v5UGtDsjtw++9DKfoKS13fo",
+	"yf94+n2rhgHjrWZanN9vFkHQ28AsdFrWbmbhQL57BpWbVue7GdxDuQ4e60fbCw9Ae8pEtrsd9rI7Oe4P",
+	"f1KH4zl90jYO3QiAncgW0kT/9lUp82XtxAq/BaT+2dnLrNPvZ9b853yeIFlJU0fj6VSgFANNVK+zM5lu",
+	"uFoNYVQrOvUF0LpBx3U2U5dyi5pb81CL0PHIeyAaUtpPtJE7o9L7l3OiJogynKYEAHTI0/CwgsgzIgwk",
+	"aVhkNEijK0ODBnHUvxG/+Tp4I6ZldTiHAsGBW/ZsuZY0xTmYvyHlMHgbmOSgTvD5hLF7oWllZTY4ot+6",
+	"9WlEi2mre6jCTVY03SSAt8OE+uvQQjNnartPr1pwB/okfk3hzSp96s9BQ3nPcyIwS8nrsRJ6P+rmqG7v",
+	"pQkGFYI5FcUtDgXv/mh/Qfoj9OSackhMJnMaPBBznmehzazTapBp0QBIhXpZVESqcCQ0zAV+Rz9fDIZF",
+	"227CiX5vXA8azy7KX6aDTSu+v/G+CnEChAL3M/Uu/pMi/dMwaZZ8eEn699hyQs+8D4z+vSIeIesnWFeO",
+	"THz9bXZa/FRr/cCTW3vQlYdZJmx5vhChSkG1cxadnCFsW4bWBS++e4qCqPPlD/4cHMAbogwVZIGNNW/S",
+	"FTH0JPQDbjvsmmKmLa/m61jU7RfyGDw2KcmtlOOYUaHZQgbZ8D9djt4FpqG7nZ0ePHIjQEbC/dj+3clR",
+	"OE3pNqrlHpmwRqPNOgXtHlouRE2lRMpQhL5G79PkrZs0qQecuR2dir3kin8GFiqqnDTh+yPeykiy5uyt",
+	"jSU1sdbaa25CTnXnMkEF1o4WB0ak/2iL1tr2OlE85DK3CAsfgvnYDl8g5UwnXkMCZOikRow3cWPFwDkd",
+	"N1YoznN5XjHtI2zug/AAVj94rz/RXQvzWVAC6jax/tr9MKlwnjdlDargVVG1y5UNnpfCq5Rga/RDF1Xk",
+	"itZ2cnA+Vr3rGktJF8ywyMAF/SiP2RDgjivZ4z0yW4GP3Zeb98zova+8C8Yp6VZSTXlygrbfTzmgLEBz",
+	"09oqvWkmeJGgec7Lcp2gSl4nSBJBcZ6gEguc5yR/OjHBsf9W6Lu6qmC1HWlnI1NJE80BCZJY4QSxVRF5",
+	"ndp0tIgdyf284TGfB0t/XJ4e/xWyCFCJ1dKlFQSQSFo5F1F9FFwlNzo8mKql7ax3JU7RHmqol97y9U/o",
+	"ifMwMKWf+xmBq4Wpj7G/M86an4JUF1kxJAGpNDLvHN8iy2WnuCzD8BvJzERuDItUIBaVLsrDVRiuK+G0",
+	"CScnwnzEFHXr3tkQ1KBGBOjW/+DCJjc4oVaHYlinUIjGBZHSAlgPiyDXMGlm5+by6wZrdo+TETu+RM0n",
+	"1mMlewA0dZLnPR8VvY0IZXqMrQxeJoM76PIMjgRV2sY0S2Y2UGeWzE6YOXtGzz3MVlQa0pr6w8nsZ82c",
+	"/cwD/dLQHe+tsDBJhS//FpqazQBce4MPtGrmNdCoPeWBht5qBlq5hQ40sTTo43B0rxp9/5MMeX82oUUp",
+	"Z0pj3GCWNcWgbCZkcp8jNnpju1beYfE7Gz8ywwjZpT2zEeUfalwa83sSRIlbkEH7I6sdtbppuDpAHcU3",
+	"3EH3XNfRHjZZTkXmuGp/d88zPpq8tepLdBu0V9pyWjWtxvdMv9fiwOEdX/jJEVyHd4MXc6hoXBqwCRwe",
+	"DdlebHZ/YBLmhyFD2bgINHgeW4GMkffAjHmAOdiDPNzEkPREEEDu1RYLyCpc2F+e7h7whXF1nWN2EzIZ",
+	"hY0wQV2HO2NLbX8Zs7ncKwgzyDyhJ1sIJXHXVaVNZMwfrQw1lNzV95wpJOsqOeM8Nw9S61lyb74HlafW",
+	"uIe7GjDorNZu6e2Pt3nh6o1YZ5eVrgsegTu+RwlskL2EroghrxKYSRMtvK2K2GfhMthbHTjKNzseV9wN",
+	"sawdJjSHjUdSAyN5a3j4YNNqbt+/2vYGdbbHa4/XoqFTOlvm3FSZ9eTCRvXHV8XHaNL0hALfel7Bmt4P",
+	"nFj3ec5NBErhKnsHCm/HSm6Hi20P1wP3LtCx4uCewAxXCh8u6+0drhb/77Lgd0tHiRX7NjmFF7aAWl/X",
+	"oplatotrSb/8GmBi4cVCkAVWoO/6huBWVG681ttFDQBtLFV+/3ZoU0wvUhRaiU28U7E66CFkldjFNFip",
+	"8LNUlRNNtUF7ilrl49rbPFR1zpEzxFEARnHIcL6WNMBLWP/y22ZlFBpX3gd2w2zaZufCNU32+NwktTGu",
+	"WmASJMWVJIhxH1GufoE5WB4LLWHHQwYgIySjm53tlJNu+MayY68ge6o9tnPwDriQQ6qWvIKsu9DqvaKW",
+	"k3gXNuBIr72fTF5zbQOpMam7903zDetYOXSSMfOEN6HEZ5JuPcwQN0TZsEWFXeOjBIBDQmWbBFFUOLNQ",
+	"XZqGGG5Fc0EiVqgI5EgwZGLaGFstXetARoZK2IaWEN279y0O7UJ7QhGis+9eBMvv6NBGG9dripDbegHY",
+	"new9zkzey1oHfOjeEmMWOECKTw9E1x++I+rsuxd/vQ7m21i9FOaitdGnEPrMpRqf0LQZOOY84aU8Cyll",
+	"8GjU4w8P7ERQ1xnuMf+U2bjzATi8A8Xb9cbwua0SyEVT55sgG0uDTDlcVFRSQdVJCGqCcrOKaxrVY03U",
+	"8GuhcYzXIdAsvJZIUpYShFuo91TABG59ksEd498ShnkMaSmkhDZ3z8yrbvA8dMZaBSw7T1hzQVRM0byL",
+	"hKTpaGZnO4gVNNW64c8sX3eS8KMOeP9o9Xi8R8k+D4b4IHzKV+RRKqB2MZQ70ar2VyIa1zMqMRW6LJgk",
+	"3qPSgeNYOy68QOeRMNJ7oTIPQFY1cM0d3RuvrIJd5hhCtilDWKYEjJSo/nCU8aaR+ktGhg7kKnjLr3fE",
+	"LWKTeq6ayhH/p1fiqx+tYONEnH6RVQaXlHxcQQEma702/2JcfWwCF83fCiolZYv2P2BE+OCj42zTGSGZ",
+	"/GhoF1RQ4h7wZAYYxu5EdoQQgx/RLV5ZLms0C1Pmy1+XSXgcAqoe3jmgWTPX2G4MOw31VOUGCvNqXD81",
+	"Xcamc5bjYAEkd4/EXoEbzzOq0nfVMX/oZGT25wRnlNnA587TP9dhVFnYVkX6WKIdS9Y69tOKktsJWqXp",
+	"o/4gqefjDR5bVVTv15aZ01cxh67+tS4MiVfkK2lKM7rxEAAvSZqRaSr0huHgjagJhYn6GzXWS7Or/mbE",
+	"Q4LgfBtM2u4h9kMr3a03MoPX9q48d8D3A08Jw59Tj6mRcDVu+qtIrJZekks19S9xtwDpu6NNn1M2NCAW",
+	"ZknNp83rxzKZv2c1L/waQZDrIs31OBc89tDq1STAgPevmlwAZTJeJtmBR7Pa9RmgrNXxFIQgO/VmiF8H",
+	"sPR2QgX4AYbcJikiEEqNKKHSDTpCpoaB/FX+OgidFYAlDp8yi9HnkOjaK/r5AtnfYUch+/CcZOgnrNBf",
+	"jy4QFoqmOUHffv3Nty++e+6D/xs4G5DKK8IyLj7WaIBgAS+KilG1bv1VliSlOP+4xCzL9XX4a9D05T4I",
+	"olVX5ULgjJy34pwCRjn3O8l0+pb9yuX8o6rBLtQ/w17aVBDbFILmMfKbjWqgqd3GZgn9TfwENm+ziYqq",
+	"XP92KC16RR3EgQw+yuHZycwDUZmtvgYuKAnDJZ29nH2zf7D/DYTxqCUwwjOoOKX/a2HyTDWXYKeNzN4Q",
+	"BR1fuOh0YdUp+PjrgwPDTEzZTjxQ/Wf/JQ2djWgeE9z+MLDmEPyLDZL/lMxemKG7yYCKCIZzJIlYEYGI",
+	"ENycnto8qleEsN9ZMjNBG38zY0BcXcllgBgXlhhQ5tFsJJHqFc/W26WC7r+OxWmzjH6cf/p8u6Bn5kqo",
+	"6F34NrwLK5zTDIkmnOjbg++CmdPznKbqQdtpaszYHS3MxnT381Mye9aoujLK7Pq5cOS108dE4IKYehZ/",
+	"68lClq9RTls1ppsa4i7V4Ukarm0NwVG6m79XBCzW5l1f18hOvB3rCpFfd8gBDQFar6cAM7i0J5+0D9lK",
+	"6E/HraStPXC76e9Mb09hJViQZ7/jk+zTs9+vT7JP0X0+Mm032OpXWBIoWdAMiU6O3Q5qYdpsIIa3VPvI",
+	"Dm1m0j8XenpUcoYUFguipox6/dBRgZstFWuPgBedSqUNT0VN7bMEkRXOK+uvNQUM/GKjDlwBrjljgGJc",
+	"2X5MDdXQEbhev66H+ALOQbMf9bu6fxi8TbMcbUKtSyL2vO2rHdwSYmwyOp/LUUHao7v54ttArjeFh5o3",
+	"INCbVyx7mJR1fHHLW8JO4xI6UMXg0h5wfJ/9ntGCML1e/yjHS3rVzUEoy5qJNdX07aCzkbhathZgrLdH",
+	"Zx8S615Irljm5zAlfupoAlnwiav3lLTKh707OZJenTAubBSsm19yxYAjIP4BimqhJ4dPgVZQWgs9efUU",
+	"raCkGZ8jsiJi3alWdsWuGCzYDC/NdKQ/DejORjrJhiLS3FN6aMIUVVS3zDKYlCnVmOkJu+Fc+MQhdPcq",
+	"QfXE63fMf3EIAebC1EXWaaGuEgMVV6yVAtYhuoGBHxPJx3Q+/1MsG0RICKSAmqyGTOGx6t0eHNG9x1xc",
+	"f+Hj8DLO9lp/cLpe4tfaAq7rVZqzTBesLNcLxGgyMtCT53vXWJLs6T46tEnGXl5cDrVoOcvXJ8ywo/nv",
+	"V7HLw+YpNAuu8+6fD/vYPiVDz3dAwdGvXd0//Ic17sXmYGGMmnlsNvYOruMrZugrXXY3sEDiIdwlqM0A",
+	"QO+eeLUH+B/q5gZpEri2z/CCMiCY3WIQbvruIqIWg2rZv/kcmImp6d4cvbG7vG7pRL34Aq73Y0HzHGk8",
+	"crjRh0gRIAPuEWHqrU+LurJeELrm/bIG1JZ0wbCqhONJkt7IqjA6pUVQzty12qlJT5u7Tn9LlXTQpvqf",
+	"l6d+/U5BQKBl+8gUkyNZa7k3hJTmikNcUM06OQIHoR5H0cLOztiFcjDRJFdMHxJqo6rtkc4SdF0pxPQ1",
+	"r+POeEF8eFJv9sbJQIV7UIZuTzPXhtavgGaDJgqTw4uFeqYNnHtalLdPWNte6HKza2voNWU4lIDUqyIa",
+	"rEc1xajxfAcCIay5N5xi93zsFCcImxeMPr6+ZdAwa9Ti8b7NmDgHj4BJmzLPgOffhJCyci2rOcq12oGe",
+	"aFTEb9+8evqgI29Yph3GYo8auXOrWUNBPbCiTD3SmuZZlZOpVpaLuv2j3AhuuKm2jWY5D7ZsCJJWAuLt",
+	"PJJLb/lhAicR2VgTDuHa1uR1TMxVobcQzemK7METAqWCM++mQbxucmdiriHQdx+53jMkKiZdxy0MDxe3",
+	"b1t+JVHA0kVZt5G20Wk/KU4VmM9uCDr7+eI9clzExX7/dQAxGP1d3JERNjbcRjbZ5zvk3hDHut+QDVjZ",
+	"xDx7f7sAjIXwMHNvLjye/e7+09rxMpITAwDU5oxj+HuQMwZfjjW1Yg+3ZvyN3m99vfbb+NFFZlVZVN+r",
+	"G25JzYPh2jLfrRM51UhUzE8vjsikmLPoS96Jg890Ih9re8GztdH501uj0mV/J00O+xe2mdsX9LFlfibn",
+	"24aC3kX0buSH2z0bnkEODReAU1MQhHdwJTzTWsmGGuZ5Ne7n+WMIo/Nq1Hd3avBgU8IUaHgJYgTSLyFY",
+	"/LFY5a0zSnuXjgkWfQjLKEFY5nNHN9DD+Cs4I6jklEExEG/ABPE8q0mxD4a3CBSn82hdMfBwabOBLquG",
+	"bLhv0jXBodpqZ15X+r7VRpeUl3UeLHyrb+Mr1nwo69wLr4kgxvbOKxUyCrRu4/eGJlM82pTBWh/dqR0z",
+	"gVZMTTF+7qPX7fppdhMe7GQcm5ejDYzXm4U/TGwqdqZfgMHUsMmQ4IAWYOrKLZ7QZq5LfTEY/j05joqZ",
+	"N9CgljGQrcDWHkc+SOrUIa/WsSI9f5tnnAH8UG3Skyard6r4+Z1u9mQZO5RH414muoNHijfs2DPlKGKP",
+	"DtrADjvykDItQRbChiBv9XHj3jTauql9TAjMkRO04Y6TwJQ58AUiCH4w3jr36TUkVBIGA5eiYpQt+paM",
+	"rsb5mTZ/96r0Z1ehR0y921Kej7btizknel8ThBnjJuagpMzYmfV/+Py9kUh65hF1WHU+9Bt+AcJpi9GN",
+	"zZdTDcA1kneLfI/GDTCN4BxQnBlaGxjhBuupGIqrMU0MqOziN1ruaYoKIqUpbomwSJdaz9GZpg20anNr",
+	"WKGbaBF8xYzLLfH9bSzTNzDOAGxY/wsjCzldYEbnRKom8MR5/Jq7WsvykN77+i7iDPuiGVkTuM3I4662",
+	"Ifnme6Ieg1EN1TvXr2x29NrtwgYSy4WcPPvd/pd++XfQ0KOGSPOFh1vz+BzQezm4iq6n/JzMTc0tdDXL",
+	"eIEp20uff/3N1ewpKMiEEagu4UK9ojOqCTM4saZqx/964ka7usr+5f+zn+/97WDvO7w3//X353/59PSf",
+	"Z8kDmXkzqezBodhdGxLMtkmv9Jp5mrd86EUJeisSPbyV0WvfEuYjzZA9h5ucpMRAyNTjw5iJ3lm3n9uz",
+	"+LrVBshyvW7zjzt6HsFjR8/4gKMHrCtjv4Czpcv64j1J9Dw00c0KkEx5SaSrb3cFqAM6SzRZFTIxd9LV",
+	"7Ok+OjZRYhAb1bS6msXe7NDvhnaDSunUQsNPL9FvtERPji4u4SKz1/n/PDlz1yoIgrtc3qEnr+9SkiMd",
+	"XXfN+Y25E03xfUKM9QpmEzO+mAHDMXEzfe00SVrmX3rUSWF8YAmxhJ4Yo+aC/OogtHpDkN4Rr3Zv4vNy",
+	"0sWpNpCXWCpit/iRg8lXLNvnJWF3RW7oKzXiB01JxtOq0GXZZSkIzmCPinwf/n/TCz5pDbkNDcFjMEAB",
+	"xxTy9Bs25AK12W1UVAL5N4tj25X20dE+tQKiV+Yvur++jVSSpmh29Pn0xjT5/BLxR7MfZsr6MtD9oicp",
+	"lmSPMkmYpEqTRFbXphNzdp9GDxKUh9toCp04XwD4JllshJ2E7trlu9DdTSJ26+G/1nXQ8J0d31ZFi89m",
+	"l8oScNfUx6vl1u3ll+zmfatzvuw2xR+19lgNnstnv1tT+qehp8EbC43yuc/nG2cGD/beOAUeMMSFlooQ",
+	"+WU8SCijwq6q1oj0eC+xTK9m+l9WYXyp+wHF6NKyCHFwUsY85dfz9RNiLEBBnU5jS9snKC2rDxIviGlj",
+	"/1Pgwv6XLke7WsBnhyswnJI7AO40sHFmUlimlkAwP62jkLsyh1I8hjZBVc3gfTZkmw5LJNU6dyrUbFi8",
+	"6Ujo0kSTG8Z9NAkHy7mXgPu8UmxIgpmzkRk4fMO63WJDE2RU7Wva3nPL9He9RifHZlpUyVAdpElSi7ri",
+	"tUPiqq5w+8eyxTbLihuyICS1bjZpv+v2W9zztD8bm/gQvKncyiiJbrwFuS68ai5RfbLPXF+IYnm99lWG",
+	"bXvZ/7y6/ry6vsSra6As1YAmHry8xt2OyDvqj6uTdyY8oJh3lzZN5D0zj449Xg47JN8QdXlqBM7P5R/Q",
+	"JdlZ3BAvmYbIUezR+AHiileY5pASOvdnYZIY5cO5oak3FecCW/r4D7b9ZlWDMgRauMp9FVOPvfc5QKUp",
+	"ylKF8v5kHrz5v6+KkSd7rxDc51aB2hOKDqMX9uXwWqdsscI0D/FbZ21ZU0Z6gv7d+Xh7XBiZ1ZaYb6pb",
+	"+fL0y/Iod6hiHMv/GNwYLFUe4MbTtqt3Ojc28aNcIMYDHmOH93h5+mD2bPldBcE3kE1vXomAYjinqR5n",
+	"B/z6TNYVeSax7YWLZ/1jM28yoezKyXESCRkwv1+vXamByNvEK5azwUzuUwspQbgpvN2qZeSl7b7v/9Fb",
+	"Q9RF2inpE3hlHUx515kiSLq6Onh3DTCUDXNJy2q/0u/lfWwq7j1NQLFoUXjCi9iEbG/lTfxo0s2VhRoV",
+	"bn64+dgLEUA6IH8bdm/z+JR+VIyVk62CWduRjspDMWrWaFJ9pgtFVzc3HFd/oXh5VDfcIMSdCyQVL0vy",
+	"MCVFj49SbwIdtzIXUzJnudg91Gp3qLgBlottQa6m3Q5j9IlAryoslL+7w3dXPzvJSkyXwKRqGJt26E+r",
+	"uIz5dH+bOU3eaSx4RvbRIUOUpYIUhCnsQ1+iNOfMFhEpBVlRXsk+LoxbkIG2gfwFfa50XI4LyHH4TaZu",
+	"DVXfI6rQHOe5RNc4vTGoxfOqhbtpap0xfsXGh9ZROKjAGdGyHASGAWMtBU+JHACLsmitoagkPR0vLMn+",
+	"0yNUKDypL8+//gxHRhNIam6dnlxgovuhSlkAQ2MAP7eHJLOtZBo4biO5Blx0pfMzsVKc2/LV8WN8blq1",
+	"ZfUWcYraZRRGg6RGamSYHu8HYfSl8t87bqO9oNZaRrJH4jHd+nm/9fmlKSWmBQKipiwjYdramI3ypQn7",
+	"dT2YzRpg1fp0ybYq0ZkQ5HTJlpTzPnUCsHtRIB0glQWOsFHob0ipvkeVJOj49dvX718jfzrPXNNnv2vx",
+	"+Mko0Hoaeqiin0lm0wi9BU1SebxVeGl9D826M6BpPo38TfD+6mlA4azsXk91igh68uH8LVxtT/fRO8i9",
+	"06GnkkhNU6GphsCRJeUtF5l+DFGJCMtMknfGieEsQUD4YkVae4oXmDKpkI3R3w/mUw9R+2Cb+EN2mIHT",
+	"3hCo0dCCD4B3vLVOQ+AH63P9ferrdZ19L6vAvl/avZBDm5EgwlKxLlXtCpY3BjRUPzZN7pDFv7WAUzlP",
+	"cd7kfWJzlnEKAY/+pInaR4cQAqmvIKbQ2Yf3EJMMlZHb6le+DjB6n1HOqh6jbD/f8tJon/5Aj51quRGX",
+	"SuROXdZsF7DhVqGyNpyTxcrqzGg4M8T7HPQ2QXC6BNeYvSoe+ooUwUsnerA699qzFJf4muZUtW2CHSJA",
+	"Op07X6gUdEVzsiAW0TPPUc3TEj2pNbw6Pl//57yuifgUVVKbEQKnA11QtsgJyvXBc8NBMp9JkoGH/mJE",
+	"2h75S9olS7tx1gPsU7exEg/CF+rJP6IYhj2saVrPAKVtak3jGqd+RDnmbY2pzkDL6bNore0YO6T9l2MK",
+	"W1wL5Ks/Mih80OOVewBezboXvLvUA9IWwH7q7s7cMh5F8NnRxoJA+uaILcBJBui+8WZbXXNIFT7yQQ/s",
+	"C+C6orlq8u3cRjsdd1xXtXSbpLHatqMgFK7dtqHyemQe12wHJFl05Ttkz2ks+UiEtSB1m1B10NR35qEP",
+	"oSe5rtWQYv3EendhYhWeht1J8H8b+kJHFFhIU48rsb6WCuUSKpYRH0TcB1JKkCBljlOXV9+Y4brPUNwy",
+	"VA5ooj7r/bH10Ul8H1dIB9W/9ibRx1QKO1uP7bU5doC08DdBW1Kn9eaYsjjiunuGA89hAVAPgtTmc7/W",
+	"gP73xX+8RdT4TcHMobgrAuJiS/n8itUoWSF8c6pM2plTGwywFpWIF1TpzQFTtIITBLYhHxctgv+g12ii",
+	"5XbE7abzJrT5M6Hd1NPIsY3dDbB86+eJBulrnq2jKZ0PydLUO2MK9Pe7bhjYrKvLvCaQe0RBddggJM+a",
+	"/Awty4ExcZqSUjNVxaiSGqwNfJ02jBE0GIVvCKuVmyvW51joSBBEilKte+zJSASMzyzqR7OInTOFGWdC",
+	"OKml6lZgHE1f7qw3m3x88XZ0dyVekczb3L6Wf6FbuI93SEBvnDHNHpraVWrJnzlkR1AvHkxT6XcfpGAU",
+	"Hb41MVPZYk4EYakDscRs3T+DCEv07+Zq01EbV8zP3/jh300BZLmXkwVOQUBczf69FDyzWD46bQJdVQcH",
+	"3xD0/C9vXumH3GFrFSjF7IrVc0GmaHxrnd83U0XpOnXWc0H+C3JwgtWjwIzj7dtOgeG9cT4TIry/0hGu",
+	"nAwH7+zn3SzgIIRfa0stRpN9xweqWtxf74FiyVbPudedAROd8MxtnxepaJ77JwYqYfR5tS6fYLICU8wY",
+	"V7r0i32sxl7CbU4dRiT2h7OPli09ZyYA1fuDtx7gByONaZ+IcVDh1hK3+3z3dysiQGOP9i9ykw4+hwx5",
+	"zJ0z9oEJ2xbB6oR6wO7V7F1sguy54mhOSTSHtpKutT9oYtMNc33LXTFnvAxcVwmUWuuix1oVCCJhQjeW",
+	"gcv8UlhsV3Cg970pPwuXT4YEnYCTsYODYSg65Wz495+zccRf/GdYSGu88vVAph+Dkuc6DIIqaVX7fXQC",
+	"ObEoxUKsLTAjFjg1BYHmkih48Vuz8HVOiu/r0CbTBYJaZ6AzyGqxILJGGE9zLu0bAjg8WCjUmdv++zzv",
+	"7YphGrLKVTCOuG6DhG20wUv/QXzpNmTjV33tPRzUyxQvpSsRAFBV14SlywKLm310aDTNPS9sv7LYzHp4",
+	"PefMBQS4UIC+SqaH+LGZzA6DuJpR4u7FV255WptMSa4rBeqV0JTYgHqSmdggnK2HnI01nbQuZon3MHcj",
+	"zKfp19/ZhnyjAT5pJQRhql6UVFhZeaBrZZeYijq+zGX7BN3DPWru8iRO2Lkju7CGsbcRO33G8zzQZZT2",
+	"EXOAwkJJhOWapc0O6uOk3f2cwcuv4II0paSR3gkZOi5YqM552b4E7oyykQD+XAd2atSvZ8dP0KqR3LD9",
+	"iYlhowLltKBKVx4hZChC89C+S1vn3b3Bt3HuYSvGj31bpveiUMJ8qQv0VoroOuw01RlR85xjQIVecovZ",
+	"MSdYUkg856LJpJO8EinZs4W4O0yLTGiYTk8nLNOfmQKdtZ8HLwiCaF+keMlzvtBJSIKunG0MbJlc3OR0",
+	"rvYC6C8BTxuXHrueYSp6MSvbPyStYdY7VFLqYOrpswnEVcdDaShxICBURI/Pcb3J21GoLe+ZwxaJmRni",
+	"cK/6+VipoabpNP6y6rHlVMvEprKwoy+izES2m6RYj3nfHR6ijMDdSkHOzCkRY1fosV/Kffe8Ug/nstDH",
+	"maUG5G9mOhDe7rtrHHTFFoAKXVeoVfp+CreAYJoSbwNallF5G5HeySMGlrVeBuM1bUYCWFfK9CPNqcx0",
+	"bg0XRjpqqWqMcyYedkwl1gd7zDyh21jzr3TzbJRvuBndk2NntrHN7v123oimDMCbhtJIQUXVv7h8JKea",
+	"B0GSQ4kiAWLVfUzS4e1WNtpBk9z+rL7t55RRuXxoVKFR8zGSJnCzNLs/hcc7RfnCspCyjK5oVmHvKYGo",
+	"suwn95FBwsF5vm7VSisdh41Isill/qzrE16LftdRACrLHLu01U6Sm7WyeV6xTYRmi5G24Ozt9DedPSZW",
+	"x2pt59hunlf3hiiok8MoU3/5djYJSixwVPUMxtwjteHFzDZ26nVXW/aBtDZr4l5JhdX4WU6NCpXBq5RK",
+	"RVMbP97RyL+S/iTAQCX30ZmgeqpNio7B4yDowwlSHGVUljlee9UWIXWcSEULrMgUo4Ccfm0pjhZEdRYy",
+	"Lg++DF+OW7VZc6hqn/FflJW/wiirnlIJThG3zgaFbgtAAKGJDPDkBMD1t5obJsKu/4mJ/icm+pYx0Vuv",
+	"Dbkt/EW7Rf2iNkPQ6DH0BBO34p2TncbHWHDnzxIZY1YXBZSeGgwzVvVv63tuKIewLsNsHdMuk3HKxjeS",
+	"sg2BP6xltRliUG5uH6t+kmLlYMCHUz86u7Fl1G+rR5kuNz2PseCSz0r6P7GW/8Ra/m9fJmC3QqNbKmDj",
+	"e9xFN4XChj6/3N5VwNDmqsPBY6kO2yoYvFu+M2TckgbxrFCrvTLHLGoIeGMLVUqE0TuidE2tU1wmCKML",
+	"47w4xaWt7HqW4yatAnkpQYG5+ik+YPIMLqPuALLn7E2T1AlIBS5LCO3TBnu5D0MSXZw/c56SxrLAbRRz",
+	"KqiiKeBwsZQIJq+YvstyMleIV8qNqNdiBmJm0aYcnefm0b3pD3A9jU4/JkaqwKX8HhGcOjTIAqI1AI2P",
+	"ZKaA6i0WxvSLJcqhdP0CmVJ4qqbh/3t4+lZ3XFbqikEVDPiz/VTuqzuFfBg0XXvQNDfuA1dv11zdTfnR",
+	"ehlpSqS8YhYhzbpdXXFJlxBjESxJbciBf1BWVkpGkmI8UXb6/lLT9QtQh1qFGqcXVRwSLnZxP5qvQvc0",
+	"LogscVpvkbclLHN/tHhNQibo1lSSfX9piuhLhfOcZJHZMtd7WKvQ+8LkUnsjC7WaTaj82EzXnWbNAOZA",
+	"QYBSH1aPRVcYQ9gzbPYuOPdJM3QjuQzcM0u+WUwDrERKvEYhUq0k9DVLNpvCzyVhF5rCY5PIiFRWOxyZ",
+	"yZJLNWUaATRE4zg8JziTngyOKyl1qrFDfdAMR3AWT+vkKyJwnj8EPHEzzXKNi7x92e++muZplSu65yp+",
+	"WiksjHTt8Pio3rCwci2xcifxD4yoT36dWTZFuXjiBLHdtqfbV3PNTQGD/GgjGrSw88SXvWXZohYVkLy8",
+	"sOprUBOpUWbGAF9P6nKxs53WqrLTiYeB1U18LNngHjUt9R54EVrB8K5zg8xrr2R9sraH8cjLBs6nVb/K",
+	"/W3IgtGlyci1fcJA80KXx8d/bSDdrJ7iNi4iLKj59jLLbkIC45rznGC283plG/FAA8n2qJuq3520O43Y",
+	"1g5gdHbO1Y4CPJtRPlOA5/RNvQ+s6xPGtbwDoCvN9/AfXvTn0yiDNJy0/UjOjJDSK7MNyvrlaYxLWtL4",
+	"2UofwcFahbalPqu7D8vWo5w1cTyhxAhf3Axdm9CwKnOOsy2gI3q9jR7CKkDKs6pNym1D5E4tP94Fwr0n",
+	"Du6j77i/kcMo+sfHf42ewg9mAyPAt98+/6b/yY9au1aco1y/XdCTAt+hv3x7+urpA606MBFYmsLiGud5",
+	"hJ/McZ1QVdQ8vKfXFo2/IRrAmHrggWfBF/+O+ExVTKdo7ruobdr0OclE3a9tCiBbe6LKyViUxjXJz6sd",
+	"Y/PVo4yGA+iGCKadoCVdLPWSS0G50MHVcyqkehBt9fgobwYZrHERS9vxJmkQZ2wGc4bwXJ+eXtqxNhV5",
+	"V7uorJnSQZFFEG00ho3uC2EYzJYMkBq8A/6Ns8xkgZoFWYMORMbod93lqTT1B4TFzaaqbemllhQAA4mV",
+	"VoNyzhZEmD72kS1xJwmU3Flag+IVM7NyFQwAakFPKI4BUu//TiMc6lE+U5RDs8pBzt4I+yOxmzsVAqTh",
+	"7R0CgNRhETAODOkbEmoOxIOJp+GiMp7gmhCX/35JXO1D2DfDjiYx1WJfGu7PBgRpDA/E59rBa9jb28fG",
+	"AvGGHovH8Ge53VDWhulG5Glc7fnCKH3w2ELh8XbNQHlM3rIRl/fn37ddOb3vd5s8OuNM9oBH75HHYLoa",
+	"JmMi39U3wQTtdfea6zStNSNzyqj+k0z0LZRiRRZahQeP9FaQ5/LuQPfSXw+9HsBNL+uNgRouXLvrkfeN",
+	"jRVwC6oVwxQzDatlb17wQ+v7v/GyN+MYhReuKKMZeFPICXZlXSyTMlBrL0/liEr5COrkZ1Ql46y2KXoc",
+	"bOqo1rg7hfFYb3Z9/Cee/E3UPyQVXkvNON7jhjZKoeKDSt606+vz6HbT1LqdaHTNGX2IXvcFEPfgsQ6m",
+	"R7HH2C9fl5u8WeMa3efZsd0qcp9RiYuzy1TdzZPfO+aojqI2kam00C7U6pmNqhssen36/vLUNdsh5f1h",
+	"QjEirTDErVTStUGH7ZjDTrwjqgC7ndfBfKgVlRGJOg75os6hEAHpUnP7h6hHyMc7PRvtoS3NMOE02S15",
+	"0KZb8u9y4/Wh8qpBP8NpWhVVjs0s4wfMK6h+6H+yw42KDDlUFZPN6aIyE9c2TgkxN0BCcqcnVGN1+kvY",
+	"xjEVPAdrpd+xHswjtau17e2Kt8J41bxfloS5EplJgzSKyiq32CEv9grKKtWUlHcetxtCyiZLe218CFfM",
+	"lYu3VeDNQ6wsCctstRIoE+yWBIyX+JXCtZ8ut4xocBUIZYsrZqs/cZaSfXTh2uem8glmSODbc6K3Qe+i",
+	"G1sQlPFbZro35e/rMvk6dLr58aQ9bVsKMDBMcAwBkVr7V8yvcgTRaXVtY/1SqTeQNH3b1HdNGyoCtdSv",
+	"mDMDF3H816EDtH35GhvtkWXtxkfYbzVZkXH8nDRbj7ho2OrBaIhWrpCtHfUhKfzMMNUUJDUcLOw/72Zd",
+	"9J2AtduiOdVXjKpGcvjHQB930w/nN7qMuMkjsMNdk5QXRPoDeZO6YraZncpwORdjIjlsxvaoZuLodnRc",
+	"hob8TMaa3jwuLD8GTo1pMNmA4/Zxo5RnBEGsQfnYiV+Ist1WINyCPF/zc2B69zyEFYuXNj+v2D+CPuS3",
+	"slEEFl13auVKs+fbTX8/q/LcFeixexWSnYjx28lbV/KcpnRSRUurpLgv6hQyKHS4R5lfmtK0WqOK5URq",
+	"cWv/7SKbwKJJXYHPcL1Kb+JnbpaPAR/VHXcSSqP3UUOhrZe6EYFRhrTiAZyL/ip3rk85an6268CfQCf5",
+	"HH7ZMBSkJCLV5z0nCVoSnAnOCyh2ok+6HLDv28PwGBEhXYZZbywZJtj9j50fyZcAgjRlHBsZYX+3k4l5",
+	"AcLMOVoE1ZB1tAaqabZlE/0GlE6m2Api6z74LAfDggc8Dk2NGX0jgg7jl9nJbdVAHrQ4WDNUu8Cey0o1",
+	"s7iuFHhTTWAwvHC7J8eeG6ogmDr3kkEnH6Pey/lLlPGfh5Wnvo2nSPddnwRn1nyQIBfEJMIbAPW4pqcR",
+	"TRznXp523iLx53L/jWxC/q5YQ8HOGSjzStY0TeAepCbGwBDXGKgWAmd6QjhdXrH2IuwQSC6x8Muumjjy",
+	"xvpkI8vNs1vignQ7shHDdxZKwHZ7Neu0uprZNkimvCRNUeIr1jmJgbd5g7533tmJ6XIraQkIqKZZMUli",
+	"efc1h2ySz8/yNarX3baEUFlnooaGa/AEAonCVQXwoo/pFW5ZANokD+XCnKIeUxgCUIEUVzifio8DqWv9",
+	"k5KgsiMUtp1hXK9SH1wJ57gbX9uf1n0FyDOLaDEFb/LINP2SGP+RGC+8ck2ZsB3Kb2a5Tp89Lf0chMjD",
+	"uBDy47d5Ob3Xk0SB01MSUU95iMfsP0bzry5Mu20kX9nDIKlvf/7vkYR1YeVhrDrWW0caY0sap9BmRtBg",
+	"rhbj/fG24lW0k+1A8PTHGreddEHq0htZ342KOzCXpAFNujy19wcpytygPkmakQRxpjhiPCPNwbaMmuh0",
+	"I7HQM9ZfQ16V0YGsRwNG1J+awu/GmQmqYWF9s7XaZq+uK5biEqdUrZFUArPMlHqBUXVH+zUcjb4unOZD",
+	"LYSGpJyRDK2Ozj5Ir2pxArBJTdPvXqBK0Zz+VqfnD2uMHb0PMqJcdKrf0RwbR5E2tWsie3OyqlzDnbUR",
+	"EeZJlbxihsZhxc46V9r6XEhrO69YLXd2ktpvj+PnKY08IgsuQody8LVkUIHaLyVgWMviWxAW21SYLqi1",
+	"nweAuizPRsXe0JW2IkKOxINc2ia7zP82Q5ywOQ8qvOZnvzhOyAIDsRKrQFsv9Mz86hZvAtEKF4g2rh52",
+	"Q9fue8UbJ8XlaV/t/cwXehKBVr9et5PwwrDpr/0mfyLV/olU+w+OVNs+7lOx6INYtROAZTxR8rio9J0J",
+	"T8MMCEfxdkTqs2sdLL5nTJh7oHK66ybs63ul2/t4uJenr+uvdqPYeEPWQ20e7t0mfd3RrgBmH77zsGw7",
+	"PQ8ztd4jkBQGhCa3KTFsp0zxzGi4UXMvFETTV+gSS1drr8Dm+UQL/WlTc0Y7JgOlZF7DCCHGGrzGd4Ac",
+	"enmqMWVq4NDNhNiKZfu8JOyuyM2wco/P5zQlDrNwX5ZAgSUhqsj34f83hUVMZorcqWepXD0YUPHo4tLs",
+	"HBfo9V2qU8W4uLnm/Ga8YIel0GOdCsMhBggjcCYmRNxt6zQYlo6HBraOA0an9Wxfu4d9yvOqYIl124uK",
+	"PJvjXMIurIl8xrguU1tpQBAIPJYE1McrJvitRILMiXCYIID4e3ma2BVLhTjTABG6sNthniP4Aos6lRNR",
+	"1pSaUwIziV0k4Hm3c6pxyrR+ecrPyRw9uTzVyOpm7k8T9OHDyTH88cOH5s96CabEz+XpFbN//N4IBSrs",
+	"9OY0N2HGiJrw+VYwY16HCcHkQdEG4AmiI8hxqvL1FePMLNtFDlXMNdF/wcU1XVS8kno4mdhqt6B7GtgV",
+	"Q4x99AuotGJ9XrHE2Dpg46j+MF+7YUOP+pPifgLLuEZgoUt+2ywThkqaDNuyzNcWeqOIIdfCvMPaIPBT",
+	"EkFs3DKwWV+kcIH+8+3Ff8Ip+N6QsuEAkHkQ9c2aVgaGG2ez5B8UJ+3UMISNkA3hGsLvdu+nlEPSTyBb",
+	"GMycI2kxqaCBERAbi9/JIGrffP1QELUL8iBpbXRdjLr8tLEUN0g1e7zs+qwjPgJQABQRP5fOw7RDrmkN",
+	"NfSEMQ2RW8WjXbtviF+Gd+7PwvLgNh8lI8gQ3h7tHiDCjTGKD2EtI2BPf+ydyXOUUakoSxXKe5PZ/tY8",
+	"+lOg3uc/3wF/vgM67wDL8LtQ/S23b6jqO/QUX723k5REM7B1IH31/Vc11AqEHWkNCWeZTi7EeX7F/tTS",
+	"d6GlT5Qln1lFT/rJp9zKTg9KRyYQLCGypuo7/B0tqbQAs6EJYYs9f0/Ehj9fCH++ELb3QjjMso4c76v7",
+	"ztCxC+n+O/z/5MKdID7e5FzDQa8fiqSSeyhX9yybpOWCQ4CtybhTsbCjo+HhNTnyToAShpVvZL5/QFCk",
+	"HqvGTjEv0zy/v+19UsFBWKepFPDIrLZ71J7LU/lQT85mQDuP7sXRwo0LJAKssxvfze+rwlYhHnk7t3ob",
+	"Y65262gdOD32FwPl1Z7zMSQcBaNX2msby0zqV5LsdLC9nKXwzO77nJ8kbb4gtti+5GlP16Y0PVD+dEiw",
+	"u3qlO+EyQ4Nu3436vm255HQup4fEDEivIfoUGpsXMVK8kaOZj3OfoIJLhQRJCVPm6WCeqHYMRCVKsRCA",
+	"YAoAGvBxsxTd9TAYRtfg+FOtRf3hhKa/vnHty23jFyArezqueUhcnm7T+mmZuJ2fMMVO3U7H2JBxjEln",
+	"a+yT/BGzYzoEnpKVtYscLMZbcedQbc+EHlMdTrqlnCxw+dPfSJTFN8zNirC5t5IpPP7Ba/5ZGXynSmXh",
+	"LfPYqYpBbmsxwsZK5S45SctLv/NrQfCNxuiyAHWyJCmd03Sy9LwPSz2TRFAiN+SsC/PRlyVAz3vHDSrK",
+	"tqimY5AVcQk42lLvTMBh0WradXJgx41BNFPLJrnfYPf56GIQBLRYCLLAymL8JQg3b1H7qZ2lg3Nr2fzd",
+	"H701xCLazQws+F84ZPhgSpDyKVGCpuiGrCFHSkACngk91xHf+5UO/t7HOj5qQZ6C96RN4Qnh3QWMsZUA",
+	"70cTQPY0jMsfRQuC7IEbLc4HriOdlwW79/AkUcZ9yMptCTAIKjF84a1uM62vL7dusVRkXCb9opsdMpyv",
+	"JZ3qSgKe7WbBpzmW0hwvuzyU6keKw00wv0eOF/zY4ljC9Jn624xmpmYLvyWCZB/5fD5LZlyUS8ygWj0M",
+	"ofP0PIfIvUqZN5mw2FLjv08CbJsJAocQGpgtJC0HtQVqIlQgouuLs5SMHkuz2Q8pZBgm8i2WztOql/Ib",
+	"2c5bDA7SOFN4hxGoNZAqe6QJQOc0jinSdJv0s2VriA2UOhBRXdJkKYhc8jyDsnuXp6jAuhLeirhwW7d9",
+	"L68YQntIH6yXyJ4rpAc6OvsAiavXJOe38PtRWZ199+IsVaYyjEWP1m2wMsYK3eodUWffvfjrdSlNz95Z",
+	"9QaYz43f3LpECWH+b6Y2n7eZWKGcYKl0l8i1/Hk+P8ZrifCC76Mz72tgxSWXxLTUwSlGlraQBmtXkukU",
+	"PPNcodRth3XjC5tLbFxOzdAf2A3jt8ws0omgNglPfj67aNHHNTvhpTz77oWhI0cLSMP5+cLeLRBFYSZ1",
+	"eXqrJ/Ce89ySs5ZwL61C08JDaJjBwa308XCQhLI1bZLW3V7gFcn0HkPmlLSpzVzYhLUr5l3TyOCyjr/S",
+	"vm/V1/EvcBcfcsV8ukufa8wtUp844aCj9IilICtqYyz6ALCavleMambA3und/39cpJ9L27Nvz6sZ/N4F",
+	"sdF++ZD57NDIFXO+75uRaTuRX/Td8vzx7hb3Wxti8N750A9zcpm9mRDMy4VhrYDYr7WvZ41MHlLE4Lv3",
+	"TdNdX/TeUIHt8H/dwu3ZkMARs32fhq/NKhKb5vUG4UqNJGLkTtWdxlDfQpTevnMmSOTHC8zZaI8nu3nU",
+	"dtiiDXt+D+Ywh2s1kkP/C7TYKZFXo1m6MIkEUWbQbh+ePdtkHtza9TXUWY0U0tNfQJ0xVmPvShOUBuAR",
+	"5kqEmrgwCPipFkQtbQ0DCOC0aBC3VBdQ2L9ib9pfmhq2cyIIS01c6Mkx/CiI5PmKtMpIo3AV6StmuGBl",
+	"LTxljhkLx0IaJFe98p0W19MDfCYsElhbhLE2BOSFTbbWELN/AyC8wCuPAMHb5ucAO9eH/Znmg7iPFafL",
+	"msHNOB5yD2hzlBEpnYZ7eaqPpZIGTAzgF2qNkEhFC03YK5bZqi/uMwhcnhORuOIdnvf2mrB0WWBxY4qX",
+	"2C/mXJAUQ8I9FfsG1cdyNKJ1HqRh9zZOUtK8rlNBFU31+42zlAgmk86RvWIuarKejv9+1K3MgTIvJFhu",
+	"xok0Dh+q7PvdsIWpm14/S7C0eBURb7LeqTO9N/fVhvXHEnmHflBE/PEML5Z8sVMOfP8ZFWI9uU7W24R9",
+	"GjzKIdDsUJCqleyDbAU0euxqlzDoGJS2mdl2gbRH5WUy8Mb4Eoh58ChX42PsiQHinrAhg5Fqn21XdhUL",
+	"u7Gy9DgcMfmVE1OTdstMdSnLcYVIfwcdGVapRD57OXuGS/ps9TW46+wXPaUOIMsklHUxsIU8I6jADC8A",
+	"KLFhKGgZgHZqSpyBC/8ay/D3TTsZ6OWErQiDoCb/knZHQ/a64SLQif0NlCjXnSAGZ8/rwo1lXIURzzqy",
+	"jn2kNQOo3ZIKLiU4Xu0N6nXZB1wacdgbhgrRyTyeQj2cOmNlg4OGcKWWXBjMRduByaAO9XBMlCGPd5oa",
+	"Unlb3fwc6qYfDGBYx4KPPWt7Zptuve+CkyOldk9QJku7/zmdk3Sd5sRotMfHfw1S7MR8EWQJpxA2hYbC",
+	"zFn/HFrwaev4mbdni+TmGAa4OstJ4izYe+DqcIZ/WFJta3fYqIIoKggSWC1dhUH77vYHk4oEBjscQB90",
+	"bGp+nH369dP/PwBJznYZBHwCAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
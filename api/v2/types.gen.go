@@ -30,11 +30,35 @@ const (
 
 // Defines values for CollectionComparisonDiffDimension.
 const (
+	CollectionComparisonDiffDimensionChanged       CollectionComparisonDiffDimension = "changed"
+	CollectionComparisonDiffDimensionClusters      CollectionComparisonDiffDimension = "clusters"
+	CollectionComparisonDiffDimensionDatastores    CollectionComparisonDiffDimension = "datastores"
+	CollectionComparisonDiffDimensionHosts         CollectionComparisonDiffDimension = "hosts"
 	CollectionComparisonDiffDimensionMigratable    CollectionComparisonDiffDimension = "migratable"
+	CollectionComparisonDiffDimensionNetworks      CollectionComparisonDiffDimension = "networks"
 	CollectionComparisonDiffDimensionNonMigratable CollectionComparisonDiffDimension = "non-migratable"
 	CollectionComparisonDiffDimensionTotal         CollectionComparisonDiffDimension = "total"
 )
 
+// Defines values for CollectionMode.
+const (
+	CollectionModeFull        CollectionMode = "full"
+	CollectionModeIncremental CollectionMode = "incremental"
+)
+
+// Defines values for CollectionScheduleCatchUp.
+const (
+	CollectionScheduleCatchUpOnce CollectionScheduleCatchUp = "once"
+	CollectionScheduleCatchUpSkip CollectionScheduleCatchUp = "skip"
+)
+
+// Defines values for CollectionScheduleRunStatus.
+const (
+	CollectionScheduleRunStatusFailed  CollectionScheduleRunStatus = "failed"
+	CollectionScheduleRunStatusSkipped CollectionScheduleRunStatus = "skipped"
+	CollectionScheduleRunStatusStarted CollectionScheduleRunStatus = "started"
+)
+
 // Defines values for CollectorStatusStatus.
 const (
 	CollectorStatusMetricsCollecting CollectorStatusStatus = "collecting metrics"
@@ -46,6 +70,24 @@ const (
 	CollectorStatusStatusReady       CollectorStatusStatus = "ready"
 )
 
+// Defines values for FilterFieldType.
+const (
+	Age     FilterFieldType = "age"
+	Array   FilterFieldType = "array"
+	Boolean FilterFieldType = "boolean"
+	Numeric FilterFieldType = "numeric"
+	String  FilterFieldType = "string"
+	Time    FilterFieldType = "time"
+)
+
+// Defines values for FilterIssueKind.
+const (
+	InvalidField FilterIssueKind = "invalid_field"
+	Reference    FilterIssueKind = "reference"
+	Syntax       FilterIssueKind = "syntax"
+	UnknownField FilterIssueKind = "unknown_field"
+)
+
 // Defines values for ForecastPairStatusState.
 const (
 	ForecastPairStatusStateCanceled  ForecastPairStatusState = "canceled"
@@ -62,6 +104,13 @@ const (
 	ForecasterStatusStateRunning ForecasterStatusState = "running"
 )
 
+// Defines values for InfraEntityChangeChange.
+const (
+	InfraEntityChangeChangeAdded   InfraEntityChangeChange = "added"
+	InfraEntityChangeChangeChanged InfraEntityChangeChange = "changed"
+	InfraEntityChangeChangeRemoved InfraEntityChangeChange = "removed"
+)
+
 // Defines values for InspectionStatusState.
 const (
 	InspectionStatusStateCanceled  InspectionStatusState = "canceled"
@@ -77,6 +126,109 @@ const (
 	InspectorStatusStateRunning InspectorStatusState = "running"
 )
 
+// Defines values for LabelChangeAction.
+const (
+	Added   LabelChangeAction = "added"
+	Removed LabelChangeAction = "removed"
+)
+
+// Defines values for LabelChangeSource.
+const (
+	Manual LabelChangeSource = "manual"
+	Rule   LabelChangeSource = "rule"
+	System LabelChangeSource = "system"
+)
+
+// Defines values for MTVNetworkMappingType.
+const (
+	Ignored MTVNetworkMappingType = "ignored"
+	Multus  MTVNetworkMappingType = "multus"
+	Pod     MTVNetworkMappingType = "pod"
+)
+
+// Defines values for MTVPlanFormat.
+const (
+	MTVPlanFormatYaml MTVPlanFormat = "yaml"
+	MTVPlanFormatZip  MTVPlanFormat = "zip"
+)
+
+// Defines values for MigrationExclusionReason.
+const (
+	Business     MigrationExclusionReason = "business"
+	Decommission MigrationExclusionReason = "decommission"
+	License      MigrationExclusionReason = "license"
+	Other        MigrationExclusionReason = "other"
+	Unsupported  MigrationExclusionReason = "unsupported"
+)
+
+// Defines values for RightsizingAccumulationDownsample.
+const (
+	RightsizingAccumulationDownsampleAvg RightsizingAccumulationDownsample = "avg"
+	RightsizingAccumulationDownsampleMax RightsizingAccumulationDownsample = "max"
+)
+
+// Defines values for RightsizingAccumulationStatusDownsample.
+const (
+	RightsizingAccumulationStatusDownsampleAvg RightsizingAccumulationStatusDownsample = "avg"
+	RightsizingAccumulationStatusDownsampleMax RightsizingAccumulationStatusDownsample = "max"
+)
+
+// Defines values for RightsizingPolicyPercentile.
+const (
+	Average RightsizingPolicyPercentile = "average"
+	Max     RightsizingPolicyPercentile = "max"
+	P95     RightsizingPolicyPercentile = "p95"
+	P99     RightsizingPolicyPercentile = "p99"
+)
+
+// Defines values for RightsizingRecommendationConfidence.
+const (
+	High   RightsizingRecommendationConfidence = "high"
+	Low    RightsizingRecommendationConfidence = "low"
+	Medium RightsizingRecommendationConfidence = "medium"
+)
+
+// Defines values for SizingSource.
+const (
+	Provisioned SizingSource = "provisioned"
+	Rightsized  SizingSource = "rightsized"
+)
+
+// Defines values for VMFieldChangeField.
+const (
+	VMFieldChangeFieldCluster         VMFieldChangeField = "cluster"
+	VMFieldChangeFieldConcerns        VMFieldChangeField = "concerns"
+	VMFieldChangeFieldCpus            VMFieldChangeField = "cpus"
+	VMFieldChangeFieldDatastores      VMFieldChangeField = "datastores"
+	VMFieldChangeFieldDiskCapacityMiB VMFieldChangeField = "diskCapacityMiB"
+	VMFieldChangeFieldHost            VMFieldChangeField = "host"
+	VMFieldChangeFieldLabels          VMFieldChangeField = "labels"
+	VMFieldChangeFieldMemoryMiB       VMFieldChangeField = "memoryMiB"
+	VMFieldChangeFieldNics            VMFieldChangeField = "nics"
+	VMFieldChangeFieldPowerState      VMFieldChangeField = "powerState"
+)
+
+// Defines values for VMFileFormat.
+const (
+	Csv  VMFileFormat = "csv"
+	Xlsx VMFileFormat = "xlsx"
+)
+
+// Defines values for VMImportRowKeyType.
+const (
+	Id   VMImportRowKeyType = "id"
+	Name VMImportRowKeyType = "name"
+	Uuid VMImportRowKeyType = "uuid"
+)
+
+// Defines values for VMWasteClass.
+const (
+	VMWasteClassIdle       VMWasteClass = "idle"
+	VMWasteClassOrphaned   VMWasteClass = "orphaned"
+	VMWasteClassOversized  VMWasteClass = "oversized"
+	VMWasteClassPoweredOff VMWasteClass = "powered_off"
+)
+
 // Defines values for VirtualMachineIssueCategory.
 const (
 	VirtualMachineIssueCategoryAdvisory    VirtualMachineIssueCategory = "Advisory"
@@ -87,19 +239,63 @@ const (
 	VirtualMachineIssueCategoryWarning     VirtualMachineIssueCategory = "Warning"
 )
 
+// Defines values for WasteClassSummaryClass.
+const (
+	WasteClassSummaryClassIdle       WasteClassSummaryClass = "idle"
+	WasteClassSummaryClassOrphaned   WasteClassSummaryClass = "orphaned"
+	WasteClassSummaryClassOversized  WasteClassSummaryClass = "oversized"
+	WasteClassSummaryClassPoweredOff WasteClassSummaryClass = "powered_off"
+)
+
+// Defines values for WaveIssueKind.
+const (
+	DuplicateVm     WaveIssueKind = "duplicate_vm"
+	ExceedsWindow   WaveIssueKind = "exceeds_window"
+	ExcludedVm      WaveIssueKind = "excluded_vm"
+	MissingGroup    WaveIssueKind = "missing_group"
+	MissingVm       WaveIssueKind = "missing_vm"
+	NoForecast      WaveIssueKind = "no_forecast"
+	NotMigratableVm WaveIssueKind = "not_migratable_vm"
+)
+
 // Defines values for CompareCollectionsDiffParamsDimension.
 const (
+	CompareCollectionsDiffParamsDimensionChanged       CompareCollectionsDiffParamsDimension = "changed"
+	CompareCollectionsDiffParamsDimensionClusters      CompareCollectionsDiffParamsDimension = "clusters"
+	CompareCollectionsDiffParamsDimensionDatastores    CompareCollectionsDiffParamsDimension = "datastores"
+	CompareCollectionsDiffParamsDimensionHosts         CompareCollectionsDiffParamsDimension = "hosts"
 	CompareCollectionsDiffParamsDimensionMigratable    CompareCollectionsDiffParamsDimension = "migratable"
+	CompareCollectionsDiffParamsDimensionNetworks      CompareCollectionsDiffParamsDimension = "networks"
 	CompareCollectionsDiffParamsDimensionNonMigratable CompareCollectionsDiffParamsDimension = "non-migratable"
 	CompareCollectionsDiffParamsDimensionTotal         CompareCollectionsDiffParamsDimension = "total"
 )
 
 // Defines values for ExportCollectionParamsFormat.
 const (
-	Xlsx ExportCollectionParamsFormat = "xlsx"
-	Zip  ExportCollectionParamsFormat = "zip"
+	ExportCollectionParamsFormatXlsx ExportCollectionParamsFormat = "xlsx"
+	ExportCollectionParamsFormatZip  ExportCollectionParamsFormat = "zip"
+)
+
+// Defines values for StartCollectorParamsMode.
+const (
+	Full        StartCollectorParamsMode = "full"
+	Incremental StartCollectorParamsMode = "incremental"
+)
+
+// Defines values for GetLatestWasteAnalysisParamsClass.
+const (
+	Idle       GetLatestWasteAnalysisParamsClass = "idle"
+	Orphaned   GetLatestWasteAnalysisParamsClass = "orphaned"
+	Oversized  GetLatestWasteAnalysisParamsClass = "oversized"
+	PoweredOff GetLatestWasteAnalysisParamsClass = "powered_off"
 )
 
+// AccumulatedRightsizingReportRequest defines model for AccumulatedRightsizingReportRequest.
+type AccumulatedRightsizingReportRequest struct {
+	// LookbackHours Hours of accumulated samples the report is computed from
+	LookbackHours int `json:"lookbackHours"`
+}
+
 // AgentModeRequest defines model for AgentModeRequest.
 type AgentModeRequest struct {
 	Mode AgentModeRequestMode `binding:"required,oneof=connected disconnected" json:"mode"`
@@ -160,6 +356,9 @@ type ApplicationVM struct {
 
 // BatchUpdateExclusionRequest defines model for BatchUpdateExclusionRequest.
 type BatchUpdateExclusionRequest struct {
+	// Exclusion Details of a migration exclusion. Only accepted along with migrationExcluded set to true.
+	Exclusion *MigrationExclusionRequest `json:"exclusion,omitempty"`
+
 	// MigrationExcluded Exclusion state to set for all VMs
 	MigrationExcluded bool `json:"migrationExcluded"`
 
@@ -198,11 +397,29 @@ type Collection struct {
 	// CreatedAt When the collection was created
 	CreatedAt time.Time `json:"createdAt"`
 
+	// DisplayName User-given name of the collection
+	DisplayName *string `json:"displayName,omitempty"`
+
 	// Id Collection identifier
 	Id string `json:"id"`
 
+	// Imported The collection was imported from a bundle
+	Imported *bool `json:"imported,omitempty"`
+
 	// Name Collection name
 	Name string `json:"name"`
+
+	// Notes User notes about the collection
+	Notes *string `json:"notes,omitempty"`
+
+	// Pinned Pinned collections are never removed by retention pruning
+	Pinned bool `json:"pinned"`
+
+	// Signer Fingerprint of the key that signed the bundle of an imported collection
+	Signer *string `json:"signer,omitempty"`
+
+	// Vcenter Credential profile of the vCenter the collection was built from
+	Vcenter *string `json:"vcenter,omitempty"`
 }
 
 // CollectionAggregate defines model for CollectionAggregate.
@@ -228,10 +445,13 @@ type CollectionAggregate struct {
 
 // CollectionComparisonDiff defines model for CollectionComparisonDiff.
 type CollectionComparisonDiff struct {
+	Changed *ComparisonChangedPage `json:"changed,omitempty"`
+
 	// Dimension The dimension being compared
-	Dimension CollectionComparisonDiffDimension `json:"dimension"`
-	OnlyInA   ComparisonDiffPage                `json:"onlyInA"`
-	OnlyInB   ComparisonDiffPage                `json:"onlyInB"`
+	Dimension      CollectionComparisonDiffDimension `json:"dimension"`
+	Infrastructure *ComparisonInfraPage              `json:"infrastructure,omitempty"`
+	OnlyInA        ComparisonDiffPage                `json:"onlyInA"`
+	OnlyInB        ComparisonDiffPage                `json:"onlyInB"`
 }
 
 // CollectionComparisonDiffDimension The dimension being compared
@@ -244,6 +464,8 @@ type CollectionComparisonSummary struct {
 
 	// Diff Numeric differences (B minus A) with symmetric set-diff counts per dimension. clusters has no onlyInA/onlyInB.
 	Diff struct {
+		// Changed Number of VMs present in both collections with at least one changed field
+		Changed       int                 `json:"changed"`
 		Clusters      ComparisonDiffEntry `json:"clusters"`
 		Migratable    ComparisonDiffEntry `json:"migratable"`
 		NonMigratable ComparisonDiffEntry `json:"nonMigratable"`
@@ -256,16 +478,153 @@ type CollectionListResponse struct {
 	Collections []Collection `json:"collections"`
 }
 
+// CollectionMode How a vCenter collection is made. An incremental collection falls back to a full one when no previous collection of the vCenter was made by this agent process; the collector status reports the mode actually run. Absent for RVTools imports.
+type CollectionMode string
+
+// CollectionSchedule defines model for CollectionSchedule.
+type CollectionSchedule struct {
+	// CatchUp What to do with runs missed while the agent was not running. skip drops them and waits for the next occurrence; once starts a single catch-up collection as soon as the agent is back.
+	CatchUp CollectionScheduleCatchUp `json:"catchUp"`
+
+	// CreatedAt When the schedule was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Cron Five-field cron expression (minute hour day-of-month month day-of-week). Mutually exclusive with intervalSeconds.
+	Cron *string `json:"cron,omitempty"`
+
+	// Id Schedule identifier
+	Id string `json:"id"`
+
+	// IntervalSeconds Fixed interval between runs in seconds. Mutually exclusive with cron.
+	IntervalSeconds *int64 `json:"intervalSeconds,omitempty"`
+
+	// LastRunAt When the schedule last triggered a collection attempt
+	LastRunAt *time.Time `json:"lastRunAt,omitempty"`
+
+	// Mode How a vCenter collection is made. An incremental collection falls back to a full one when no previous collection of the vCenter was made by this agent process; the collector status reports the mode actually run. Absent for RVTools imports.
+	Mode CollectionMode `json:"mode"`
+
+	// Name Schedule name
+	Name string `json:"name"`
+
+	// NextRunAt Next planned run. Absent while the schedule is paused.
+	NextRunAt *time.Time `json:"nextRunAt,omitempty"`
+
+	// Paused Paused schedules are never triggered
+	Paused bool `json:"paused"`
+
+	// Vcenter Credential profile of the vCenter to collect
+	Vcenter string `json:"vcenter"`
+}
+
+// CollectionScheduleCatchUp What to do with runs missed while the agent was not running. skip drops them and waits for the next occurrence; once starts a single catch-up collection as soon as the agent is back.
+type CollectionScheduleCatchUp string
+
+// CollectionScheduleListResponse defines model for CollectionScheduleListResponse.
+type CollectionScheduleListResponse struct {
+	Schedules []CollectionSchedule `json:"schedules"`
+}
+
+// CollectionScheduleRun defines model for CollectionScheduleRun.
+type CollectionScheduleRun struct {
+	// CatchUp True when the run was evaluated after being missed
+	CatchUp bool `json:"catchUp"`
+
+	// Error Reason for skipped or failed runs
+	Error *string `json:"error,omitempty"`
+	Id    int64   `json:"id"`
+
+	// ScheduledAt The planned run time
+	ScheduledAt time.Time `json:"scheduledAt"`
+
+	// Status started — a collection was started; skipped — the run was missed during downtime or another collection or inspection was in progress; failed — the collection could not be started
+	Status CollectionScheduleRunStatus `json:"status"`
+
+	// TriggeredAt When the agent evaluated the run
+	TriggeredAt time.Time `json:"triggeredAt"`
+}
+
+// CollectionScheduleRunStatus started — a collection was started; skipped — the run was missed during downtime or another collection or inspection was in progress; failed — the collection could not be started
+type CollectionScheduleRunStatus string
+
+// CollectionScheduleRunListResponse defines model for CollectionScheduleRunListResponse.
+type CollectionScheduleRunListResponse struct {
+	Runs []CollectionScheduleRun `json:"runs"`
+}
+
+// CollectionTrendPoint defines model for CollectionTrendPoint.
+type CollectionTrendPoint struct {
+	// Clusters Number of distinct clusters
+	Clusters int `json:"clusters"`
+
+	// CollectionId Collection identifier
+	CollectionId string `json:"collectionId"`
+
+	// ConcernsByCategory Number of concerns per concern category
+	ConcernsByCategory map[string]int `json:"concernsByCategory"`
+
+	// Cpus Total provisioned vCPUs
+	Cpus int64 `json:"cpus"`
+
+	// CreatedAt When the collection was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Imported The collection was imported from a bundle
+	Imported bool `json:"imported"`
+
+	// MemoryMiB Total provisioned memory in MiB
+	MemoryMiB int64 `json:"memoryMiB"`
+
+	// Migratable Number of migratable VMs (no Critical concerns)
+	Migratable int `json:"migratable"`
+
+	// NonMigratable Number of non-migratable VMs (at least one Critical concern)
+	NonMigratable int `json:"nonMigratable"`
+
+	// StorageMiB Total provisioned storage in MiB
+	StorageMiB int64 `json:"storageMiB"`
+
+	// TotalVMs Number of VMs
+	TotalVMs int `json:"totalVMs"`
+
+	// Vcenter Credential profile of the vCenter the collection was built from
+	Vcenter *string `json:"vcenter,omitempty"`
+}
+
+// CollectionTrendsResponse defines model for CollectionTrendsResponse.
+type CollectionTrendsResponse struct {
+	// Points One point per collection, oldest first
+	Points []CollectionTrendPoint `json:"points"`
+}
+
 // CollectorStatus defines model for CollectorStatus.
 type CollectorStatus struct {
 	// Error Error message when status is error
-	Error  *string               `json:"error,omitempty"`
+	Error *string `json:"error,omitempty"`
+
+	// Mode How a vCenter collection is made. An incremental collection falls back to a full one when no previous collection of the vCenter was made by this agent process; the collector status reports the mode actually run. Absent for RVTools imports.
+	Mode   *CollectionMode       `json:"mode,omitempty"`
 	Status CollectorStatusStatus `json:"status"`
 }
 
 // CollectorStatusStatus defines model for CollectorStatus.Status.
 type CollectorStatusStatus string
 
+// ComparisonChangedPage defines model for ComparisonChangedPage.
+type ComparisonChangedPage struct {
+	// Page Current page number
+	Page int `json:"page"`
+
+	// PageCount Total number of pages
+	PageCount int `json:"pageCount"`
+
+	// Total Total number of changed VMs
+	Total int `json:"total"`
+
+	// Vms Changed VMs on this page. Empty array when page exceeds pageCount.
+	Vms []VMChange `json:"vms"`
+}
+
 // ComparisonDiffEntry defines model for ComparisonDiffEntry.
 type ComparisonDiffEntry struct {
 	// Delta Difference (B minus A). Positive means B has more.
@@ -293,6 +652,43 @@ type ComparisonDiffPage struct {
 	VmIds []string `json:"vmIds"`
 }
 
+// ComparisonInfraPage defines model for ComparisonInfraPage.
+type ComparisonInfraPage struct {
+	// Entities Entities on this page, sorted by name. Empty array when page exceeds pageCount.
+	Entities []InfraEntityChange `json:"entities"`
+
+	// Page Current page number
+	Page int `json:"page"`
+
+	// PageCount Total number of pages
+	PageCount int `json:"pageCount"`
+
+	// Total Total number of entities that moved
+	Total int `json:"total"`
+}
+
+// CreateCollectionScheduleRequest defines model for CreateCollectionScheduleRequest.
+type CreateCollectionScheduleRequest struct {
+	// CatchUp What to do with runs missed while the agent was not running. skip drops them and waits for the next occurrence; once starts a single catch-up collection as soon as the agent is back.
+	CatchUp *CollectionScheduleCatchUp `json:"catchUp,omitempty"`
+
+	// Cron Five-field cron expression or a descriptor such as @daily. Mutually exclusive with intervalSeconds.
+	Cron *string `json:"cron,omitempty"`
+
+	// IntervalSeconds Fixed interval between runs in seconds (minimum 300). Mutually exclusive with cron.
+	IntervalSeconds *int64 `json:"intervalSeconds,omitempty"`
+
+	// Mode How a vCenter collection is made. An incremental collection falls back to a full one when no previous collection of the vCenter was made by this agent process; the collector status reports the mode actually run. Absent for RVTools imports.
+	Mode *CollectionMode `json:"mode,omitempty"`
+	Name string          `binding:"required,min=1,max=100" json:"name"`
+
+	// Paused Create the schedule in the paused state
+	Paused *bool `json:"paused,omitempty"`
+
+	// Vcenter Credential profile of the vCenter to collect. Defaults to the default profile. The profile must exist.
+	Vcenter *string `json:"vcenter,omitempty"`
+}
+
 // CreateGroupRequest defines model for CreateGroupRequest.
 type CreateGroupRequest struct {
 	Description *string `binding:"omitempty,max=500" json:"description,omitempty"`
@@ -300,6 +696,66 @@ type CreateGroupRequest struct {
 	Name        string  `binding:"required,min=1,max=100" json:"name"`
 }
 
+// CreateLabelRequest defines model for CreateLabelRequest.
+type CreateLabelRequest struct {
+	Category    *string `json:"category,omitempty"`
+	Color       *string `json:"color,omitempty"`
+	CreatedBy   *string `json:"createdBy,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+}
+
+// CreateLabelRuleRequest defines model for CreateLabelRuleRequest.
+type CreateLabelRuleRequest struct {
+	Expression string `json:"expression"`
+	Label      string `json:"label"`
+
+	// Name Letters, digits, '_' and '-'
+	Name     string `json:"name"`
+	Priority *int   `json:"priority,omitempty"`
+}
+
+// CreateSavedFilterRequest defines model for CreateSavedFilterRequest.
+type CreateSavedFilterRequest struct {
+	Description *string `json:"description,omitempty"`
+	Expression  string  `json:"expression"`
+
+	// Name Letters, digits, '_' and '-'
+	Name  string  `json:"name"`
+	Owner *string `json:"owner,omitempty"`
+}
+
+// CreateWaveRequest defines model for CreateWaveRequest.
+type CreateWaveRequest struct {
+	Description  *string   `json:"description,omitempty"`
+	ForecastPair *string   `json:"forecastPair,omitempty"`
+	GroupIds     *[]string `json:"groupIds,omitempty"`
+
+	// Name Letters, digits, '_' and '-'
+	Name        string     `json:"name"`
+	Position    *int       `json:"position,omitempty"`
+	VmIds       *[]string  `json:"vmIds,omitempty"`
+	WindowEnd   *time.Time `json:"windowEnd,omitempty"`
+	WindowStart *time.Time `json:"windowStart,omitempty"`
+}
+
+// CredentialProfile defines model for CredentialProfile.
+type CredentialProfile struct {
+	// Name Profile name
+	Name string `json:"name"`
+
+	// Url vCenter URL
+	Url string `json:"url"`
+
+	// Username vCenter username
+	Username string `json:"username"`
+}
+
+// CredentialProfileListResponse defines model for CredentialProfileListResponse.
+type CredentialProfileListResponse struct {
+	Profiles []CredentialProfile `json:"profiles"`
+}
+
 // CredentialStatus defines model for CredentialStatus.
 type CredentialStatus struct {
 	// Url vCenter URL the credentials belong to
@@ -356,6 +812,99 @@ type EstimateRange struct {
 	WorstCase string `json:"worstCase"`
 }
 
+// FilterExplanation defines model for FilterExplanation.
+type FilterExplanation struct {
+	// Args Values bound to the placeholders of sql
+	Args []interface{} `json:"args"`
+	Ast  FilterNode    `json:"ast"`
+
+	// MatchedVms Number of VMs of the latest collection matching the expression
+	MatchedVms *int `json:"matchedVms,omitempty"`
+
+	// Sql SQL condition the expression compiles to
+	Sql string `json:"sql"`
+}
+
+// FilterExpressionRequest defines model for FilterExpressionRequest.
+type FilterExpressionRequest struct {
+	// Expression VM filter expression
+	Expression string `json:"expression"`
+}
+
+// FilterField defines model for FilterField.
+type FilterField struct {
+	Aliases *[]string `json:"aliases,omitempty"`
+
+	// Collection Collection usable in quantifiers and aggregates (disk, net or concern)
+	Collection *string `json:"collection,omitempty"`
+
+	// Examples Distinct values of the field in the latest collection
+	Examples []string        `json:"examples"`
+	Name     string          `json:"name"`
+	Type     FilterFieldType `json:"type"`
+
+	// Units Quantity or duration units the field accepts
+	Units []string `json:"units"`
+}
+
+// FilterFieldType defines model for FilterField.Type.
+type FilterFieldType string
+
+// FilterFieldsResponse defines model for FilterFieldsResponse.
+type FilterFieldsResponse struct {
+	Fields []FilterField `json:"fields"`
+}
+
+// FilterIssue defines model for FilterIssue.
+type FilterIssue struct {
+	// Field Field the issue is about, set on field issues. The saved filter name on reference issues.
+	Field *string `json:"field,omitempty"`
+
+	// Kind syntax: the expression cannot be parsed. unknown_field: no field has this name.
+	// invalid_field: the field does not support the value or operator it is used with.
+	// reference: a saved filter reference is unknown or part of a cycle.
+	Kind    FilterIssueKind `json:"kind"`
+	Message string          `json:"message"`
+
+	// Offset Character offset of the issue in the expression. Issues inside a saved filter are reported at its reference.
+	Offset int `json:"offset"`
+
+	// Suggestions Known fields close to an unknown field, or saved filters close to an unknown reference, best first
+	Suggestions *[]string `json:"suggestions,omitempty"`
+}
+
+// FilterIssueKind syntax: the expression cannot be parsed. unknown_field: no field has this name.
+// invalid_field: the field does not support the value or operator it is used with.
+// reference: a saved filter reference is unknown or part of a cycle.
+type FilterIssueKind string
+
+// FilterNode defines model for FilterNode.
+type FilterNode struct {
+	Children *[]FilterNode `json:"children,omitempty"`
+
+	// Field Field of variables and aggregates
+	Field *string `json:"field,omitempty"`
+
+	// Operator Operator as written in the expression (e.g. and, >=, not in, is not null, count)
+	Operator *string `json:"operator,omitempty"`
+
+	// Type Node kind: binary, not, in, contains, between, is, aggregate, quantifier,
+	// variable, string, numeric, boolean or regex
+	Type string `json:"type"`
+
+	// Value Value of literals and contains tests
+	Value *string `json:"value,omitempty"`
+
+	// Values Values of in tests
+	Values *[]string `json:"values,omitempty"`
+}
+
+// FilterValidationResult defines model for FilterValidationResult.
+type FilterValidationResult struct {
+	Issues []FilterIssue `json:"issues"`
+	Valid  bool          `json:"valid"`
+}
+
 // ForecastPairStatus defines model for ForecastPairStatus.
 type ForecastPairStatus struct {
 	CompletedRuns     int                     `json:"completedRuns"`
@@ -450,91 +999,589 @@ type GuestNetwork struct {
 	PrefixLength *int32 `json:"prefixLength,omitempty"`
 }
 
-// InspectionStatus defines model for InspectionStatus.
-type InspectionStatus struct {
-	// Details Human-readable details about the current inspection state
-	Details *string `json:"details,omitempty"`
+// InfraEntityChange defines model for InfraEntityChange.
+type InfraEntityChange struct {
+	After  *InfraEntityState `json:"after,omitempty"`
+	Before *InfraEntityState `json:"before,omitempty"`
+
+	// Change added and removed entities exist in B or A only; changed ones have different VMs or capacity
+	Change InfraEntityChangeChange `json:"change"`
+
+	// Name Cluster, host, datastore or network name
+	Name string `json:"name"`
+
+	// VmsAdded IDs of the VMs that belong to the entity in B but not in A
+	VmsAdded []string `json:"vmsAdded"`
+
+	// VmsRemoved IDs of the VMs that belong to the entity in A but not in B
+	VmsRemoved []string `json:"vmsRemoved"`
+}
+
+// InfraEntityChangeChange added and removed entities exist in B or A only; changed ones have different VMs or capacity
+type InfraEntityChangeChange string
+
+// InfraEntityState defines model for InfraEntityState.
+type InfraEntityState struct {
+	// CapacityMiB Capacity in MiB (datastores only)
+	CapacityMiB *int64 `json:"capacityMiB,omitempty"`
+
+	// CpuCores Physical CPU cores (clusters and hosts only)
+	CpuCores *int64 `json:"cpuCores,omitempty"`
+
+	// FreeMiB Free space in MiB (datastores only)
+	FreeMiB *int64 `json:"freeMiB,omitempty"`
+
+	// Hosts Number of ESXi hosts (clusters only)
+	Hosts *int `json:"hosts,omitempty"`
+
+	// MemoryMiB Physical memory in MiB (clusters and hosts only)
+	MemoryMiB *int64 `json:"memoryMiB,omitempty"`
+
+	// VmCount Number of VMs in the entity
+	VmCount int `json:"vmCount"`
+}
+
+// InspectionStatus defines model for InspectionStatus.
+type InspectionStatus struct {
+	// Details Human-readable details about the current inspection state
+	Details *string `json:"details,omitempty"`
+
+	// Error Error message when state is error
+	Error *string `json:"error,omitempty"`
+
+	// State Current inspection state
+	State InspectionStatusState `json:"state"`
+}
+
+// InspectionStatusState Current inspection state
+type InspectionStatusState string
+
+// InspectorStatus defines model for InspectorStatus.
+type InspectorStatus struct {
+	// State Inspector state
+	State InspectorStatusState `json:"state"`
+	Vddk  *VddkProperties      `json:"vddk,omitempty"`
+}
+
+// InspectorStatusState Inspector state
+type InspectorStatusState string
+
+// Inventory defines model for Inventory.
+type Inventory struct {
+	Inventory externalRef0.UpdateInventory `json:"inventory"`
+}
+
+// Label defines model for Label.
+type Label struct {
+	// Category Kind of label, e.g. wave, owner or risk
+	Category string `json:"category"`
+
+	// Color Hex color, e.g. '#1f77b4', or empty
+	Color       string    `json:"color"`
+	CreatedAt   time.Time `json:"createdAt"`
+	CreatedBy   string    `json:"createdBy"`
+	Description string    `json:"description"`
+	Name        string    `json:"name"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// LabelChange defines model for LabelChange.
+type LabelChange struct {
+	Action LabelChangeAction `json:"action"`
+
+	// Actor Who made a manual change, or the label rule that made a rule change
+	Actor string    `json:"actor"`
+	At    time.Time `json:"at"`
+	Label string    `json:"label"`
+
+	// Source manual for changes made through the API, rule for label rules, system for the agent
+	Source LabelChangeSource `json:"source"`
+}
+
+// LabelChangeAction defines model for LabelChange.Action.
+type LabelChangeAction string
+
+// LabelChangeSource manual for changes made through the API, rule for label rules, system for the agent
+type LabelChangeSource string
+
+// LabelHistoryResponse defines model for LabelHistoryResponse.
+type LabelHistoryResponse struct {
+	History []LabelChange `json:"history"`
+}
+
+// LabelListResponse defines model for LabelListResponse.
+type LabelListResponse struct {
+	Labels []Label `json:"labels"`
+}
+
+// LabelRule defines model for LabelRule.
+type LabelRule struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Expression VM filter expression, possibly referencing saved filters
+	Expression string `json:"expression"`
+
+	// Label Label applied to the matching VMs
+	Label string `json:"label"`
+	Name  string `json:"name"`
+
+	// Priority The rule with the highest priority is recorded when several rules apply the same label
+	Priority  int       `json:"priority"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// LabelRuleListResponse defines model for LabelRuleListResponse.
+type LabelRuleListResponse struct {
+	Rules []LabelRule `json:"rules"`
+}
+
+// MTVMappings defines model for MTVMappings.
+type MTVMappings struct {
+	Networks []MTVNetworkMapping `json:"networks"`
+	Storage  []MTVStorageMapping `json:"storage"`
+}
+
+// MTVNetworkMapping defines model for MTVNetworkMapping.
+type MTVNetworkMapping struct {
+	// Name Name of the NetworkAttachmentDefinition of a multus destination
+	Name *string `json:"name,omitempty"`
+
+	// Namespace Namespace of the NetworkAttachmentDefinition of a multus destination
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Source vSphere network name
+	Source string `json:"source"`
+
+	// Type Pod network, NetworkAttachmentDefinition, or left unconnected
+	Type MTVNetworkMappingType `json:"type"`
+}
+
+// MTVNetworkMappingType Pod network, NetworkAttachmentDefinition, or left unconnected
+type MTVNetworkMappingType string
+
+// MTVPlanFormat defines model for MTVPlanFormat.
+type MTVPlanFormat string
+
+// MTVStorageMapping defines model for MTVStorageMapping.
+type MTVStorageMapping struct {
+	// Source vSphere datastore name
+	Source       string `json:"source"`
+	StorageClass string `json:"storageClass"`
+}
+
+// MigrationExclusion Why, by whom and until when a VM was excluded from migration
+type MigrationExclusion struct {
+	ExcludedAt time.Time `json:"excludedAt"`
+	ExcludedBy string    `json:"excludedBy"`
+
+	// ExpiresAt Time after which the exclusion is lifted by the next collection sync
+	ExpiresAt     *time.Time `json:"expiresAt,omitempty"`
+	Justification string     `json:"justification"`
+
+	// Reason Reason code of a migration exclusion
+	Reason MigrationExclusionReason `json:"reason"`
+}
+
+// MigrationExclusionReason Reason code of a migration exclusion
+type MigrationExclusionReason string
+
+// MigrationExclusionRequest Details of a migration exclusion. Only accepted along with migrationExcluded set to true.
+type MigrationExclusionRequest struct {
+	// ExcludedBy Who excluded the VMs
+	ExcludedBy *string `json:"excludedBy,omitempty"`
+
+	// ExpiresAt Time after which the exclusion is lifted by the next collection sync
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Justification Free-text justification of the exclusion
+	Justification *string `json:"justification,omitempty"`
+
+	// Reason Reason code of a migration exclusion
+	Reason MigrationExclusionReason `json:"reason"`
+}
+
+// NodeProfile defines model for NodeProfile.
+type NodeProfile struct {
+	Cores int `json:"cores"`
+
+	// CpuOvercommit Ratio of VM vCPUs to node cores, 1 when unset
+	CpuOvercommit *float64 `json:"cpuOvercommit,omitempty"`
+	MemoryMB      int64    `json:"memoryMB"`
+
+	// MemoryOvercommit Ratio of VM memory to node memory, 1 when unset
+	MemoryOvercommit *float64 `json:"memoryOvercommit,omitempty"`
+	Name             string   `json:"name"`
+
+	// ReservedCores Cores kept for the system and OpenShift workloads
+	ReservedCores *float64 `json:"reservedCores,omitempty"`
+
+	// ReservedMemoryMB Memory kept for the system and OpenShift workloads
+	ReservedMemoryMB *int64 `json:"reservedMemoryMB,omitempty"`
+}
+
+// OperationCapability defines model for OperationCapability.
+type OperationCapability struct {
+	// Enabled Whether stored credentials have sufficient privileges
+	Enabled bool `json:"enabled"`
+
+	// MissingPrivileges Specific vSphere privileges that are missing
+	MissingPrivileges *[]string `json:"missingPrivileges,omitempty"`
+}
+
+// PairCapability defines model for PairCapability.
+type PairCapability struct {
+	Capabilities    []string `json:"capabilities"`
+	PairName        string   `json:"pairName"`
+	SourceDatastore string   `json:"sourceDatastore"`
+	TargetDatastore string   `json:"targetDatastore"`
+}
+
+// PairCapabilityRequest defines model for PairCapabilityRequest.
+type PairCapabilityRequest struct {
+	Pairs []DatastorePairRequest `json:"pairs"`
+}
+
+// Process defines model for Process.
+type Process struct {
+	// Name Name of the process
+	Name string `json:"name"`
+
+	// Version Version of the process
+	Version *string `json:"version,omitempty"`
+}
+
+// RightsizingAccumulation defines model for RightsizingAccumulation.
+type RightsizingAccumulation struct {
+	// Downsample Keep the average or the highest sample of each bucket
+	Downsample RightsizingAccumulationDownsample `json:"downsample"`
+
+	// DownsampleIntervalSeconds Bucket of downsampled samples, a multiple of 300 greater than it
+	DownsampleIntervalSeconds int64 `json:"downsampleIntervalSeconds"`
+	Enabled                   bool  `json:"enabled"`
+
+	// IntervalSeconds Time between runs, from 5 minutes to less than a day
+	IntervalSeconds int64 `json:"intervalSeconds"`
+
+	// RawRetentionSeconds Age from which samples are downsampled, at least a day
+	RawRetentionSeconds int64 `json:"rawRetentionSeconds"`
+
+	// RetentionSeconds Age from which samples are dropped, at least the raw retention
+	RetentionSeconds int64 `json:"retentionSeconds"`
+}
+
+// RightsizingAccumulationDownsample Keep the average or the highest sample of each bucket
+type RightsizingAccumulationDownsample string
+
+// RightsizingAccumulationStatus defines model for RightsizingAccumulationStatus.
+type RightsizingAccumulationStatus struct {
+	// Downsample Keep the average or the highest sample of each bucket
+	Downsample RightsizingAccumulationStatusDownsample `json:"downsample"`
+
+	// DownsampleIntervalSeconds Bucket of downsampled samples, a multiple of 300 greater than it
+	DownsampleIntervalSeconds int64 `json:"downsampleIntervalSeconds"`
+	Enabled                   bool  `json:"enabled"`
+
+	// IntervalSeconds Time between runs, from 5 minutes to less than a day
+	IntervalSeconds int64 `json:"intervalSeconds"`
+
+	// LastError Error of the last run, absent when it succeeded
+	LastError *string    `json:"lastError,omitempty"`
+	LastRunAt *time.Time `json:"lastRunAt,omitempty"`
+
+	// LastSampleCount Samples appended by the last run
+	LastSampleCount int        `json:"lastSampleCount"`
+	NewestSampleAt  *time.Time `json:"newestSampleAt,omitempty"`
+	OldestSampleAt  *time.Time `json:"oldestSampleAt,omitempty"`
+
+	// RawRetentionSeconds Age from which samples are downsampled, at least a day
+	RawRetentionSeconds int64 `json:"rawRetentionSeconds"`
+
+	// RetentionSeconds Age from which samples are dropped, at least the raw retention
+	RetentionSeconds int64 `json:"retentionSeconds"`
+
+	// SampleCount Samples in the rolling store
+	SampleCount int64 `json:"sampleCount"`
+}
+
+// RightsizingAccumulationStatusDownsample Keep the average or the highest sample of each bucket
+type RightsizingAccumulationStatusDownsample string
+
+// RightsizingClusterRecommendations defines model for RightsizingClusterRecommendations.
+type RightsizingClusterRecommendations struct {
+	Cluster string                           `json:"cluster"`
+	Summary RightsizingRecommendationSummary `json:"summary"`
+}
+
+// RightsizingClusterRecommendationsList defines model for RightsizingClusterRecommendationsList.
+type RightsizingClusterRecommendationsList struct {
+	Clusters []RightsizingClusterRecommendations `json:"clusters"`
+	Policy   RightsizingPolicy                   `json:"policy"`
+	ReportId string                              `json:"reportId"`
+}
+
+// RightsizingClusterResponse defines model for RightsizingClusterResponse.
+type RightsizingClusterResponse struct {
+	Cluster  RightsizingClusterUtilization `json:"cluster"`
+	ReportId string                        `json:"report_id"`
+}
+
+// RightsizingClusterUtilization defines model for RightsizingClusterUtilization.
+type RightsizingClusterUtilization struct {
+	ClusterId   string  `json:"cluster_id"`
+	ClusterName string  `json:"cluster_name"`
+	Confidence  float64 `json:"confidence"`
+	CpuAvg      float64 `json:"cpu_avg"`
+	CpuMax      float64 `json:"cpu_max"`
+	CpuP95      float64 `json:"cpu_p95"`
+	Disk        float64 `json:"disk"`
+
+	// IopsAvg Average disk IOPS of the VMs
+	IopsAvg float64 `json:"iops_avg"`
+
+	// IopsMax Sum of the peak disk IOPS of the VMs
+	IopsMax float64 `json:"iops_max"`
+
+	// IopsP95 Sum of the p95 disk IOPS of the VMs
+	IopsP95 float64 `json:"iops_p95"`
+	MemAvg  float64 `json:"mem_avg"`
+	MemMax  float64 `json:"mem_max"`
+	MemP95  float64 `json:"mem_p95"`
+
+	// NetAvg Average network throughput of the VMs, in KBps
+	NetAvg float64 `json:"net_avg"`
+
+	// NetMax Sum of the peak network throughput of the VMs, in KBps
+	NetMax float64 `json:"net_max"`
+
+	// NetP95 Sum of the p95 network throughput of the VMs, in KBps
+	NetP95 float64 `json:"net_p95"`
+
+	// ReadLatencyP95 Highest p95 disk read latency of the VMs, in ms
+	ReadLatencyP95           float64 `json:"read_latency_p95"`
+	TotalProvisionedCpus     int     `json:"total_provisioned_cpus"`
+	TotalProvisionedDiskKb   float64 `json:"total_provisioned_disk_kb"`
+	TotalProvisionedMemoryMb int     `json:"total_provisioned_memory_mb"`
+	VmCount                  int     `json:"vm_count"`
+
+	// WriteLatencyP95 Highest p95 disk write latency of the VMs, in ms
+	WriteLatencyP95 float64 `json:"write_latency_p95"`
+}
+
+// RightsizingMetricSeries defines model for RightsizingMetricSeries.
+type RightsizingMetricSeries struct {
+	MetricKey string `json:"metricKey"`
+
+	// Points Buckets holding samples, ordered by time
+	Points []RightsizingSeriesPoint `json:"points"`
+}
+
+// RightsizingPolicy defines model for RightsizingPolicy.
+type RightsizingPolicy struct {
+	CreatedAt   *time.Time `json:"createdAt,omitempty"`
+	Description *string    `json:"description,omitempty"`
+
+	// HeadroomPct Headroom added to the utilization statistic
+	HeadroomPct float64 `json:"headroomPct"`
+
+	// MaxCpus Most vCPUs recommended, unbounded when 0
+	MaxCpus *int `json:"maxCpus,omitempty"`
+
+	// MaxMemoryMB Most memory recommended, unbounded when 0
+	MaxMemoryMB *int64 `json:"maxMemoryMB,omitempty"`
+
+	// MemoryStepMB Round memory up to a multiple of this, to the MB when 0
+	MemoryStepMB *int64 `json:"memoryStepMB,omitempty"`
+
+	// MinCpus Fewest vCPUs recommended, unbounded when 0
+	MinCpus *int `json:"minCpus,omitempty"`
+
+	// MinMemoryMB Least memory recommended, unbounded when 0
+	MinMemoryMB *int64 `json:"minMemoryMB,omitempty"`
+
+	// Name Policy name; letters, digits, '_' and '-'
+	Name string `json:"name"`
+
+	// Percentile Utilization statistic VMs are sized by
+	Percentile RightsizingPolicyPercentile `json:"percentile"`
+
+	// RoundToSockets Round vCPUs up to whole sockets of the VM's cores per socket
+	RoundToSockets *bool      `json:"roundToSockets,omitempty"`
+	UpdatedAt      *time.Time `json:"updatedAt,omitempty"`
+}
+
+// RightsizingPolicyPercentile Utilization statistic VMs are sized by
+type RightsizingPolicyPercentile string
+
+// RightsizingRecommendation defines model for RightsizingRecommendation.
+type RightsizingRecommendation struct {
+	Cluster string `json:"cluster"`
+
+	// Confidence High from 80%, medium from 50%, low below
+	Confidence RightsizingRecommendationConfidence `json:"confidence"`
+
+	// ConfidencePct Share of the expected samples collected for the scarcer of CPU and memory
+	ConfidencePct       float64 `json:"confidencePct"`
+	Name                string  `json:"name"`
+	ProvisionedCpus     int     `json:"provisionedCpus"`
+	ProvisionedMemoryMB int64   `json:"provisionedMemoryMB"`
+	RecommendedCpus     int     `json:"recommendedCpus"`
+	RecommendedMemoryMB int64   `json:"recommendedMemoryMB"`
+
+	// SavedCpus Negative when the VM needs more vCPUs than provisioned
+	SavedCpus int `json:"savedCpus"`
+
+	// SavedMemoryMB Negative when the VM needs more memory than provisioned
+	SavedMemoryMB int64  `json:"savedMemoryMB"`
+	VmId          string `json:"vmId"`
+}
+
+// RightsizingRecommendationConfidence High from 80%, medium from 50%, low below
+type RightsizingRecommendationConfidence string
+
+// RightsizingRecommendationSummary defines model for RightsizingRecommendationSummary.
+type RightsizingRecommendationSummary struct {
+	HighConfidence      int   `json:"highConfidence"`
+	LowConfidence       int   `json:"lowConfidence"`
+	MediumConfidence    int   `json:"mediumConfidence"`
+	ProvisionedCpus     int   `json:"provisionedCpus"`
+	ProvisionedMemoryMB int64 `json:"provisionedMemoryMB"`
+	RecommendedCpus     int   `json:"recommendedCpus"`
+	RecommendedMemoryMB int64 `json:"recommendedMemoryMB"`
+	SavedCpus           int   `json:"savedCpus"`
+	SavedMemoryMB       int64 `json:"savedMemoryMB"`
+	VmCount             int   `json:"vmCount"`
+}
+
+// RightsizingRecommendations defines model for RightsizingRecommendations.
+type RightsizingRecommendations struct {
+	Policy   RightsizingPolicy                `json:"policy"`
+	ReportId string                           `json:"reportId"`
+	Summary  RightsizingRecommendationSummary `json:"summary"`
+	Vms      []RightsizingRecommendation      `json:"vms"`
+}
+
+// RightsizingReportSummary defines model for RightsizingReportSummary.
+type RightsizingReportSummary struct {
+	CreatedAt time.Time `json:"createdAt"`
 
-	// Error Error message when state is error
-	Error *string `json:"error,omitempty"`
+	// ExpectedSampleCount Samples a VM metric has over the window once fully accumulated
+	ExpectedSampleCount int    `json:"expectedSampleCount"`
+	Id                  string `json:"id"`
 
-	// State Current inspection state
-	State InspectionStatusState `json:"state"`
+	// IntervalId vSphere sampling interval of the samples, in seconds
+	IntervalId  int       `json:"intervalId"`
+	Vcenter     string    `json:"vcenter"`
+	WindowEnd   time.Time `json:"windowEnd"`
+	WindowStart time.Time `json:"windowStart"`
 }
 
-// InspectionStatusState Current inspection state
-type InspectionStatusState string
+// RightsizingSeriesPoint defines model for RightsizingSeriesPoint.
+type RightsizingSeriesPoint struct {
+	Average     float64 `json:"average"`
+	Max         float64 `json:"max"`
+	Min         float64 `json:"min"`
+	SampleCount int     `json:"sampleCount"`
 
-// InspectorStatus defines model for InspectorStatus.
-type InspectorStatus struct {
-	// State Inspector state
-	State InspectorStatusState `json:"state"`
-	Vddk  *VddkProperties      `json:"vddk,omitempty"`
+	// Timestamp Start of the bucket
+	Timestamp time.Time `json:"timestamp"`
 }
 
-// InspectorStatusState Inspector state
-type InspectorStatusState string
+// SavedFilter defines model for SavedFilter.
+type SavedFilter struct {
+	CreatedAt   time.Time `json:"createdAt"`
+	Description string    `json:"description"`
 
-// Inventory defines model for Inventory.
-type Inventory struct {
-	Inventory externalRef0.UpdateInventory `json:"inventory"`
-}
+	// Expression VM filter expression, possibly referencing other saved filters
+	Expression string `json:"expression"`
 
-// OperationCapability defines model for OperationCapability.
-type OperationCapability struct {
-	// Enabled Whether stored credentials have sufficient privileges
-	Enabled bool `json:"enabled"`
+	// Name Name referenced as @name in filter expressions
+	Name      string    `json:"name"`
+	Owner     string    `json:"owner"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
 
-	// MissingPrivileges Specific vSphere privileges that are missing
-	MissingPrivileges *[]string `json:"missingPrivileges,omitempty"`
+// SavedFilterListResponse defines model for SavedFilterListResponse.
+type SavedFilterListResponse struct {
+	Filters []SavedFilter `json:"filters"`
 }
 
-// PairCapability defines model for PairCapability.
-type PairCapability struct {
-	Capabilities    []string `json:"capabilities"`
-	PairName        string   `json:"pairName"`
-	SourceDatastore string   `json:"sourceDatastore"`
-	TargetDatastore string   `json:"targetDatastore"`
+// SizingNode defines model for SizingNode.
+type SizingNode struct {
+	Index int `json:"index"`
+
+	// StrandedCpu Allocatable vCPUs left unused on the node
+	StrandedCpu float64 `json:"strandedCpu"`
+
+	// StrandedMemoryMB Allocatable memory left unused on the node
+	StrandedMemoryMB float64           `json:"strandedMemoryMB"`
+	UsedCpu          float64           `json:"usedCpu"`
+	UsedMemoryMB     float64           `json:"usedMemoryMB"`
+	Vms              []SizingPlacement `json:"vms"`
 }
 
-// PairCapabilityRequest defines model for PairCapabilityRequest.
-type PairCapabilityRequest struct {
-	Pairs []DatastorePairRequest `json:"pairs"`
+// SizingPlacement defines model for SizingPlacement.
+type SizingPlacement struct {
+	// Cpu vCPUs the VM needs on the target
+	Cpu float64 `json:"cpu"`
+
+	// MemoryMB Memory the VM needs on the target
+	MemoryMB float64 `json:"memoryMB"`
+	Name     string  `json:"name"`
+	VmId     string  `json:"vmId"`
 }
 
-// Process defines model for Process.
-type Process struct {
-	// Name Name of the process
-	Name string `json:"name"`
+// SizingProfileResult defines model for SizingProfileResult.
+type SizingProfileResult struct {
+	// AllocatableCpu vCPUs a node of the profile allocates to VMs
+	AllocatableCpu float64 `json:"allocatableCpu"`
 
-	// Version Version of the process
-	Version *string `json:"version,omitempty"`
+	// AllocatableMemoryMB Memory a node of the profile allocates to VMs
+	AllocatableMemoryMB float64      `json:"allocatableMemoryMB"`
+	NodeCount           int          `json:"nodeCount"`
+	Nodes               []SizingNode `json:"nodes"`
+	Profile             NodeProfile  `json:"profile"`
+	StrandedCpu         float64      `json:"strandedCpu"`
+	StrandedMemoryMB    float64      `json:"strandedMemoryMB"`
+
+	// Unplaced VMs larger than a node of the profile
+	Unplaced []SizingPlacement `json:"unplaced"`
 }
 
-// RightsizingClusterResponse defines model for RightsizingClusterResponse.
-type RightsizingClusterResponse struct {
-	Cluster  RightsizingClusterUtilization `json:"cluster"`
-	ReportId string                        `json:"report_id"`
+// SizingRequest defines model for SizingRequest.
+type SizingRequest struct {
+	// GroupId Size the VMs of this group instead of the whole collection
+	GroupId *openapi_types.UUID `json:"groupId,omitempty"`
+
+	// HeadroomPct Headroom added to the p95 utilization of rightsized VMs, 20 when unset
+	HeadroomPct *float64      `json:"headroomPct,omitempty"`
+	Profiles    []NodeProfile `json:"profiles"`
+
+	// Source Size VMs by their provisioned resources or by their p95 utilization plus headroom
+	Source *SizingSource `json:"source,omitempty"`
+
+	// Vcenter Credential profile name. Sizes the latest collection of that vCenter instead of the latest collection overall.
+	Vcenter *string `json:"vcenter,omitempty"`
 }
 
-// RightsizingClusterUtilization defines model for RightsizingClusterUtilization.
-type RightsizingClusterUtilization struct {
-	ClusterId                string  `json:"cluster_id"`
-	ClusterName              string  `json:"cluster_name"`
-	Confidence               float64 `json:"confidence"`
-	CpuAvg                   float64 `json:"cpu_avg"`
-	CpuMax                   float64 `json:"cpu_max"`
-	CpuP95                   float64 `json:"cpu_p95"`
-	Disk                     float64 `json:"disk"`
-	MemAvg                   float64 `json:"mem_avg"`
-	MemMax                   float64 `json:"mem_max"`
-	MemP95                   float64 `json:"mem_p95"`
-	TotalProvisionedCpus     int     `json:"total_provisioned_cpus"`
-	TotalProvisionedDiskKb   float64 `json:"total_provisioned_disk_kb"`
-	TotalProvisionedMemoryMb int     `json:"total_provisioned_memory_mb"`
-	VmCount                  int     `json:"vm_count"`
+// SizingResult defines model for SizingResult.
+type SizingResult struct {
+	CreatedAt   time.Time             `json:"createdAt"`
+	GroupId     *openapi_types.UUID   `json:"groupId,omitempty"`
+	HeadroomPct float64               `json:"headroomPct"`
+	Id          string                `json:"id"`
+	Profiles    []SizingProfileResult `json:"profiles"`
+
+	// ProvisionedFallbackCount VMs of a rightsized simulation sized by provisioned resources for lack of utilization
+	ProvisionedFallbackCount int `json:"provisionedFallbackCount"`
+
+	// Source Size VMs by their provisioned resources or by their p95 utilization plus headroom
+	Source  SizingSource `json:"source"`
+	VmCount int          `json:"vmCount"`
 }
 
+// SizingSource Size VMs by their provisioned resources or by their p95 utilization plus headroom
+type SizingSource string
+
 // StartForecasterRequest defines model for StartForecasterRequest.
 type StartForecasterRequest struct {
 	Concurrency *int                   `json:"concurrency,omitempty"`
@@ -549,6 +1596,24 @@ type StartInspectionRequest struct {
 	VmIds []string `binding:"required,min=1,dive,required" json:"vmIds"`
 }
 
+// UpdateCollectionRequest Fields to change; omitted fields are left unchanged.
+type UpdateCollectionRequest struct {
+	// DisplayName User-given name of the collection. An empty string clears it.
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Notes User notes about the collection. An empty string clears them.
+	Notes *string `json:"notes,omitempty"`
+
+	// Pinned Exempts the collection from retention pruning
+	Pinned *bool `json:"pinned,omitempty"`
+}
+
+// UpdateCollectionScheduleRequest defines model for UpdateCollectionScheduleRequest.
+type UpdateCollectionScheduleRequest struct {
+	// Paused true pauses the schedule, false resumes it. Resuming plans the next run from now.
+	Paused bool `json:"paused"`
+}
+
 // UpdateGroupRequest defines model for UpdateGroupRequest.
 type UpdateGroupRequest struct {
 	Description *string `binding:"omitempty,max=500" json:"description,omitempty"`
@@ -556,8 +1621,27 @@ type UpdateGroupRequest struct {
 	Name        *string `binding:"omitempty,min=1,max=100" json:"name,omitempty"`
 }
 
+// UpdateLabelRequest defines model for UpdateLabelRequest.
+type UpdateLabelRequest struct {
+	Category *string `json:"category,omitempty"`
+
+	// Color Hex color, or empty to clear it
+	Color       *string `json:"color,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// UpdateLabelRuleRequest defines model for UpdateLabelRuleRequest.
+type UpdateLabelRuleRequest struct {
+	Expression *string `json:"expression,omitempty"`
+	Label      *string `json:"label,omitempty"`
+	Priority   *int    `json:"priority,omitempty"`
+}
+
 // UpdateLabelVMsRequest defines model for UpdateLabelVMsRequest.
 type UpdateLabelVMsRequest struct {
+	// Actor Who changes the label, recorded in the label history
+	Actor *string `json:"actor,omitempty"`
+
 	// Add VMs to add the label to
 	Add *[]string `binding:"omitempty,dive,required" json:"add,omitempty"`
 
@@ -565,6 +1649,55 @@ type UpdateLabelVMsRequest struct {
 	Remove *[]string `binding:"omitempty,dive,required" json:"remove,omitempty"`
 }
 
+// UpdateSavedFilterRequest defines model for UpdateSavedFilterRequest.
+type UpdateSavedFilterRequest struct {
+	Description *string `json:"description,omitempty"`
+	Expression  *string `json:"expression,omitempty"`
+	Owner       *string `json:"owner,omitempty"`
+}
+
+// UpdateWaveRequest defines model for UpdateWaveRequest.
+type UpdateWaveRequest struct {
+	Description  *string    `json:"description,omitempty"`
+	ForecastPair *string    `json:"forecastPair,omitempty"`
+	GroupIds     *[]string  `json:"groupIds,omitempty"`
+	Position     *int       `json:"position,omitempty"`
+	VmIds        *[]string  `json:"vmIds,omitempty"`
+	WindowEnd    *time.Time `json:"windowEnd,omitempty"`
+	WindowStart  *time.Time `json:"windowStart,omitempty"`
+}
+
+// VMChange defines model for VMChange.
+type VMChange struct {
+	Changes []VMFieldChange `json:"changes"`
+
+	// Name VM name in collection B
+	Name string `json:"name"`
+
+	// VmId VM identifier
+	VmId string `json:"vmId"`
+}
+
+// VMFieldChange defines model for VMFieldChange.
+type VMFieldChange struct {
+	// After Value in collection B
+	After interface{} `json:"after"`
+
+	// Before Value in collection A
+	Before interface{} `json:"before"`
+
+	// Field The changed field. datastores, nics (as "network (MAC)"), concerns (concern IDs)
+	// and labels are sorted string arrays; the other fields are scalars.
+	Field VMFieldChangeField `json:"field"`
+}
+
+// VMFieldChangeField The changed field. datastores, nics (as "network (MAC)"), concerns (concern IDs)
+// and labels are sorted string arrays; the other fields are scalars.
+type VMFieldChangeField string
+
+// VMFileFormat defines model for VMFileFormat.
+type VMFileFormat string
+
 // VMFilterOptionsResponse defines model for VMFilterOptionsResponse.
 type VMFilterOptionsResponse struct {
 	// Applications Distinct detected application names
@@ -583,6 +1716,36 @@ type VMFilterOptionsResponse struct {
 	Datacenters []string `json:"datacenters"`
 }
 
+// VMImportReport defines model for VMImportReport.
+type VMImportReport struct {
+	Ambiguous []VMImportRow `json:"ambiguous"`
+
+	// Applied Whether the matched rows were applied
+	Applied   bool          `json:"applied"`
+	Matched   []VMImportRow `json:"matched"`
+	Unmatched []VMImportRow `json:"unmatched"`
+}
+
+// VMImportRow defines model for VMImportRow.
+type VMImportRow struct {
+	// Key The MoRef, UUID or name the row refers to its VM by, empty when the row has none
+	Key string `json:"key"`
+
+	// KeyType Column of the key, omitted when the row has none
+	KeyType           *VMImportRowKeyType `json:"keyType,omitempty"`
+	Labels            *[]string           `json:"labels,omitempty"`
+	MigrationExcluded *bool               `json:"migrationExcluded,omitempty"`
+
+	// Row Line of the row in the file, the header being line 1
+	Row int `json:"row"`
+
+	// VmIds The matched VM, or the candidates of an ambiguous row
+	VmIds []string `json:"vmIds"`
+}
+
+// VMImportRowKeyType Column of the key, omitted when the row has none
+type VMImportRowKeyType string
+
 // VMLabelsResponse defines model for VMLabelsResponse.
 type VMLabelsResponse struct {
 	// Counts Number of VMs with each label (same order as labels array)
@@ -592,6 +1755,23 @@ type VMLabelsResponse struct {
 	Labels []string `json:"labels"`
 }
 
+// VMWaste defines model for VMWaste.
+type VMWaste struct {
+	Class   VMWasteClass `json:"class"`
+	Cluster string       `json:"cluster"`
+
+	// Evidence Values the VM was classified by and the thresholds they were held against
+	Evidence            map[string]interface{} `json:"evidence"`
+	Name                string                 `json:"name"`
+	PowerState          string                 `json:"powerState"`
+	ProvisionedCpus     int                    `json:"provisionedCpus"`
+	ProvisionedMemoryMb int64                  `json:"provisionedMemoryMb"`
+	VmId                string                 `json:"vmId"`
+}
+
+// VMWasteClass defines model for VMWaste.Class.
+type VMWasteClass string
+
 // VcenterCredentials defines model for VcenterCredentials.
 type VcenterCredentials struct {
 	// Cacert PEM-encoded CA certificate bundle for verifying the vCenter TLS certificate. Mutually exclusive with skipTls.
@@ -718,6 +1898,9 @@ type VirtualMachineDetail struct {
 	Devices    *[]VirtualMachineDevice `json:"devices,omitempty"`
 	Disks      []VirtualMachineDisk    `json:"disks"`
 
+	// Exclusion Why, by whom and until when a VM was excluded from migration
+	Exclusion *MigrationExclusion `json:"exclusion,omitempty"`
+
 	// FaultToleranceEnabled Whether Fault Tolerance is enabled
 	FaultToleranceEnabled *bool `json:"faultToleranceEnabled,omitempty"`
 
@@ -776,6 +1959,9 @@ type VirtualMachineDetail struct {
 	// Processes List of processes detected on this VM
 	Processes *[]Process `json:"processes,omitempty"`
 
+	// RuleLabels Labels applied by label rules, mapped to the rule that applied them
+	RuleLabels *map[string]string `json:"ruleLabels,omitempty"`
+
 	// StorageUsed Storage consumed in bytes
 	StorageUsed *int64 `json:"storageUsed,omitempty"`
 
@@ -881,6 +2067,12 @@ type VirtualMachineNIC struct {
 
 // VirtualMachineUpdateRequest defines model for VirtualMachineUpdateRequest.
 type VirtualMachineUpdateRequest struct {
+	// Actor Who changes the labels, recorded in the label history
+	Actor *string `json:"actor,omitempty"`
+
+	// Exclusion Details of a migration exclusion. Only accepted along with migrationExcluded set to true.
+	Exclusion *MigrationExclusionRequest `json:"exclusion,omitempty"`
+
 	// Labels User-defined labels (replaces existing labels)
 	Labels *[]string `binding:"omitempty,dive,notblank,min=1,max=100" json:"labels,omitempty"`
 
@@ -890,21 +2082,195 @@ type VirtualMachineUpdateRequest struct {
 
 // VmUtilizationDetails defines model for VmUtilizationDetails.
 type VmUtilizationDetails struct {
-	Confidence          float64 `json:"confidence"`
-	CpuAvg              float64 `json:"cpu_avg"`
-	CpuLatest           float64 `json:"cpu_latest"`
-	CpuMax              float64 `json:"cpu_max"`
-	CpuP95              float64 `json:"cpu_p95"`
-	Disk                float64 `json:"disk"`
-	MemAvg              float64 `json:"mem_avg"`
-	MemLatest           float64 `json:"mem_latest"`
-	MemMax              float64 `json:"mem_max"`
-	MemP95              float64 `json:"mem_p95"`
-	Moid                string  `json:"moid"`
+	Confidence float64 `json:"confidence"`
+	CpuAvg     float64 `json:"cpu_avg"`
+	CpuLatest  float64 `json:"cpu_latest"`
+	CpuMax     float64 `json:"cpu_max"`
+	CpuP95     float64 `json:"cpu_p95"`
+	Disk       float64 `json:"disk"`
+
+	// IopsAvg Average read and write IOPS of all the virtual disks
+	IopsAvg float64 `json:"iops_avg"`
+
+	// IopsMax Peak read and write IOPS of all the virtual disks
+	IopsMax float64 `json:"iops_max"`
+
+	// IopsP95 p95 read and write IOPS of all the virtual disks
+	IopsP95   float64 `json:"iops_p95"`
+	MemAvg    float64 `json:"mem_avg"`
+	MemLatest float64 `json:"mem_latest"`
+	MemMax    float64 `json:"mem_max"`
+	MemP95    float64 `json:"mem_p95"`
+	Moid      string  `json:"moid"`
+
+	// NetAvg Average network throughput, received and transmitted, in KBps
+	NetAvg float64 `json:"net_avg"`
+
+	// NetMax Peak network throughput, received and transmitted, in KBps
+	NetMax float64 `json:"net_max"`
+
+	// NetP95 p95 network throughput, received and transmitted, in KBps
+	NetP95 float64 `json:"net_p95"`
+
+	// NetRxP95 p95 received network throughput, in KBps
+	NetRxP95 float64 `json:"net_rx_p95"`
+
+	// NetTxP95 p95 transmitted network throughput, in KBps
+	NetTxP95            float64 `json:"net_tx_p95"`
 	ProvisionedCpus     int     `json:"provisioned_cpus"`
 	ProvisionedDiskKb   float64 `json:"provisioned_disk_kb"`
 	ProvisionedMemoryMb int     `json:"provisioned_memory_mb"`
-	VmName              string  `json:"vm_name"`
+
+	// ReadLatencyP95 p95 read latency of the slowest virtual disk, in ms
+	ReadLatencyP95 float64 `json:"read_latency_p95"`
+	VmName         string  `json:"vm_name"`
+
+	// WriteLatencyP95 p95 write latency of the slowest virtual disk, in ms
+	WriteLatencyP95 float64 `json:"write_latency_p95"`
+}
+
+// VmUtilizationSeries defines model for VmUtilizationSeries.
+type VmUtilizationSeries struct {
+	// BucketSeconds Width of the buckets the samples are aggregated into
+	BucketSeconds int `json:"bucketSeconds"`
+
+	// IntervalId Seconds between the samples of the report
+	IntervalId  int                       `json:"intervalId"`
+	Metrics     []RightsizingMetricSeries `json:"metrics"`
+	Moid        string                    `json:"moid"`
+	ReportId    string                    `json:"reportId"`
+	WindowEnd   time.Time                 `json:"windowEnd"`
+	WindowStart time.Time                 `json:"windowStart"`
+}
+
+// WasteAnalysis defines model for WasteAnalysis.
+type WasteAnalysis struct {
+	AnalyzedAt time.Time `json:"analyzedAt"`
+
+	// PoweredOffUnknown Powered-off VMs not classified because no collection recorded when they were powered off
+	PoweredOffUnknown int `json:"poweredOffUnknown"`
+
+	// ReportId Rightsizing report the utilization came from, absent without one
+	ReportId   *string             `json:"reportId,omitempty"`
+	Summary    []WasteClassSummary `json:"summary"`
+	Thresholds WasteThresholds     `json:"thresholds"`
+	Vms        []VMWaste           `json:"vms"`
+}
+
+// WasteClassSummary defines model for WasteClassSummary.
+type WasteClassSummary struct {
+	Class WasteClassSummaryClass `json:"class"`
+
+	// ProvisionedCpus vCPUs retiring the VMs of the class frees
+	ProvisionedCpus int `json:"provisionedCpus"`
+
+	// ProvisionedMemoryMb Memory retiring the VMs of the class frees
+	ProvisionedMemoryMb int64 `json:"provisionedMemoryMb"`
+	VmCount             int   `json:"vmCount"`
+}
+
+// WasteClassSummaryClass defines model for WasteClassSummary.Class.
+type WasteClassSummaryClass string
+
+// WasteThresholds defines model for WasteThresholds.
+type WasteThresholds struct {
+	// IdleCpuP95Pct CPU p95 (%) below which a powered-on VM may be idle, from 0 to 100
+	IdleCpuP95Pct float64 `json:"idleCpuP95Pct"`
+
+	// IdleNetP95Kbps Network p95 (KBps) at most which a powered-on VM may be idle
+	IdleNetP95Kbps float64 `json:"idleNetP95Kbps"`
+
+	// OrphanedIopsP95 IOPS p95 at most which a powered-on VM without VMware Tools is orphaned
+	OrphanedIopsP95 float64 `json:"orphanedIopsP95"`
+
+	// OversizedSavedPct Share (%) of vCPUs or memory the default policy must save for a VM to be oversized
+	OversizedSavedPct float64 `json:"oversizedSavedPct"`
+
+	// PoweredOffDays Days since a collection first saw a powered-off VM powered off from which it is classified
+	PoweredOffDays int `json:"poweredOffDays"`
+
+	// UpdatedAt Absent until the thresholds are first updated
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// Wave defines model for Wave.
+type Wave struct {
+	CreatedAt   time.Time `json:"createdAt"`
+	Description *string   `json:"description,omitempty"`
+
+	// ForecastPair Forecaster datastore pair whose throughput estimates the transfer
+	ForecastPair *string  `json:"forecastPair,omitempty"`
+	GroupIds     []string `json:"groupIds"`
+	Name         string   `json:"name"`
+
+	// Position Waves are planned in ascending position
+	Position    int        `json:"position"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+	VmIds       []string   `json:"vmIds"`
+	WindowEnd   *time.Time `json:"windowEnd,omitempty"`
+	WindowStart *time.Time `json:"windowStart,omitempty"`
+}
+
+// WaveIssue defines model for WaveIssue.
+type WaveIssue struct {
+	GroupId *string       `json:"groupId,omitempty"`
+	Kind    WaveIssueKind `json:"kind"`
+	Message string        `json:"message"`
+
+	// OtherWave Another wave planning the VM of a duplicate_vm issue
+	OtherWave *string `json:"otherWave,omitempty"`
+	VmId      *string `json:"vmId,omitempty"`
+}
+
+// WaveIssueKind defines model for WaveIssue.Kind.
+type WaveIssueKind string
+
+// WaveListResponse defines model for WaveListResponse.
+type WaveListResponse struct {
+	Waves []Wave `json:"waves"`
+}
+
+// WavePlan defines model for WavePlan.
+type WavePlan struct {
+	CollectionId string        `json:"collectionId"`
+	Waves        []WaveSummary `json:"waves"`
+}
+
+// WaveReadiness defines model for WaveReadiness.
+type WaveReadiness struct {
+	Blocked  int `json:"blocked"`
+	Excluded int `json:"excluded"`
+	Ready    int `json:"ready"`
+	Review   int `json:"review"`
+}
+
+// WaveSummary defines model for WaveSummary.
+type WaveSummary struct {
+	// DiskMB Total disk of the wave's VMs, excluded VMs aside
+	DiskMB    int64         `json:"diskMB"`
+	Issues    []WaveIssue   `json:"issues"`
+	Readiness WaveReadiness `json:"readiness"`
+
+	// Ready Whether the wave has no issue
+	Ready    bool           `json:"ready"`
+	Transfer *EstimateRange `json:"transfer,omitempty"`
+	VmCount  int            `json:"vmCount"`
+	Wave     Wave           `json:"wave"`
+
+	// WindowCapacityMB Disk the expected throughput transfers within the window
+	WindowCapacityMB *int64 `json:"windowCapacityMB,omitempty"`
+}
+
+// ListCollectionsParams defines parameters for ListCollections.
+type ListCollectionsParams struct {
+	// Vcenter Only list collections of this vCenter (credential profile name)
+	Vcenter *string `form:"vcenter,omitempty" json:"vcenter,omitempty"`
+}
+
+// CompareCollectionsParams defines parameters for CompareCollections.
+type CompareCollectionsParams struct {
+	// ByExpression Only compare the VMs matching this filter expression, evaluated in each collection. Cluster counts are not filtered.
+	ByExpression *string `form:"byExpression,omitempty" json:"byExpression,omitempty"`
 }
 
 // CompareCollectionsDiffParams defines parameters for CompareCollectionsDiff.
@@ -914,11 +2280,32 @@ type CompareCollectionsDiffParams struct {
 
 	// PageSize Number of VM IDs per page per side
 	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// ByExpression Only compare the VMs matching this filter expression, evaluated in each collection.
+	// Applies to the total, migratable, non-migratable and changed dimensions.
+	ByExpression *string `form:"byExpression,omitempty" json:"byExpression,omitempty"`
 }
 
 // CompareCollectionsDiffParamsDimension defines parameters for CompareCollectionsDiff.
 type CompareCollectionsDiffParamsDimension string
 
+// ImportCollectionBundleMultipartBody defines parameters for ImportCollectionBundle.
+type ImportCollectionBundleMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// GetCollectionTrendsParams defines parameters for GetCollectionTrends.
+type GetCollectionTrendsParams struct {
+	// Vcenter Only include collections of this vCenter (credential profile name)
+	Vcenter *string `form:"vcenter,omitempty" json:"vcenter,omitempty"`
+
+	// ByExpression Only count VMs matching this filter expression. Exclusive with groupId.
+	ByExpression *string `form:"byExpression,omitempty" json:"byExpression,omitempty"`
+
+	// GroupId Only count VMs of this group. Exclusive with byExpression.
+	GroupId *string `form:"groupId,omitempty" json:"groupId,omitempty"`
+}
+
 // ExportCollectionParams defines parameters for ExportCollection.
 type ExportCollectionParams struct {
 	// Scope Comma-separated export scopes (e.g., "overview,vms,groups"). Defaults to "overview".
@@ -926,6 +2313,9 @@ type ExportCollectionParams struct {
 
 	// Format Output format: zip (CSV files in a ZIP archive) or xlsx (Excel workbook with one sheet per scope)
 	Format *ExportCollectionParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// ByExpression Only export the VMs matching this filter expression. Applies to the overview, vms, inspection, utilization, recommendations and waste scopes.
+	ByExpression *string `form:"byExpression,omitempty" json:"byExpression,omitempty"`
 }
 
 // ExportCollectionParamsFormat defines parameters for ExportCollection.
@@ -970,6 +2360,32 @@ type ListVirtualMachinesParams struct {
 	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 }
 
+// GetVMUtilizationSeriesParams defines parameters for GetVMUtilizationSeries.
+type GetVMUtilizationSeriesParams struct {
+	// ReportId Rightsizing report ID, the latest completed report by default
+	ReportId *string `form:"reportId,omitempty" json:"reportId,omitempty"`
+
+	// BucketSeconds Width of the buckets the samples are aggregated into, a multiple of the report interval. The report interval by default.
+	BucketSeconds *int `form:"bucketSeconds,omitempty" json:"bucketSeconds,omitempty"`
+
+	// Metric Metric keys to return (e.g. cpu.usage.average), all by default
+	Metric *[]string `form:"metric,omitempty" json:"metric,omitempty"`
+}
+
+// StartCollectorParams defines parameters for StartCollector.
+type StartCollectorParams struct {
+	// Vcenter Credential profile of the vCenter to collect. Defaults to the default profile.
+	Vcenter *string `form:"vcenter,omitempty" json:"vcenter,omitempty"`
+
+	// Mode Collection mode. An incremental collection clones the previous collection of the vCenter
+	// and patches only the VMs changed since it; it falls back to a full collection when no
+	// previous collection of the vCenter was made by this agent process.
+	Mode *StartCollectorParamsMode `form:"mode,omitempty" json:"mode,omitempty"`
+}
+
+// StartCollectorParamsMode defines parameters for StartCollector.
+type StartCollectorParamsMode string
+
 // StartRvtoolsCollectorMultipartBody defines parameters for StartRvtoolsCollector.
 type StartRvtoolsCollectorMultipartBody struct {
 	Files []openapi_types.File `json:"files"`
@@ -1011,6 +2427,27 @@ type GetLatestGroupParams struct {
 	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 }
 
+// GetLatestGroupMTVPlanParams defines parameters for GetLatestGroupMTVPlan.
+type GetLatestGroupMTVPlanParams struct {
+	// Format Output format
+	Format *MTVPlanFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Namespace Namespace of the manifests and of the providers, where MTV is installed
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// TargetNamespace Namespace the VMs are migrated to. Defaults to the namespace of the manifests.
+	TargetNamespace *string `form:"targetNamespace,omitempty" json:"targetNamespace,omitempty"`
+
+	// SourceProvider Name of the vSphere Provider
+	SourceProvider *string `form:"sourceProvider,omitempty" json:"sourceProvider,omitempty"`
+
+	// DestinationProvider Name of the OpenShift Provider
+	DestinationProvider *string `form:"destinationProvider,omitempty" json:"destinationProvider,omitempty"`
+
+	// Vcenter Credential profile name. Reads the group from the latest collection of that vCenter instead of the latest collection overall.
+	Vcenter *string `form:"vcenter,omitempty" json:"vcenter,omitempty"`
+}
+
 // GetInspectorStatusParams defines parameters for GetInspectorStatus.
 type GetInspectorStatusParams struct {
 	// IncludeVddk Include VDDK metadata in the response
@@ -1022,8 +2459,38 @@ type PutInspectorVddkMultipartBody struct {
 	File openapi_types.File `json:"file"`
 }
 
+// GetLatestInventoryParams defines parameters for GetLatestInventory.
+type GetLatestInventoryParams struct {
+	// Vcenter Credential profile name. Returns the inventory of the latest collection of that vCenter instead of the latest collection overall.
+	Vcenter *string `form:"vcenter,omitempty" json:"vcenter,omitempty"`
+}
+
+// ListLatestRecommendationsParams defines parameters for ListLatestRecommendations.
+type ListLatestRecommendationsParams struct {
+	// Policy Policy name, "default" when unset
+	Policy *string `form:"policy,omitempty" json:"policy,omitempty"`
+
+	// GroupId Only recommend the VMs of this group
+	GroupId *openapi_types.UUID `form:"groupId,omitempty" json:"groupId,omitempty"`
+}
+
+// ListLatestClusterRecommendationsParams defines parameters for ListLatestClusterRecommendations.
+type ListLatestClusterRecommendationsParams struct {
+	// Policy Policy name, "default" when unset
+	Policy *string `form:"policy,omitempty" json:"policy,omitempty"`
+}
+
+// GetLatestSizingParams defines parameters for GetLatestSizing.
+type GetLatestSizingParams struct {
+	// Vcenter Credential profile name. Returns the latest simulation of the latest collection of that vCenter instead of the latest collection overall.
+	Vcenter *string `form:"vcenter,omitempty" json:"vcenter,omitempty"`
+}
+
 // ListLatestVirtualMachinesParams defines parameters for ListLatestVirtualMachines.
 type ListLatestVirtualMachinesParams struct {
+	// Vcenter Credential profile name. Lists VMs from the latest collection of that vCenter instead of the latest collection overall.
+	Vcenter *string `form:"vcenter,omitempty" json:"vcenter,omitempty"`
+
 	// ByExpression Filter by expression
 	ByExpression *string `form:"byExpression,omitempty" json:"byExpression,omitempty"`
 
@@ -1037,15 +2504,129 @@ type ListLatestVirtualMachinesParams struct {
 	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 }
 
+// ExportLatestVMExclusionParams defines parameters for ExportLatestVMExclusion.
+type ExportLatestVMExclusionParams struct {
+	// Format Output format
+	Format *VMFileFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ImportLatestVMExclusionMultipartBody defines parameters for ImportLatestVMExclusion.
+type ImportLatestVMExclusionMultipartBody struct {
+	// File CSV or XLSX file; only the first sheet of an XLSX file is read
+	File openapi_types.File `json:"file"`
+}
+
+// ImportLatestVMExclusionParams defines parameters for ImportLatestVMExclusion.
+type ImportLatestVMExclusionParams struct {
+	// DryRun Only report how the rows match, without applying them
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// ExportLatestVMLabelsParams defines parameters for ExportLatestVMLabels.
+type ExportLatestVMLabelsParams struct {
+	// Format Output format
+	Format *VMFileFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ImportLatestVMLabelsMultipartBody defines parameters for ImportLatestVMLabels.
+type ImportLatestVMLabelsMultipartBody struct {
+	// File CSV or XLSX file; only the first sheet of an XLSX file is read
+	File openapi_types.File `json:"file"`
+}
+
+// ImportLatestVMLabelsParams defines parameters for ImportLatestVMLabels.
+type ImportLatestVMLabelsParams struct {
+	// DryRun Only report how the rows match, without applying them
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// Actor Who imports the labels, recorded in the label history
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+}
+
+// DeleteLatestLabelGloballyParams defines parameters for DeleteLatestLabelGlobally.
+type DeleteLatestLabelGloballyParams struct {
+	// Actor Who removes the label, recorded in the label history
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+}
+
+// GetLatestVMRecommendationParams defines parameters for GetLatestVMRecommendation.
+type GetLatestVMRecommendationParams struct {
+	// Policy Policy name, "default" when unset
+	Policy *string `form:"policy,omitempty" json:"policy,omitempty"`
+}
+
+// GetLatestVMUtilizationSeriesParams defines parameters for GetLatestVMUtilizationSeries.
+type GetLatestVMUtilizationSeriesParams struct {
+	// ReportId Rightsizing report ID, the latest completed report by default
+	ReportId *string `form:"reportId,omitempty" json:"reportId,omitempty"`
+
+	// BucketSeconds Width of the buckets the samples are aggregated into, a multiple of the report interval. The report interval by default.
+	BucketSeconds *int `form:"bucketSeconds,omitempty" json:"bucketSeconds,omitempty"`
+
+	// Metric Metric keys to return (e.g. cpu.usage.average), all by default
+	Metric *[]string `form:"metric,omitempty" json:"metric,omitempty"`
+}
+
+// GetLatestWasteAnalysisParams defines parameters for GetLatestWasteAnalysis.
+type GetLatestWasteAnalysisParams struct {
+	// Class Only return the VMs of this class. The summary covers every class.
+	Class *GetLatestWasteAnalysisParamsClass `form:"class,omitempty" json:"class,omitempty"`
+
+	// Vcenter Credential profile name. Returns the analysis of the latest collection of that vCenter instead of the latest collection overall.
+	Vcenter *string `form:"vcenter,omitempty" json:"vcenter,omitempty"`
+}
+
+// GetLatestWasteAnalysisParamsClass defines parameters for GetLatestWasteAnalysis.
+type GetLatestWasteAnalysisParamsClass string
+
+// AnalyzeWasteParams defines parameters for AnalyzeWaste.
+type AnalyzeWasteParams struct {
+	// Vcenter Credential profile name. Analyzes the latest collection of that vCenter instead of the latest collection overall.
+	Vcenter *string `form:"vcenter,omitempty" json:"vcenter,omitempty"`
+}
+
+// GetWavePlanParams defines parameters for GetWavePlan.
+type GetWavePlanParams struct {
+	// Vcenter Credential profile name. Plans the waves against the latest collection of that vCenter instead of the latest collection overall.
+	Vcenter *string `form:"vcenter,omitempty" json:"vcenter,omitempty"`
+}
+
 // SetAgentModeJSONRequestBody defines body for SetAgentMode for application/json ContentType.
 type SetAgentModeJSONRequestBody = AgentModeRequest
 
+// ImportCollectionBundleMultipartRequestBody defines body for ImportCollectionBundle for multipart/form-data ContentType.
+type ImportCollectionBundleMultipartRequestBody ImportCollectionBundleMultipartBody
+
+// CreateCollectionScheduleJSONRequestBody defines body for CreateCollectionSchedule for application/json ContentType.
+type CreateCollectionScheduleJSONRequestBody = CreateCollectionScheduleRequest
+
+// UpdateCollectionScheduleJSONRequestBody defines body for UpdateCollectionSchedule for application/json ContentType.
+type UpdateCollectionScheduleJSONRequestBody = UpdateCollectionScheduleRequest
+
+// UpdateCollectionJSONRequestBody defines body for UpdateCollection for application/json ContentType.
+type UpdateCollectionJSONRequestBody = UpdateCollectionRequest
+
 // StartRvtoolsCollectorMultipartRequestBody defines body for StartRvtoolsCollector for multipart/form-data ContentType.
 type StartRvtoolsCollectorMultipartRequestBody StartRvtoolsCollectorMultipartBody
 
 // PutCredentialsJSONRequestBody defines body for PutCredentials for application/json ContentType.
 type PutCredentialsJSONRequestBody = VcenterCredentials
 
+// PutCredentialProfileJSONRequestBody defines body for PutCredentialProfile for application/json ContentType.
+type PutCredentialProfileJSONRequestBody = VcenterCredentials
+
+// ExplainFilterJSONRequestBody defines body for ExplainFilter for application/json ContentType.
+type ExplainFilterJSONRequestBody = FilterExpressionRequest
+
+// CreateSavedFilterJSONRequestBody defines body for CreateSavedFilter for application/json ContentType.
+type CreateSavedFilterJSONRequestBody = CreateSavedFilterRequest
+
+// UpdateSavedFilterJSONRequestBody defines body for UpdateSavedFilter for application/json ContentType.
+type UpdateSavedFilterJSONRequestBody = UpdateSavedFilterRequest
+
+// ValidateFilterJSONRequestBody defines body for ValidateFilter for application/json ContentType.
+type ValidateFilterJSONRequestBody = FilterExpressionRequest
+
 // StartForecasterJSONRequestBody defines body for StartForecaster for application/json ContentType.
 type StartForecasterJSONRequestBody = StartForecasterRequest
 
@@ -1064,11 +2645,56 @@ type StartInspectionJSONRequestBody = StartInspectionRequest
 // PutInspectorVddkMultipartRequestBody defines body for PutInspectorVddk for multipart/form-data ContentType.
 type PutInspectorVddkMultipartRequestBody PutInspectorVddkMultipartBody
 
+// CreateLabelRuleJSONRequestBody defines body for CreateLabelRule for application/json ContentType.
+type CreateLabelRuleJSONRequestBody = CreateLabelRuleRequest
+
+// UpdateLabelRuleJSONRequestBody defines body for UpdateLabelRule for application/json ContentType.
+type UpdateLabelRuleJSONRequestBody = UpdateLabelRuleRequest
+
+// CreateLabelJSONRequestBody defines body for CreateLabel for application/json ContentType.
+type CreateLabelJSONRequestBody = CreateLabelRequest
+
+// UpdateLabelJSONRequestBody defines body for UpdateLabel for application/json ContentType.
+type UpdateLabelJSONRequestBody = UpdateLabelRequest
+
+// ReplaceMTVMappingsJSONRequestBody defines body for ReplaceMTVMappings for application/json ContentType.
+type ReplaceMTVMappingsJSONRequestBody = MTVMappings
+
+// UpdateRightsizingAccumulationJSONRequestBody defines body for UpdateRightsizingAccumulation for application/json ContentType.
+type UpdateRightsizingAccumulationJSONRequestBody = RightsizingAccumulation
+
+// CreateAccumulatedRightsizingReportJSONRequestBody defines body for CreateAccumulatedRightsizingReport for application/json ContentType.
+type CreateAccumulatedRightsizingReportJSONRequestBody = AccumulatedRightsizingReportRequest
+
+// CreateRightsizingPolicyJSONRequestBody defines body for CreateRightsizingPolicy for application/json ContentType.
+type CreateRightsizingPolicyJSONRequestBody = RightsizingPolicy
+
+// UpdateRightsizingPolicyJSONRequestBody defines body for UpdateRightsizingPolicy for application/json ContentType.
+type UpdateRightsizingPolicyJSONRequestBody = RightsizingPolicy
+
+// RunSizingJSONRequestBody defines body for RunSizing for application/json ContentType.
+type RunSizingJSONRequestBody = SizingRequest
+
 // BatchUpdateLatestVMExclusionJSONRequestBody defines body for BatchUpdateLatestVMExclusion for application/json ContentType.
 type BatchUpdateLatestVMExclusionJSONRequestBody = BatchUpdateExclusionRequest
 
+// ImportLatestVMExclusionMultipartRequestBody defines body for ImportLatestVMExclusion for multipart/form-data ContentType.
+type ImportLatestVMExclusionMultipartRequestBody ImportLatestVMExclusionMultipartBody
+
+// ImportLatestVMLabelsMultipartRequestBody defines body for ImportLatestVMLabels for multipart/form-data ContentType.
+type ImportLatestVMLabelsMultipartRequestBody ImportLatestVMLabelsMultipartBody
+
 // UpdateLatestLabelVMsJSONRequestBody defines body for UpdateLatestLabelVMs for application/json ContentType.
 type UpdateLatestLabelVMsJSONRequestBody = UpdateLabelVMsRequest
 
 // UpdateLatestVirtualMachineJSONRequestBody defines body for UpdateLatestVirtualMachine for application/json ContentType.
 type UpdateLatestVirtualMachineJSONRequestBody = VirtualMachineUpdateRequest
+
+// UpdateWasteThresholdsJSONRequestBody defines body for UpdateWasteThresholds for application/json ContentType.
+type UpdateWasteThresholdsJSONRequestBody = WasteThresholds
+
+// CreateWaveJSONRequestBody defines body for CreateWave for application/json ContentType.
+type CreateWaveJSONRequestBody = CreateWaveRequest
+
+// UpdateWaveJSONRequestBody defines body for UpdateWave for application/json ContentType.
+type UpdateWaveJSONRequestBody = UpdateWaveRequest
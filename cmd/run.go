@@ -352,8 +352,22 @@ func initPool(cfg *config.Configuration) (*store.Pool, error) {
 			continue
 		}
 
-		if err := db.Migrate(context.Background(), func(ctx context.Context, db *sql.DB) error {
-			return migrations.RunCollection(ctx, db, name)
+		if err := db.Migrate(context.Background(), func(ctx context.Context, sqlDb *sql.DB) error {
+			if err := migrations.RunCollection(ctx, sqlDb, name); err != nil {
+				return err
+			}
+			st, err := db.Store()
+			if err != nil {
+				return err
+			}
+			src, err := st.CollectionSource().Get(ctx)
+			if err != nil {
+				return err
+			}
+			db.VCenter = src.VCenter
+			db.Imported = src.Imported
+			db.Signer = src.Signer
+			return nil
 		}); err != nil {
 			zap.S().Errorw("failed to migrate collection database", "db_name", name, "error", err)
 			_ = db.Close()
@@ -366,7 +380,7 @@ func initPool(cfg *config.Configuration) (*store.Pool, error) {
 		}
 
 		pool.Add(db)
-		zap.S().Infow("registered collection database", "name", name, "path", match)
+		zap.S().Infow("registered collection database", "name", name, "path", match, "vcenter", db.VCenter)
 	}
 
 	return pool, nil
@@ -409,6 +423,12 @@ func validateConfiguration(cfg *config.Configuration) error {
 		return fmt.Errorf("invalid server mode %q: must be %q or %q", cfg.Server.ServerMode, config.ServerModeProd, config.ServerModeDev)
 	}
 
+	for _, key := range cfg.Agent.TrustedBundleKeys {
+		if err := services.V2ValidateBundlePublicKey(key); err != nil {
+			return err
+		}
+	}
+
 	// validate flags for rvtools mode
 	// In rvtools mode:
 	// - we don't care about agent-id and source-id.
@@ -476,6 +496,8 @@ func registerAgentFlags(flagSet *pflag.FlagSet, config *config.Configuration) {
 	flagSet.StringVar(&config.Agent.SourceID, "source-id", config.Agent.SourceID, "Source identifier (UUID) for this agent")
 	flagSet.StringVar(&config.Agent.Version, "version", config.Agent.Version, "Agent version to report to console")
 	flagSet.StringVar(&config.Agent.DataFolder, "data-folder", config.Agent.DataFolder, "Path to the persistent data folder")
+	flagSet.IntVar(&config.Agent.RetainCollections, "retain-collections", config.Agent.RetainCollections, "Number of unpinned collections kept per vCenter, 0 keeps all")
+	flagSet.StringSliceVar(&config.Agent.TrustedBundleKeys, "trusted-bundle-keys", config.Agent.TrustedBundleKeys, "Base64 public keys of the agents whose collection bundles can be imported, besides the agent's own")
 	flagSet.BoolVar(&config.Agent.RVToolsMode, "rvtools-mode", config.Agent.RVToolsMode, "RVTool mode: enabled or disabled (default: disable)")
 }
 
@@ -39,7 +39,8 @@ type Agent struct {
 	OpaPoliciesFolder   string        `debugmap:"visible"`
 	UpdateInterval      time.Duration `debugmap:"visible" default:"5s"`
 	LegacyStatusEnabled bool          `debugmap:"visible" default:"true"`
-	RetainCollections   int           `debugmap:"visible" default:"1"`
+	RetainCollections   int           `debugmap:"visible" default:"0"`
+	TrustedBundleKeys   []string      `debugmap:"visible"`
 	RVToolsMode         bool          `debugmap:"visible" default:"false"`
 }
 
@@ -231,6 +231,7 @@ func (a *Agent) ToOption() AgentOption {
 		to.UpdateInterval = a.UpdateInterval
 		to.LegacyStatusEnabled = a.LegacyStatusEnabled
 		to.RetainCollections = a.RetainCollections
+		to.TrustedBundleKeys = a.TrustedBundleKeys
 		to.RVToolsMode = a.RVToolsMode
 	}
 }
@@ -249,6 +250,7 @@ func (a *Agent) DebugMap() map[string]any {
 	debugMap["UpdateInterval"] = helpers.DebugValue(a.UpdateInterval, false)
 	debugMap["LegacyStatusEnabled"] = helpers.DebugValue(a.LegacyStatusEnabled, false)
 	debugMap["RetainCollections"] = helpers.DebugValue(a.RetainCollections, false)
+	debugMap["TrustedBundleKeys"] = helpers.DebugValue(a.TrustedBundleKeys, false)
 	debugMap["RVToolsMode"] = helpers.DebugValue(a.RVToolsMode, false)
 	return debugMap
 }
@@ -346,6 +348,13 @@ func WithRetainCollections(retainCollections int) AgentOption {
 	}
 }
 
+// WithTrustedBundleKeys returns an option that can set TrustedBundleKeys on a Agent
+func WithTrustedBundleKeys(trustedBundleKeys []string) AgentOption {
+	return func(a *Agent) {
+		a.TrustedBundleKeys = trustedBundleKeys
+	}
+}
+
 // WithRVToolsMode returns an option that can set RVToolsMode on a Agent
 func WithRVToolsMode(rVToolsMode bool) AgentOption {
 	return func(a *Agent) {
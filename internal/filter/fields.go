@@ -0,0 +1,206 @@
+package filter
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// Field describes a field of the VM filter DSL. Its column and type are resolved
+// through DefaultMapper, so the catalog only lists names.
+type Field struct {
+	Name    string
+	Aliases []string
+	// Sized is set on fields holding MiB, which accept quantity units (KB, MB, GB, TB).
+	Sized bool
+}
+
+// DefaultFields lists every field DefaultMapper resolves, in the order of its documentation.
+var DefaultFields = []Field{
+	{Name: "id"},
+	{Name: "name"},
+	{Name: "folder_id"},
+	{Name: "folder"},
+	{Name: "host"},
+	{Name: "smbios_uuid"},
+	{Name: "vm_uuid"},
+	{Name: "firmware"},
+	{Name: "powerstate", Aliases: []string{"status"}},
+	{Name: "connection_state"},
+	{Name: "ft_state"},
+	{Name: "os_config"},
+	{Name: "os_tools"},
+	{Name: "dns_name"},
+	{Name: "ip_address"},
+	{Name: "hw_version"},
+	{Name: "resource_pool"},
+	{Name: "datacenter"},
+	{Name: "cluster"},
+	{Name: "vcenter"},
+	{Name: "cpus"},
+	{Name: "memory", Sized: true},
+	{Name: "storage_used", Sized: true},
+	{Name: "total_disk_capacity", Sized: true},
+	{Name: "provisioned", Sized: true},
+	{Name: "issues_count"},
+	{Name: "template"},
+	{Name: "cbt"},
+	{Name: "enable_uuid"},
+	{Name: "migratable"},
+	{Name: "migration_excluded"},
+	{Name: "labels"},
+	{Name: "groups"},
+	{Name: "disk.path"},
+	{Name: "disk.sharing"},
+	{Name: "disk.shared_bus"},
+	{Name: "disk.mode"},
+	{Name: "disk.controller"},
+	{Name: "disk.label"},
+	{Name: "disk.key"},
+	{Name: "disk.capacity", Sized: true},
+	{Name: "disk.raw"},
+	{Name: "disk.thin"},
+	{Name: "concern.label"},
+	{Name: "concern.category"},
+	{Name: "concern.assessment"},
+	{Name: "inspection.status"},
+	{Name: "inspection.error"},
+	{Name: "inspection.completed_at"},
+	{Name: "inspection_concern.label"},
+	{Name: "inspection_concern.category"},
+	{Name: "inspection_concern.msg"},
+	{Name: "cpu.sockets"},
+	{Name: "cpu.cores_per_socket"},
+	{Name: "cpu.hot_add"},
+	{Name: "cpu.hot_remove"},
+	{Name: "mem.ballooned", Sized: true},
+	{Name: "mem.hot_add"},
+	{Name: "net.network"},
+	{Name: "net.mac"},
+	{Name: "net.nic_label"},
+	{Name: "net.adapter"},
+	{Name: "net.switch"},
+	{Name: "net.type"},
+	{Name: "net.ipv4"},
+	{Name: "net.ipv6"},
+	{Name: "net.cluster"},
+	{Name: "net.connected"},
+	{Name: "net.starts_connected"},
+	{Name: "datastore.name"},
+	{Name: "datastore.address"},
+	{Name: "datastore.object_id"},
+	{Name: "datastore.mha"},
+	{Name: "datastore.type"},
+	{Name: "datastore.hosts"},
+	{Name: "datastore.free", Sized: true},
+	{Name: "datastore.capacity", Sized: true},
+	{Name: "utilization.provisioned_cpus"},
+	{Name: "utilization.provisioned_memory", Sized: true},
+	{Name: "utilization.provisioned_disk"},
+	{Name: "utilization.cpu_avg"},
+	{Name: "utilization.cpu_max"},
+	{Name: "utilization.cpu_latest"},
+	{Name: "utilization.mem_avg"},
+	{Name: "utilization.mem_max"},
+	{Name: "utilization.mem_latest"},
+	{Name: "utilization.disk"},
+	{Name: "utilization.confidence"},
+	{Name: "utilization.net_avg"},
+	{Name: "utilization.net_p95"},
+	{Name: "utilization.net_max"},
+	{Name: "utilization.net_rx_p95"},
+	{Name: "utilization.net_tx_p95"},
+	{Name: "utilization.iops_avg"},
+	{Name: "utilization.iops_p95"},
+	{Name: "utilization.iops_max"},
+	{Name: "utilization.read_latency_p95"},
+	{Name: "utilization.write_latency_p95"},
+	{Name: "application.name", Aliases: []string{"application"}},
+	{Name: "application.description"},
+	{Name: "created_at"},
+	{Name: "booted_at"},
+	{Name: "exclusion.reason"},
+	{Name: "exclusion.justification"},
+	{Name: "exclusion.by"},
+	{Name: "exclusion.excluded_at"},
+	{Name: "exclusion.expires_at"},
+	{Name: "snapshot.name"},
+	{Name: "snapshot.created_at"},
+	{Name: "snapshot.age"},
+	{Name: "waste.class"},
+}
+
+// SizeUnits are the quantity units accepted by sized fields.
+var SizeUnits = []string{"KB", "MB", "GB", "TB"}
+
+// DurationUnits are the duration units accepted by age fields.
+var DurationUnits = []string{"s", "m", "h", "d", "w"}
+
+// maxSuggestions is the number of suggestions returned by Suggest.
+const maxSuggestions = 3
+
+// Suggest returns the DefaultFields names closest to an unknown field name, best first.
+func Suggest(name string) []string {
+	var names []string
+	for _, f := range DefaultFields {
+		names = append(names, f.Name)
+		names = append(names, f.Aliases...)
+	}
+	return SuggestFrom(name, names)
+}
+
+// SuggestFrom returns the names closest to an unknown name, best first. A name is suggested
+// when it starts with the unknown name or is within a small edit distance of it.
+func SuggestFrom(name string, names []string) []string {
+	name = strings.ToLower(name)
+	maxDistance := max(2, len(name)/3)
+
+	type candidate struct {
+		name     string
+		distance int
+	}
+	var candidates []candidate
+	for _, n := range names {
+		d := levenshtein(name, n)
+		if strings.HasPrefix(n, name) {
+			d = 0
+		}
+		if d <= maxDistance {
+			candidates = append(candidates, candidate{name: n, distance: d})
+		}
+	}
+
+	slices.SortStableFunc(candidates, func(a, b candidate) int {
+		return cmp.Compare(a.distance, b.distance)
+	})
+
+	suggestions := make([]string, 0, maxSuggestions)
+	for _, c := range candidates {
+		if len(suggestions) == maxSuggestions {
+			break
+		}
+		suggestions = append(suggestions, c.name)
+	}
+	return suggestions
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
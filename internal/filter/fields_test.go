@@ -0,0 +1,68 @@
+package filter
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	pkgfilter "github.com/kubev2v/assisted-migration-agent/pkg/filter"
+)
+
+var _ = Describe("Field catalog", func() {
+	Context("DefaultFields", func() {
+		It("should only list fields known to DefaultMapper", func() {
+			for _, f := range DefaultFields {
+				for _, name := range append([]string{f.Name}, f.Aliases...) {
+					_, _, err := DefaultMapper(name)
+					Expect(err).ToNot(HaveOccurred(), name)
+				}
+			}
+		})
+
+		It("should only mark numeric fields as sized", func() {
+			for _, f := range DefaultFields {
+				if !f.Sized {
+					continue
+				}
+				_, typ, err := DefaultMapper(f.Name)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(typ).To(Equal(pkgfilter.NumericField), f.Name)
+			}
+		})
+
+		It("should have a collection for every dotted field usable in quantifiers", func() {
+			for _, f := range DefaultFields {
+				prefix, _, ok := strings.Cut(f.Name, ".")
+				if !ok || (prefix != "disk" && prefix != "net" && prefix != "concern" && prefix != "snapshot" && prefix != "waste") {
+					continue
+				}
+				_, err := DefaultCollections(prefix)
+				Expect(err).ToNot(HaveOccurred(), f.Name)
+			}
+		})
+	})
+
+	Context("Suggest", func() {
+		It("should suggest fields close to a misspelled name", func() {
+			Expect(Suggest("memroy")).To(ContainElement("memory"))
+			Expect(Suggest("clustr")).To(HaveExactElements("cluster"))
+		})
+
+		It("should rank exact prefixes first", func() {
+			Expect(Suggest("disk.cap")).To(HaveExactElements("disk.capacity", "disk.raw"))
+		})
+
+		It("should be case-insensitive", func() {
+			Expect(Suggest("CLUSTR")).To(ContainElement("cluster"))
+		})
+
+		It("should return at most three suggestions", func() {
+			Expect(len(Suggest("net."))).To(Equal(3))
+		})
+
+		It("should return nothing for unrelated names", func() {
+			Expect(Suggest("zzzzzzzzzz")).To(BeEmpty())
+		})
+	})
+})
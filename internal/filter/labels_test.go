@@ -101,11 +101,11 @@ var _ = Describe("Labels Filter", func() {
 	})
 
 	Context("Parser errors", func() {
-		It("should reject 'not' without 'in' or 'contains'", func() {
+		It("should reject 'not' without 'in', 'contains' or 'between'", func() {
 			input := "labels not"
 			_, err := pkgfilter.Parse([]byte(input), testMapper)
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(ContainSubstring("expected 'in' or 'contains' after 'not'"))
+			Expect(err.Error()).To(ContainSubstring("expected 'in', 'contains' or 'between' after 'not'"))
 		})
 
 		It("should reject contains without string value", func() {
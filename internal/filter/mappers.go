@@ -14,7 +14,7 @@ import (
 )
 
 // DefaultMapper maps VM-related filter field names to SQL column references in the
-// flat filter subquery (see internal/store VMStore). This is the primary mapper for
+// flat filter query VMScope. This is the primary mapper for
 // VM filtering operations.
 //
 // Flat names reference vinfo columns; dotted names reference joined tables:
@@ -27,6 +27,10 @@ import (
 //	cbt, enable_uuid, datacenter, cluster, hw_version, total_disk_capacity,
 //	provisioned, resource_pool, labels, groups
 //
+// collection_source — flat name, same value for every VM of a collection:
+//
+//	vcenter (credential profile the collection was built from)
+//
 // vdisk (dk) — disk.* prefix:
 //
 //	disk.key, disk.path, disk.capacity, disk.sharing, disk.raw,
@@ -38,7 +42,7 @@ import (
 //
 // vm_inspection_status (i) — inspection.* prefix:
 //
-//	inspection.status, inspection.error
+//	inspection.status, inspection.error, inspection.completed_at
 //
 // vm_inspection_concerns (ic) — inspection_concern.* prefix (latest run only in filter subquery):
 //
@@ -68,11 +72,34 @@ import (
 //	utilization.provisioned_cpus, utilization.provisioned_memory, utilization.provisioned_disk,
 //	utilization.cpu_avg, utilization.cpu_max, utilization.cpu_latest,
 //	utilization.mem_avg, utilization.mem_max, utilization.mem_latest,
-//	utilization.disk, utilization.confidence
+//	utilization.disk, utilization.confidence,
+//	utilization.net_avg, utilization.net_p95, utilization.net_max,
+//	utilization.net_rx_p95, utilization.net_tx_p95,
+//	utilization.iops_avg, utilization.iops_p95, utilization.iops_max,
+//	utilization.read_latency_p95, utilization.write_latency_p95
 //
 // vm_applications (va) — application.* prefix:
 //
 //	application, application.name, application.description
+//
+// vm_lifecycle (lc) — flat names, times:
+//
+//	created_at, booted_at
+//
+// vm_migration_exclusions (ex) — exclusion.* prefix, the details of migration_excluded:
+//
+//	exclusion.reason, exclusion.justification, exclusion.by, exclusion.excluded_at,
+//	exclusion.expires_at
+//
+// vm_snapshots (snap) — snapshot.* prefix; snapshot.age is the time elapsed since
+// snapshot.created_at, compared with durations:
+//
+//	snapshot.name, snapshot.created_at, snapshot.age
+//
+// vm_waste (waste) — waste.* prefix, the classes of the latest waste analysis (idle,
+// powered_off, orphaned, oversized):
+//
+//	waste.class
 var DefaultMapper filter.MapFunc = func(name string) (string, filter.FieldType, error) {
 	switch strings.ToLower(name) {
 	// vinfo (v) — string fields
@@ -115,6 +142,10 @@ var DefaultMapper filter.MapFunc = func(name string) (string, filter.FieldType,
 	case "cluster":
 		return `v."Cluster"`, filter.StringField, nil
 
+	// collection_source — string field
+	case "vcenter":
+		return `(SELECT vcenter FROM collection_source)`, filter.StringField, nil
+
 	// vinfo (v) — numeric fields
 	case "cpus":
 		return `v."CPUs"`, filter.NumericField, nil
@@ -182,6 +213,8 @@ var DefaultMapper filter.MapFunc = func(name string) (string, filter.FieldType,
 		return `i.status`, filter.StringField, nil
 	case "inspection.error":
 		return `i.error`, filter.StringField, nil
+	case "inspection.completed_at":
+		return `i.completed_at`, filter.TimeField, nil
 
 	// vm_inspection_concerns (ic) — inspection_concern.* prefix
 	case "inspection_concern.label":
@@ -272,6 +305,26 @@ var DefaultMapper filter.MapFunc = func(name string) (string, filter.FieldType,
 		return "utilization.disk_pct", filter.NumericField, nil
 	case "utilization.confidence":
 		return "utilization.confidence_pct", filter.NumericField, nil
+	case "utilization.net_avg":
+		return "utilization.net_avg_kbps", filter.NumericField, nil
+	case "utilization.net_p95":
+		return "utilization.net_p95_kbps", filter.NumericField, nil
+	case "utilization.net_max":
+		return "utilization.net_max_kbps", filter.NumericField, nil
+	case "utilization.net_rx_p95":
+		return "utilization.net_rx_p95_kbps", filter.NumericField, nil
+	case "utilization.net_tx_p95":
+		return "utilization.net_tx_p95_kbps", filter.NumericField, nil
+	case "utilization.iops_avg":
+		return "utilization.iops_avg", filter.NumericField, nil
+	case "utilization.iops_p95":
+		return "utilization.iops_p95", filter.NumericField, nil
+	case "utilization.iops_max":
+		return "utilization.iops_max", filter.NumericField, nil
+	case "utilization.read_latency_p95":
+		return "utilization.read_latency_p95_ms", filter.NumericField, nil
+	case "utilization.write_latency_p95":
+		return "utilization.write_latency_p95_ms", filter.NumericField, nil
 
 	// vm_applications (va) — application.* prefix
 	case "application", "application.name":
@@ -279,11 +332,67 @@ var DefaultMapper filter.MapFunc = func(name string) (string, filter.FieldType,
 	case "application.description":
 		return `va.app_desc`, filter.StringField, nil
 
+	// vm_lifecycle (lc) — time fields
+	case "created_at":
+		return `lc.created_at`, filter.TimeField, nil
+	case "booted_at":
+		return `lc.booted_at`, filter.TimeField, nil
+
+	// vm_migration_exclusions (ex) — exclusion.* prefix
+	case "exclusion.reason":
+		return `ex.reason`, filter.StringField, nil
+	case "exclusion.justification":
+		return `ex.justification`, filter.StringField, nil
+	case "exclusion.by":
+		return `ex.excluded_by`, filter.StringField, nil
+	case "exclusion.excluded_at":
+		return `ex.excluded_at`, filter.TimeField, nil
+	case "exclusion.expires_at":
+		return `ex.expires_at`, filter.TimeField, nil
+
+	// vm_snapshots (snap) — snapshot.* prefix
+	case "snapshot.name":
+		return `snap.name`, filter.StringField, nil
+	case "snapshot.created_at":
+		return `snap.created_at`, filter.TimeField, nil
+	case "snapshot.age":
+		return `snap.created_at`, filter.AgeField, nil
+
+	// vm_waste (waste) — waste.* prefix
+	case "waste.class":
+		return `waste.class`, filter.StringField, nil
+
 	default:
 		return "", 0, fmt.Errorf("unknown filter field: %s", name)
 	}
 }
 
+// DefaultCollections maps the child collections of a VM to their tables in the flat
+// filter subquery. Quantifiers and aggregates over them compile to subqueries correlated
+// on the VM ID, so that e.g. all(disk.thin = true) holds when every disk of the VM is thin:
+//
+//	disk    — vdisk (dk), fields disk.*
+//	net     — vnetwork (net), fields net.*
+//	concern — concerns (c), fields concern.*
+//	snapshot — vm_snapshots (snap), fields snapshot.*
+//	waste   — vm_waste (waste), fields waste.*
+var DefaultCollections filter.CollectionFunc = func(name string) (filter.Collection, error) {
+	switch strings.ToLower(name) {
+	case "disk":
+		return filter.Collection{From: "vdisk dk", Correlation: `dk."VM ID" = v."VM ID"`}, nil
+	case "net":
+		return filter.Collection{From: "vnetwork net", Correlation: `net."VM ID" = v."VM ID"`}, nil
+	case "concern":
+		return filter.Collection{From: "concerns c", Correlation: `c."VM_ID" = v."VM ID"`}, nil
+	case "snapshot":
+		return filter.Collection{From: "vm_snapshots snap", Correlation: `snap.vm_id = v."VM ID"`}, nil
+	case "waste":
+		return filter.Collection{From: "vm_waste waste", Correlation: `waste.vm_id = v."VM ID"`}, nil
+	default:
+		return filter.Collection{}, fmt.Errorf("unknown filter collection: %s", name)
+	}
+}
+
 // GroupMapper maps group-related filter field names to SQL column references
 // in the groups table.
 //
@@ -355,10 +464,58 @@ var CollectionMapper filter.MapFunc = func(name string) (string, filter.FieldTyp
 	}
 }
 
-// ParseWithDefaultMap parses a filter expression using the DefaultMapper (VM fields).
-// This is a convenience wrapper around filter.Parse() for VM filtering operations.
+// VMScope is the flat query VM filters are evaluated on: vinfo (v) left-joined with every
+// table DefaultMapper refers to, so that a VM spans one row per disk, NIC, concern, etc.
+// internal/store selects the IDs of the matching VMs from it.
+var VMScope = filter.Scope{
+	Key: `v."VM ID"`,
+	From: `vinfo v
+LEFT JOIN vdisk dk ON v."VM ID" = dk."VM ID"
+LEFT JOIN concerns c ON v."VM ID" = c."VM_ID"
+LEFT JOIN vm_inspection_status i ON v."VM ID" = i."VM ID"
+LEFT JOIN vcpu cpu ON v."VM ID" = cpu."VM ID"
+LEFT JOIN vmemory mem ON v."VM ID" = mem."VM ID"
+LEFT JOIN vnetwork net ON v."VM ID" = net."VM ID"
+LEFT JOIN (SELECT "VM_ID", COUNT(*) AS issues_count FROM concerns GROUP BY "VM_ID") cc ON v."VM ID" = cc."VM_ID"
+LEFT JOIN (SELECT "VM_ID", COUNT(*) AS critical_count FROM concerns WHERE "Category" = 'Critical' GROUP BY "VM_ID") crit ON v."VM ID" = crit."VM_ID"
+LEFT JOIN (SELECT "VM ID", SUM("Capacity MiB") AS total_disk FROM vdisk GROUP BY "VM ID") d ON v."VM ID" = d."VM ID"
+LEFT JOIN vdatastore ds ON ds."Name" = regexp_extract(COALESCE(dk."Path", dk."Disk Path"), '\[([^\]]+)\]', 1)
+LEFT JOIN vm_inspection_concerns ic ON v."VM ID" = ic."VM ID" AND ic.inspection_id = (SELECT MAX(inspection_id) FROM vm_inspection_concerns imx WHERE imx."VM ID" = v."VM ID")
+LEFT JOIN (
+	SELECT moid, vm_name,
+	       provisioned_cpus, provisioned_memory_mb, provisioned_disk_kb,
+	       cpu_avg_pct, cpu_p95_pct, cpu_max_pct, cpu_latest_pct,
+	       mem_avg_pct, mem_p95_pct, mem_max_pct, mem_latest_pct,
+	       disk_pct, confidence_pct,
+	       net_avg_kbps, net_p95_kbps, net_max_kbps, net_rx_p95_kbps, net_tx_p95_kbps,
+	       iops_avg, iops_p95, iops_max, read_latency_p95_ms, write_latency_p95_ms
+	FROM rightsizing_vm_utilization
+	WHERE report_id = (
+	      SELECT id FROM rightsizing_reports
+	      WHERE written_batch_count > 0
+	      ORDER BY created_at DESC LIMIT 1
+	)
+) utilization ON v."VM ID" = utilization.moid
+LEFT JOIN vm_applications va ON v."VM ID" = va.vm_id
+LEFT JOIN vm_lifecycle lc ON v."VM ID" = lc.vm_id
+LEFT JOIN vm_migration_exclusions ex ON v."VM ID" = ex.vm_id
+LEFT JOIN vm_snapshots snap ON v."VM ID" = snap.vm_id
+LEFT JOIN vm_waste waste ON v."VM ID" = waste.vm_id
+LEFT JOIN (
+	SELECT u.vm_id, ARRAY_AGG(DISTINCT grp.name) AS groups
+	FROM group_matches gm
+	JOIN groups grp ON gm.group_id = grp.id
+	, UNNEST(gm.vm_ids) AS u(vm_id)
+	GROUP BY u.vm_id
+) g ON v."VM ID" = g.vm_id`,
+	Collections: DefaultCollections,
+}
+
+// ParseWithDefaultMap parses a filter expression using the DefaultMapper (VM fields) and
+// VMScope, whose collections are DefaultCollections (VM disks, NICs, concerns and snapshots).
+// This is a convenience wrapper around filter.ParseWithScope() for VM filtering operations.
 func ParseWithDefaultMap(src []byte) (sq.Sqlizer, error) {
-	return filter.Parse(src, DefaultMapper)
+	return filter.ParseWithScope(src, DefaultMapper, VMScope)
 }
 
 // ParseWithGroupMap parses a filter expression using the GroupMapper (group fields).
@@ -37,6 +37,7 @@ var _ = Describe("VM Mapper Tests", func() {
 			{"enable_uuid", `v."EnableUUID"`},
 			{"datacenter", `v."Datacenter"`},
 			{"cluster", `v."Cluster"`},
+			{"vcenter", `(SELECT vcenter FROM collection_source)`},
 			{"hw_version", `v."HW version"`},
 			{"total_disk_capacity", `d.total_disk`},
 			{"provisioned", `v."Provisioned MiB"`},
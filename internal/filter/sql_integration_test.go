@@ -994,6 +994,97 @@ var _ = Describe("Filter Integration with DuckDB", func() {
 		})
 	})
 
+	// ============================================================
+	// NOT, BETWEEN, IS NULL / IS EMPTY
+	// ============================================================
+
+	Context("NOT operator", func() {
+		It("should negate a grouped expression", func() {
+			names, err := queryVMs("not (active = true or cpus > 1)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names).To(Equal([]string{"vm-legacy", "vm-worker-02"}))
+		})
+
+		It("should match the De Morgan rewrite", func() {
+			negated, err := queryVMs("not (name like 'web' and memory >= 4GB)")
+			Expect(err).ToNot(HaveOccurred())
+			rewritten, err := queryVMs("name not in ['vm-web-01', 'vm-web-02'] or memory < 4GB")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(negated).To(Equal(rewritten))
+			Expect(negated).To(HaveLen(9))
+		})
+
+		It("should keep rows whose negated column is NULL", func() {
+			_, err := db.Exec(`ALTER TABLE vms ADD COLUMN "host" VARCHAR`)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = db.Exec(`UPDATE vms SET "host" = 'esx-1' WHERE "name" LIKE 'vm-web%'`)
+			Expect(err).ToNot(HaveOccurred())
+
+			names, err := queryVMs("not host = 'esx-1'")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names).To(HaveLen(8))
+			Expect(names).ToNot(ContainElements("vm-web-01", "vm-web-02"))
+		})
+	})
+
+	Context("BETWEEN operator", func() {
+		It("should include both bounds", func() {
+			names, err := queryVMs("memory between 4GB and 16GB")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names).To(Equal([]string{"vm-cache-01", "vm-db-02", "vm-test", "vm-web-02"}))
+		})
+
+		It("should exclude the range with NOT BETWEEN", func() {
+			names, err := queryVMs("cpus not between 2 and 8")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names).To(Equal([]string{"vm-analytics", "vm-legacy", "vm-worker-02"}))
+		})
+
+		It("should compare string bounds lexically", func() {
+			names, err := queryVMs("name between 'vm-db' and 'vm-test'")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names).To(Equal([]string{"vm-db-01", "vm-db-02", "vm-legacy", "vm-test"}))
+		})
+	})
+
+	Context("IS NULL and IS EMPTY predicates", func() {
+		BeforeEach(func() {
+			_, err := db.Exec(`ALTER TABLE vms ADD COLUMN "host" VARCHAR`)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = db.Exec(`ALTER TABLE vms ADD COLUMN "labels" VARCHAR[]`)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = db.Exec(`UPDATE vms SET "host" = 'esx-1', "labels" = ['prod'] WHERE "name" LIKE 'vm-db%'`)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = db.Exec(`UPDATE vms SET "labels" = [] WHERE "name" = 'vm-test'`)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should find rows with a NULL column", func() {
+			names, err := queryVMs("host is null")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names).To(HaveLen(8))
+		})
+
+		It("should find rows with a value", func() {
+			names, err := queryVMs("host is not null")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names).To(Equal([]string{"vm-db-01", "vm-db-02"}))
+		})
+
+		It("should treat NULL and empty arrays as empty", func() {
+			names, err := queryVMs("labels is empty")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names).To(HaveLen(8))
+			Expect(names).To(ContainElement("vm-test"))
+		})
+
+		It("should find rows with at least one element", func() {
+			names, err := queryVMs("labels is not empty and memory between 16GB and 32GB")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names).To(Equal([]string{"vm-db-01", "vm-db-02"}))
+		})
+	})
+
 	Context("SQL Injection Prevention - LIKE operator", func() {
 		It("should treat injection in like value as literal substring", func() {
 			names, err := queryVMs("name like '\\'; DROP TABLE vms; --'")
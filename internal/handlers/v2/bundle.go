@@ -0,0 +1,77 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	v2 "github.com/kubev2v/assisted-migration-agent/api/v2"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+const maxBundleSize = 4 << 30
+
+// ExportCollectionBundle streams a collection as a signed bundle.
+// (GET /collections/{id}/bundle)
+func (h *Handler) ExportCollectionBundle(c *gin.Context, id string) {
+	bundle, err := h.svc.BundleService().Export(c.Request.Context(), id)
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "collection not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	defer func() { _ = bundle.Close() }()
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, bundle.Filename))
+	c.Header("Content-Type", "application/gzip")
+	c.Status(http.StatusOK)
+	if err := bundle.Stream(c.Writer); err != nil {
+		// Headers are already sent, the client sees a truncated archive.
+		zap.S().Errorw("failed to stream collection bundle", "collection", id, "error", err)
+	}
+}
+
+// ImportCollectionBundle verifies a bundle and adds its collection to the pool.
+// (POST /collections/import)
+func (h *Handler) ImportCollectionBundle(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxBundleSize)
+	file, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	r, err := file.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	defer func() { _ = r.Close() }()
+
+	db, meta, err := h.svc.BundleService().Import(c.Request.Context(), r)
+	if err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if srvErrors.IsDuplicateResourceError(err) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, v2.NewCollectionFromDatabase(db, meta))
+}
@@ -0,0 +1,137 @@
+package v2_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kubev2v/migration-planner/pkg/duckdb_parser"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/config"
+	handlers "github.com/kubev2v/assisted-migration-agent/internal/handlers/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	svc "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	"github.com/kubev2v/assisted-migration-agent/internal/store/migrations"
+	"github.com/kubev2v/assisted-migration-agent/pkg/crypto"
+)
+
+var _ = Describe("Collection bundle handlers", func() {
+	var (
+		ctx      context.Context
+		tmpDir   string
+		pool     *store.Pool
+		provider *stubServiceProvider
+		router   *gin.Engine
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		gin.SetMode(gin.TestMode)
+
+		var err error
+		tmpDir, err = os.MkdirTemp("", "handler-bundle-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		pool, _ = newMainStore(tmpDir)
+
+		db, err := pool.NewDatabase("col-1000", filepath.Join(tmpDir, "collection_1000.duckdb"), time.Unix(1000, 0), store.EagerConnectionInitilization, 0, store.ReadWriteDatabase)
+		Expect(err).NotTo(HaveOccurred())
+		st, err := db.Store()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(duckdb_parser.New(st.Querier(), nil).Init()).To(Succeed())
+		Expect(db.Migrate(ctx, func(ctx context.Context, sqlDb *sql.DB) error {
+			return migrations.RunCollection(ctx, sqlDb, "collection_1000")
+		})).To(Succeed())
+		Expect(st.CollectionSource().Save(ctx, models.CollectionSource{VCenter: "vc-east", Mode: models.CollectionModeFull})).To(Succeed())
+		pool.Add(db)
+
+		keyMgr, err := crypto.NewKeyManager(tmpDir)
+		Expect(err).NotTo(HaveOccurred())
+		provider = &stubServiceProvider{bundleSvc: svc.NewBundleService(pool, tmpDir, "v1.2.3", "agent-a", keyMgr, nil)}
+		handler := handlers.NewHandler(config.Configuration{}, provider)
+
+		router = gin.New()
+		router.GET("/collections/:id/bundle", func(c *gin.Context) { handler.ExportCollectionBundle(c, c.Param("id")) })
+		router.POST("/collections/import", handler.ImportCollectionBundle)
+	})
+
+	AfterEach(func() {
+		pool.Close()
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+
+	upload := func(path string, content []byte) *httptest.ResponseRecorder {
+		var body bytes.Buffer
+		mw := multipart.NewWriter(&body)
+		part, err := mw.CreateFormFile("file", "collection.bundle.tar.gz")
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		_, err = part.Write(content)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		ExpectWithOffset(1, mw.Close()).To(Succeed())
+
+		req := httptest.NewRequest(http.MethodPost, path, &body)
+		req.Header.Set("Content-Type", mw.FormDataContentType())
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	Context("GET /collections/{id}/bundle", func() {
+		It("streams the bundle as an attachment", func() {
+			w := get("/collections/col-1000/bundle")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Header().Get("Content-Type")).To(Equal("application/gzip"))
+			Expect(w.Header().Get("Content-Disposition")).To(ContainSubstring(`filename="collection_1000.bundle.tar.gz"`))
+			Expect(w.Body.Len()).To(BeNumerically(">", 0))
+		})
+
+		It("returns 404 for an unknown collection", func() {
+			Expect(get("/collections/missing/bundle").Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Context("POST /collections/import", func() {
+		It("returns 400 when no file is uploaded", func() {
+			req := httptest.NewRequest(http.MethodPost, "/collections/import", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("returns 400 for a file that is not a bundle", func() {
+			Expect(upload("/collections/import", []byte("not a bundle")).Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("returns 409 for a collection that already exists", func() {
+			exported := get("/collections/col-1000/bundle")
+			Expect(exported.Code).To(Equal(http.StatusOK))
+
+			Expect(upload("/collections/import", exported.Body.Bytes()).Code).To(Equal(http.StatusConflict))
+		})
+
+		It("is not available in rvtools mode", func() {
+			rvtools := handlers.NewRVToolsHandler(config.Configuration{}, provider)
+			router.POST("/rvtools/collections/import", rvtools.ImportCollectionBundle)
+
+			Expect(upload("/rvtools/collections/import", []byte("not a bundle")).Code).To(Equal(http.StatusNotImplemented))
+		})
+	})
+})
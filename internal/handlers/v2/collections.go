@@ -1,23 +1,128 @@
 package v2
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 
 	v2 "github.com/kubev2v/assisted-migration-agent/api/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	svc "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
 )
 
-// ListCollections returns all collections.
+// ListCollections returns all collections, optionally only those of one vCenter.
 // (GET /collections)
-func (h *Handler) ListCollections(c *gin.Context) {
-	databases := h.svc.CollectionService().List()
+func (h *Handler) ListCollections(c *gin.Context, params v2.ListCollectionsParams) {
+	collectionSrv := h.svc.CollectionService()
+	databases := collectionSrv.List()
+
+	metadata, err := collectionSrv.Metadata(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
 
 	resp := v2.CollectionListResponse{
 		Collections: make([]v2.Collection, 0, len(databases)),
 	}
 	for _, db := range databases {
-		resp.Collections = append(resp.Collections, v2.NewCollectionFromDatabase(db))
+		if params.Vcenter != nil && db.VCenter != *params.Vcenter {
+			continue
+		}
+		resp.Collections = append(resp.Collections, v2.NewCollectionFromDatabase(db, metadata[svc.CollectionDatabaseName(db)]))
 	}
 	c.JSON(http.StatusOK, resp)
 }
+
+// GetCollectionTrends returns the readiness metrics of every collection as a time series.
+// (GET /collections/trends)
+func (h *Handler) GetCollectionTrends(c *gin.Context, params v2.GetCollectionTrendsParams) {
+	var filter models.TrendFilter
+	if params.Vcenter != nil {
+		filter.VCenter = *params.Vcenter
+	}
+	if params.ByExpression != nil && params.GroupId != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "byExpression and groupId are mutually exclusive"})
+		return
+	}
+	if params.ByExpression != nil {
+		expr, err := h.expandFilter(c.Request.Context(), *params.ByExpression)
+		if err != nil {
+			if srvErrors.IsValidationError(err) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("expression filter is invalid: %v", err)})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		filter.Expression = expr
+	}
+	if params.GroupId != nil {
+		filter.GroupID = *params.GroupId
+	}
+
+	points, err := h.svc.CollectionService().Trends(c.Request.Context(), filter)
+	if err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewCollectionTrendsResponse(points))
+}
+
+// UpdateCollection renames, annotates, pins or unpins a collection.
+// (PATCH /collections/{id})
+func (h *Handler) UpdateCollection(c *gin.Context, id string) {
+	var req v2.UpdateCollectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": validationErrorMessage(err)})
+		return
+	}
+
+	upd := v2.NewCollectionMetadataUpdate(req)
+	if upd.IsEmpty() {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "at least one of displayName, notes or pinned is required"})
+		return
+	}
+
+	db, meta, err := h.svc.CollectionService().Update(c.Request.Context(), id, upd)
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewCollectionFromDatabase(db, meta))
+}
+
+// DeleteCollection removes a collection from the pool and deletes its database file.
+// (DELETE /collections/{id})
+func (h *Handler) DeleteCollection(c *gin.Context, id string) {
+	if err := h.svc.CollectionService().Delete(c.Request.Context(), id); err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if srvErrors.IsOperationInProgressError(err) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
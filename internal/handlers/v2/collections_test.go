@@ -0,0 +1,101 @@
+package v2_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v2api "github.com/kubev2v/assisted-migration-agent/api/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/config"
+	handlers "github.com/kubev2v/assisted-migration-agent/internal/handlers/v2"
+	svc "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+)
+
+var _ = Describe("Collection handlers", func() {
+	var (
+		ctx    context.Context
+		tmpDir string
+		pool   *store.Pool
+		mainSt *store.Store2
+		router *gin.Engine
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		gin.SetMode(gin.TestMode)
+
+		var err error
+		tmpDir, err = os.MkdirTemp("", "handler-collections-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		pool, mainSt = newMainStore(tmpDir)
+
+		db, err := pool.NewDatabase("col-1000", filepath.Join(tmpDir, "collection_1000.duckdb"), time.Unix(1000, 0), store.EagerConnectionInitilization, 0, store.ReadWriteDatabase)
+		Expect(err).NotTo(HaveOccurred())
+		pool.Add(db)
+
+		handler := handlers.NewHandler(config.Configuration{}, &stubServiceProvider{collectionSvc: svc.NewCollectionService(pool)})
+
+		router = gin.New()
+		router.PATCH("/collections/:id", func(c *gin.Context) { handler.UpdateCollection(c, c.Param("id")) })
+		router.DELETE("/collections/:id", func(c *gin.Context) { handler.DeleteCollection(c, c.Param("id")) })
+	})
+
+	AfterEach(func() {
+		pool.Close()
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	Context("PATCH /collections/{id}", func() {
+		It("renames and pins the collection", func() {
+			w := serve(http.MethodPatch, "/collections/col-1000", `{"displayName":"before remediation","pinned":true}`)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp v2api.Collection
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Id).To(Equal("col-1000"))
+			Expect(resp.DisplayName).To(HaveValue(Equal("before remediation")))
+			Expect(resp.Pinned).To(BeTrue())
+		})
+
+		It("returns 400 when no field is given", func() {
+			Expect(serve(http.MethodPatch, "/collections/col-1000", `{}`).Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("returns 404 for an unknown collection", func() {
+			Expect(serve(http.MethodPatch, "/collections/missing", `{"pinned":true}`).Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Context("DELETE /collections/{id}", func() {
+		It("deletes the collection", func() {
+			Expect(serve(http.MethodDelete, "/collections/col-1000", "").Code).To(Equal(http.StatusNoContent))
+			Expect(serve(http.MethodDelete, "/collections/col-1000", "").Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("returns 409 while a collection is running", func() {
+			_, err := mainSt.Collection().Create(ctx, "collection_2000")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(serve(http.MethodDelete, "/collections/col-1000", "").Code).To(Equal(http.StatusConflict))
+		})
+	})
+})
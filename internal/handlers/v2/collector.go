@@ -1,24 +1,45 @@
 package v2
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 
 	v2 "github.com/kubev2v/assisted-migration-agent/api/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
 	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
 )
 
-// StartCollector creates and starts a new collector.
+// StartCollector creates and starts a new collector, for the default credential
+// profile unless a vCenter is given, running a full collection unless the mode says otherwise.
 // (POST /collector)
-func (h *Handler) StartCollector(c *gin.Context) {
-	status, err := h.svc.StartCollecting(c.Request.Context())
+func (h *Handler) StartCollector(c *gin.Context, params v2.StartCollectorParams) {
+	vcenter := models.DefaultCredentialProfile
+	if params.Vcenter != nil {
+		vcenter = *params.Vcenter
+	}
+
+	mode := models.CollectionModeFull
+	if params.Mode != nil {
+		mode = models.CollectionMode(*params.Mode)
+	}
+	if !mode.IsValid() {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid mode: must be one of full, incremental"})
+		return
+	}
+
+	status, err := h.svc.StartVCenterCollecting(c.Request.Context(), vcenter, mode)
 	if err != nil {
 		if srvErrors.IsOperationInProgressError(err) {
 			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
 			return
 		}
 		if srvErrors.IsCredentialsNotSetError(err) {
+			if vcenter != models.DefaultCredentialProfile {
+				c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("credentials required: store via PUT /credentials/profiles/%s first", vcenter)})
+				return
+			}
 			c.JSON(http.StatusBadRequest, gin.H{"error": "credentials required: store via PUT /credentials first"})
 			return
 		}
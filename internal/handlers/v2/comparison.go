@@ -1,12 +1,14 @@
 package v2
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 
 	v2 "github.com/kubev2v/assisted-migration-agent/api/v2"
 	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	svc "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
 	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
 )
 
@@ -17,7 +19,7 @@ const (
 
 // CompareCollections returns aggregate stats and diffs for two collections.
 // (GET /collections/{aId}/compare/{bId})
-func (h *Handler) CompareCollections(c *gin.Context, aId string, bId string) {
+func (h *Handler) CompareCollections(c *gin.Context, aId string, bId string, params v2.CompareCollectionsParams) {
 	if aId == bId {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "cannot compare a collection with itself"})
 		return
@@ -32,6 +34,11 @@ func (h *Handler) CompareCollections(c *gin.Context, aId string, bId string) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
+	if params.ByExpression != nil {
+		if !h.filterComparison(c, svc, *params.ByExpression) {
+			return
+		}
+	}
 
 	summary, err := svc.Summary(c.Request.Context())
 	if err != nil {
@@ -42,12 +49,14 @@ func (h *Handler) CompareCollections(c *gin.Context, aId string, bId string) {
 	c.JSON(http.StatusOK, v2.NewCollectionComparisonSummaryFromModel(summary))
 }
 
-// CompareCollectionsDiff returns paginated VM IDs that differ between two collections.
+// CompareCollectionsDiff returns paginated VM IDs that differ between two collections,
+// the VMs whose fields changed for the changed dimension, or the infrastructure entities
+// that moved for the clusters, hosts, datastores and networks dimensions.
 // (GET /collections/{aId}/compare/{bId}/{dimension})
 func (h *Handler) CompareCollectionsDiff(c *gin.Context, aId string, bId string, dimension v2.CompareCollectionsDiffParamsDimension, params v2.CompareCollectionsDiffParams) {
 	dim := models.ComparisonDimension(dimension)
 	if !dim.IsValid() {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dimension: must be one of total, migratable, non-migratable"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dimension: must be one of total, migratable, non-migratable, changed, clusters, hosts, datastores, networks"})
 		return
 	}
 
@@ -65,6 +74,11 @@ func (h *Handler) CompareCollectionsDiff(c *gin.Context, aId string, bId string,
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
+	if params.ByExpression != nil {
+		if !h.filterComparison(c, svc, *params.ByExpression) {
+			return
+		}
+	}
 
 	page := 1
 	if params.Page != nil && *params.Page > 0 {
@@ -83,3 +97,19 @@ func (h *Handler) CompareCollectionsDiff(c *gin.Context, aId string, bId string,
 
 	c.JSON(http.StatusOK, v2.NewCollectionComparisonDiffFromModel(diff))
 }
+
+// filterComparison restricts the compared VMs to those matching expr. It writes the error
+// response and returns false when expr is invalid.
+func (h *Handler) filterComparison(c *gin.Context, comparison *svc.ComparisonService, expr string) bool {
+	expr, err := h.expandFilter(c.Request.Context(), expr)
+	if err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("expression filter is invalid: %v", err)})
+			return false
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return false
+	}
+	comparison.WithExpression(expr)
+	return true
+}
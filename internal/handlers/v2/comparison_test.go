@@ -100,7 +100,7 @@ var _ = Describe("CompareCollections handler", func() {
 		handler = handlers.NewHandler(config.Configuration{}, provider)
 		router = gin.New()
 		router.GET("/collections/compare/:aId/:bId", func(c *gin.Context) {
-			handler.CompareCollections(c, c.Param("aId"), c.Param("bId"))
+			handler.CompareCollections(c, c.Param("aId"), c.Param("bId"), v2api.CompareCollectionsParams{})
 		})
 	}
 
@@ -91,8 +91,10 @@ func capabilityToAPI(c models.OperationCapability) v2.OperationCapability {
 	return result
 }
 
+// DeleteCredentials removes the credentials of the default profile. Named profiles are kept.
 func (h *Handler) DeleteCredentials(c *gin.Context) {
-	if err := h.svc.CredentialsService().DeleteAll(c.Request.Context()); err != nil {
+	err := h.svc.CredentialsService().DeleteProfile(c.Request.Context(), models.DefaultCredentialProfile)
+	if err != nil && !srvErrors.IsResourceNotFoundError(err) {
 		zap.S().Errorw("failed to delete credentials", "error", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
 		return
@@ -100,3 +102,85 @@ func (h *Handler) DeleteCredentials(c *gin.Context) {
 
 	c.Status(http.StatusNoContent)
 }
+
+// ListCredentialProfiles returns the stored credential profiles.
+// (GET /credentials/profiles)
+func (h *Handler) ListCredentialProfiles(c *gin.Context) {
+	profiles, err := h.svc.CredentialsService().ListProfiles(c.Request.Context())
+	if err != nil {
+		zap.S().Errorw("failed to list credential profiles", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+		return
+	}
+
+	resp := v2.CredentialProfileListResponse{
+		Profiles: make([]v2.CredentialProfile, 0, len(profiles)),
+	}
+	for _, p := range profiles {
+		resp.Profiles = append(resp.Profiles, v2.NewCredentialProfileFromModel(p))
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// PutCredentialProfile validates and stores the credentials of a named vCenter profile.
+// (PUT /credentials/profiles/{name})
+func (h *Handler) PutCredentialProfile(c *gin.Context, name string) {
+	var req v2.PutCredentialProfileJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": validationErrorMessage(err)})
+		return
+	}
+
+	creds, err := v2.CredsFromAPI(req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	url, err := h.svc.CredentialsService().StoreProfile(c.Request.Context(), name, creds)
+	if err != nil {
+		if srvErrors.IsVCenterError(err) || srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		zap.S().Errorw("failed to store credential profile", "profile", name, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.CredentialProfile{Name: name, Url: url, Username: creds.Username})
+}
+
+// GetCredentialProfile returns the URL and username of a credential profile.
+// (GET /credentials/profiles/{name})
+func (h *Handler) GetCredentialProfile(c *gin.Context, name string) {
+	profile, err := h.svc.CredentialsService().GetProfile(c.Request.Context(), name)
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		zap.S().Errorw("failed to get credential profile", "profile", name, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewCredentialProfileFromModel(*profile))
+}
+
+// DeleteCredentialProfile removes the credentials of a profile.
+// (DELETE /credentials/profiles/{name})
+func (h *Handler) DeleteCredentialProfile(c *gin.Context, name string) {
+	if err := h.svc.CredentialsService().DeleteProfile(c.Request.Context(), name); err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		zap.S().Errorw("failed to delete credential profile", "profile", name, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
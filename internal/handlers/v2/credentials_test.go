@@ -0,0 +1,143 @@
+package v2_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v2api "github.com/kubev2v/assisted-migration-agent/api/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/config"
+	handlers "github.com/kubev2v/assisted-migration-agent/internal/handlers/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	svc "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	"github.com/kubev2v/assisted-migration-agent/pkg/crypto"
+)
+
+var _ = Describe("Credential profile handlers", func() {
+	var (
+		ctx      context.Context
+		tmpDir   string
+		pool     *store.Pool
+		keyMgr   *crypto.KeyManager
+		credsSvc *svc.CredentialsService
+		router   *gin.Engine
+	)
+
+	// saveProfile stores credentials under a profile without verifying them against a vCenter.
+	saveProfile := func(profile, url string) {
+		recordID := profile
+		if profile == models.DefaultCredentialProfile {
+			recordID = "credentials"
+		}
+		ExpectWithOffset(1, credsSvc.Save(ctx, keyMgr.Key(), recordID, models.Credentials{URL: url, Username: "admin", Password: "pass"})).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		gin.SetMode(gin.TestMode)
+
+		var err error
+		tmpDir, err = os.MkdirTemp("", "handler-credentials-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		var st *store.Store2
+		pool, st = newMainStore(tmpDir)
+
+		keyMgr, err = crypto.NewKeyManager(tmpDir)
+		Expect(err).NotTo(HaveOccurred())
+		credsSvc = svc.NewCredentialsService(st).WithKeyManager(keyMgr)
+
+		handler := handlers.NewHandler(config.Configuration{}, &stubServiceProvider{credentialsSvc: credsSvc})
+
+		router = gin.New()
+		router.DELETE("/credentials", handler.DeleteCredentials)
+		router.GET("/credentials/profiles", handler.ListCredentialProfiles)
+		router.PUT("/credentials/profiles/:name", func(c *gin.Context) { handler.PutCredentialProfile(c, c.Param("name")) })
+		router.GET("/credentials/profiles/:name", func(c *gin.Context) { handler.GetCredentialProfile(c, c.Param("name")) })
+		router.DELETE("/credentials/profiles/:name", func(c *gin.Context) { handler.DeleteCredentialProfile(c, c.Param("name")) })
+	})
+
+	AfterEach(func() {
+		pool.Close()
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	It("lists the stored profiles by name", func() {
+		saveProfile("lab", "https://lab.local/sdk")
+		saveProfile(models.DefaultCredentialProfile, "https://prod.local/sdk")
+
+		w := serve(http.MethodGet, "/credentials/profiles", "")
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+		var resp v2api.CredentialProfileListResponse
+		Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+		Expect(resp.Profiles).To(HaveLen(2))
+		Expect(resp.Profiles[0].Name).To(Equal(models.DefaultCredentialProfile))
+		Expect(resp.Profiles[1].Name).To(Equal("lab"))
+		Expect(resp.Profiles[1].Url).To(Equal("https://lab.local/sdk"))
+	})
+
+	It("returns 400 for an invalid profile name", func() {
+		w := serve(http.MethodPut, "/credentials/profiles/Not_A_Label", `{"url":"https://lab.local/sdk","username":"admin","password":"pass"}`)
+
+		Expect(w.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("returns 400 for a malformed body", func() {
+		w := serve(http.MethodPut, "/credentials/profiles/lab", `{"url":`)
+
+		Expect(w.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("returns 404 for an unknown profile", func() {
+		Expect(serve(http.MethodGet, "/credentials/profiles/lab", "").Code).To(Equal(http.StatusNotFound))
+		Expect(serve(http.MethodDelete, "/credentials/profiles/lab", "").Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("deletes a named profile", func() {
+		saveProfile("lab", "https://lab.local/sdk")
+
+		Expect(serve(http.MethodDelete, "/credentials/profiles/lab", "").Code).To(Equal(http.StatusNoContent))
+		Expect(serve(http.MethodGet, "/credentials/profiles/lab", "").Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("deletes only the default profile on DELETE /credentials", func() {
+		saveProfile(models.DefaultCredentialProfile, "https://prod.local/sdk")
+		saveProfile("lab", "https://lab.local/sdk")
+
+		w := serve(http.MethodDelete, "/credentials", "")
+
+		Expect(w.Code).To(Equal(http.StatusNoContent))
+		Expect(serve(http.MethodGet, "/credentials/profiles/"+models.DefaultCredentialProfile, "").Code).To(Equal(http.StatusNotFound))
+		Expect(serve(http.MethodGet, "/credentials/profiles/lab", "").Code).To(Equal(http.StatusOK))
+	})
+
+	It("returns 204 on DELETE /credentials when no credentials are stored", func() {
+		Expect(serve(http.MethodDelete, "/credentials", "").Code).To(Equal(http.StatusNoContent))
+	})
+
+	It("blocks the profile endpoints in rvtools mode", func() {
+		rvtools := handlers.NewRVToolsHandler(config.Configuration{}, &stubServiceProvider{credentialsSvc: credsSvc})
+		router.GET("/rvtools/credentials/profiles", rvtools.ListCredentialProfiles)
+		router.PUT("/rvtools/credentials/profiles/:name", func(c *gin.Context) { rvtools.PutCredentialProfile(c, c.Param("name")) })
+
+		Expect(serve(http.MethodGet, "/rvtools/credentials/profiles", "").Code).To(Equal(http.StatusNotImplemented))
+		Expect(serve(http.MethodPut, "/rvtools/credentials/profiles/lab", `{}`).Code).To(Equal(http.StatusNotImplemented))
+	})
+})
@@ -50,7 +50,20 @@ func (h *Handler) ExportCollection(c *gin.Context, id string, params v2.ExportCo
 		}
 	}
 
-	if params.Format != nil && *params.Format == v2.Xlsx {
+	if params.ByExpression != nil {
+		expr, err := h.expandFilter(c.Request.Context(), *params.ByExpression)
+		if err != nil {
+			if srvErrors.IsValidationError(err) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("expression filter is invalid: %v", err)})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		exportSvc.WithExpression(expr)
+	}
+
+	if params.Format != nil && *params.Format == v2.ExportCollectionParamsFormatXlsx {
 		var buf bytes.Buffer
 		if err := exportSvc.WriteExcel(c.Request.Context(), scopes, &buf); err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "export generation failed"})
@@ -0,0 +1,81 @@
+package v2
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	v2 "github.com/kubev2v/assisted-migration-agent/api/v2"
+	vmfilter "github.com/kubev2v/assisted-migration-agent/internal/filter"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+	"github.com/kubev2v/assisted-migration-agent/pkg/filter"
+)
+
+// ValidateFilter checks a VM filter expression and reports its issues.
+// (POST /filters/validate)
+func (h *Handler) ValidateFilter(c *gin.Context) {
+	var req v2.FilterExpressionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": validationErrorMessage(err)})
+		return
+	}
+
+	validation, err := h.svc.FilterService().Validate(c.Request.Context(), req.Expression)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewFilterValidationResultFromModel(validation))
+}
+
+// ExplainFilter returns the tree, the SQL and the matched VM count of a VM filter expression.
+// (POST /filters/explain)
+func (h *Handler) ExplainFilter(c *gin.Context) {
+	var req v2.FilterExpressionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": validationErrorMessage(err)})
+		return
+	}
+
+	explanation, err := h.svc.FilterService().Explain(c.Request.Context(), req.Expression)
+	if err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewFilterExplanationFromModel(*explanation))
+}
+
+// GetFilterFields lists the fields of the VM filter DSL.
+// (GET /filters/fields)
+func (h *Handler) GetFilterFields(c *gin.Context) {
+	fields, err := h.svc.FilterService().Fields(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewFilterFieldsResponse(fields))
+}
+
+// expandFilter inlines the saved filters referenced by a VM filter expression and checks
+// the result. Unknown references and invalid expressions are validation errors.
+func (h *Handler) expandFilter(ctx context.Context, expr string) (string, error) {
+	if len(filter.References([]byte(expr))) > 0 {
+		expanded, err := h.svc.SavedFilterService().Expand(ctx, expr)
+		if err != nil {
+			return "", err
+		}
+		expr = expanded
+	}
+	if _, err := vmfilter.ParseWithDefaultMap([]byte(expr)); err != nil {
+		return "", srvErrors.NewValidationError(err.Error())
+	}
+	return expr, nil
+}
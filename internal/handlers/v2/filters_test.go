@@ -0,0 +1,122 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v2api "github.com/kubev2v/assisted-migration-agent/api/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/config"
+	handlers "github.com/kubev2v/assisted-migration-agent/internal/handlers/v2"
+	svc "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+)
+
+var _ = Describe("Filter handlers", func() {
+	var (
+		tmpDir string
+		pool   *store.Pool
+		router *gin.Engine
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+
+		var err error
+		tmpDir, err = os.MkdirTemp("", "handler-filters-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		pool, _ = newMainStore(tmpDir)
+		handler := handlers.NewHandler(config.Configuration{}, &stubServiceProvider{filterSvc: svc.NewFilterService(pool)})
+
+		router = gin.New()
+		router.POST("/filters/validate", handler.ValidateFilter)
+		router.POST("/filters/explain", handler.ExplainFilter)
+		router.GET("/filters/fields", handler.GetFilterFields)
+	})
+
+	AfterEach(func() {
+		pool.Close()
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	Context("POST /filters/validate", func() {
+		It("accepts a valid expression", func() {
+			w := serve(http.MethodPost, "/filters/validate", `{"expression":"memory >= 8GB and count(disk) > 1"}`)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp v2api.FilterValidationResult
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Valid).To(BeTrue())
+			Expect(resp.Issues).To(BeEmpty())
+		})
+
+		It("reports an unknown field with its offset", func() {
+			w := serve(http.MethodPost, "/filters/validate", `{"expression":"cluster = 'a' and memroy > 8GB"}`)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp v2api.FilterValidationResult
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Valid).To(BeFalse())
+			Expect(resp.Issues).To(HaveLen(1))
+			Expect(resp.Issues[0].Kind).To(Equal(v2api.UnknownField))
+			Expect(resp.Issues[0].Offset).To(Equal(18))
+		})
+
+		It("returns 400 for a malformed body", func() {
+			Expect(serve(http.MethodPost, "/filters/validate", `{"expression":`).Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Context("POST /filters/explain", func() {
+		It("returns the tree and the SQL without a matched count when there is no collection", func() {
+			w := serve(http.MethodPost, "/filters/explain", `{"expression":"cluster = 'prod'"}`)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp v2api.FilterExplanation
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Ast.Type).To(Equal("binary"))
+			Expect(resp.Sql).To(ContainSubstring(`v."Cluster"`))
+			Expect(resp.Args).To(ConsistOf("prod"))
+			Expect(resp.MatchedVms).To(BeNil())
+		})
+
+		It("returns 400 for an invalid expression", func() {
+			Expect(serve(http.MethodPost, "/filters/explain", `{"expression":"cluster = "}`).Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("returns 400 for a malformed body", func() {
+			Expect(serve(http.MethodPost, "/filters/explain", `not json`).Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Context("GET /filters/fields", func() {
+		It("lists the fields of the DSL", func() {
+			w := serve(http.MethodGet, "/filters/fields", "")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp v2api.FilterFieldsResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Fields).NotTo(BeEmpty())
+			names := make([]string, 0, len(resp.Fields))
+			for _, f := range resp.Fields {
+				names = append(names, f.Name)
+			}
+			Expect(names).To(ContainElements("cluster", "memory", "disk.capacity"))
+		})
+	})
+})
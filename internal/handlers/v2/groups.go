@@ -10,7 +10,6 @@ import (
 	"github.com/kubev2v/migration-planner/pkg/inventory/converters"
 
 	v2 "github.com/kubev2v/assisted-migration-agent/api/v2"
-	vmfilter "github.com/kubev2v/assisted-migration-agent/internal/filter"
 	"github.com/kubev2v/assisted-migration-agent/internal/models"
 	services "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
 	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
@@ -189,8 +188,12 @@ func (h *Handler) createGroup(c *gin.Context, groupSvc *services.GroupService) {
 		return
 	}
 
-	if _, err := vmfilter.ParseWithDefaultMap([]byte(req.Filter)); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("filter is invalid: %v", err)})
+	if _, err := h.expandFilter(c.Request.Context(), req.Filter); err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("filter is invalid: %v", err)})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
@@ -319,8 +322,12 @@ func (h *Handler) updateGroup(c *gin.Context, groupSvc *services.GroupService, g
 	}
 
 	if req.Filter != nil {
-		if _, err := vmfilter.ParseWithDefaultMap([]byte(*req.Filter)); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("filter is invalid: %v", err)})
+		if _, err := h.expandFilter(c.Request.Context(), *req.Filter); err != nil {
+			if srvErrors.IsValidationError(err) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("filter is invalid: %v", err)})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
 	}
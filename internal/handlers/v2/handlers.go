@@ -11,10 +11,22 @@ import (
 type ServiceProvider interface {
 	ConsoleService() *svc.Console
 	CollectionService() *svc.CollectionService
+	BundleService() *svc.BundleService
 	InspectorService() (*svc.InspectorService, error)
 	VddkService() *svc.VddkService
 	CredentialsService() *svc.CredentialsService
 	ForecasterService() *svc.ForecasterService
+	ScheduleService() *svc.ScheduleService
+	FilterService() *svc.FilterService
+	SavedFilterService() *svc.SavedFilterService
+	LabelRuleService() *svc.LabelRuleService
+	LabelService() *svc.LabelService
+	WaveService() *svc.WaveService
+	MTVService() *svc.MTVService
+	SizingService() *svc.SizingService
+	RecommendationService() *svc.RecommendationService
+	RightsizingAccumulationService() *svc.RightsizingAccumulationService
+	WasteService() *svc.WasteService
 
 	ApplicationService(collectionID string) (*svc.ApplicationService, error)
 	ComparisonService(aId, bId string) (*svc.ComparisonService, error)
@@ -29,8 +41,11 @@ type ServiceProvider interface {
 	LatestInventoryService() (*svc.InventoryService, error)
 	LatestRightsizingService() (*svc.RightsizingService, error)
 
+	VCenterVirtualMachineService(vcenter string) (*svc.VMService, error)
+	VCenterInventoryService(vcenter string) (*svc.InventoryService, error)
+
 	GetCollectorStatus() models.CollectorStatus
-	StartCollecting(ctx context.Context) (models.CollectorStatus, error)
+	StartVCenterCollecting(ctx context.Context, vcenter string, mode models.CollectionMode) (models.CollectorStatus, error)
 	StopCollecting() error
 	StartRVToolsCollecting(rvtoolFiles []string) (models.CollectorStatus, error)
 }
@@ -2,7 +2,9 @@ package v2_test
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/kubev2v/migration-planner/pkg/inventory"
 	. "github.com/onsi/ginkgo/v2"
@@ -10,6 +12,8 @@ import (
 
 	"github.com/kubev2v/assisted-migration-agent/internal/models"
 	svc "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	"github.com/kubev2v/assisted-migration-agent/internal/store/migrations"
 )
 
 func TestHandlersV2(t *testing.T) {
@@ -27,19 +31,57 @@ func (m *mockInventoryBuilder) BuildInventory(_ context.Context, vmIDs []string)
 	return &inventory.Inventory{VCenterID: "test-vcenter", VCenterVersion: "7.0.0"}, nil
 }
 
+// newMainStore returns a pool holding a migrated main database stored in tmpDir, and its store.
+func newMainStore(tmpDir string) (*store.Pool, *store.Store2) {
+	pool := store.NewPool(5 * time.Minute)
+	mainDB, err := pool.NewDatabase(store.MainDatabaseID, filepath.Join(tmpDir, "agent.duckdb"), time.Now(), store.EagerConnectionInitilization, 0, store.ReadWriteDatabase)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	ExpectWithOffset(1, mainDB.Migrate(context.Background(), migrations.RunMain)).To(Succeed())
+	pool.Add(mainDB)
+
+	st, err := mainDB.Store()
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return pool, st
+}
+
 // stubServiceProvider implements handlers.ServiceProvider.
-// All methods return nil/no-error except GroupService and LatestGroupService,
-// which return the pre-built groupSvc field.
+// All methods return nil/no-error except those backed by a pre-built service field,
+// such as GroupService and LatestGroupService returning groupSvc.
 type stubServiceProvider struct {
-	groupSvc *svc.GroupService
+	groupSvc          *svc.GroupService
+	credentialsSvc    *svc.CredentialsService
+	collectionSvc     *svc.CollectionService
+	bundleSvc         *svc.BundleService
+	filterSvc         *svc.FilterService
+	savedFilterSvc    *svc.SavedFilterService
+	labelRuleSvc      *svc.LabelRuleService
+	waveSvc           *svc.WaveService
+	mtvSvc            *svc.MTVService
+	recommendationSvc *svc.RecommendationService
 }
 
 func (s *stubServiceProvider) ConsoleService() *svc.Console                     { return nil }
-func (s *stubServiceProvider) CollectionService() *svc.CollectionService        { return nil }
+func (s *stubServiceProvider) CollectionService() *svc.CollectionService        { return s.collectionSvc }
+func (s *stubServiceProvider) BundleService() *svc.BundleService                { return s.bundleSvc }
 func (s *stubServiceProvider) InspectorService() (*svc.InspectorService, error) { return nil, nil }
 func (s *stubServiceProvider) VddkService() *svc.VddkService                    { return nil }
-func (s *stubServiceProvider) CredentialsService() *svc.CredentialsService      { return nil }
+func (s *stubServiceProvider) CredentialsService() *svc.CredentialsService      { return s.credentialsSvc }
 func (s *stubServiceProvider) ForecasterService() *svc.ForecasterService        { return nil }
+func (s *stubServiceProvider) ScheduleService() *svc.ScheduleService            { return nil }
+func (s *stubServiceProvider) FilterService() *svc.FilterService                { return s.filterSvc }
+func (s *stubServiceProvider) SavedFilterService() *svc.SavedFilterService      { return s.savedFilterSvc }
+func (s *stubServiceProvider) LabelRuleService() *svc.LabelRuleService          { return s.labelRuleSvc }
+func (s *stubServiceProvider) LabelService() *svc.LabelService                  { return nil }
+func (s *stubServiceProvider) WaveService() *svc.WaveService                    { return s.waveSvc }
+func (s *stubServiceProvider) MTVService() *svc.MTVService                      { return s.mtvSvc }
+func (s *stubServiceProvider) SizingService() *svc.SizingService                { return nil }
+func (s *stubServiceProvider) RecommendationService() *svc.RecommendationService {
+	return s.recommendationSvc
+}
+func (s *stubServiceProvider) RightsizingAccumulationService() *svc.RightsizingAccumulationService {
+	return nil
+}
+func (s *stubServiceProvider) WasteService() *svc.WasteService { return nil }
 func (s *stubServiceProvider) ApplicationService(_ string) (*svc.ApplicationService, error) {
 	return nil, nil
 }
@@ -67,10 +109,16 @@ func (s *stubServiceProvider) LatestInventoryService() (*svc.InventoryService, e
 func (s *stubServiceProvider) LatestRightsizingService() (*svc.RightsizingService, error) {
 	return nil, nil
 }
+func (s *stubServiceProvider) VCenterVirtualMachineService(_ string) (*svc.VMService, error) {
+	return nil, nil
+}
+func (s *stubServiceProvider) VCenterInventoryService(_ string) (*svc.InventoryService, error) {
+	return nil, nil
+}
 func (s *stubServiceProvider) GetCollectorStatus() models.CollectorStatus {
 	return models.CollectorStatus{State: models.CollectorStateReady}
 }
-func (s *stubServiceProvider) StartCollecting(_ context.Context) (models.CollectorStatus, error) {
+func (s *stubServiceProvider) StartVCenterCollecting(_ context.Context, _ string, _ models.CollectionMode) (models.CollectorStatus, error) {
 	return models.CollectorStatus{State: models.CollectorStateReady}, nil
 }
 func (s *stubServiceProvider) StopCollecting() error { return nil }
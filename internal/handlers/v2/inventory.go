@@ -32,10 +32,19 @@ func (h *Handler) GetInventory(c *gin.Context, id string) {
 	h.getInventory(c, invSvc)
 }
 
-// GetLatestInventory returns the inventory from the latest collection.
+// GetLatestInventory returns the inventory from the latest collection, or from the
+// latest collection of a vCenter when one is given.
 // (GET /inventory)
-func (h *Handler) GetLatestInventory(c *gin.Context) {
-	invSvc, err := h.svc.LatestInventoryService()
+func (h *Handler) GetLatestInventory(c *gin.Context, params v2api.GetLatestInventoryParams) {
+	var (
+		invSvc *services.InventoryService
+		err    error
+	)
+	if params.Vcenter != nil {
+		invSvc, err = h.svc.VCenterInventoryService(*params.Vcenter)
+	} else {
+		invSvc, err = h.svc.LatestInventoryService()
+	}
 	if err != nil {
 		if srvErrors.IsResourceNotFoundError(err) {
 			c.JSON(http.StatusNotFound, gin.H{"error": "no collections found"})
@@ -102,7 +102,9 @@ var _ = Describe("Inventory handler", func() {
 		handler := handlers.NewHandler(*cfg, mgr)
 
 		router := gin.New()
-		router.GET("/api/v2/inventory", handler.GetLatestInventory)
+		router.GET("/api/v2/inventory", func(c *gin.Context) {
+			handler.GetLatestInventory(c, v2api.GetLatestInventoryParams{})
+		})
 
 		req := httptest.NewRequest(http.MethodGet, "/api/v2/inventory", nil)
 		w := httptest.NewRecorder()
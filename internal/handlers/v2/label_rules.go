@@ -0,0 +1,111 @@
+package v2
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	v2 "github.com/kubev2v/assisted-migration-agent/api/v2"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+// ListLabelRules returns all label rules.
+// (GET /label-rules)
+func (h *Handler) ListLabelRules(c *gin.Context) {
+	rules, err := h.svc.LabelRuleService().List(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp := v2.LabelRuleListResponse{
+		Rules: make([]v2.LabelRule, 0, len(rules)),
+	}
+	for _, r := range rules {
+		resp.Rules = append(resp.Rules, v2.NewLabelRuleFromModel(r))
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// CreateLabelRule creates a label rule and applies the rules to the latest collection.
+// (POST /label-rules)
+func (h *Handler) CreateLabelRule(c *gin.Context) {
+	var req v2.CreateLabelRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": validationErrorMessage(err)})
+		return
+	}
+
+	created, err := h.svc.LabelRuleService().Create(c.Request.Context(), v2.NewLabelRuleFromAPI(req))
+	if err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if srvErrors.IsDuplicateResourceError(err) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, v2.NewLabelRuleFromModel(*created))
+}
+
+// GetLabelRule returns a label rule by name.
+// (GET /label-rules/{name})
+func (h *Handler) GetLabelRule(c *gin.Context, name string) {
+	r, err := h.svc.LabelRuleService().Get(c.Request.Context(), name)
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewLabelRuleFromModel(*r))
+}
+
+// UpdateLabelRule updates a label rule and applies the rules to the latest collection.
+// (PATCH /label-rules/{name})
+func (h *Handler) UpdateLabelRule(c *gin.Context, name string) {
+	var req v2.UpdateLabelRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": validationErrorMessage(err)})
+		return
+	}
+
+	r, err := h.svc.LabelRuleService().Update(c.Request.Context(), name, v2.NewLabelRuleUpdateFromAPI(req))
+	if err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewLabelRuleFromModel(*r))
+}
+
+// DeleteLabelRule deletes a label rule and removes the labels it applied from the latest collection.
+// (DELETE /label-rules/{name})
+func (h *Handler) DeleteLabelRule(c *gin.Context, name string) {
+	if err := h.svc.LabelRuleService().Delete(c.Request.Context(), name); err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,111 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v2api "github.com/kubev2v/assisted-migration-agent/api/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/config"
+	handlers "github.com/kubev2v/assisted-migration-agent/internal/handlers/v2"
+	svc "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+)
+
+var _ = Describe("Label rule handlers", func() {
+	var (
+		tmpDir string
+		pool   *store.Pool
+		router *gin.Engine
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+
+		var err error
+		tmpDir, err = os.MkdirTemp("", "handler-label-rules-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		pool, _ = newMainStore(tmpDir)
+
+		savedFilters := svc.NewSavedFilterService(pool)
+		handler := handlers.NewHandler(config.Configuration{}, &stubServiceProvider{labelRuleSvc: svc.NewLabelRuleService(pool, savedFilters)})
+
+		router = gin.New()
+		router.GET("/label-rules", handler.ListLabelRules)
+		router.POST("/label-rules", handler.CreateLabelRule)
+		router.GET("/label-rules/:name", func(c *gin.Context) { handler.GetLabelRule(c, c.Param("name")) })
+		router.PATCH("/label-rules/:name", func(c *gin.Context) { handler.UpdateLabelRule(c, c.Param("name")) })
+		router.DELETE("/label-rules/:name", func(c *gin.Context) { handler.DeleteLabelRule(c, c.Param("name")) })
+	})
+
+	AfterEach(func() {
+		pool.Close()
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	It("lists the rules, highest priority first", func() {
+		Expect(serve(http.MethodPost, "/label-rules", `{"name":"prod","label":"production","expression":"cluster = 'prod'"}`).Code).To(Equal(http.StatusCreated))
+		Expect(serve(http.MethodPost, "/label-rules", `{"name":"on","label":"running","expression":"powerstate = 'poweredOn'","priority":5}`).Code).To(Equal(http.StatusCreated))
+
+		w := serve(http.MethodGet, "/label-rules", "")
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+		var resp v2api.LabelRuleListResponse
+		Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+		Expect(resp.Rules).To(HaveLen(2))
+		Expect(resp.Rules[0].Name).To(Equal("on"))
+		Expect(resp.Rules[1].Name).To(Equal("prod"))
+	})
+
+	It("updates a rule", func() {
+		Expect(serve(http.MethodPost, "/label-rules", `{"name":"prod","label":"production","expression":"cluster = 'prod'"}`).Code).To(Equal(http.StatusCreated))
+
+		w := serve(http.MethodPatch, "/label-rules/prod", `{"label":"prod-vm"}`)
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+		var resp v2api.LabelRule
+		Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+		Expect(resp.Label).To(Equal("prod-vm"))
+		Expect(resp.Expression).To(Equal("cluster = 'prod'"))
+	})
+
+	It("returns 400 for an invalid rule", func() {
+		Expect(serve(http.MethodPost, "/label-rules", `{"name":"bad name","label":"prod","expression":"cluster = 'prod'"}`).Code).To(Equal(http.StatusBadRequest))
+		Expect(serve(http.MethodPost, "/label-rules", `{"name":"prod","label":"prod","expression":"memroy > 8GB"}`).Code).To(Equal(http.StatusBadRequest))
+		Expect(serve(http.MethodPost, "/label-rules", `{"name":`).Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("returns 409 for a duplicate name", func() {
+		Expect(serve(http.MethodPost, "/label-rules", `{"name":"prod","label":"production","expression":"cluster = 'prod'"}`).Code).To(Equal(http.StatusCreated))
+
+		Expect(serve(http.MethodPost, "/label-rules", `{"name":"prod","label":"other","expression":"cluster = 'dev'"}`).Code).To(Equal(http.StatusConflict))
+	})
+
+	It("returns 404 for an unknown rule", func() {
+		Expect(serve(http.MethodGet, "/label-rules/missing", "").Code).To(Equal(http.StatusNotFound))
+		Expect(serve(http.MethodPatch, "/label-rules/missing", `{"label":"x"}`).Code).To(Equal(http.StatusNotFound))
+		Expect(serve(http.MethodDelete, "/label-rules/missing", "").Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("deletes a rule", func() {
+		Expect(serve(http.MethodPost, "/label-rules", `{"name":"prod","label":"production","expression":"cluster = 'prod'"}`).Code).To(Equal(http.StatusCreated))
+
+		Expect(serve(http.MethodDelete, "/label-rules/prod", "").Code).To(Equal(http.StatusNoContent))
+		Expect(serve(http.MethodGet, "/label-rules/prod", "").Code).To(Equal(http.StatusNotFound))
+	})
+})
@@ -0,0 +1,111 @@
+package v2
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	v2 "github.com/kubev2v/assisted-migration-agent/api/v2"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+// ListLabels returns all label definitions.
+// (GET /labels)
+func (h *Handler) ListLabels(c *gin.Context) {
+	labels, err := h.svc.LabelService().List(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp := v2.LabelListResponse{
+		Labels: make([]v2.Label, 0, len(labels)),
+	}
+	for _, l := range labels {
+		resp.Labels = append(resp.Labels, v2.NewLabelFromModel(l))
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// CreateLabel creates a label definition.
+// (POST /labels)
+func (h *Handler) CreateLabel(c *gin.Context) {
+	var req v2.CreateLabelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": validationErrorMessage(err)})
+		return
+	}
+
+	created, err := h.svc.LabelService().Create(c.Request.Context(), v2.NewLabelFromAPI(req))
+	if err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if srvErrors.IsDuplicateResourceError(err) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, v2.NewLabelFromModel(*created))
+}
+
+// GetLabel returns a label definition by name.
+// (GET /labels/{name})
+func (h *Handler) GetLabel(c *gin.Context, name string) {
+	l, err := h.svc.LabelService().Get(c.Request.Context(), name)
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewLabelFromModel(*l))
+}
+
+// UpdateLabel updates the color, description or category of a label.
+// (PATCH /labels/{name})
+func (h *Handler) UpdateLabel(c *gin.Context, name string) {
+	var req v2.UpdateLabelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": validationErrorMessage(err)})
+		return
+	}
+
+	l, err := h.svc.LabelService().Update(c.Request.Context(), name, v2.NewLabelUpdateFromAPI(req))
+	if err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewLabelFromModel(*l))
+}
+
+// DeleteLabel deletes a label definition, leaving the label on its VMs.
+// (DELETE /labels/{name})
+func (h *Handler) DeleteLabel(c *gin.Context, name string) {
+	if err := h.svc.LabelService().Delete(c.Request.Context(), name); err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,95 @@
+package v2
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	v2 "github.com/kubev2v/assisted-migration-agent/api/v2"
+	services "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+// GetLatestGroupMTVPlan generates the Forklift/MTV manifests migrating the VMs of a group of the latest collection.
+// (GET /groups/{groupId}/mtv-plan)
+func (h *Handler) GetLatestGroupMTVPlan(c *gin.Context, groupId string, params v2.GetLatestGroupMTVPlanParams) {
+	gid, err := uuid.Parse(groupId)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid group ID"})
+		return
+	}
+
+	format := services.MTVPlanYAML
+	if params.Format != nil {
+		format = string(*params.Format)
+	}
+
+	mtvSvc := h.svc.MTVService()
+	plan, err := mtvSvc.GeneratePlan(c.Request.Context(), gid, v2.NewMTVPlanOptionsFromAPI(params))
+	if err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := mtvSvc.WritePlan(plan, format, &buf); err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	contentType := "application/yaml"
+	if format == services.MTVPlanZip {
+		contentType = "application/zip"
+	}
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s-mtv-plan.%s"`, plan.Name, format))
+	c.Data(http.StatusOK, contentType, buf.Bytes())
+}
+
+// GetMTVMappings returns the network and datastore mapping tables.
+// (GET /mtv/mappings)
+func (h *Handler) GetMTVMappings(c *gin.Context) {
+	mappings, err := h.svc.MTVService().GetMappings(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewMTVMappingsFromModel(*mappings))
+}
+
+// ReplaceMTVMappings replaces the network and datastore mapping tables.
+// (PUT /mtv/mappings)
+func (h *Handler) ReplaceMTVMappings(c *gin.Context) {
+	var req v2.MTVMappings
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": validationErrorMessage(err)})
+		return
+	}
+
+	mappings, err := h.svc.MTVService().SetMappings(c.Request.Context(), v2.NewMTVMappingsFromAPI(req))
+	if err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewMTVMappingsFromModel(*mappings))
+}
@@ -0,0 +1,93 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v2api "github.com/kubev2v/assisted-migration-agent/api/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/config"
+	handlers "github.com/kubev2v/assisted-migration-agent/internal/handlers/v2"
+	svc "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+)
+
+var _ = Describe("MTV handlers", func() {
+	var (
+		tmpDir string
+		pool   *store.Pool
+		router *gin.Engine
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+
+		var err error
+		tmpDir, err = os.MkdirTemp("", "handler-mtv-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		pool, _ = newMainStore(tmpDir)
+
+		handler := handlers.NewHandler(config.Configuration{}, &stubServiceProvider{mtvSvc: svc.NewMTVService(pool)})
+
+		router = gin.New()
+		router.GET("/mtv/mappings", handler.GetMTVMappings)
+		router.PUT("/mtv/mappings", handler.ReplaceMTVMappings)
+		router.GET("/groups/:id/mtv-plan", func(c *gin.Context) {
+			var params v2api.GetLatestGroupMTVPlanParams
+			if ns := c.Query("namespace"); ns != "" {
+				params.Namespace = &ns
+			}
+			handler.GetLatestGroupMTVPlan(c, c.Param("id"), params)
+		})
+	})
+
+	AfterEach(func() {
+		pool.Close()
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	It("replaces and returns the mappings", func() {
+		w := serve(http.MethodPut, "/mtv/mappings", `{"networks":[{"source":"VM Network","type":"pod"}],"storage":[{"source":"datastore1","storageClass":"standard"}]}`)
+		Expect(w.Code).To(Equal(http.StatusOK))
+
+		w = serve(http.MethodGet, "/mtv/mappings", "")
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+		var resp v2api.MTVMappings
+		Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+		Expect(resp.Networks).To(HaveLen(1))
+		Expect(resp.Networks[0].Source).To(Equal("VM Network"))
+		Expect(resp.Storage).To(HaveLen(1))
+		Expect(resp.Storage[0].StorageClass).To(Equal("standard"))
+	})
+
+	It("returns 400 for invalid mappings", func() {
+		Expect(serve(http.MethodPut, "/mtv/mappings", `{"networks":[{"source":"VM Network","type":"bridge"}],"storage":[]}`).Code).To(Equal(http.StatusBadRequest))
+		Expect(serve(http.MethodPut, "/mtv/mappings", `{"networks":`).Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("returns 400 for an invalid group ID or option", func() {
+		Expect(serve(http.MethodGet, "/groups/not-a-uuid/mtv-plan", "").Code).To(Equal(http.StatusBadRequest))
+		Expect(serve(http.MethodGet, "/groups/"+uuid.NewString()+"/mtv-plan?namespace=Not_Valid", "").Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("returns 404 without any collection", func() {
+		Expect(serve(http.MethodGet, "/groups/"+uuid.NewString()+"/mtv-plan", "").Code).To(Equal(http.StatusNotFound))
+	})
+})
@@ -0,0 +1,174 @@
+package v2
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	v2 "github.com/kubev2v/assisted-migration-agent/api/v2"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+// ListRightsizingPolicies returns all rightsizing policies.
+// (GET /rightsizing/policies)
+func (h *Handler) ListRightsizingPolicies(c *gin.Context) {
+	policies, err := h.svc.RecommendationService().ListPolicies(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp := make([]v2.RightsizingPolicy, 0, len(policies))
+	for _, p := range policies {
+		resp = append(resp, v2.NewRightsizingPolicyFromModel(p))
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// CreateRightsizingPolicy creates a rightsizing policy.
+// (POST /rightsizing/policies)
+func (h *Handler) CreateRightsizingPolicy(c *gin.Context) {
+	var req v2.RightsizingPolicy
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": validationErrorMessage(err)})
+		return
+	}
+
+	created, err := h.svc.RecommendationService().CreatePolicy(c.Request.Context(), v2.NewRightsizingPolicyFromAPI(req))
+	if err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if srvErrors.IsDuplicateResourceError(err) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, v2.NewRightsizingPolicyFromModel(*created))
+}
+
+// GetRightsizingPolicy returns a rightsizing policy by name.
+// (GET /rightsizing/policies/{name})
+func (h *Handler) GetRightsizingPolicy(c *gin.Context, name string) {
+	p, err := h.svc.RecommendationService().GetPolicy(c.Request.Context(), name)
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewRightsizingPolicyFromModel(*p))
+}
+
+// UpdateRightsizingPolicy replaces a rightsizing policy. The name in the path wins over the body.
+// (PUT /rightsizing/policies/{name})
+func (h *Handler) UpdateRightsizingPolicy(c *gin.Context, name string) {
+	var req v2.RightsizingPolicy
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": validationErrorMessage(err)})
+		return
+	}
+
+	p := v2.NewRightsizingPolicyFromAPI(req)
+	p.Name = name
+	updated, err := h.svc.RecommendationService().UpdatePolicy(c.Request.Context(), p)
+	if err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewRightsizingPolicyFromModel(*updated))
+}
+
+// DeleteRightsizingPolicy deletes a rightsizing policy.
+// (DELETE /rightsizing/policies/{name})
+func (h *Handler) DeleteRightsizingPolicy(c *gin.Context, name string) {
+	if err := h.svc.RecommendationService().DeletePolicy(c.Request.Context(), name); err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListLatestRecommendations recommends the size of the VMs of the latest rightsizing report.
+// (GET /rightsizing/recommendations)
+func (h *Handler) ListLatestRecommendations(c *gin.Context, params v2.ListLatestRecommendationsParams) {
+	var policy string
+	if params.Policy != nil {
+		policy = *params.Policy
+	}
+
+	recs, err := h.svc.RecommendationService().ListRecommendations(c.Request.Context(), policy, params.GroupId)
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewRightsizingRecommendationsFromModel(*recs))
+}
+
+// ListLatestClusterRecommendations totals the VM recommendations of each cluster.
+// (GET /rightsizing/recommendations/clusters)
+func (h *Handler) ListLatestClusterRecommendations(c *gin.Context, params v2.ListLatestClusterRecommendationsParams) {
+	var policy string
+	if params.Policy != nil {
+		policy = *params.Policy
+	}
+
+	reportID, p, clusters, err := h.svc.RecommendationService().ListClusterRecommendations(c.Request.Context(), policy)
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewRightsizingClusterRecommendationsListFromModel(reportID, *p, clusters))
+}
+
+// GetLatestVMRecommendation recommends the size of a VM from the latest rightsizing report.
+// (GET /virtualmachines/{vmId}/recommendation)
+func (h *Handler) GetLatestVMRecommendation(c *gin.Context, vmId string, params v2.GetLatestVMRecommendationParams) {
+	var policy string
+	if params.Policy != nil {
+		policy = *params.Policy
+	}
+
+	rec, err := h.svc.RecommendationService().GetVMRecommendation(c.Request.Context(), vmId, policy)
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewRightsizingRecommendationFromModel(*rec))
+}
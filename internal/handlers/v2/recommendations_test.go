@@ -0,0 +1,111 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v2api "github.com/kubev2v/assisted-migration-agent/api/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/config"
+	handlers "github.com/kubev2v/assisted-migration-agent/internal/handlers/v2"
+	svc "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+)
+
+var _ = Describe("Rightsizing recommendation handlers", func() {
+	var (
+		tmpDir string
+		pool   *store.Pool
+		router *gin.Engine
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+
+		var err error
+		tmpDir, err = os.MkdirTemp("", "handler-recommendations-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		pool, _ = newMainStore(tmpDir)
+
+		handler := handlers.NewHandler(config.Configuration{}, &stubServiceProvider{recommendationSvc: svc.NewRecommendationService(pool)})
+
+		router = gin.New()
+		router.GET("/rightsizing/policies", handler.ListRightsizingPolicies)
+		router.POST("/rightsizing/policies", handler.CreateRightsizingPolicy)
+		router.GET("/rightsizing/policies/:name", func(c *gin.Context) { handler.GetRightsizingPolicy(c, c.Param("name")) })
+		router.PUT("/rightsizing/policies/:name", func(c *gin.Context) { handler.UpdateRightsizingPolicy(c, c.Param("name")) })
+		router.DELETE("/rightsizing/policies/:name", func(c *gin.Context) { handler.DeleteRightsizingPolicy(c, c.Param("name")) })
+		router.GET("/rightsizing/recommendations", func(c *gin.Context) {
+			var params v2api.ListLatestRecommendationsParams
+			if policy := c.Query("policy"); policy != "" {
+				params.Policy = &policy
+			}
+			handler.ListLatestRecommendations(c, params)
+		})
+	})
+
+	AfterEach(func() {
+		pool.Close()
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	It("lists the named policies after the built-in default", func() {
+		Expect(serve(http.MethodPost, "/rightsizing/policies", `{"name":"peak","percentile":"max","headroomPct":10}`).Code).To(Equal(http.StatusCreated))
+
+		w := serve(http.MethodGet, "/rightsizing/policies", "")
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+		var resp []v2api.RightsizingPolicy
+		Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+		Expect(resp).To(HaveLen(2))
+		Expect(resp[0].Name).To(Equal("default"))
+		Expect(resp[1].Name).To(Equal("peak"))
+	})
+
+	It("replaces a policy, keeping the name of the path", func() {
+		Expect(serve(http.MethodPost, "/rightsizing/policies", `{"name":"peak","percentile":"max","headroomPct":10}`).Code).To(Equal(http.StatusCreated))
+
+		w := serve(http.MethodPut, "/rightsizing/policies/peak", `{"name":"other","percentile":"p99","headroomPct":30}`)
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+		var resp v2api.RightsizingPolicy
+		Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+		Expect(resp.Name).To(Equal("peak"))
+		Expect(resp.HeadroomPct).To(Equal(30.0))
+	})
+
+	It("returns 400 for an invalid policy", func() {
+		Expect(serve(http.MethodPost, "/rightsizing/policies", `{"name":"bad name","percentile":"p95","headroomPct":10}`).Code).To(Equal(http.StatusBadRequest))
+		Expect(serve(http.MethodPost, "/rightsizing/policies", `{"name":"peak","percentile":"p50","headroomPct":10}`).Code).To(Equal(http.StatusBadRequest))
+		Expect(serve(http.MethodPost, "/rightsizing/policies", `{"name":"peak","percentile":"max","headroomPct":10,"minCpus":4,"maxCpus":2}`).Code).To(Equal(http.StatusBadRequest))
+		Expect(serve(http.MethodPost, "/rightsizing/policies", `{"name":`).Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("returns 409 for a duplicate name", func() {
+		Expect(serve(http.MethodPost, "/rightsizing/policies", `{"name":"peak","percentile":"max","headroomPct":10}`).Code).To(Equal(http.StatusCreated))
+
+		Expect(serve(http.MethodPost, "/rightsizing/policies", `{"name":"peak","percentile":"max","headroomPct":10}`).Code).To(Equal(http.StatusConflict))
+	})
+
+	It("returns 404 for an unknown policy", func() {
+		Expect(serve(http.MethodGet, "/rightsizing/policies/missing", "").Code).To(Equal(http.StatusNotFound))
+		Expect(serve(http.MethodPut, "/rightsizing/policies/missing", `{"name":"missing","percentile":"p95","headroomPct":10}`).Code).To(Equal(http.StatusNotFound))
+		Expect(serve(http.MethodDelete, "/rightsizing/policies/missing", "").Code).To(Equal(http.StatusNotFound))
+		Expect(serve(http.MethodGet, "/rightsizing/recommendations?policy=missing", "").Code).To(Equal(http.StatusNotFound))
+	})
+})
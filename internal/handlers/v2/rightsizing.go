@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
@@ -88,6 +89,38 @@ func (h *Handler) GetLatestVMUtilization(c *gin.Context, vmId string) {
 	h.getVMUtilization(c, rsSvc, vmId)
 }
 
+// GetVMUtilizationSeries returns the metric time series of a VM.
+// (GET /collections/{id}/virtualmachines/{vmId}/utilization/series)
+func (h *Handler) GetVMUtilizationSeries(c *gin.Context, id string, vmId string, params v2.GetVMUtilizationSeriesParams) {
+	rsSvc, err := h.svc.RightsizingService(id)
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "collection not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.getVMUtilizationSeries(c, rsSvc, vmId, params.ReportId, params.BucketSeconds, params.Metric)
+}
+
+// GetLatestVMUtilizationSeries returns the metric time series of a VM from the latest collection.
+// (GET /virtualmachines/{vmId}/utilization/series)
+func (h *Handler) GetLatestVMUtilizationSeries(c *gin.Context, vmId string, params v2.GetLatestVMUtilizationSeriesParams) {
+	rsSvc, err := h.svc.LatestRightsizingService()
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "no collections found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.getVMUtilizationSeries(c, rsSvc, vmId, params.ReportId, params.BucketSeconds, params.Metric)
+}
+
 // ── Private shared logic ───────────────────────────────────────────────
 
 func (h *Handler) getVMUtilization(c *gin.Context, rsSvc *services.RightsizingService, vmId string) {
@@ -108,3 +141,40 @@ func (h *Handler) getVMUtilization(c *gin.Context, rsSvc *services.RightsizingSe
 	}
 	c.JSON(http.StatusOK, v2.NewVmUtilizationDetailsFromModel(*details))
 }
+
+func (h *Handler) getVMUtilizationSeries(c *gin.Context, rsSvc *services.RightsizingService, vmId string, reportID *string, bucketSeconds *int, metrics *[]string) {
+	if !vmIDPattern.MatchString(vmId) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid vm_id format: %q", vmId)})
+		return
+	}
+
+	var (
+		id         string
+		bucket     time.Duration
+		metricKeys []string
+	)
+	if reportID != nil {
+		id = *reportID
+	}
+	if bucketSeconds != nil {
+		bucket = time.Duration(*bucketSeconds) * time.Second
+	}
+	if metrics != nil {
+		metricKeys = *metrics
+	}
+
+	series, err := rsSvc.GetVMSeries(c.Request.Context(), vmId, id, bucket, metricKeys)
+	if err != nil {
+		switch {
+		case srvErrors.IsValidationError(err):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case srvErrors.IsResourceNotFoundError(err):
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		default:
+			zap.S().Named("rightsizing_handler").Errorw("failed to get VM utilization series", "vm_id", vmId, "error", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+	c.JSON(http.StatusOK, v2.NewVmUtilizationSeriesFromModel(*series))
+}
@@ -0,0 +1,88 @@
+package v2
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	v2 "github.com/kubev2v/assisted-migration-agent/api/v2"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+// GetRightsizingAccumulation returns the rolling accumulation of rightsizing samples.
+// (GET /rightsizing/accumulation)
+func (h *Handler) GetRightsizingAccumulation(c *gin.Context) {
+	status, err := h.svc.RightsizingAccumulationService().GetStatus(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewRightsizingAccumulationStatusFromModel(*status))
+}
+
+// UpdateRightsizingAccumulation configures the rolling accumulation of rightsizing samples.
+// (PUT /rightsizing/accumulation)
+func (h *Handler) UpdateRightsizingAccumulation(c *gin.Context) {
+	var req v2.RightsizingAccumulation
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": validationErrorMessage(err)})
+		return
+	}
+
+	status, err := h.svc.RightsizingAccumulationService().UpdateConfig(c.Request.Context(), v2.NewRightsizingAccumulationFromAPI(req))
+	if err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewRightsizingAccumulationStatusFromModel(*status))
+}
+
+// RunRightsizingAccumulation pulls and accumulates rightsizing samples now.
+// (POST /rightsizing/accumulation/run)
+func (h *Handler) RunRightsizingAccumulation(c *gin.Context) {
+	status, err := h.svc.RightsizingAccumulationService().Run(c.Request.Context(), time.Now())
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewRightsizingAccumulationStatusFromModel(*status))
+}
+
+// CreateAccumulatedRightsizingReport computes a rightsizing report of the latest collection from the accumulated samples.
+// (POST /rightsizing/accumulation/reports)
+func (h *Handler) CreateAccumulatedRightsizingReport(c *gin.Context) {
+	var req v2.AccumulatedRightsizingReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": validationErrorMessage(err)})
+		return
+	}
+
+	lookback := time.Duration(req.LookbackHours) * time.Hour
+	report, err := h.svc.RightsizingAccumulationService().BuildReport(c.Request.Context(), lookback, time.Now())
+	if err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, v2.NewRightsizingReportSummaryFromModel(*report))
+}
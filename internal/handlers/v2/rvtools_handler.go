@@ -32,25 +32,40 @@ func rvtoolsNotAvailable(c *gin.Context) {
 // Blocked endpoints — not available in rvtools mode.
 
 func (h *RVToolsHandler) SetAgentMode(c *gin.Context)              { rvtoolsNotAvailable(c) }
-func (h *RVToolsHandler) StartCollector(c *gin.Context)            { rvtoolsNotAvailable(c) }
 func (h *RVToolsHandler) PutCredentials(c *gin.Context)            { rvtoolsNotAvailable(c) }
 func (h *RVToolsHandler) GetCredentials(c *gin.Context)            { rvtoolsNotAvailable(c) }
 func (h *RVToolsHandler) DeleteCredentials(c *gin.Context)         { rvtoolsNotAvailable(c) }
 func (h *RVToolsHandler) GetCredentialCapabilities(c *gin.Context) { rvtoolsNotAvailable(c) }
-func (h *RVToolsHandler) StartInspection(c *gin.Context)           { rvtoolsNotAvailable(c) }
-func (h *RVToolsHandler) StopInspection(c *gin.Context)            { rvtoolsNotAvailable(c) }
-func (h *RVToolsHandler) PutInspectorVddk(c *gin.Context)          { rvtoolsNotAvailable(c) }
+func (h *RVToolsHandler) ListCredentialProfiles(c *gin.Context)    { rvtoolsNotAvailable(c) }
+func (h *RVToolsHandler) StartCollector(c *gin.Context, _ v2.StartCollectorParams) {
+	rvtoolsNotAvailable(c)
+}
+func (h *RVToolsHandler) PutCredentialProfile(c *gin.Context, _ string) {
+	rvtoolsNotAvailable(c)
+}
+func (h *RVToolsHandler) GetCredentialProfile(c *gin.Context, _ string) {
+	rvtoolsNotAvailable(c)
+}
+func (h *RVToolsHandler) DeleteCredentialProfile(c *gin.Context, _ string) {
+	rvtoolsNotAvailable(c)
+}
+func (h *RVToolsHandler) StartInspection(c *gin.Context)  { rvtoolsNotAvailable(c) }
+func (h *RVToolsHandler) StopInspection(c *gin.Context)   { rvtoolsNotAvailable(c) }
+func (h *RVToolsHandler) PutInspectorVddk(c *gin.Context) { rvtoolsNotAvailable(c) }
 func (h *RVToolsHandler) GetInspectorStatus(c *gin.Context, _ v2.GetInspectorStatusParams) {
 	rvtoolsNotAvailable(c)
 }
 func (h *RVToolsHandler) GetInspectorVddkStatus(c *gin.Context)     { rvtoolsNotAvailable(c) }
 func (h *RVToolsHandler) ListApplications(c *gin.Context, _ string) { rvtoolsNotAvailable(c) }
-func (h *RVToolsHandler) CompareCollections(c *gin.Context, _ string, _ string) {
+func (h *RVToolsHandler) CompareCollections(c *gin.Context, _ string, _ string, _ v2.CompareCollectionsParams) {
 	rvtoolsNotAvailable(c)
 }
 func (h *RVToolsHandler) CompareCollectionsDiff(c *gin.Context, _ string, _ string, _ v2.CompareCollectionsDiffParamsDimension, _ v2.CompareCollectionsDiffParams) {
 	rvtoolsNotAvailable(c)
 }
+func (h *RVToolsHandler) GetCollectionTrends(c *gin.Context, _ v2.GetCollectionTrendsParams) {
+	rvtoolsNotAvailable(c)
+}
 func (h *RVToolsHandler) GetClusterUtilization(c *gin.Context, _ string, _ string) {
 	rvtoolsNotAvailable(c)
 }
@@ -67,3 +82,18 @@ func (h *RVToolsHandler) DeleteForecasterRun(c *gin.Context, _ int64) { rvtoolsN
 func (h *RVToolsHandler) GetForecasterStats(c *gin.Context, _ v2.GetForecasterStatsParams) {
 	rvtoolsNotAvailable(c)
 }
+func (h *RVToolsHandler) ListCollectionSchedules(c *gin.Context)  { rvtoolsNotAvailable(c) }
+func (h *RVToolsHandler) CreateCollectionSchedule(c *gin.Context) { rvtoolsNotAvailable(c) }
+func (h *RVToolsHandler) GetCollectionSchedule(c *gin.Context, _ string) {
+	rvtoolsNotAvailable(c)
+}
+func (h *RVToolsHandler) UpdateCollectionSchedule(c *gin.Context, _ string) {
+	rvtoolsNotAvailable(c)
+}
+func (h *RVToolsHandler) DeleteCollectionSchedule(c *gin.Context, _ string) {
+	rvtoolsNotAvailable(c)
+}
+func (h *RVToolsHandler) ListCollectionScheduleRuns(c *gin.Context, _ string) {
+	rvtoolsNotAvailable(c)
+}
+func (h *RVToolsHandler) ImportCollectionBundle(c *gin.Context) { rvtoolsNotAvailable(c) }
@@ -0,0 +1,115 @@
+package v2
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	v2 "github.com/kubev2v/assisted-migration-agent/api/v2"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+// ListSavedFilters returns all saved filters.
+// (GET /filters/saved)
+func (h *Handler) ListSavedFilters(c *gin.Context) {
+	filters, err := h.svc.SavedFilterService().List(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp := v2.SavedFilterListResponse{
+		Filters: make([]v2.SavedFilter, 0, len(filters)),
+	}
+	for _, f := range filters {
+		resp.Filters = append(resp.Filters, v2.NewSavedFilterFromModel(f))
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// CreateSavedFilter creates a saved filter.
+// (POST /filters/saved)
+func (h *Handler) CreateSavedFilter(c *gin.Context) {
+	var req v2.CreateSavedFilterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": validationErrorMessage(err)})
+		return
+	}
+
+	created, err := h.svc.SavedFilterService().Create(c.Request.Context(), v2.NewSavedFilterFromAPI(req))
+	if err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if srvErrors.IsDuplicateResourceError(err) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, v2.NewSavedFilterFromModel(*created))
+}
+
+// GetSavedFilter returns a saved filter by name.
+// (GET /filters/saved/{name})
+func (h *Handler) GetSavedFilter(c *gin.Context, name string) {
+	f, err := h.svc.SavedFilterService().Get(c.Request.Context(), name)
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewSavedFilterFromModel(*f))
+}
+
+// UpdateSavedFilter updates a saved filter and refreshes the groups built on it.
+// (PATCH /filters/saved/{name})
+func (h *Handler) UpdateSavedFilter(c *gin.Context, name string) {
+	var req v2.UpdateSavedFilterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": validationErrorMessage(err)})
+		return
+	}
+
+	f, err := h.svc.SavedFilterService().Update(c.Request.Context(), name, v2.NewSavedFilterUpdateFromAPI(req))
+	if err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewSavedFilterFromModel(*f))
+}
+
+// DeleteSavedFilter deletes a saved filter that is no longer referenced.
+// (DELETE /filters/saved/{name})
+func (h *Handler) DeleteSavedFilter(c *gin.Context, name string) {
+	if err := h.svc.SavedFilterService().Delete(c.Request.Context(), name); err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
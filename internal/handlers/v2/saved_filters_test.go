@@ -0,0 +1,111 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v2api "github.com/kubev2v/assisted-migration-agent/api/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/config"
+	handlers "github.com/kubev2v/assisted-migration-agent/internal/handlers/v2"
+	svc "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+)
+
+var _ = Describe("Saved filter handlers", func() {
+	var (
+		tmpDir string
+		pool   *store.Pool
+		router *gin.Engine
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+
+		var err error
+		tmpDir, err = os.MkdirTemp("", "handler-saved-filters-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		pool, _ = newMainStore(tmpDir)
+
+		handler := handlers.NewHandler(config.Configuration{}, &stubServiceProvider{savedFilterSvc: svc.NewSavedFilterService(pool)})
+
+		router = gin.New()
+		router.GET("/filters/saved", handler.ListSavedFilters)
+		router.POST("/filters/saved", handler.CreateSavedFilter)
+		router.GET("/filters/saved/:name", func(c *gin.Context) { handler.GetSavedFilter(c, c.Param("name")) })
+		router.PATCH("/filters/saved/:name", func(c *gin.Context) { handler.UpdateSavedFilter(c, c.Param("name")) })
+		router.DELETE("/filters/saved/:name", func(c *gin.Context) { handler.DeleteSavedFilter(c, c.Param("name")) })
+	})
+
+	AfterEach(func() {
+		pool.Close()
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	It("creates, lists and gets a saved filter", func() {
+		w := serve(http.MethodPost, "/filters/saved", `{"name":"prod","expression":"cluster = 'prod'","owner":"alice"}`)
+		Expect(w.Code).To(Equal(http.StatusCreated))
+
+		w = serve(http.MethodGet, "/filters/saved", "")
+		Expect(w.Code).To(Equal(http.StatusOK))
+		var list v2api.SavedFilterListResponse
+		Expect(json.Unmarshal(w.Body.Bytes(), &list)).To(Succeed())
+		Expect(list.Filters).To(HaveLen(1))
+
+		w = serve(http.MethodGet, "/filters/saved/prod", "")
+		Expect(w.Code).To(Equal(http.StatusOK))
+		var resp v2api.SavedFilter
+		Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+		Expect(resp.Expression).To(Equal("cluster = 'prod'"))
+		Expect(resp.Owner).To(Equal("alice"))
+	})
+
+	It("returns 400 for an invalid expression or name", func() {
+		Expect(serve(http.MethodPost, "/filters/saved", `{"name":"prod","expression":"memroy > 8GB"}`).Code).To(Equal(http.StatusBadRequest))
+		Expect(serve(http.MethodPost, "/filters/saved", `{"name":"bad name","expression":"cluster = 'prod'"}`).Code).To(Equal(http.StatusBadRequest))
+		Expect(serve(http.MethodPost, "/filters/saved", `{"name":`).Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("returns 400 for an update introducing a cycle", func() {
+		Expect(serve(http.MethodPost, "/filters/saved", `{"name":"a","expression":"cluster = 'prod'"}`).Code).To(Equal(http.StatusCreated))
+		Expect(serve(http.MethodPost, "/filters/saved", `{"name":"b","expression":"@a and memory > 4GB"}`).Code).To(Equal(http.StatusCreated))
+
+		Expect(serve(http.MethodPatch, "/filters/saved/a", `{"expression":"@b"}`).Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("returns 409 for a duplicate name", func() {
+		Expect(serve(http.MethodPost, "/filters/saved", `{"name":"prod","expression":"cluster = 'prod'"}`).Code).To(Equal(http.StatusCreated))
+
+		Expect(serve(http.MethodPost, "/filters/saved", `{"name":"prod","expression":"cluster = 'dev'"}`).Code).To(Equal(http.StatusConflict))
+	})
+
+	It("returns 404 for an unknown saved filter", func() {
+		Expect(serve(http.MethodGet, "/filters/saved/missing", "").Code).To(Equal(http.StatusNotFound))
+		Expect(serve(http.MethodPatch, "/filters/saved/missing", `{"description":"x"}`).Code).To(Equal(http.StatusNotFound))
+		Expect(serve(http.MethodDelete, "/filters/saved/missing", "").Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("refuses to delete a referenced saved filter", func() {
+		Expect(serve(http.MethodPost, "/filters/saved", `{"name":"prod","expression":"cluster = 'prod'"}`).Code).To(Equal(http.StatusCreated))
+		Expect(serve(http.MethodPost, "/filters/saved", `{"name":"big-prod","expression":"@prod and memory > 16GB"}`).Code).To(Equal(http.StatusCreated))
+
+		Expect(serve(http.MethodDelete, "/filters/saved/prod", "").Code).To(Equal(http.StatusBadRequest))
+		Expect(serve(http.MethodDelete, "/filters/saved/big-prod", "").Code).To(Equal(http.StatusNoContent))
+		Expect(serve(http.MethodDelete, "/filters/saved/prod", "").Code).To(Equal(http.StatusNoContent))
+	})
+})
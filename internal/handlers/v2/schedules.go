@@ -0,0 +1,129 @@
+package v2
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	v2 "github.com/kubev2v/assisted-migration-agent/api/v2"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+// ListCollectionSchedules returns all recurring collection schedules.
+// (GET /collections/schedules)
+func (h *Handler) ListCollectionSchedules(c *gin.Context) {
+	schedules, err := h.svc.ScheduleService().List(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp := v2.CollectionScheduleListResponse{
+		Schedules: make([]v2.CollectionSchedule, 0, len(schedules)),
+	}
+	for _, s := range schedules {
+		resp.Schedules = append(resp.Schedules, v2.NewCollectionScheduleFromModel(s))
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// CreateCollectionSchedule creates a recurring collection schedule.
+// (POST /collections/schedules)
+func (h *Handler) CreateCollectionSchedule(c *gin.Context) {
+	var req v2.CreateCollectionScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": validationErrorMessage(err)})
+		return
+	}
+
+	created, err := h.svc.ScheduleService().Create(c.Request.Context(), v2.NewCollectionScheduleFromAPI(req))
+	if err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, v2.NewCollectionScheduleFromModel(*created))
+}
+
+// GetCollectionSchedule returns a collection schedule by ID.
+// (GET /collections/schedules/{scheduleId})
+func (h *Handler) GetCollectionSchedule(c *gin.Context, scheduleId string) {
+	sch, err := h.svc.ScheduleService().Get(c.Request.Context(), scheduleId)
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewCollectionScheduleFromModel(*sch))
+}
+
+// UpdateCollectionSchedule pauses or resumes a collection schedule.
+// (PATCH /collections/schedules/{scheduleId})
+func (h *Handler) UpdateCollectionSchedule(c *gin.Context, scheduleId string) {
+	var req v2.UpdateCollectionScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": validationErrorMessage(err)})
+		return
+	}
+
+	sch, err := h.svc.ScheduleService().SetPaused(c.Request.Context(), scheduleId, req.Paused)
+	if err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewCollectionScheduleFromModel(*sch))
+}
+
+// DeleteCollectionSchedule deletes a collection schedule and its run history.
+// (DELETE /collections/schedules/{scheduleId})
+func (h *Handler) DeleteCollectionSchedule(c *gin.Context, scheduleId string) {
+	if err := h.svc.ScheduleService().Delete(c.Request.Context(), scheduleId); err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListCollectionScheduleRuns returns the run history of a collection schedule.
+// (GET /collections/schedules/{scheduleId}/runs)
+func (h *Handler) ListCollectionScheduleRuns(c *gin.Context, scheduleId string) {
+	runs, err := h.svc.ScheduleService().ListRuns(c.Request.Context(), scheduleId)
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp := v2.CollectionScheduleRunListResponse{
+		Runs: make([]v2.CollectionScheduleRun, 0, len(runs)),
+	}
+	for _, r := range runs {
+		resp.Runs = append(resp.Runs, v2.NewCollectionScheduleRunFromModel(r))
+	}
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,57 @@
+package v2
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	v2 "github.com/kubev2v/assisted-migration-agent/api/v2"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+// GetLatestSizing returns the latest target cluster sizing simulation of the latest collection.
+// (GET /sizing)
+func (h *Handler) GetLatestSizing(c *gin.Context, params v2.GetLatestSizingParams) {
+	vcenter := ""
+	if params.Vcenter != nil {
+		vcenter = *params.Vcenter
+	}
+
+	result, err := h.svc.SizingService().GetLatest(c.Request.Context(), vcenter)
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewSizingResultFromModel(*result))
+}
+
+// RunSizing simulates the target cluster sizing of the latest collection on the requested node profiles.
+// (POST /sizing)
+func (h *Handler) RunSizing(c *gin.Context) {
+	var req v2.SizingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": validationErrorMessage(err)})
+		return
+	}
+
+	result, err := h.svc.SizingService().Simulate(c.Request.Context(), v2.NewSizingRequestFromAPI(req))
+	if err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, v2.NewSizingResultFromModel(*result))
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/gin-gonic/gin"
 
 	v2 "github.com/kubev2v/assisted-migration-agent/api/v2"
-	vmfilter "github.com/kubev2v/assisted-migration-agent/internal/filter"
 	services "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
 	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
 )
@@ -48,10 +47,19 @@ func (h *Handler) ListVirtualMachines(c *gin.Context, id string, params v2.ListV
 	h.listVirtualMachines(c, vmSvc, params.ByExpression, params.Sort, params.Page, params.PageSize)
 }
 
-// ListLatestVirtualMachines returns VMs from the latest collection.
+// ListLatestVirtualMachines returns VMs from the latest collection, or from the
+// latest collection of a vCenter when one is given.
 // (GET /virtualmachines)
 func (h *Handler) ListLatestVirtualMachines(c *gin.Context, params v2.ListLatestVirtualMachinesParams) {
-	vmSvc, err := h.svc.LatestVirtualMachineService()
+	var (
+		vmSvc *services.VMService
+		err   error
+	)
+	if params.Vcenter != nil {
+		vmSvc, err = h.svc.VCenterVirtualMachineService(*params.Vcenter)
+	} else {
+		vmSvc, err = h.svc.LatestVirtualMachineService()
+	}
 	if err != nil {
 		if srvErrors.IsResourceNotFoundError(err) {
 			c.JSON(http.StatusNotFound, gin.H{"error": "no collections found"})
@@ -210,7 +218,7 @@ func (h *Handler) UpdateLatestLabelVMs(c *gin.Context, label string) {
 
 // DeleteLatestLabelGlobally removes a label from all VMs in the latest collection.
 // (DELETE /virtualmachines/labels/{label})
-func (h *Handler) DeleteLatestLabelGlobally(c *gin.Context, label string) {
+func (h *Handler) DeleteLatestLabelGlobally(c *gin.Context, label string, params v2.DeleteLatestLabelGloballyParams) {
 	vmSvc, err := h.svc.LatestVirtualMachineService()
 	if err != nil {
 		if srvErrors.IsResourceNotFoundError(err) {
@@ -221,7 +229,39 @@ func (h *Handler) DeleteLatestLabelGlobally(c *gin.Context, label string) {
 		return
 	}
 
-	h.deleteLabelGlobally(c, vmSvc, label)
+	h.deleteLabelGlobally(c, vmSvc, label, params.Actor)
+}
+
+// GetLatestVMLabelHistory returns the label history of a VM from the latest collection.
+// (GET /virtualmachines/{vmId}/labels/history)
+func (h *Handler) GetLatestVMLabelHistory(c *gin.Context, vmId string) {
+	vmSvc, err := h.svc.LatestVirtualMachineService()
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "no collections found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	history, err := vmSvc.LabelHistory(c.Request.Context(), vmId)
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp := v2.LabelHistoryResponse{
+		History: make([]v2.LabelChange, 0, len(history)),
+	}
+	for _, change := range history {
+		resp.History = append(resp.History, v2.NewLabelChangeFromModel(change))
+	}
+	c.JSON(http.StatusOK, resp)
 }
 
 // ── Private shared logic ───────────────────────────────────────────────
@@ -242,11 +282,16 @@ func (h *Handler) listVirtualMachines(c *gin.Context, vmSvc *services.VMService,
 	}
 
 	if byExpression != nil {
-		if _, err := vmfilter.ParseWithDefaultMap([]byte(*byExpression)); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("expression filter is invalid: %v", err)})
+		expr, err := h.expandFilter(c.Request.Context(), *byExpression)
+		if err != nil {
+			if srvErrors.IsValidationError(err) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("expression filter is invalid: %v", err)})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
-		svcParams.Expression = *byExpression
+		svcParams.Expression = expr
 	}
 
 	if sort != nil {
@@ -315,13 +360,31 @@ func (h *Handler) updateVirtualMachine(c *gin.Context, vmSvc *services.VMService
 	}
 
 	ctx := c.Request.Context()
+	if req.Actor != nil {
+		ctx = services.WithLabelActor(ctx, *req.Actor)
+	}
+
+	if req.Exclusion != nil && (req.MigrationExcluded == nil || !*req.MigrationExcluded) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "exclusion requires migrationExcluded to be true"})
+		return
+	}
 
 	if req.MigrationExcluded != nil {
-		if err := vmSvc.UpdateMigrationExcluded(ctx, vmId, *req.MigrationExcluded); err != nil {
+		var err error
+		if req.Exclusion != nil {
+			err = vmSvc.ExcludeFromMigration(ctx, []string{vmId}, v2.NewMigrationExclusionFromAPI(*req.Exclusion))
+		} else {
+			err = vmSvc.UpdateMigrationExcluded(ctx, vmId, *req.MigrationExcluded)
+		}
+		if err != nil {
 			if srvErrors.IsResourceNotFoundError(err) {
 				c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 				return
 			}
+			if srvErrors.IsValidationError(err) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
@@ -357,11 +420,26 @@ func (h *Handler) batchUpdateVMExclusion(c *gin.Context, vmSvc *services.VMServi
 		return
 	}
 
-	if err := vmSvc.UpdateMigrationExcludedBatch(c.Request.Context(), req.VmIds, req.MigrationExcluded); err != nil {
+	if req.Exclusion != nil && !req.MigrationExcluded {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "exclusion requires migrationExcluded to be true"})
+		return
+	}
+
+	var err error
+	if req.Exclusion != nil {
+		err = vmSvc.ExcludeFromMigration(c.Request.Context(), req.VmIds, v2.NewMigrationExclusionFromAPI(*req.Exclusion))
+	} else {
+		err = vmSvc.UpdateMigrationExcludedBatch(c.Request.Context(), req.VmIds, req.MigrationExcluded)
+	}
+	if err != nil {
 		if srvErrors.IsResourceNotFoundError(err) {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 			return
 		}
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -427,7 +505,12 @@ func (h *Handler) updateLabelVMs(c *gin.Context, vmSvc *services.VMService, labe
 		removeVMIDs = *req.Remove
 	}
 
-	if err := vmSvc.UpdateLabelVMs(c.Request.Context(), addVMIDs, removeVMIDs, label); err != nil {
+	ctx := c.Request.Context()
+	if req.Actor != nil {
+		ctx = services.WithLabelActor(ctx, *req.Actor)
+	}
+
+	if err := vmSvc.UpdateLabelVMs(ctx, addVMIDs, removeVMIDs, label); err != nil {
 		if srvErrors.IsResourceNotFoundError(err) {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 			return
@@ -443,7 +526,7 @@ func (h *Handler) updateLabelVMs(c *gin.Context, vmSvc *services.VMService, labe
 	c.Status(http.StatusOK)
 }
 
-func (h *Handler) deleteLabelGlobally(c *gin.Context, vmSvc *services.VMService, label string) {
+func (h *Handler) deleteLabelGlobally(c *gin.Context, vmSvc *services.VMService, label string, actor *string) {
 	if strings.TrimSpace(label) == "" {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "label cannot be empty or whitespace-only"})
 		return
@@ -453,7 +536,12 @@ func (h *Handler) deleteLabelGlobally(c *gin.Context, vmSvc *services.VMService,
 		return
 	}
 
-	affected, err := vmSvc.RemoveLabelFromAllVMs(c.Request.Context(), label)
+	ctx := c.Request.Context()
+	if actor != nil {
+		ctx = services.WithLabelActor(ctx, *actor)
+	}
+
+	affected, err := vmSvc.RemoveLabelFromAllVMs(ctx, label)
 	if err != nil {
 		if srvErrors.IsValidationError(err) {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
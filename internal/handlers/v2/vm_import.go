@@ -0,0 +1,164 @@
+package v2
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	v2 "github.com/kubev2v/assisted-migration-agent/api/v2"
+	services "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+const maxVMFileSize = 32 << 20
+
+// ImportLatestVMLabels adds the labels of a CSV or XLSX file to the VMs of the latest collection.
+// (POST /virtualmachines/labels/import)
+func (h *Handler) ImportLatestVMLabels(c *gin.Context, params v2.ImportLatestVMLabelsParams) {
+	vmSvc, err := h.svc.LatestVirtualMachineService()
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "no collections found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx := c.Request.Context()
+	if params.Actor != nil {
+		ctx = services.WithLabelActor(ctx, *params.Actor)
+	}
+
+	h.importVMFile(c, func(r io.Reader) (any, error) {
+		report, err := vmSvc.ImportLabels(ctx, r, params.DryRun != nil && *params.DryRun)
+		if err != nil {
+			return nil, err
+		}
+		return v2.NewVMImportReportFromModel(*report), nil
+	})
+}
+
+// ExportLatestVMLabels writes the labels of the VMs of the latest collection as CSV or XLSX.
+// (GET /virtualmachines/labels/export)
+func (h *Handler) ExportLatestVMLabels(c *gin.Context, params v2.ExportLatestVMLabelsParams) {
+	vmSvc, err := h.svc.LatestVirtualMachineService()
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "no collections found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.exportVMFile(c, "vm-labels", params.Format, vmSvc.ExportLabels)
+}
+
+// ImportLatestVMExclusion sets the migration exclusion of the VMs of the latest collection
+// from a CSV or XLSX file.
+// (POST /virtualmachines/batch-update-exclusion/import)
+func (h *Handler) ImportLatestVMExclusion(c *gin.Context, params v2.ImportLatestVMExclusionParams) {
+	vmSvc, err := h.svc.LatestVirtualMachineService()
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "no collections found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.importVMFile(c, func(r io.Reader) (any, error) {
+		report, err := vmSvc.ImportMigrationExclusion(c.Request.Context(), r, params.DryRun != nil && *params.DryRun)
+		if err != nil {
+			return nil, err
+		}
+		return v2.NewVMImportReportFromModel(*report), nil
+	})
+}
+
+// ExportLatestVMExclusion writes the migration exclusion of the VMs of the latest collection
+// as CSV or XLSX.
+// (GET /virtualmachines/batch-update-exclusion/export)
+func (h *Handler) ExportLatestVMExclusion(c *gin.Context, params v2.ExportLatestVMExclusionParams) {
+	vmSvc, err := h.svc.LatestVirtualMachineService()
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "no collections found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.exportVMFile(c, "vm-migration-exclusion", params.Format, vmSvc.ExportMigrationExclusion)
+}
+
+// importVMFile passes the uploaded file to importFn and writes its result.
+func (h *Handler) importVMFile(c *gin.Context, importFn func(r io.Reader) (any, error)) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxVMFileSize)
+	file, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	r, err := file.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	defer func() { _ = r.Close() }()
+
+	resp, err := importFn(r)
+	if err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// exportVMFile writes the file exportFn produces as an attachment named after name.
+func (h *Handler) exportVMFile(c *gin.Context, name string, format *v2.VMFileFormat, exportFn func(ctx context.Context, format string, w io.Writer) error) {
+	f := services.VMFileCSV
+	if format != nil {
+		f = string(*format)
+	}
+
+	var buf bytes.Buffer
+	if err := exportFn(c.Request.Context(), f, &buf); err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	contentType := "text/csv"
+	if f == services.VMFileXLSX {
+		contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, name, f))
+	c.Data(http.StatusOK, contentType, buf.Bytes())
+}
@@ -0,0 +1,96 @@
+package v2
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	v2 "github.com/kubev2v/assisted-migration-agent/api/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+// GetLatestWasteAnalysis returns the waste analysis of the latest collection.
+// (GET /waste)
+func (h *Handler) GetLatestWasteAnalysis(c *gin.Context, params v2.GetLatestWasteAnalysisParams) {
+	var class models.WasteClass
+	if params.Class != nil {
+		class = models.WasteClass(*params.Class)
+	}
+	vcenter := ""
+	if params.Vcenter != nil {
+		vcenter = *params.Vcenter
+	}
+
+	analysis, err := h.svc.WasteService().GetLatest(c.Request.Context(), class, vcenter)
+	if err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewWasteAnalysisFromModel(*analysis))
+}
+
+// AnalyzeWaste classifies the VMs of the latest collection as idle, powered off, orphaned or oversized.
+// (POST /waste)
+func (h *Handler) AnalyzeWaste(c *gin.Context, params v2.AnalyzeWasteParams) {
+	vcenter := ""
+	if params.Vcenter != nil {
+		vcenter = *params.Vcenter
+	}
+
+	analysis, err := h.svc.WasteService().Analyze(c.Request.Context(), time.Now(), vcenter)
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, v2.NewWasteAnalysisFromModel(*analysis))
+}
+
+// GetWasteThresholds returns the thresholds of the waste analysis.
+// (GET /waste/thresholds)
+func (h *Handler) GetWasteThresholds(c *gin.Context) {
+	thresholds, err := h.svc.WasteService().GetThresholds(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewWasteThresholdsFromModel(*thresholds))
+}
+
+// UpdateWasteThresholds configures the thresholds of the waste analysis.
+// (PUT /waste/thresholds)
+func (h *Handler) UpdateWasteThresholds(c *gin.Context) {
+	var req v2.WasteThresholds
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": validationErrorMessage(err)})
+		return
+	}
+
+	thresholds, err := h.svc.WasteService().UpdateThresholds(c.Request.Context(), v2.NewWasteThresholdsFromAPI(req))
+	if err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewWasteThresholdsFromModel(*thresholds))
+}
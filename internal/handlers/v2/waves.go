@@ -0,0 +1,144 @@
+package v2
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	v2 "github.com/kubev2v/assisted-migration-agent/api/v2"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+// ListWaves returns all migration waves in order.
+// (GET /waves)
+func (h *Handler) ListWaves(c *gin.Context) {
+	waves, err := h.svc.WaveService().List(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp := v2.WaveListResponse{
+		Waves: make([]v2.Wave, 0, len(waves)),
+	}
+	for _, w := range waves {
+		resp.Waves = append(resp.Waves, v2.NewWaveFromModel(w))
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// CreateWave creates a migration wave.
+// (POST /waves)
+func (h *Handler) CreateWave(c *gin.Context) {
+	var req v2.CreateWaveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": validationErrorMessage(err)})
+		return
+	}
+
+	w, err := v2.NewWaveFromAPI(req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	created, err := h.svc.WaveService().Create(c.Request.Context(), w)
+	if err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if srvErrors.IsDuplicateResourceError(err) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, v2.NewWaveFromModel(*created))
+}
+
+// GetWavePlan plans the migration waves against the latest collection, or the latest
+// collection of a vCenter when one is given.
+// (GET /waves/plan)
+func (h *Handler) GetWavePlan(c *gin.Context, params v2.GetWavePlanParams) {
+	vcenter := ""
+	if params.Vcenter != nil {
+		vcenter = *params.Vcenter
+	}
+	plan, err := h.svc.WaveService().Plan(c.Request.Context(), vcenter)
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewWavePlanFromModel(*plan))
+}
+
+// GetWave returns a migration wave by name.
+// (GET /waves/{name})
+func (h *Handler) GetWave(c *gin.Context, name string) {
+	w, err := h.svc.WaveService().Get(c.Request.Context(), name)
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewWaveFromModel(*w))
+}
+
+// UpdateWave updates a migration wave.
+// (PATCH /waves/{name})
+func (h *Handler) UpdateWave(c *gin.Context, name string) {
+	var req v2.UpdateWaveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": validationErrorMessage(err)})
+		return
+	}
+
+	update, err := v2.NewWaveUpdateFromAPI(req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	w, err := h.svc.WaveService().Update(c.Request.Context(), name, update)
+	if err != nil {
+		if srvErrors.IsValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.NewWaveFromModel(*w))
+}
+
+// DeleteWave deletes a migration wave.
+// (DELETE /waves/{name})
+func (h *Handler) DeleteWave(c *gin.Context, name string) {
+	if err := h.svc.WaveService().Delete(c.Request.Context(), name); err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,111 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v2api "github.com/kubev2v/assisted-migration-agent/api/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/config"
+	handlers "github.com/kubev2v/assisted-migration-agent/internal/handlers/v2"
+	svc "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+)
+
+var _ = Describe("Wave handlers", func() {
+	var (
+		tmpDir string
+		pool   *store.Pool
+		router *gin.Engine
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+
+		var err error
+		tmpDir, err = os.MkdirTemp("", "handler-waves-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		pool, _ = newMainStore(tmpDir)
+
+		handler := handlers.NewHandler(config.Configuration{}, &stubServiceProvider{waveSvc: svc.NewWaveService(pool, nil)})
+
+		router = gin.New()
+		router.GET("/waves", handler.ListWaves)
+		router.POST("/waves", handler.CreateWave)
+		router.GET("/waves/plan", func(c *gin.Context) { handler.GetWavePlan(c, v2api.GetWavePlanParams{}) })
+		router.GET("/waves/:name", func(c *gin.Context) { handler.GetWave(c, c.Param("name")) })
+		router.PATCH("/waves/:name", func(c *gin.Context) { handler.UpdateWave(c, c.Param("name")) })
+		router.DELETE("/waves/:name", func(c *gin.Context) { handler.DeleteWave(c, c.Param("name")) })
+	})
+
+	AfterEach(func() {
+		pool.Close()
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	It("creates and lists waves in order", func() {
+		Expect(serve(http.MethodPost, "/waves", `{"name":"wave-2","position":2}`).Code).To(Equal(http.StatusCreated))
+		Expect(serve(http.MethodPost, "/waves", `{"name":"wave-1","position":1,"vmIds":["vm-1"]}`).Code).To(Equal(http.StatusCreated))
+
+		w := serve(http.MethodGet, "/waves", "")
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+		var resp v2api.WaveListResponse
+		Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+		Expect(resp.Waves).To(HaveLen(2))
+		Expect(resp.Waves[0].Name).To(Equal("wave-1"))
+		Expect(resp.Waves[0].VmIds).To(ConsistOf("vm-1"))
+		Expect(resp.Waves[1].Name).To(Equal("wave-2"))
+	})
+
+	It("updates a wave", func() {
+		Expect(serve(http.MethodPost, "/waves", `{"name":"wave-1"}`).Code).To(Equal(http.StatusCreated))
+
+		w := serve(http.MethodPatch, "/waves/wave-1", `{"description":"pilot"}`)
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+		var resp v2api.Wave
+		Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+		Expect(resp.Description).To(HaveValue(Equal("pilot")))
+	})
+
+	It("returns 400 for an invalid wave", func() {
+		Expect(serve(http.MethodPost, "/waves", `{"name":"bad name"}`).Code).To(Equal(http.StatusBadRequest))
+		Expect(serve(http.MethodPost, "/waves", `{"name":"wave-1","groupIds":["not-a-uuid"]}`).Code).To(Equal(http.StatusBadRequest))
+		Expect(serve(http.MethodPost, "/waves", `{"name":"wave-1","windowStart":"2026-06-02T00:00:00Z","windowEnd":"2026-06-01T00:00:00Z"}`).Code).To(Equal(http.StatusBadRequest))
+		Expect(serve(http.MethodPost, "/waves", `{"name":`).Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("returns 409 for a duplicate name", func() {
+		Expect(serve(http.MethodPost, "/waves", `{"name":"wave-1"}`).Code).To(Equal(http.StatusCreated))
+
+		Expect(serve(http.MethodPost, "/waves", `{"name":"wave-1"}`).Code).To(Equal(http.StatusConflict))
+	})
+
+	It("returns 404 for an unknown wave", func() {
+		Expect(serve(http.MethodGet, "/waves/missing", "").Code).To(Equal(http.StatusNotFound))
+		Expect(serve(http.MethodPatch, "/waves/missing", `{"description":"x"}`).Code).To(Equal(http.StatusNotFound))
+		Expect(serve(http.MethodDelete, "/waves/missing", "").Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("returns 404 for a plan without any collection", func() {
+		Expect(serve(http.MethodPost, "/waves", `{"name":"wave-1"}`).Code).To(Equal(http.StatusCreated))
+
+		Expect(serve(http.MethodGet, "/waves/plan", "").Code).To(Equal(http.StatusNotFound))
+	})
+})
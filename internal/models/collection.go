@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 type CollectionState string
 
 const (
@@ -12,3 +14,52 @@ type Collection struct {
 	State    CollectionState
 	Error    string
 }
+
+// CollectionMode selects how a vCenter collection is built.
+type CollectionMode string
+
+const (
+	// CollectionModeFull runs the vSphere collector over the whole vCenter.
+	CollectionModeFull CollectionMode = "full"
+	// CollectionModeIncremental clones the previous collection and patches only the VMs
+	// reported as changed by the vCenter property collector.
+	CollectionModeIncremental CollectionMode = "incremental"
+)
+
+func (m CollectionMode) IsValid() bool {
+	return m == CollectionModeFull || m == CollectionModeIncremental
+}
+
+// CollectionSource identifies the vCenter a collection database was built from.
+// VCenter is the name of the credential profile used for the collection.
+// Imported is set when the collection comes from a bundle exported by another agent, and
+// Signer is then the fingerprint of the key that signed the bundle.
+type CollectionSource struct {
+	VCenter  string
+	URL      string
+	Mode     CollectionMode
+	Imported bool
+	Signer   string
+}
+
+// CollectionMetadata holds the user-managed attributes of a collection database.
+// Database is the collection database name (collection_<unix>).
+type CollectionMetadata struct {
+	Database    string
+	DisplayName string
+	Notes       string
+	Pinned      bool
+	UpdatedAt   time.Time
+}
+
+// CollectionMetadataUpdate is a partial update of CollectionMetadata; nil fields are left unchanged.
+type CollectionMetadataUpdate struct {
+	DisplayName *string
+	Notes       *string
+	Pinned      *bool
+}
+
+// IsEmpty reports whether the update changes nothing.
+func (u CollectionMetadataUpdate) IsEmpty() bool {
+	return u.DisplayName == nil && u.Notes == nil && u.Pinned == nil
+}
@@ -46,9 +46,12 @@ const (
 )
 
 // CollectorStatus holds the current collector state and metadata.
+// Mode is the mode a vCenter collection actually runs in, which may differ from the
+// requested one; it is empty for RVTools imports.
 type CollectorStatus struct {
 	ID    string
 	State CollectorStateType
+	Mode  CollectionMode
 	Error error
 }
 
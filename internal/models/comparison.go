@@ -9,6 +9,23 @@ type VMComparisonRow struct {
 	Migratable bool
 }
 
+// VMStateRow is the state of one VM as tracked by the "changed" comparison dimension.
+// List fields are sorted so that two rows can be compared element by element.
+type VMStateRow struct {
+	VMID            string
+	Name            string
+	CPUs            int
+	MemoryMiB       int64
+	DiskCapacityMiB int64
+	PowerState      string
+	Host            string
+	Cluster         string
+	Datastores      []string
+	NICs            []string
+	Concerns        []string
+	Labels          []string
+}
+
 // CollectionMeta holds identifying metadata for one collection.
 // Used to populate CollectionAggregate without requiring a live *store.Database.
 type CollectionMeta struct {
@@ -50,15 +67,101 @@ type ComparisonSummary struct {
 	Migratable    ComparisonDiffEntry
 	NonMigratable ComparisonDiffEntry
 	Clusters      ComparisonDiffEntry
+	// Changed is the number of VMs present in both collections with at least one changed field.
+	Changed int
 }
 
 // ComparisonDiff is the result returned by ComparisonService.Diff.
+// Changed is only set for DimensionChanged and Infrastructure only for infrastructure
+// dimensions; OnlyInA and OnlyInB are empty for both.
 type ComparisonDiff struct {
-	Dimension ComparisonDimension
-	OnlyInA   ComparisonDiffPage
-	OnlyInB   ComparisonDiffPage
+	Dimension      ComparisonDimension
+	OnlyInA        ComparisonDiffPage
+	OnlyInB        ComparisonDiffPage
+	Changed        *ComparisonChangedPage
+	Infrastructure *ComparisonInfraPage
 }
 
+// VMFieldChange is the before (A) and after (B) value of one changed VM field.
+type VMFieldChange struct {
+	Field  VMField
+	Before any
+	After  any
+}
+
+// VMChange lists the changed fields of a VM present in both collections.
+type VMChange struct {
+	VMID    string
+	Name    string
+	Changes []VMFieldChange
+}
+
+// ComparisonChangedPage is one page of the VMs changed between two collections.
+type ComparisonChangedPage struct {
+	Total     int
+	Page      int
+	PageCount int
+	VMs       []VMChange
+}
+
+// InfraEntity is a cluster, ESXi host, datastore or network of one collection with its
+// member VMs. Capacity fields that do not apply to the entity kind are zero: clusters and
+// hosts have CPU cores and memory (clusters also their host count), datastores capacity
+// and free space, networks only members.
+type InfraEntity struct {
+	Name        string
+	VMIDs       []string
+	Hosts       int
+	CPUCores    int64
+	MemoryMiB   int64
+	CapacityMiB int64
+	FreeMiB     int64
+}
+
+// InfraChangeKind tells how an infrastructure entity moved between two collections.
+type InfraChangeKind string
+
+const (
+	InfraAdded   InfraChangeKind = "added"
+	InfraRemoved InfraChangeKind = "removed"
+	InfraChanged InfraChangeKind = "changed"
+)
+
+// InfraEntityChange is an infrastructure entity added, removed or changed between two
+// collections. Before is nil for added entities and After for removed ones.
+type InfraEntityChange struct {
+	Name       string
+	Change     InfraChangeKind
+	Before     *InfraEntity
+	After      *InfraEntity
+	VMsAdded   []string
+	VMsRemoved []string
+}
+
+// ComparisonInfraPage is one page of the infrastructure entities that moved between two collections.
+type ComparisonInfraPage struct {
+	Total     int
+	Page      int
+	PageCount int
+	Entities  []InfraEntityChange
+}
+
+// VMField names a VM field tracked by the "changed" comparison dimension.
+type VMField string
+
+const (
+	VMFieldCPUs            VMField = "cpus"
+	VMFieldMemoryMiB       VMField = "memoryMiB"
+	VMFieldDiskCapacityMiB VMField = "diskCapacityMiB"
+	VMFieldPowerState      VMField = "powerState"
+	VMFieldHost            VMField = "host"
+	VMFieldCluster         VMField = "cluster"
+	VMFieldDatastores      VMField = "datastores"
+	VMFieldNICs            VMField = "nics"
+	VMFieldConcerns        VMField = "concerns"
+	VMFieldLabels          VMField = "labels"
+)
+
 // ComparisonDimension identifies which metric to drill into.
 type ComparisonDimension string
 
@@ -66,12 +169,26 @@ const (
 	DimensionTotal         ComparisonDimension = "total"
 	DimensionMigratable    ComparisonDimension = "migratable"
 	DimensionNonMigratable ComparisonDimension = "non-migratable"
+	DimensionChanged       ComparisonDimension = "changed"
+	DimensionClusters      ComparisonDimension = "clusters"
+	DimensionHosts         ComparisonDimension = "hosts"
+	DimensionDatastores    ComparisonDimension = "datastores"
+	DimensionNetworks      ComparisonDimension = "networks"
 )
 
 // IsValid reports whether d is a recognised dimension.
 func (d ComparisonDimension) IsValid() bool {
 	switch d {
-	case DimensionTotal, DimensionMigratable, DimensionNonMigratable:
+	case DimensionTotal, DimensionMigratable, DimensionNonMigratable, DimensionChanged:
+		return true
+	}
+	return d.IsInfrastructure()
+}
+
+// IsInfrastructure reports whether d compares infrastructure entities rather than VMs.
+func (d ComparisonDimension) IsInfrastructure() bool {
+	switch d {
+	case DimensionClusters, DimensionHosts, DimensionDatastores, DimensionNetworks:
 		return true
 	}
 	return false
@@ -0,0 +1,102 @@
+package models
+
+import "time"
+
+// VMChangeKind tells how a VM changed between a collection and the one it was built from.
+type VMChangeKind string
+
+const (
+	VMChangeAdded    VMChangeKind = "added"
+	VMChangeModified VMChangeKind = "modified"
+	VMChangeRemoved  VMChangeKind = "removed"
+)
+
+// CollectionDelta lists the VMs an incremental collection changed relative to the
+// collection it was cloned from.
+type CollectionDelta struct {
+	Added    []string
+	Modified []string
+	Removed  []string
+}
+
+// Changed returns the added and modified VM IDs.
+func (d CollectionDelta) Changed() []string {
+	changed := make([]string, 0, len(d.Added)+len(d.Modified))
+	changed = append(changed, d.Added...)
+	return append(changed, d.Modified...)
+}
+
+// All returns every VM ID of the delta.
+func (d CollectionDelta) All() []string {
+	return append(d.Changed(), d.Removed...)
+}
+
+// VMPatch carries the vCenter state of a VM that changed since the previous collection.
+// HostID is resolved to the host, cluster and datacenter names of the collection's vhost table.
+type VMPatch struct {
+	ID             string
+	Name           string
+	PowerState     string
+	HostID         string
+	Template       bool
+	CPUs           int32
+	CoresPerSocket int32
+	MemoryMiB      int32
+	CPUHotAdd      bool
+	CPUHotRemove   bool
+	MemoryHotAdd   bool
+	UUID           string
+	InstanceUUID   string
+	Firmware       string
+	HWVersion      string
+	GuestOS        string
+	ToolsGuestOS   string
+	DNSName        string
+	IPAddress      string
+	CBT            bool
+	ProvisionedMiB int64
+	InUseMiB       int64
+	Disks          []VMPatchDisk
+	NICs           []VMPatchNIC
+	Lifecycle      *VMLifecycle
+}
+
+type VMPatchDisk struct {
+	Key         int32
+	UnitNumber  int32
+	Label       string
+	File        string
+	Controller  string
+	Mode        string
+	Sharing     string
+	UUID        string
+	CapacityMiB int64
+	Thin        bool
+	RDM         bool
+}
+
+type VMPatchNIC struct {
+	Label          string
+	Adapter        string
+	MAC            string
+	Network        string
+	Connected      bool
+	StartConnected bool
+	IPv4           string
+	IPv6           string
+}
+
+// VMLifecycle carries the lifecycle timestamps and the snapshots of a VM. Timestamps are nil
+// when vCenter does not report them, e.g. the boot time of a powered off VM.
+type VMLifecycle struct {
+	VMID      string
+	CreatedAt *time.Time
+	BootedAt  *time.Time
+	Snapshots []VMSnapshot
+}
+
+type VMSnapshot struct {
+	ID        string
+	Name      string
+	CreatedAt time.Time
+}
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"time"
+
+	"github.com/kubev2v/assisted-migration-agent/pkg/filter"
+)
+
+// FilterIssueKind classifies a problem found in a filter expression.
+type FilterIssueKind string
+
+const (
+	// FilterIssueSyntax is an expression that cannot be parsed.
+	FilterIssueSyntax FilterIssueKind = "syntax"
+	// FilterIssueUnknownField is a field that no VM field matches.
+	FilterIssueUnknownField FilterIssueKind = "unknown_field"
+	// FilterIssueInvalidField is a known field used with a value or operator it does not support.
+	FilterIssueInvalidField FilterIssueKind = "invalid_field"
+	// FilterIssueReference is a saved filter reference that cannot be expanded: unknown,
+	// part of a cycle or nested too deeply.
+	FilterIssueReference FilterIssueKind = "reference"
+)
+
+// FilterIssue is a problem found in a filter expression.
+type FilterIssue struct {
+	Kind FilterIssueKind
+	// Offset is the character offset of the issue in the expression.
+	Offset  int
+	Message string
+	// Field is set on field issues, and on reference issues to the referenced saved filter.
+	Field string
+	// Suggestions are known fields close to an unknown field, or saved filters close to an
+	// unknown reference, best first.
+	Suggestions []string
+}
+
+// FilterValidation is the result of validating a filter expression.
+type FilterValidation struct {
+	Valid  bool
+	Issues []FilterIssue
+}
+
+// FilterExplanation shows how a filter expression is understood and run.
+type FilterExplanation struct {
+	Tree filter.Node
+	SQL  string
+	Args []any
+	// MatchedVMs is the number of VMs of the latest collection matching the expression,
+	// nil when there is no collection.
+	MatchedVMs *int
+}
+
+// FilterField describes a field of the VM filter DSL.
+type FilterField struct {
+	Name    string
+	Aliases []string
+	// Type is string, numeric, boolean or array.
+	Type string
+	// Collection is the collection the field belongs to in quantifiers and aggregates,
+	// empty for VM fields.
+	Collection string
+	// Units are the quantity units the field accepts.
+	Units []string
+	// Examples are values of the field in the latest collection.
+	Examples []string
+}
+
+// SavedFilter is a named VM filter expression that other expressions reference as @name.
+type SavedFilter struct {
+	Name        string
+	Description string
+	Expression  string
+	Owner       string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// SavedFilterUpdate is a partial update of SavedFilter; nil fields are left unchanged.
+type SavedFilterUpdate struct {
+	Description *string
+	Expression  *string
+	Owner       *string
+}
@@ -0,0 +1,59 @@
+package models
+
+import "time"
+
+// Label is the definition of a VM label: how it is shown and what it is for. Labels are
+// applied to VMs by name, whether they are defined or not.
+type Label struct {
+	Name        string
+	Color       string
+	Description string
+	// Category groups labels of the same kind, e.g. wave, owner or risk.
+	Category  string
+	CreatedBy string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// LabelUpdate is a partial update of Label; nil fields are left unchanged.
+type LabelUpdate struct {
+	Color       *string
+	Description *string
+	Category    *string
+}
+
+// LabelChangeAction is what happened to the label of a VM.
+type LabelChangeAction string
+
+const (
+	LabelAdded   LabelChangeAction = "added"
+	LabelRemoved LabelChangeAction = "removed"
+)
+
+// LabelChangeSource is what changed the label of a VM.
+type LabelChangeSource string
+
+const (
+	// LabelSourceManual is a change made through the API.
+	LabelSourceManual LabelChangeSource = "manual"
+	// LabelSourceRule is a change made by a label rule.
+	LabelSourceRule LabelChangeSource = "rule"
+	// LabelSourceSystem is a change made by the agent, e.g. the New label moved by a collection.
+	LabelSourceSystem LabelChangeSource = "system"
+)
+
+// LabelActor is who changes labels: the user for manual changes, the rule for rule changes.
+type LabelActor struct {
+	Source LabelChangeSource
+	Name   string
+}
+
+// LabelChange is an entry of the label history of a VM.
+type LabelChange struct {
+	VMID   string
+	Label  string
+	Action LabelChangeAction
+	Source LabelChangeSource
+	Actor  string
+	At     time.Time
+}
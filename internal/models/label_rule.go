@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// LabelRule labels the VMs matching a filter expression. Rules are evaluated after every
+// collection and inspection run; a VM that stops matching loses the label again.
+type LabelRule struct {
+	Name       string
+	Label      string
+	Expression string
+	// Priority orders the rules: when several rules apply the same label to a VM, the
+	// rule with the highest priority is recorded as having applied it.
+	Priority  int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// LabelRuleUpdate is a partial update of LabelRule; nil fields are left unchanged.
+type LabelRuleUpdate struct {
+	Label      *string
+	Expression *string
+	Priority   *int
+}
+
+// VMRuleLabel is a label applied to a VM by a label rule.
+type VMRuleLabel struct {
+	VMID  string
+	Label string
+	Rule  string
+}
+
+// LabelRuleResult is the outcome of evaluating the label rules against a collection.
+type LabelRuleResult struct {
+	Added   int
+	Removed int
+	// Skipped are the rules that could not be evaluated. The labels they applied are kept.
+	Skipped []string
+}
@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// MigrationExclusionReason is the reason code of a migration exclusion.
+type MigrationExclusionReason string
+
+const (
+	ExclusionReasonLicense      MigrationExclusionReason = "license"
+	ExclusionReasonUnsupported  MigrationExclusionReason = "unsupported"
+	ExclusionReasonDecommission MigrationExclusionReason = "decommission"
+	ExclusionReasonBusiness     MigrationExclusionReason = "business"
+	ExclusionReasonOther        MigrationExclusionReason = "other"
+)
+
+// MigrationExclusionReasons lists the known reason codes.
+var MigrationExclusionReasons = []MigrationExclusionReason{
+	ExclusionReasonLicense,
+	ExclusionReasonUnsupported,
+	ExclusionReasonDecommission,
+	ExclusionReasonBusiness,
+	ExclusionReasonOther,
+}
+
+// MigrationExclusion records why and by whom a VM was excluded from migration.
+type MigrationExclusion struct {
+	VMID          string
+	Reason        MigrationExclusionReason
+	Justification string
+	ExcludedBy    string
+	ExcludedAt    time.Time
+	// ExpiresAt is the time after which the exclusion is lifted by the next collection sync.
+	// Nil when the exclusion does not expire.
+	ExpiresAt *time.Time
+}
@@ -0,0 +1,76 @@
+package models
+
+// MTVNetworkType is the kind of destination a vSphere network is mapped to in a Forklift NetworkMap.
+type MTVNetworkType string
+
+const (
+	// MTVNetworkPod maps a network to the pod network.
+	MTVNetworkPod MTVNetworkType = "pod"
+	// MTVNetworkMultus maps a network to a NetworkAttachmentDefinition.
+	MTVNetworkMultus MTVNetworkType = "multus"
+	// MTVNetworkIgnored leaves the NICs on a network unconnected.
+	MTVNetworkIgnored MTVNetworkType = "ignored"
+)
+
+// MTVNetworkTypes lists the valid network destination types.
+var MTVNetworkTypes = []MTVNetworkType{MTVNetworkPod, MTVNetworkMultus, MTVNetworkIgnored}
+
+// MTVNetworkMapping maps a vSphere network, by name, to a destination network.
+// Namespace and Name identify the NetworkAttachmentDefinition of a multus destination.
+type MTVNetworkMapping struct {
+	Source    string
+	Type      MTVNetworkType
+	Namespace string
+	Name      string
+}
+
+// MTVStorageMapping maps a vSphere datastore, by name, to a storage class.
+type MTVStorageMapping struct {
+	Source       string
+	StorageClass string
+}
+
+// MTVMappings holds the user-defined mapping tables, ordered by source.
+type MTVMappings struct {
+	Networks []MTVNetworkMapping
+	Storage  []MTVStorageMapping
+}
+
+// MTVPlanOptions sets the names and namespaces used in the generated manifests.
+type MTVPlanOptions struct {
+	// Namespace is the namespace of the Plan and maps, where MTV is installed.
+	Namespace string
+	// TargetNamespace is the namespace the VMs are migrated to.
+	TargetNamespace string
+	// SourceProvider is the name of the vSphere Provider, in Namespace.
+	SourceProvider string
+	// DestinationProvider is the name of the OpenShift Provider, in Namespace.
+	DestinationProvider string
+	// VCenter is the credential profile of the vCenter whose latest collection the group is
+	// read from; empty for the latest collection of any vCenter.
+	VCenter string
+}
+
+// MTVManifest is one generated Forklift custom resource, rendered as YAML.
+type MTVManifest struct {
+	Kind    string
+	Name    string
+	Content []byte
+}
+
+// MTVPlan is the set of Forklift manifests migrating the VMs of a group.
+type MTVPlan struct {
+	// Name is the name shared by the Plan, NetworkMap and StorageMap.
+	Name      string
+	Manifests []MTVManifest
+	// Warnings lists the networks and datastores left unmapped and the VMs left out of the plan.
+	Warnings []string
+}
+
+// VMPlacement is the networks and datastores a VM is attached to.
+type VMPlacement struct {
+	VMID       string
+	Name       string
+	Networks   []string
+	Datastores []string
+}
@@ -0,0 +1,167 @@
+package models
+
+import (
+	"time"
+
+	"github.com/kubev2v/assisted-migration-agent/pkg/rightsizing"
+)
+
+// DefaultRightsizingPolicyName is the policy recommendations use when none is given. Until a
+// policy of that name is stored, it is DefaultRightsizingPolicy.
+const DefaultRightsizingPolicyName = "default"
+
+// DefaultRightsizingPolicy sizes VMs by their p95 utilization plus 20%, with at least one
+// vCPU and 1 GiB of memory, whole sockets and memory rounded up to the GiB.
+var DefaultRightsizingPolicy = RightsizingPolicy{
+	Name:           DefaultRightsizingPolicyName,
+	Description:    "p95 utilization plus 20% headroom",
+	Percentile:     rightsizing.PercentileP95,
+	HeadroomPct:    20,
+	MinCPUs:        1,
+	MinMemoryMB:    1024,
+	RoundToSockets: true,
+	MemoryStepMB:   1024,
+}
+
+// RightsizingPolicy is a named profile of how VMs are sized from their utilization.
+type RightsizingPolicy struct {
+	Name        string
+	Description string
+	// Percentile is the statistic VMs are sized by: average, p95, p99 or max.
+	Percentile  string
+	HeadroomPct float64
+	// MinCPUs, MaxCPUs, MinMemoryMB and MaxMemoryMB bound recommendations; zero is unbounded.
+	MinCPUs     int
+	MaxCPUs     int
+	MinMemoryMB int64
+	MaxMemoryMB int64
+	// RoundToSockets rounds vCPUs up to whole sockets of the VM's cores per socket.
+	RoundToSockets bool
+	// MemoryStepMB rounds memory up to a multiple of it; zero rounds to the MB.
+	MemoryStepMB int64
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Recommend returns the recommended size of a VM under the policy. The confidence of the
+// recommendation is that of the scarcer of the CPU and memory samples; a resource without
+// samples keeps its provisioned size and grades the recommendation low.
+func (p RightsizingPolicy) Recommend(vm RightsizingVMStats) RightsizingRecommendation {
+	policy := rightsizing.Policy{
+		Percentile:     p.Percentile,
+		HeadroomPct:    p.HeadroomPct,
+		MinCPUs:        p.MinCPUs,
+		MaxCPUs:        p.MaxCPUs,
+		MinMemoryMB:    p.MinMemoryMB,
+		MaxMemoryMB:    p.MaxMemoryMB,
+		RoundToSockets: p.RoundToSockets,
+		MemoryStepMB:   p.MemoryStepMB,
+	}
+
+	r := RightsizingRecommendation{
+		VMID:                vm.MOID,
+		Name:                vm.Name,
+		Cluster:             vm.Cluster,
+		ProvisionedCPUs:     vm.ProvisionedCPUs,
+		RecommendedCPUs:     vm.ProvisionedCPUs,
+		ProvisionedMemoryMB: vm.ProvisionedMemoryMB,
+		RecommendedMemoryMB: vm.ProvisionedMemoryMB,
+	}
+	samples := 0
+	if vm.CPU != nil && vm.Memory != nil {
+		samples = min(vm.CPU.SampleCount, vm.Memory.SampleCount)
+	}
+	if vm.CPU != nil {
+		r.RecommendedCPUs = rightsizing.RecommendCPUs(policy, vm.ProvisionedCPUs, vm.CoresPerSocket, rightsizing.MetricStats(*vm.CPU))
+	}
+	if vm.Memory != nil {
+		r.RecommendedMemoryMB = rightsizing.RecommendMemoryMB(policy, rightsizing.MetricStats(*vm.Memory))
+	}
+	r.SavedCPUs = r.ProvisionedCPUs - r.RecommendedCPUs
+	r.SavedMemoryMB = r.ProvisionedMemoryMB - r.RecommendedMemoryMB
+	r.ConfidencePct = rightsizing.ConfidencePct(samples, vm.ExpectedSampleCount)
+	r.Confidence = rightsizing.ConfidenceGrade(r.ConfidencePct)
+	return r
+}
+
+// RightsizingVMStats is the provisioned resources of a VM and its CPU and memory statistics in
+// a rightsizing report.
+type RightsizingVMStats struct {
+	MOID                string
+	Name                string
+	Cluster             string
+	ProvisionedCPUs     int
+	CoresPerSocket      int
+	ProvisionedMemoryMB int64
+	ExpectedSampleCount int
+	// CPU holds cpu.usage.average, in hundredths of a percent; Memory holds
+	// mem.consumed.average, in KB. Either is nil when the report has no samples for it.
+	CPU    *RightsizingMetricStats
+	Memory *RightsizingMetricStats
+}
+
+// RightsizingRecommendation is the recommended size of a VM. Savings are negative when the
+// VM needs more than it is provisioned with.
+type RightsizingRecommendation struct {
+	VMID                string
+	Name                string
+	Cluster             string
+	ProvisionedCPUs     int
+	RecommendedCPUs     int
+	SavedCPUs           int
+	ProvisionedMemoryMB int64
+	RecommendedMemoryMB int64
+	SavedMemoryMB       int64
+	// ConfidencePct is the share of the expected samples collected for the VM's scarcer
+	// metric; Confidence grades it high, medium or low.
+	ConfidencePct float64
+	Confidence    string
+}
+
+// RightsizingRecommendationSummary totals the recommendations of a set of VMs.
+type RightsizingRecommendationSummary struct {
+	VMCount             int
+	ProvisionedCPUs     int
+	RecommendedCPUs     int
+	SavedCPUs           int
+	ProvisionedMemoryMB int64
+	RecommendedMemoryMB int64
+	SavedMemoryMB       int64
+	// HighConfidence, MediumConfidence and LowConfidence count the VMs of each grade.
+	HighConfidence   int
+	MediumConfidence int
+	LowConfidence    int
+}
+
+// Add adds a recommendation to the summary.
+func (s *RightsizingRecommendationSummary) Add(r RightsizingRecommendation) {
+	s.VMCount++
+	s.ProvisionedCPUs += r.ProvisionedCPUs
+	s.RecommendedCPUs += r.RecommendedCPUs
+	s.SavedCPUs += r.SavedCPUs
+	s.ProvisionedMemoryMB += r.ProvisionedMemoryMB
+	s.RecommendedMemoryMB += r.RecommendedMemoryMB
+	s.SavedMemoryMB += r.SavedMemoryMB
+	switch r.Confidence {
+	case rightsizing.ConfidenceHigh:
+		s.HighConfidence++
+	case rightsizing.ConfidenceMedium:
+		s.MediumConfidence++
+	default:
+		s.LowConfidence++
+	}
+}
+
+// RightsizingRecommendations is the recommendations of a set of VMs under a policy.
+type RightsizingRecommendations struct {
+	ReportID string
+	Policy   RightsizingPolicy
+	VMs      []RightsizingRecommendation
+	Summary  RightsizingRecommendationSummary
+}
+
+// RightsizingClusterRecommendations totals the recommendations of the VMs of a cluster.
+type RightsizingClusterRecommendations struct {
+	Cluster string
+	Summary RightsizingRecommendationSummary
+}
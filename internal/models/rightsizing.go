@@ -147,6 +147,16 @@ type RightsizingClusterUtilization struct {
 	TotalProvisionedCpus     int64
 	TotalProvisionedMemoryMb int64
 	TotalProvisionedDiskKb   float64
+	// Network throughput (KBps) and disk IOPS, summed over the VMs.
+	NetAvg  float64
+	NetP95  float64
+	NetMax  float64
+	IopsAvg float64
+	IopsP95 float64
+	IopsMax float64
+	// Disk latencies (ms) of the slowest VM.
+	ReadLatencyP95  float64
+	WriteLatencyP95 float64
 }
 
 // VmUtilizationDetails holds the full utilization breakdown for a single VM.
@@ -169,4 +179,45 @@ type VmUtilizationDetails struct {
 	MemLatest  float64
 	Disk       float64
 	Confidence float64
+	// Network throughput (KBps); NetAvg, NetP95 and NetMax count both directions.
+	NetAvg   float64
+	NetP95   float64
+	NetMax   float64
+	NetRxP95 float64
+	NetTxP95 float64
+	// Read and write operations per second of all the virtual disks.
+	IopsAvg float64
+	IopsP95 float64
+	IopsMax float64
+	// Disk latencies (ms) of the slowest virtual disk.
+	ReadLatencyP95  float64
+	WriteLatencyP95 float64
+}
+
+// VmUtilizationSeries holds the time series of the metrics of a VM in a rightsizing report,
+// aggregated into buckets. Returned by GET /vms/{id}/utilization/series.
+type VmUtilizationSeries struct {
+	ReportID    string
+	MOID        string
+	IntervalID  int // seconds between the samples of the report
+	Bucket      time.Duration
+	WindowStart time.Time
+	WindowEnd   time.Time
+	Metrics     []RightsizingMetricSeries
+}
+
+// RightsizingMetricSeries is the time series of one metric of a VM, ordered by time.
+type RightsizingMetricSeries struct {
+	MetricKey string
+	Points    []RightsizingSeriesPoint
+}
+
+// RightsizingSeriesPoint aggregates the samples of a metric taken in the bucket starting at
+// Timestamp. Max keeps the short peaks the average of a wide bucket flattens.
+type RightsizingSeriesPoint struct {
+	Timestamp   time.Time
+	SampleCount int
+	Average     float64
+	Min         float64
+	Max         float64
 }
@@ -0,0 +1,54 @@
+package models
+
+import "time"
+
+// DownsampleFunc is how a downsampled bucket aggregates its raw samples.
+type DownsampleFunc string
+
+const (
+	// DownsampleAvg keeps the average of the samples of a bucket.
+	DownsampleAvg DownsampleFunc = "avg"
+	// DownsampleMax keeps the highest sample of a bucket, so that peaks survive downsampling.
+	DownsampleMax DownsampleFunc = "max"
+)
+
+func (f DownsampleFunc) IsValid() bool {
+	return f == DownsampleAvg || f == DownsampleMax
+}
+
+// RightsizingAccumulation configures the rolling accumulation of rightsizing samples.
+//
+// Every Interval, the accumulation pulls the short-interval samples vCenter still holds and
+// appends them to the rolling store. Samples older than RawRetention are downsampled into
+// buckets of DownsampleInterval, and every sample older than Retention is dropped.
+type RightsizingAccumulation struct {
+	Enabled            bool
+	Interval           time.Duration
+	RawRetention       time.Duration
+	DownsampleInterval time.Duration
+	Downsample         DownsampleFunc
+	Retention          time.Duration
+}
+
+// RightsizingAccumulationStatus is the configuration of the accumulation, the outcome of its
+// last run and the extent of the rolling store.
+type RightsizingAccumulationStatus struct {
+	RightsizingAccumulation
+	LastRunAt       *time.Time
+	LastSampleCount int
+	LastError       string
+	SampleCount     int64
+	OldestSampleAt  *time.Time
+	NewestSampleAt  *time.Time
+}
+
+// RightsizingSample is one performance sample of a VM metric in the rolling store.
+// Interval is the sampling interval of vCenter, or the bucket of a downsampled sample.
+type RightsizingSample struct {
+	VCenter   string
+	MOID      string
+	MetricKey string
+	SampledAt time.Time
+	Interval  time.Duration
+	Value     float64
+}
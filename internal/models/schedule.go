@@ -0,0 +1,59 @@
+package models
+
+import "time"
+
+// CatchUpPolicy defines how a schedule handles runs missed while the agent was down.
+type CatchUpPolicy string
+
+const (
+	// CatchUpSkip drops missed runs and waits for the next occurrence.
+	CatchUpSkip CatchUpPolicy = "skip"
+	// CatchUpOnce runs a single catch-up collection as soon as possible,
+	// regardless of how many occurrences were missed.
+	CatchUpOnce CatchUpPolicy = "once"
+)
+
+func (p CatchUpPolicy) IsValid() bool {
+	return p == CatchUpSkip || p == CatchUpOnce
+}
+
+// CollectionSchedule is a recurring vCenter collection.
+// Exactly one of Cron or Interval is set.
+// Profile is the credential profile of the vCenter to collect and Mode how it is collected.
+type CollectionSchedule struct {
+	ID        string
+	Name      string
+	Cron      string
+	Interval  time.Duration
+	CatchUp   CatchUpPolicy
+	Profile   string
+	Mode      CollectionMode
+	Paused    bool
+	NextRunAt *time.Time
+	LastRunAt *time.Time
+	CreatedAt time.Time
+}
+
+// ScheduleRunStatus is the outcome of a single schedule trigger.
+type ScheduleRunStatus string
+
+const (
+	// ScheduleRunStarted - the collection was started.
+	ScheduleRunStarted ScheduleRunStatus = "started"
+	// ScheduleRunSkipped - the occurrence was not run (missed during downtime,
+	// or another collection or inspection was already in progress).
+	ScheduleRunSkipped ScheduleRunStatus = "skipped"
+	// ScheduleRunFailed - the collection could not be started.
+	ScheduleRunFailed ScheduleRunStatus = "failed"
+)
+
+// ScheduleRun is one entry of a schedule's run history.
+type ScheduleRun struct {
+	ID          int64
+	ScheduleID  string
+	ScheduledAt time.Time
+	TriggeredAt time.Time
+	Status      ScheduleRunStatus
+	CatchUp     bool
+	Error       string
+}
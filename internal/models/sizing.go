@@ -0,0 +1,127 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SizingSource is where the CPU and memory a VM needs on the target come from.
+type SizingSource string
+
+const (
+	// SizingProvisioned sizes VMs by their provisioned vCPUs and memory.
+	SizingProvisioned SizingSource = "provisioned"
+	// SizingRightsized sizes VMs by their p95 utilization in the latest rightsizing report,
+	// plus headroom, and falls back to provisioned for VMs without utilization.
+	SizingRightsized SizingSource = "rightsized"
+)
+
+// NodeProfile is a target OpenShift node type.
+type NodeProfile struct {
+	Name     string
+	Cores    int
+	MemoryMB int64
+	// CPUOvercommit and MemoryOvercommit are the ratios of VM resources to node resources.
+	CPUOvercommit    float64
+	MemoryOvercommit float64
+	// ReservedCores and ReservedMemoryMB are kept for the system and OpenShift workloads.
+	ReservedCores    float64
+	ReservedMemoryMB int64
+}
+
+// AllocatableCPU returns the vCPUs a node of the profile can allocate to VMs.
+func (p NodeProfile) AllocatableCPU() float64 {
+	return (float64(p.Cores) - p.ReservedCores) * p.CPUOvercommit
+}
+
+// AllocatableMemoryMB returns the memory, in MB, a node of the profile can allocate to VMs.
+func (p NodeProfile) AllocatableMemoryMB() float64 {
+	return float64(p.MemoryMB-p.ReservedMemoryMB) * p.MemoryOvercommit
+}
+
+// SizingRequest is a sizing simulation of the VMs of a collection, or of one of its groups,
+// on each node profile.
+type SizingRequest struct {
+	GroupID *uuid.UUID
+	Source  SizingSource
+	// HeadroomPct is added to the p95 utilization of rightsized VMs, 20% when nil.
+	HeadroomPct *float64
+	Profiles    []NodeProfile
+	// VCenter is the credential profile of the vCenter whose latest collection is sized; empty
+	// for the latest collection of any vCenter.
+	VCenter string
+}
+
+// SizingVM is the provisioned resources and utilization of a VM to size.
+type SizingVM struct {
+	VMID     string
+	Name     string
+	CPUs     int
+	MemoryMB int64
+	// CPUP95Pct and MemP95Pct are nil when the latest rightsizing report has no utilization for the VM.
+	CPUP95Pct *float64
+	MemP95Pct *float64
+}
+
+// SizingPlacement is the CPU, in vCPUs, and memory, in MB, a VM needs on the target.
+type SizingPlacement struct {
+	VMID     string
+	Name     string
+	CPU      float64
+	MemoryMB float64
+}
+
+// SizingNode is a node of the simulation and the VMs placed on it.
+type SizingNode struct {
+	Index        int
+	VMs          []SizingPlacement
+	UsedCPU      float64
+	UsedMemoryMB float64
+	// StrandedCPU and StrandedMemoryMB are the allocatable capacity left unused.
+	StrandedCPU      float64
+	StrandedMemoryMB float64
+}
+
+// SizingProfileResult is the simulation of a node profile.
+type SizingProfileResult struct {
+	Profile   NodeProfile
+	NodeCount int
+	Nodes     []SizingNode
+	// Unplaced lists the VMs larger than a node of the profile.
+	Unplaced         []SizingPlacement
+	StrandedCPU      float64
+	StrandedMemoryMB float64
+}
+
+// Tally sets the used and stranded capacity of the nodes, and of the profile, from the VMs
+// placed on them.
+func (r *SizingProfileResult) Tally() {
+	r.StrandedCPU, r.StrandedMemoryMB = 0, 0
+	for i := range r.Nodes {
+		n := &r.Nodes[i]
+		n.UsedCPU, n.UsedMemoryMB = 0, 0
+		for _, vm := range n.VMs {
+			n.UsedCPU += vm.CPU
+			n.UsedMemoryMB += vm.MemoryMB
+		}
+		n.StrandedCPU = r.Profile.AllocatableCPU() - n.UsedCPU
+		n.StrandedMemoryMB = r.Profile.AllocatableMemoryMB() - n.UsedMemoryMB
+		r.StrandedCPU += n.StrandedCPU
+		r.StrandedMemoryMB += n.StrandedMemoryMB
+	}
+}
+
+// SizingResult is a sizing simulation stored in a collection.
+type SizingResult struct {
+	ID          string
+	GroupID     *uuid.UUID
+	Source      SizingSource
+	HeadroomPct float64
+	VMCount     int
+	// ProvisionedFallbackCount counts the VMs sized by provisioned resources in a rightsized
+	// simulation, for lack of utilization.
+	ProvisionedFallbackCount int
+	Profiles                 []SizingProfileResult
+	CreatedAt                time.Time
+}
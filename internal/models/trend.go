@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// TrendMetrics are the readiness metrics of one collection. VMs excluded from migration
+// are not counted, as in comparisons.
+type TrendMetrics struct {
+	TotalVMs      int
+	Migratable    int
+	NonMigratable int
+	// ConcernsByCategory counts concerns (not VMs) per concern category.
+	ConcernsByCategory map[string]int
+	CPUs               int64
+	MemoryMiB          int64
+	StorageMiB         int64
+	Clusters           int
+}
+
+// TrendPoint is the metrics of one collection in a trend timeline.
+type TrendPoint struct {
+	CollectionID string
+	CreatedAt    time.Time
+	VCenter      string
+	Imported     bool
+	TrendMetrics
+}
+
+// TrendFilter restricts the VMs counted in a trend timeline. Expression and GroupID are
+// mutually exclusive.
+type TrendFilter struct {
+	VCenter    string
+	Expression string
+	GroupID    string
+}
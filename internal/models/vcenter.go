@@ -23,3 +23,15 @@ func (c Credentials) Validate() error {
 	}
 	return nil
 }
+
+// DefaultCredentialProfile is the profile used by PUT /credentials and by
+// collections started without an explicit vCenter.
+const DefaultCredentialProfile = "default"
+
+// CredentialProfile describes a named set of stored vCenter credentials.
+// It never carries the password.
+type CredentialProfile struct {
+	Name     string
+	URL      string
+	Username string
+}
@@ -59,6 +59,9 @@ type VM struct {
 	FaultToleranceEnabled bool
 	NestedHVEnabled       bool
 
+	// Exclusion holds the details of the migration exclusion, nil when none were given.
+	Exclusion *MigrationExclusion
+
 	ToolsStatus        string
 	ToolsRunningStatus string
 
@@ -75,7 +78,9 @@ type VM struct {
 	InspectionConcerns []VmInspectionConcern
 
 	Labels []string
-	Groups []string
+	// RuleLabels maps the labels applied by label rules to the rule that applied them.
+	RuleLabels map[string]string
+	Groups     []string
 
 	GuestApps []GuestApp
 }
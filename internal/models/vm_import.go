@@ -0,0 +1,39 @@
+package models
+
+// VMIdentity holds the keys a VM can be referred to by in an imported file.
+type VMIdentity struct {
+	ID         string
+	Name       string
+	UUID       string
+	SMBIOSUUID string
+}
+
+// VMImportKeyType is the column an imported row was matched on.
+type VMImportKeyType string
+
+const (
+	VMImportKeyID   VMImportKeyType = "id"
+	VMImportKeyUUID VMImportKeyType = "uuid"
+	VMImportKeyName VMImportKeyType = "name"
+)
+
+// VMImportRow is a row of an imported label or exclusion file.
+type VMImportRow struct {
+	// Row is the line of the row in the file, the header being line 1.
+	Row     int
+	Key     string
+	KeyType VMImportKeyType
+	// VMIDs is the matched VM, or the candidates of an ambiguous row.
+	VMIDs             []string
+	Labels            []string
+	MigrationExcluded *bool
+}
+
+// VMImportReport sorts the rows of an imported file by how they matched the VMs. Only
+// matched rows are applied.
+type VMImportReport struct {
+	Matched   []VMImportRow
+	Unmatched []VMImportRow
+	Ambiguous []VMImportRow
+	Applied   bool
+}
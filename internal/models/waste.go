@@ -0,0 +1,199 @@
+package models
+
+import (
+	"slices"
+	"time"
+)
+
+// WasteClass is a reason to retire a VM rather than migrate it.
+type WasteClass string
+
+const (
+	// WasteIdle is a powered-on VM with next to no CPU use and network I/O.
+	WasteIdle WasteClass = "idle"
+	// WastePoweredOff is a VM powered off and not powered on for a long time.
+	WastePoweredOff WasteClass = "powered_off"
+	// WasteOrphaned is a powered-on VM with no disk activity whose VMware Tools do not report.
+	WasteOrphaned WasteClass = "orphaned"
+	// WasteOversized is a VM whose rightsizing recommendation saves much of its resources.
+	WasteOversized WasteClass = "oversized"
+)
+
+// WasteClasses lists the known waste classes.
+var WasteClasses = []WasteClass{WasteIdle, WastePoweredOff, WasteOrphaned, WasteOversized}
+
+func (c WasteClass) IsValid() bool {
+	return slices.Contains(WasteClasses, c)
+}
+
+// DefaultWasteThresholds are the thresholds until they are configured.
+var DefaultWasteThresholds = WasteThresholds{
+	IdleCPUP95Pct:     5,
+	IdleNetP95KBps:    1,
+	PoweredOffDays:    30,
+	OrphanedIOPSP95:   1,
+	OversizedSavedPct: 50,
+}
+
+// WasteThresholds configures how VMs are classified as waste.
+//
+// A powered-on VM is idle when its CPU p95 is below IdleCPUP95Pct and its network p95 is at
+// most IdleNetP95KBps. A powered-off VM is powered_off when it was first seen powered off at
+// least PoweredOffDays ago; one whose power-off time is unknown is not classified. vSphere
+// clears the boot time of powered-off VMs, and their creation time says nothing of when they
+// were powered off. A powered-on VM is orphaned when its IOPS p95
+// is at most OrphanedIOPSP95 and VMware Tools report no guest OS. A VM is oversized when its
+// recommendation under the default rightsizing policy saves at least OversizedSavedPct of its
+// vCPUs or memory.
+type WasteThresholds struct {
+	IdleCPUP95Pct     float64
+	IdleNetP95KBps    float64
+	PoweredOffDays    int
+	OrphanedIOPSP95   float64
+	OversizedSavedPct float64
+	UpdatedAt         *time.Time
+}
+
+// WasteCandidate is what a VM is classified from: its inventory, lifecycle and utilization in
+// the latest rightsizing report. Utilization values are nil when the report has none.
+type WasteCandidate struct {
+	VMID                string
+	Name                string
+	Cluster             string
+	PowerState          string
+	ProvisionedCPUs     int
+	ProvisionedMemoryMB int64
+	// ToolsGuestOS is the guest OS reported by VMware Tools, empty when they do not report.
+	ToolsGuestOS string
+	// PoweredOffSince is when a collection first saw the VM powered off, nil when it is not
+	// powered off or no collection recorded it, e.g. in an imported collection.
+	PoweredOffSince *time.Time
+	CPUP95Pct       *float64
+	NetP95KBps      *float64
+	IOPSP95         *float64
+}
+
+// Classify returns the waste classes of a VM at a time, each with its evidence: the values it
+// was classified by and the thresholds they were held against. rec is the recommendation of the
+// VM under the default rightsizing policy, nil without one.
+func (t WasteThresholds) Classify(vm WasteCandidate, rec *RightsizingRecommendation, now time.Time) []VMWaste {
+	var out []VMWaste
+	add := func(class WasteClass, evidence map[string]any) {
+		out = append(out, VMWaste{
+			VMID:                vm.VMID,
+			Name:                vm.Name,
+			Cluster:             vm.Cluster,
+			PowerState:          vm.PowerState,
+			ProvisionedCPUs:     vm.ProvisionedCPUs,
+			ProvisionedMemoryMB: vm.ProvisionedMemoryMB,
+			Class:               class,
+			Evidence:            evidence,
+		})
+	}
+
+	poweredOn := vm.PowerState == "poweredOn"
+	if poweredOn && vm.CPUP95Pct != nil && vm.NetP95KBps != nil &&
+		*vm.CPUP95Pct < t.IdleCPUP95Pct && *vm.NetP95KBps <= t.IdleNetP95KBps {
+		add(WasteIdle, map[string]any{
+			"cpu_p95_pct":      *vm.CPUP95Pct,
+			"max_cpu_p95_pct":  t.IdleCPUP95Pct,
+			"net_p95_kbps":     *vm.NetP95KBps,
+			"max_net_p95_kbps": t.IdleNetP95KBps,
+		})
+	}
+
+	if vm.PowerState == "poweredOff" && vm.PoweredOffSince != nil {
+		days := int(now.Sub(*vm.PoweredOffSince) / (24 * time.Hour))
+		if days >= t.PoweredOffDays {
+			add(WastePoweredOff, map[string]any{
+				"powered_off_since":    vm.PoweredOffSince.UTC().Format(time.RFC3339),
+				"days_since":           days,
+				"min_powered_off_days": t.PoweredOffDays,
+			})
+		}
+	}
+
+	if poweredOn && vm.IOPSP95 != nil && *vm.IOPSP95 <= t.OrphanedIOPSP95 && vm.ToolsGuestOS == "" {
+		add(WasteOrphaned, map[string]any{
+			"iops_p95":        *vm.IOPSP95,
+			"max_iops_p95":    t.OrphanedIOPSP95,
+			"tools_reporting": false,
+		})
+	}
+
+	if rec != nil {
+		cpuPct := savedPct(float64(rec.SavedCPUs), float64(rec.ProvisionedCPUs))
+		memPct := savedPct(float64(rec.SavedMemoryMB), float64(rec.ProvisionedMemoryMB))
+		if cpuPct >= t.OversizedSavedPct || memPct >= t.OversizedSavedPct {
+			add(WasteOversized, map[string]any{
+				"provisioned_cpus":      rec.ProvisionedCPUs,
+				"recommended_cpus":      rec.RecommendedCPUs,
+				"saved_cpus_pct":        cpuPct,
+				"provisioned_memory_mb": rec.ProvisionedMemoryMB,
+				"recommended_memory_mb": rec.RecommendedMemoryMB,
+				"saved_memory_pct":      memPct,
+				"min_saved_pct":         t.OversizedSavedPct,
+				"confidence":            rec.Confidence,
+			})
+		}
+	}
+	return out
+}
+
+// savedPct returns saved as a percentage of provisioned, zero when nothing is provisioned.
+func savedPct(saved, provisioned float64) float64 {
+	if provisioned <= 0 {
+		return 0
+	}
+	return saved / provisioned * 100
+}
+
+// VMWaste is a waste class of a VM with the evidence it was classified by. A VM may have
+// several classes.
+type VMWaste struct {
+	VMID                string
+	Name                string
+	Cluster             string
+	PowerState          string
+	ProvisionedCPUs     int
+	ProvisionedMemoryMB int64
+	Class               WasteClass
+	Evidence            map[string]any
+}
+
+// WasteClassSummary totals the VMs of a waste class and the resources retiring them frees.
+type WasteClassSummary struct {
+	Class               WasteClass
+	VMCount             int
+	ProvisionedCPUs     int
+	ProvisionedMemoryMB int64
+}
+
+// WasteAnalysis is the classification of the VMs of a collection. ReportID is the rightsizing
+// report the utilization came from, empty without one. PoweredOffUnknown counts the powered-off
+// VMs left unclassified because their power-off time is unknown.
+type WasteAnalysis struct {
+	ReportID          string
+	Thresholds        WasteThresholds
+	AnalyzedAt        time.Time
+	VMs               []VMWaste
+	Summary           []WasteClassSummary
+	PoweredOffUnknown int
+}
+
+// Tally totals the VMs of each class into the summary, in the order of WasteClasses.
+func (a *WasteAnalysis) Tally() {
+	a.Summary = make([]WasteClassSummary, 0, len(WasteClasses))
+	for _, class := range WasteClasses {
+		s := WasteClassSummary{Class: class}
+		for _, vm := range a.VMs {
+			if vm.Class != class {
+				continue
+			}
+			s.VMCount++
+			s.ProvisionedCPUs += vm.ProvisionedCPUs
+			s.ProvisionedMemoryMB += vm.ProvisionedMemoryMB
+		}
+		a.Summary = append(a.Summary, s)
+	}
+}
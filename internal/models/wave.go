@@ -0,0 +1,102 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Wave is an ordered set of groups and VMs migrated together within a target window.
+// The VMs of a wave are the VMs of its groups, as matched in the latest collection, and
+// the VMs it lists.
+type Wave struct {
+	Name        string
+	Description string
+	// Position orders the waves, lowest first.
+	Position    int
+	WindowStart *time.Time
+	WindowEnd   *time.Time
+	// ForecastPair is the forecaster datastore pair whose throughput estimates the transfer.
+	ForecastPair string
+	GroupIDs     []uuid.UUID
+	VMIDs        []string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// WaveUpdate is a partial update of Wave; nil fields are left unchanged.
+type WaveUpdate struct {
+	Description  *string
+	Position     *int
+	WindowStart  *time.Time
+	WindowEnd    *time.Time
+	ForecastPair *string
+	GroupIDs     *[]uuid.UUID
+	VMIDs        *[]string
+}
+
+// WaveIssueKind is the kind of a problem the wave planner found.
+type WaveIssueKind string
+
+const (
+	// WaveIssueDuplicateVM is a VM planned in more than one wave.
+	WaveIssueDuplicateVM WaveIssueKind = "duplicate_vm"
+	// WaveIssueExcludedVM is a VM excluded from migration.
+	WaveIssueExcludedVM WaveIssueKind = "excluded_vm"
+	// WaveIssueNotMigratableVM is a VM with critical concerns.
+	WaveIssueNotMigratableVM WaveIssueKind = "not_migratable_vm"
+	// WaveIssueMissingVM is a VM listed by a wave that is not in the latest collection.
+	WaveIssueMissingVM WaveIssueKind = "missing_vm"
+	// WaveIssueMissingGroup is a group of a wave that is not in the latest collection.
+	WaveIssueMissingGroup WaveIssueKind = "missing_group"
+	// WaveIssueNoForecast is a wave whose transfer cannot be estimated: it has no forecast
+	// pair or the pair has no benchmark runs.
+	WaveIssueNoForecast WaveIssueKind = "no_forecast"
+	// WaveIssueExceedsWindow is a wave whose expected transfer does not fit its window.
+	WaveIssueExceedsWindow WaveIssueKind = "exceeds_window"
+)
+
+// WaveIssue is a problem the wave planner found in a wave.
+type WaveIssue struct {
+	Kind    WaveIssueKind
+	Message string
+	// VMID is set on the issues about a VM, GroupID on the issues about a group.
+	VMID    string
+	GroupID *uuid.UUID
+	// OtherWave is the first other wave planning the VM of a duplicate_vm issue.
+	OtherWave string
+}
+
+// WaveReadiness counts the VMs of a wave by migration status, with the same rules as the VM list.
+type WaveReadiness struct {
+	Ready    int
+	Review   int
+	Blocked  int
+	Excluded int
+}
+
+// WaveSummary is the plan of a wave.
+type WaveSummary struct {
+	Wave      Wave
+	VMCount   int
+	DiskMB    int64
+	Readiness WaveReadiness
+	// Transfer is the estimated duration of the transfer of the wave's disks, nil when
+	// it cannot be estimated.
+	Transfer *EstimateRange
+	// WindowCapacityMB is the disk the expected throughput transfers within the window,
+	// nil when the wave has no window or its transfer cannot be estimated.
+	WindowCapacityMB *int64
+	Issues           []WaveIssue
+}
+
+// Ready reports whether the wave can be migrated as planned: it has no issue.
+func (s WaveSummary) Ready() bool {
+	return len(s.Issues) == 0
+}
+
+// WavePlan is the plan of every wave against a collection, in wave order.
+type WavePlan struct {
+	CollectionID string
+	Waves        []WaveSummary
+}
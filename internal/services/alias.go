@@ -88,4 +88,6 @@ var (
 	V2WithKeyManager        = v2.WithKeyManager
 	V2WithPool              = v2.WithPool
 	V2WithOpaValidator      = v2.WithOpaValidatior
+
+	V2ValidateBundlePublicKey = v2.ValidateBundlePublicKey
 )
@@ -14,6 +14,7 @@ import (
 
 	"github.com/xuri/excelize/v2"
 
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
 	"github.com/kubev2v/assisted-migration-agent/internal/store"
 )
 
@@ -144,6 +145,7 @@ var sheetNames = map[string]string{
 	"groups":           "Groups",
 	"inspection":       "Inspection",
 	"storage-forecast": "Storage Forecast",
+	"recommendations":  "Recommendations",
 }
 
 func scopeSheetName(scope string) string {
@@ -192,6 +194,9 @@ func (s *ExportService) exportScope(ctx context.Context, exportStore *store.Expo
 	if scope == "utilization" {
 		return exportStore.ExportUtilization(ctx, tmpDir)
 	}
+	if scope == "recommendations" {
+		return exportStore.ExportRecommendations(ctx, tmpDir, models.DefaultRightsizingPolicy)
+	}
 	filename, ok := exportStore.ScopeFilename(scope)
 	if !ok {
 		return fmt.Errorf("unknown export scope: %s", scope)
@@ -0,0 +1,618 @@
+package v2
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	"github.com/kubev2v/assisted-migration-agent/internal/store/migrations"
+	"github.com/kubev2v/assisted-migration-agent/pkg/crypto"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+const (
+	bundleFormatVersion = 1
+
+	bundleManifestFile  = "manifest.json"
+	bundleSignatureFile = "manifest.sig"
+	bundleDatabaseFile  = "collection.duckdb"
+	bundleGroupsFile    = "groups.json"
+	bundleLabelsFile    = "labels.json"
+
+	// bundleMaxManifestSize caps the manifest and signature entries, which are read in memory.
+	bundleMaxManifestSize = 1 << 20
+
+	// bundleSigningContext separates the bundle signing key from the credentials key it is derived from.
+	bundleSigningContext = "assisted-migration-agent/collection-bundle/v1"
+)
+
+// bundleManifest describes the content of a collection bundle. Its signature covers
+// the SHA-256 of every other file, so the manifest alone authenticates the bundle.
+type bundleManifest struct {
+	FormatVersion int               `json:"formatVersion"`
+	AgentVersion  string            `json:"agentVersion"`
+	AgentID       string            `json:"agentId"`
+	ExportedAt    time.Time         `json:"exportedAt"`
+	PublicKey     string            `json:"publicKey"`
+	Collection    bundleCollection  `json:"collection"`
+	Files         map[string]string `json:"files"`
+}
+
+type bundleCollection struct {
+	ID          string                `json:"id"`
+	Database    string                `json:"database"`
+	CreatedAt   time.Time             `json:"createdAt"`
+	VCenter     string                `json:"vcenter"`
+	URL         string                `json:"url,omitempty"`
+	Mode        models.CollectionMode `json:"mode"`
+	DisplayName string                `json:"displayName,omitempty"`
+	Notes       string                `json:"notes,omitempty"`
+}
+
+type bundleGroup struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Filter      string `json:"filter"`
+}
+
+// Bundle is a collection bundle staged on disk and ready to be written.
+type Bundle struct {
+	// Filename is the suggested file name of the archive.
+	Filename string
+	dir      string
+	manifest []byte
+	files    []string
+}
+
+// Stream writes the bundle as a gzip-compressed tar archive.
+func (b *Bundle) Stream(w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	signature, err := os.ReadFile(filepath.Join(b.dir, bundleSignatureFile))
+	if err != nil {
+		return err
+	}
+	if err := writeTarFile(tw, bundleManifestFile, b.manifest); err != nil {
+		return err
+	}
+	if err := writeTarFile(tw, bundleSignatureFile, signature); err != nil {
+		return err
+	}
+
+	for _, name := range b.files {
+		f, err := os.Open(filepath.Join(b.dir, name))
+		if err != nil {
+			return err
+		}
+		info, err := f.Stat()
+		if err != nil {
+			_ = f.Close()
+			return err
+		}
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: info.Size(), ModTime: info.ModTime()}); err != nil {
+			_ = f.Close()
+			return err
+		}
+		_, err = io.Copy(tw, f)
+		_ = f.Close()
+		if err != nil {
+			return fmt.Errorf("writing %s: %w", name, err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// Close removes the staged files.
+func (b *Bundle) Close() error {
+	return os.RemoveAll(b.dir)
+}
+
+// BundleService exports collections as signed, portable bundles and imports bundles
+// exported by other agents.
+//
+// Bundles are signed with an Ed25519 key derived from the agent's credentials key and
+// carry the public key. A bundle is only imported when it was signed by the agent itself
+// or by one of the trusted keys configured on the agent, so that a bundle altered and
+// re-signed with another key is rejected. The signer fingerprint is recorded on the
+// imported collection.
+type BundleService struct {
+	pool         *store.Pool
+	dataDir      string
+	agentVersion string
+	agentID      string
+	signingKey   ed25519.PrivateKey
+	trustedKeys  map[string]bool
+}
+
+// NewBundleService creates the bundle service. trustedKeys are the base64 Ed25519 public
+// keys of the agents whose bundles are accepted on import, besides the agent's own key.
+func NewBundleService(pool *store.Pool, dataDir, agentVersion, agentID string, km *crypto.KeyManager, trustedKeys []string) *BundleService {
+	seed := sha256.Sum256(append([]byte(bundleSigningContext), km.Key()...))
+	s := &BundleService{
+		pool:         pool,
+		dataDir:      dataDir,
+		agentVersion: agentVersion,
+		agentID:      agentID,
+		signingKey:   ed25519.NewKeyFromSeed(seed[:]),
+		trustedKeys:  make(map[string]bool, len(trustedKeys)+1),
+	}
+	s.trustedKeys[s.PublicKey()] = true
+	for _, key := range trustedKeys {
+		s.trustedKeys[key] = true
+	}
+	return s
+}
+
+// PublicKey returns the base64 public key the agent signs its bundles with. It is the key
+// to add to the trusted bundle keys of the agents importing them.
+func (s *BundleService) PublicKey() string {
+	return base64.StdEncoding.EncodeToString(s.signingKey.Public().(ed25519.PublicKey))
+}
+
+// ValidateBundlePublicKey checks that key is a base64 Ed25519 public key.
+func ValidateBundlePublicKey(key string) error {
+	decoded, err := base64.StdEncoding.DecodeString(key)
+	if err != nil || len(decoded) != ed25519.PublicKeySize {
+		return fmt.Errorf("invalid bundle public key %q: must be a base64 Ed25519 public key", key)
+	}
+	return nil
+}
+
+// Export stages a bundle of the collection: a consistent copy of its database, its
+// metadata, and the signed manifest. Groups and VM labels are also written as JSON, so
+// they can be reviewed without DuckDB, and are restored from it on import. The caller
+// must Close it.
+func (s *BundleService) Export(ctx context.Context, id string) (*Bundle, error) {
+	if id == store.MainDatabaseID {
+		return nil, srvErrors.NewResourceNotFoundError("collection", id)
+	}
+	db, err := s.pool.Get(id)
+	if err != nil {
+		return nil, srvErrors.NewResourceNotFoundError("collection", id)
+	}
+
+	st, err := db.Store()
+	if err != nil {
+		return nil, err
+	}
+	src, err := st.CollectionSource().Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reading collection source: %w", err)
+	}
+	groups, err := st.Group().List(ctx, nil, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("listing groups: %w", err)
+	}
+	labels, err := st.VM().ListLabels(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing VM labels: %w", err)
+	}
+
+	mainSt, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	name := CollectionDatabaseName(db)
+	meta, err := mainSt.CollectionMetadata().Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp(s.dataDir, "bundle-export-*")
+	if err != nil {
+		return nil, fmt.Errorf("creating bundle staging dir: %w", err)
+	}
+	b := &Bundle{
+		Filename: name + ".bundle.tar.gz",
+		dir:      dir,
+		files:    []string{bundleDatabaseFile, bundleGroupsFile, bundleLabelsFile},
+	}
+	if err := s.stage(ctx, b, db, src, meta, groups, labels); err != nil {
+		_ = b.Close()
+		return nil, err
+	}
+	return b, nil
+}
+
+func (s *BundleService) stage(ctx context.Context, b *Bundle, db *store.Database, src *models.CollectionSource, meta models.CollectionMetadata, groups []models.Group, labels map[string][]string) error {
+	// CloneTo exports and re-imports the database, which gives a consistent file even
+	// while the collection is being read or written.
+	clone, err := db.CloneTo(ctx, "bundle", filepath.Join(b.dir, bundleDatabaseFile))
+	if err != nil {
+		return fmt.Errorf("copying collection database: %w", err)
+	}
+	if err := clone.Close(); err != nil {
+		return fmt.Errorf("closing collection copy: %w", err)
+	}
+
+	bundleGroups := make([]bundleGroup, 0, len(groups))
+	for _, g := range groups {
+		bundleGroups = append(bundleGroups, bundleGroup{ID: g.ID.String(), Name: g.Name, Description: g.Description, Filter: g.Filter})
+	}
+	if err := writeJSONFile(filepath.Join(b.dir, bundleGroupsFile), bundleGroups); err != nil {
+		return err
+	}
+	if err := writeJSONFile(filepath.Join(b.dir, bundleLabelsFile), labels); err != nil {
+		return err
+	}
+
+	files := make(map[string]string, len(b.files))
+	for _, name := range b.files {
+		sum, err := fileSHA256(filepath.Join(b.dir, name))
+		if err != nil {
+			return err
+		}
+		files[name] = sum
+	}
+
+	manifest, err := json.MarshalIndent(bundleManifest{
+		FormatVersion: bundleFormatVersion,
+		AgentVersion:  s.agentVersion,
+		AgentID:       s.agentID,
+		ExportedAt:    time.Now().UTC(),
+		PublicKey:     s.PublicKey(),
+		Collection: bundleCollection{
+			ID:          db.ID,
+			Database:    CollectionDatabaseName(db),
+			CreatedAt:   db.CreatedAt.UTC(),
+			VCenter:     src.VCenter,
+			URL:         src.URL,
+			Mode:        src.Mode,
+			DisplayName: meta.DisplayName,
+			Notes:       meta.Notes,
+		},
+		Files: files,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding bundle manifest: %w", err)
+	}
+	b.manifest = manifest
+
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(s.signingKey, manifest))
+	return os.WriteFile(filepath.Join(b.dir, bundleSignatureFile), []byte(signature), 0600)
+}
+
+// Import validates a bundle, migrates its collection database and registers it in the pool.
+// The collection keeps its original creation time and is flagged as imported, so it never
+// becomes the latest collection nor the base of a sync. Invalid bundles and bundles of an
+// untrusted signer are reported as validation errors, and a bundle whose collection already
+// exists as a duplicate resource.
+func (s *BundleService) Import(ctx context.Context, r io.Reader) (*store.Database, models.CollectionMetadata, error) {
+	dir, err := os.MkdirTemp(s.dataDir, "bundle-import-*")
+	if err != nil {
+		return nil, models.CollectionMetadata{}, fmt.Errorf("creating bundle staging dir: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	manifest, err := s.extract(r, dir)
+	if err != nil {
+		return nil, models.CollectionMetadata{}, err
+	}
+
+	name := fmt.Sprintf("collection_%d", manifest.Collection.CreatedAt.Unix())
+	dbPath := filepath.Join(s.dataDir, name+".duckdb")
+	if _, err := os.Stat(dbPath); err == nil {
+		return nil, models.CollectionMetadata{}, srvErrors.NewDuplicateResourceError("collection", "database", name)
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, models.CollectionMetadata{}, err
+	}
+
+	mainSt, err := s.mainStore()
+	if err != nil {
+		return nil, models.CollectionMetadata{}, err
+	}
+	// Like a running collection, the import is marked in the catalog until the database is
+	// registered, so that a file left behind by an interrupted import is cleaned up at startup.
+	if _, err := mainSt.Collection().Create(ctx, name); err != nil {
+		return nil, models.CollectionMetadata{}, fmt.Errorf("creating collection marker for %s: %w", name, err)
+	}
+	defer func() {
+		if err := mainSt.Collection().Delete(ctx, name); err != nil {
+			zap.S().Named("bundle_service").Warnw("failed to delete collection marker", "database", name, "error", err)
+		}
+	}()
+
+	if err := os.Rename(filepath.Join(dir, bundleDatabaseFile), dbPath); err != nil {
+		return nil, models.CollectionMetadata{}, fmt.Errorf("moving collection database: %w", err)
+	}
+
+	db, meta, err := s.register(ctx, mainSt, name, dbPath, dir, manifest)
+	if err != nil {
+		_ = os.Remove(dbPath)
+		return nil, models.CollectionMetadata{}, err
+	}
+
+	zap.S().Named("bundle_service").Infow("collection bundle imported",
+		"id", db.ID, "database", name, "vcenter", db.VCenter,
+		"agent_id", manifest.AgentID, "agent_version", manifest.AgentVersion, "signer", db.Signer)
+	return db, meta, nil
+}
+
+func (s *BundleService) register(ctx context.Context, mainSt *store.Store2, name, dbPath, dir string, manifest *bundleManifest) (*store.Database, models.CollectionMetadata, error) {
+	hash := sha256.Sum256([]byte(dbPath))
+	id := hex.EncodeToString(hash[:])[:6]
+
+	db, err := s.pool.NewDatabase(id, dbPath, manifest.Collection.CreatedAt, store.LazyConnectionInitilization, 512, store.ReadWriteDatabase)
+	if err != nil {
+		return nil, models.CollectionMetadata{}, fmt.Errorf("opening imported collection: %w", err)
+	}
+
+	if err := db.Migrate(ctx, func(ctx context.Context, sqlDb *sql.DB) error {
+		if err := migrations.RunCollection(ctx, sqlDb, name); err != nil {
+			return err
+		}
+		st, err := db.Store()
+		if err != nil {
+			return err
+		}
+		if err := st.CollectionSource().Save(ctx, models.CollectionSource{
+			VCenter:  manifest.Collection.VCenter,
+			URL:      manifest.Collection.URL,
+			Mode:     manifest.Collection.Mode,
+			Imported: true,
+			Signer:   signerFingerprint(manifest.PublicKey),
+		}); err != nil {
+			return err
+		}
+		return restoreBundleMetadata(ctx, st, dir, manifest)
+	}); err != nil {
+		_ = db.Close()
+		return nil, models.CollectionMetadata{}, fmt.Errorf("migrating imported collection: %w", err)
+	}
+	if err := db.Close(); err != nil {
+		return nil, models.CollectionMetadata{}, err
+	}
+	db.VCenter = manifest.Collection.VCenter
+	db.Imported = true
+	db.Signer = signerFingerprint(manifest.PublicKey)
+
+	meta, err := mainSt.CollectionMetadata().Update(ctx, name, models.CollectionMetadataUpdate{
+		DisplayName: &manifest.Collection.DisplayName,
+		Notes:       &manifest.Collection.Notes,
+	})
+	if err != nil {
+		return nil, models.CollectionMetadata{}, err
+	}
+
+	s.pool.Add(db)
+	return db, meta, nil
+}
+
+// extract unpacks a bundle into dir, checking the manifest signature, its signer and the
+// checksum of every file it lists. Unknown entries are rejected.
+func (s *BundleService) extract(r io.Reader, dir string) (*bundleManifest, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, srvErrors.NewValidationError("invalid bundle: not a gzip archive")
+	}
+	defer func() { _ = gz.Close() }()
+
+	var manifestData, signature []byte
+	sums := make(map[string]string)
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, srvErrors.NewValidationError(fmt.Sprintf("invalid bundle: %v", err))
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			return nil, srvErrors.NewValidationError(fmt.Sprintf("invalid bundle: unexpected entry %s", hdr.Name))
+		}
+
+		switch hdr.Name {
+		case bundleManifestFile:
+			if manifestData, err = readBundleEntry(tr, hdr); err != nil {
+				return nil, err
+			}
+		case bundleSignatureFile:
+			if signature, err = readBundleEntry(tr, hdr); err != nil {
+				return nil, err
+			}
+		case bundleDatabaseFile, bundleGroupsFile, bundleLabelsFile:
+			sum, err := extractFile(tr, filepath.Join(dir, hdr.Name))
+			if err != nil {
+				return nil, err
+			}
+			sums[hdr.Name] = sum
+		default:
+			return nil, srvErrors.NewValidationError(fmt.Sprintf("invalid bundle: unexpected entry %s", hdr.Name))
+		}
+	}
+
+	if manifestData == nil || signature == nil {
+		return nil, srvErrors.NewValidationError("invalid bundle: missing manifest or signature")
+	}
+
+	var manifest bundleManifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		return nil, srvErrors.NewValidationError(fmt.Sprintf("invalid bundle manifest: %v", err))
+	}
+	if manifest.FormatVersion != bundleFormatVersion {
+		return nil, srvErrors.NewValidationError(fmt.Sprintf("unsupported bundle format version %d", manifest.FormatVersion))
+	}
+
+	publicKey, err := base64.StdEncoding.DecodeString(manifest.PublicKey)
+	if err != nil || len(publicKey) != ed25519.PublicKeySize {
+		return nil, srvErrors.NewValidationError("invalid bundle: malformed public key")
+	}
+	sig, err := base64.StdEncoding.DecodeString(string(signature))
+	if err != nil || !ed25519.Verify(publicKey, manifestData, sig) {
+		return nil, srvErrors.NewValidationError("invalid bundle: signature verification failed")
+	}
+	if !s.trustedKeys[manifest.PublicKey] {
+		return nil, srvErrors.NewValidationError(fmt.Sprintf(
+			"untrusted bundle signer %s: add its public key %s to the trusted bundle keys to import it",
+			signerFingerprint(manifest.PublicKey), manifest.PublicKey))
+	}
+
+	if _, ok := manifest.Files[bundleDatabaseFile]; !ok {
+		return nil, srvErrors.NewValidationError("invalid bundle: no collection database")
+	}
+	for name, want := range manifest.Files {
+		if sums[name] != want {
+			return nil, srvErrors.NewValidationError(fmt.Sprintf("invalid bundle: checksum mismatch for %s", name))
+		}
+	}
+	if manifest.Collection.CreatedAt.IsZero() {
+		return nil, srvErrors.NewValidationError("invalid bundle: collection creation time missing")
+	}
+
+	return &manifest, nil
+}
+
+// restoreBundleMetadata applies the groups and VM labels of the bundle to the imported
+// database. Only the files listed in the signed manifest are applied.
+func restoreBundleMetadata(ctx context.Context, st *store.Store2, dir string, manifest *bundleManifest) error {
+	if _, ok := manifest.Files[bundleGroupsFile]; ok {
+		var groups []bundleGroup
+		if err := readJSONFile(filepath.Join(dir, bundleGroupsFile), &groups); err != nil {
+			return err
+		}
+		ids := make([]uuid.UUID, 0, len(groups))
+		for _, bg := range groups {
+			g := models.Group{Name: bg.Name, Description: bg.Description, Filter: bg.Filter}
+			id, err := uuid.Parse(bg.ID)
+			if err != nil {
+				return srvErrors.NewValidationError(fmt.Sprintf("invalid bundle: malformed group id %q", bg.ID))
+			}
+			restored, err := st.Group().Update(ctx, id, g)
+			if srvErrors.IsResourceNotFoundError(err) {
+				restored, err = st.Group().Create(ctx, g)
+			}
+			if err != nil {
+				return fmt.Errorf("restoring group %s: %w", bg.Name, err)
+			}
+			ids = append(ids, restored.ID)
+		}
+		if len(ids) > 0 {
+			if err := st.Group().RefreshMatches(ctx, ids...); err != nil {
+				return fmt.Errorf("refreshing group matches: %w", err)
+			}
+		}
+	}
+
+	if _, ok := manifest.Files[bundleLabelsFile]; ok {
+		var labels map[string][]string
+		if err := readJSONFile(filepath.Join(dir, bundleLabelsFile), &labels); err != nil {
+			return err
+		}
+		for vmID, vmLabels := range labels {
+			if err := st.VM().UpdateLabels(ctx, vmID, vmLabels); err != nil {
+				return fmt.Errorf("restoring labels of VM %s: %w", vmID, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *BundleService) mainStore() (*store.Store2, error) {
+	mainDB, err := s.pool.Get(store.MainDatabaseID)
+	if err != nil {
+		return nil, fmt.Errorf("getting main database: %w", err)
+	}
+	return mainDB.Store()
+}
+
+// signerFingerprint returns the hex SHA-256 prefix of a base64 public key.
+func signerFingerprint(publicKey string) string {
+	sum := sha256.Sum256([]byte(publicKey))
+	return hex.EncodeToString(sum[:8])
+}
+
+func writeTarFile(tw *tar.Writer, name string, data []byte) error {
+	if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(data)), ModTime: time.Now()}); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
+
+func writeJSONFile(path string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("encoding %s: %w", filepath.Base(path), err)
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+func readJSONFile(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return srvErrors.NewValidationError(fmt.Sprintf("invalid bundle: malformed %s: %v", filepath.Base(path), err))
+	}
+	return nil
+}
+
+// readBundleEntry reads a small bundle entry in memory, rejecting entries over bundleMaxManifestSize.
+func readBundleEntry(r io.Reader, hdr *tar.Header) ([]byte, error) {
+	if hdr.Size > bundleMaxManifestSize {
+		return nil, srvErrors.NewValidationError(fmt.Sprintf("invalid bundle: %s exceeds %d bytes", hdr.Name, bundleMaxManifestSize))
+	}
+	data, err := io.ReadAll(io.LimitReader(r, bundleMaxManifestSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("reading bundle %s: %w", hdr.Name, err)
+	}
+	if len(data) > bundleMaxManifestSize {
+		return nil, srvErrors.NewValidationError(fmt.Sprintf("invalid bundle: %s exceeds %d bytes", hdr.Name, bundleMaxManifestSize))
+	}
+	return data, nil
+}
+
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("hashing %s: %w", filepath.Base(path), err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func extractFile(r io.Reader, path string) (string, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+
+	var h hash.Hash = sha256.New()
+	if _, err := io.Copy(io.MultiWriter(f, h), r); err != nil {
+		return "", fmt.Errorf("extracting %s: %w", filepath.Base(path), err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,257 @@
+package v2_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	v2 "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	"github.com/kubev2v/assisted-migration-agent/pkg/crypto"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+// repackBundle rewrites a bundle, passing every entry through edit.
+func repackBundle(data []byte, edit func(name string, content []byte) []byte) []byte {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	tr := tar.NewReader(gz)
+
+	var out bytes.Buffer
+	gzw := gzip.NewWriter(&out)
+	tw := tar.NewWriter(gzw)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		content, err := io.ReadAll(tr)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		content = edit(hdr.Name, content)
+		hdr.Size = int64(len(content))
+		ExpectWithOffset(1, tw.WriteHeader(hdr)).To(Succeed())
+		_, err = tw.Write(content)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	}
+	ExpectWithOffset(1, tw.Close()).To(Succeed())
+	ExpectWithOffset(1, gzw.Close()).To(Succeed())
+	return out.Bytes()
+}
+
+var _ = Describe("BundleService", func() {
+	var (
+		ctx       context.Context
+		srcDir    string
+		dstDir    string
+		srcPool   *store.Pool
+		dstPool   *store.Pool
+		exporter  *v2.BundleService
+		importer  *v2.BundleService
+		collected *store.Database
+		bundle    []byte
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		srcDir, err = os.MkdirTemp("", "bundle-src-*")
+		Expect(err).NotTo(HaveOccurred())
+		dstDir, err = os.MkdirTemp("", "bundle-dst-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		srcPool = newTestPool(srcDir)
+		dstPool = newTestPool(dstDir)
+
+		srcKeys, err := crypto.NewKeyManager(srcDir)
+		Expect(err).NotTo(HaveOccurred())
+		dstKeys, err := crypto.NewKeyManager(dstDir)
+		Expect(err).NotTo(HaveOccurred())
+		exporter = v2.NewBundleService(srcPool, srcDir, "v1.2.3", "agent-a", srcKeys, nil)
+		importer = v2.NewBundleService(dstPool, dstDir, "v1.2.3", "agent-b", dstKeys, []string{exporter.PublicKey()})
+
+		var st *store.Store2
+		collected, st = addTestCollection(srcPool, "src001", time.Unix(1700000000, 0))
+		Expect(st.CollectionSource().Save(ctx, models.CollectionSource{VCenter: "vc-east", URL: "https://vc-east.local/sdk", Mode: models.CollectionModeFull})).To(Succeed())
+		_, err = st.Querier().ExecContext(ctx,
+			`INSERT INTO vinfo ("VM ID", "VM", "Cluster", "Powerstate", "Template", "Memory", "CPUs") VALUES ('vm-1', 'vm-1', 'cluster-a', 'poweredOn', false, 1024, 2)`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(st.VM().UpdateLabels(ctx, "vm-1", []string{"approved"})).To(Succeed())
+		_, err = st.Group().Create(ctx, models.Group{Name: "cluster-a", Filter: "cluster = 'cluster-a'"})
+		Expect(err).NotTo(HaveOccurred())
+		collected.VCenter = "vc-east"
+
+		mainDB, err := srcPool.Get(store.MainDatabaseID)
+		Expect(err).NotTo(HaveOccurred())
+		mainSt, err := mainDB.Store()
+		Expect(err).NotTo(HaveOccurred())
+		name := "before remediation"
+		_, err = mainSt.CollectionMetadata().Update(ctx, "collection_1700000000", models.CollectionMetadataUpdate{DisplayName: &name})
+		Expect(err).NotTo(HaveOccurred())
+
+		b, err := exporter.Export(ctx, collected.ID)
+		Expect(err).NotTo(HaveOccurred())
+		var buf bytes.Buffer
+		Expect(b.Stream(&buf)).To(Succeed())
+		Expect(b.Close()).To(Succeed())
+		bundle = buf.Bytes()
+	})
+
+	AfterEach(func() {
+		srcPool.Close()
+		dstPool.Close()
+		_ = os.RemoveAll(srcDir)
+		_ = os.RemoveAll(dstDir)
+	})
+
+	It("imports an exported collection as a non-latest collection", func() {
+		db, meta, err := importer.Import(ctx, bytes.NewReader(bundle))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(db.Imported).To(BeTrue())
+		Expect(db.VCenter).To(Equal("vc-east"))
+		Expect(db.CreatedAt.Unix()).To(Equal(int64(1700000000)))
+		Expect(meta.DisplayName).To(Equal("before remediation"))
+		Expect(db.Signer).NotTo(BeEmpty())
+
+		_, err = dstPool.Latest()
+		Expect(err).To(HaveOccurred())
+
+		st, err := db.Store()
+		Expect(err).NotTo(HaveOccurred())
+		src, err := st.CollectionSource().Get(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(src.Imported).To(BeTrue())
+		Expect(src.URL).To(Equal("https://vc-east.local/sdk"))
+		Expect(src.Signer).To(Equal(db.Signer))
+
+		var count int
+		Expect(st.Querier().QueryRowContext(ctx, `SELECT count(*) FROM vinfo`).Scan(&count)).To(Succeed())
+		Expect(count).To(Equal(1))
+	})
+
+	It("restores the groups and VM labels of the collection", func() {
+		db, _, err := importer.Import(ctx, bytes.NewReader(bundle))
+		Expect(err).NotTo(HaveOccurred())
+
+		st, err := db.Store()
+		Expect(err).NotTo(HaveOccurred())
+		groups, err := st.Group().List(ctx, nil, 0, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(groups).To(HaveLen(1))
+		Expect(groups[0].Name).To(Equal("cluster-a"))
+		matched, err := st.Group().GetMatchedIDs(ctx, groups[0].ID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(matched).To(ConsistOf("vm-1"))
+
+		labels, err := st.VM().ListLabels(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(labels).To(Equal(map[string][]string{"vm-1": {"approved"}}))
+	})
+
+	It("rejects a bundle with an oversized manifest", func() {
+		oversized := repackBundle(bundle, func(name string, content []byte) []byte {
+			if name == "manifest.json" {
+				return append(content, bytes.Repeat([]byte(" "), 2<<20)...)
+			}
+			return content
+		})
+
+		_, _, err := importer.Import(ctx, bytes.NewReader(oversized))
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("exceeds"))
+		Expect(dstPool.List()).To(HaveLen(1))
+	})
+
+	It("rejects a bundle of a collection that already exists", func() {
+		_, _, err := importer.Import(ctx, bytes.NewReader(bundle))
+		Expect(err).NotTo(HaveOccurred())
+
+		_, _, err = importer.Import(ctx, bytes.NewReader(bundle))
+		Expect(srvErrors.IsDuplicateResourceError(err)).To(BeTrue())
+	})
+
+	It("rejects a bundle whose content was altered", func() {
+		tampered := repackBundle(bundle, func(name string, content []byte) []byte {
+			if name == "labels.json" {
+				return []byte(`{"vm-1":["rejected"]}`)
+			}
+			return content
+		})
+
+		_, _, err := importer.Import(ctx, bytes.NewReader(tampered))
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+		Expect(dstPool.List()).To(HaveLen(1))
+	})
+
+	It("rejects a bundle whose manifest was altered", func() {
+		tampered := repackBundle(bundle, func(name string, content []byte) []byte {
+			if name == "manifest.json" {
+				return bytes.Replace(content, []byte("vc-east"), []byte("vc-west"), 1)
+			}
+			return content
+		})
+
+		_, _, err := importer.Import(ctx, bytes.NewReader(tampered))
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("rejects a bundle of a signer that is not trusted", func() {
+		dstKeys, err := crypto.NewKeyManager(dstDir)
+		Expect(err).NotTo(HaveOccurred())
+		untrusting := v2.NewBundleService(dstPool, dstDir, "v1.2.3", "agent-b", dstKeys, nil)
+
+		_, _, err = untrusting.Import(ctx, bytes.NewReader(bundle))
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring(exporter.PublicKey()))
+		Expect(dstPool.List()).To(HaveLen(1))
+	})
+
+	It("rejects a bundle altered and re-signed with another key", func() {
+		labels := []byte(`{"vm-1":["rejected"]}`)
+		labelsSum := sha256.Sum256(labels)
+		_, forgerKey, err := ed25519.GenerateKey(nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		var manifest []byte
+		forged := repackBundle(bundle, func(name string, content []byte) []byte {
+			switch name {
+			case "labels.json":
+				return labels
+			case "manifest.json":
+				var m map[string]any
+				Expect(json.Unmarshal(content, &m)).To(Succeed())
+				m["files"].(map[string]any)["labels.json"] = hex.EncodeToString(labelsSum[:])
+				m["publicKey"] = base64.StdEncoding.EncodeToString(forgerKey.Public().(ed25519.PublicKey))
+				manifest, err = json.Marshal(m)
+				Expect(err).NotTo(HaveOccurred())
+				return manifest
+			case "manifest.sig":
+				return []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(forgerKey, manifest)))
+			}
+			return content
+		})
+
+		_, _, err = importer.Import(ctx, bytes.NewReader(forged))
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("untrusted bundle signer"))
+		Expect(dstPool.List()).To(HaveLen(1))
+	})
+
+	It("returns not found when exporting an unknown collection", func() {
+		_, err := exporter.Export(ctx, "missing")
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+	})
+})
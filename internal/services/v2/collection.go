@@ -1,13 +1,29 @@
 package v2
 
-import "github.com/kubev2v/assisted-migration-agent/internal/store"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
 
 type CollectionService struct {
-	pool *store.Pool
+	pool         *store.Pool
+	trackers     *vmChangeTrackers
+	savedFilters *SavedFilterService
 }
 
 func NewCollectionService(pool *store.Pool) *CollectionService {
-	return &CollectionService{pool: pool}
+	return &CollectionService{pool: pool, savedFilters: NewSavedFilterService(pool)}
 }
 
 func (s *CollectionService) List() []*store.Database {
@@ -20,3 +36,153 @@ func (s *CollectionService) List() []*store.Database {
 	}
 	return result
 }
+
+// Metadata returns the user metadata of every collection that has some, keyed by database name.
+func (s *CollectionService) Metadata(ctx context.Context) (map[string]models.CollectionMetadata, error) {
+	mainSt, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	return mainSt.CollectionMetadata().List(ctx)
+}
+
+// Update renames, annotates, pins or unpins a collection.
+func (s *CollectionService) Update(ctx context.Context, id string, upd models.CollectionMetadataUpdate) (*store.Database, models.CollectionMetadata, error) {
+	db, err := s.get(id)
+	if err != nil {
+		return nil, models.CollectionMetadata{}, err
+	}
+
+	mainSt, err := s.mainStore()
+	if err != nil {
+		return nil, models.CollectionMetadata{}, err
+	}
+
+	meta, err := mainSt.CollectionMetadata().Update(ctx, CollectionDatabaseName(db), upd)
+	if err != nil {
+		return nil, models.CollectionMetadata{}, err
+	}
+	return db, meta, nil
+}
+
+// Delete removes a collection from the pool, deletes its DuckDB file and forgets its
+// catalog entry and metadata. It is refused while a collection is running, since the
+// running collection may be syncing from the one being deleted.
+func (s *CollectionService) Delete(ctx context.Context, id string) error {
+	db, err := s.get(id)
+	if err != nil {
+		return err
+	}
+
+	mainSt, err := s.mainStore()
+	if err != nil {
+		return err
+	}
+
+	markers, err := mainSt.Collection().List(ctx)
+	if err != nil {
+		return err
+	}
+	for _, m := range markers {
+		if m.State == models.CollectionStateRunning {
+			return srvErrors.NewCollectionInProgressError()
+		}
+	}
+
+	return s.remove(ctx, mainSt, db)
+}
+
+// Prune removes the collections of each vCenter beyond the retain most recent unpinned ones.
+// Pinned and imported collections are kept and do not count. A retain lower than 1 disables pruning.
+func (s *CollectionService) Prune(ctx context.Context, retain int) error {
+	if retain < 1 {
+		return nil
+	}
+
+	mainSt, err := s.mainStore()
+	if err != nil {
+		return err
+	}
+
+	metadata, err := mainSt.CollectionMetadata().List(ctx)
+	if err != nil {
+		return err
+	}
+
+	kept := make(map[string]int)
+	for db := range s.pool.All() {
+		if db.ID == store.MainDatabaseID || db.Imported || metadata[CollectionDatabaseName(db)].Pinned {
+			continue
+		}
+		if kept[db.VCenter] < retain {
+			kept[db.VCenter]++
+			continue
+		}
+
+		zap.S().Named("collection_service").Infow("pruning collection", "id", db.ID, "vcenter", db.VCenter, "path", db.Path)
+		if err := s.remove(ctx, mainSt, db); err != nil {
+			return fmt.Errorf("pruning collection %s: %w", db.ID, err)
+		}
+	}
+	return nil
+}
+
+// CollectionDatabaseName returns the name of a collection database (collection_<unix>).
+func CollectionDatabaseName(db *store.Database) string {
+	return strings.TrimSuffix(filepath.Base(db.Path), filepath.Ext(db.Path))
+}
+
+// latestCollection returns the latest collection of a vCenter, or the latest collection of
+// any vCenter when vcenter is empty.
+func latestCollection(pool *store.Pool, vcenter string) (*store.Database, error) {
+	if vcenter == "" {
+		return pool.Latest()
+	}
+	return pool.LatestFor(vcenter)
+}
+
+func (s *CollectionService) remove(ctx context.Context, mainSt *store.Store2, db *store.Database) error {
+	// A change tracker continues from the latest collection of its vCenter; once that is gone
+	// the next incremental collection would patch an older one.
+	if latest, err := s.pool.LatestFor(db.VCenter); err == nil && latest == db && s.trackers != nil {
+		s.trackers.Drop(ctx, db.VCenter)
+	}
+
+	if _, err := s.pool.Remove(db.ID); err != nil {
+		return err
+	}
+	if err := db.Close(); err != nil {
+		zap.S().Named("collection_service").Warnw("failed to close collection database", "id", db.ID, "error", err)
+	}
+
+	for _, path := range []string{db.Path, db.Path + ".wal"} {
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("removing collection file %s: %w", path, err)
+		}
+	}
+
+	name := CollectionDatabaseName(db)
+	if err := mainSt.Collection().Delete(ctx, name); err != nil {
+		return err
+	}
+	return mainSt.CollectionMetadata().Delete(ctx, name)
+}
+
+func (s *CollectionService) get(id string) (*store.Database, error) {
+	if id == store.MainDatabaseID {
+		return nil, srvErrors.NewResourceNotFoundError("collection", id)
+	}
+	db, err := s.pool.Get(id)
+	if err != nil {
+		return nil, srvErrors.NewResourceNotFoundError("collection", id)
+	}
+	return db, nil
+}
+
+func (s *CollectionService) mainStore() (*store.Store2, error) {
+	mainDB, err := s.pool.Get(store.MainDatabaseID)
+	if err != nil {
+		return nil, fmt.Errorf("getting main database: %w", err)
+	}
+	return mainDB.Store()
+}
@@ -0,0 +1,144 @@
+package v2_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/config"
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	v2 "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+var _ = Describe("CollectionService", func() {
+	var (
+		ctx    context.Context
+		pool   *store.Pool
+		mainSt *store.Store2
+		tmpDir string
+		srv    *v2.CollectionService
+	)
+
+	// addCollection registers an empty collection of the given vCenter.
+	addCollection := func(ts int64, vcenter string) *store.Database {
+		db, _ := addTestCollection(pool, fmt.Sprintf("col-%d", ts), time.Unix(ts, 0))
+		db.VCenter = vcenter
+		return db
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		tmpDir, err = os.MkdirTemp("", "collection-service-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		pool = newTestPool(tmpDir)
+		mainDB, err := pool.Get(store.MainDatabaseID)
+		Expect(err).NotTo(HaveOccurred())
+		mainSt, err = mainDB.Store()
+		Expect(err).NotTo(HaveOccurred())
+
+		srv = v2.NewCollectionService(pool)
+	})
+
+	AfterEach(func() {
+		pool.Close()
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	Describe("Update", func() {
+		It("stores the metadata of the collection", func() {
+			db := addCollection(1000, "default")
+			name := "before remediation"
+
+			updated, meta, err := srv.Update(ctx, db.ID, models.CollectionMetadataUpdate{DisplayName: &name})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeIdenticalTo(db))
+			Expect(meta.DisplayName).To(Equal(name))
+
+			metadata, err := srv.Metadata(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(metadata["collection_1000"].DisplayName).To(Equal(name))
+		})
+
+		It("returns not found for the main database", func() {
+			pinned := true
+			_, _, err := srv.Update(ctx, store.MainDatabaseID, models.CollectionMetadataUpdate{Pinned: &pinned})
+			Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+		})
+	})
+
+	Describe("Delete", func() {
+		It("removes the collection from the pool, the disk and the catalog", func() {
+			db := addCollection(1000, "default")
+			pinned := true
+			_, _, err := srv.Update(ctx, db.ID, models.CollectionMetadataUpdate{Pinned: &pinned})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(srv.Delete(ctx, db.ID)).To(Succeed())
+
+			_, err = pool.Get(db.ID)
+			Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+			Expect(db.Path).NotTo(BeAnExistingFile())
+			metadata, err := srv.Metadata(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(metadata).To(BeEmpty())
+		})
+
+		It("is refused while a collection is running", func() {
+			db := addCollection(1000, "default")
+			_, err := mainSt.Collection().Create(ctx, "collection_2000")
+			Expect(err).NotTo(HaveOccurred())
+
+			err = srv.Delete(ctx, db.ID)
+			Expect(srvErrors.IsOperationInProgressError(err)).To(BeTrue())
+			Expect(db.Path).To(BeAnExistingFile())
+		})
+	})
+
+	Describe("Prune", func() {
+		It("keeps the newest unpinned collections of each vCenter and every pinned one", func() {
+			pinnedDB := addCollection(1000, "vc-east")
+			oldEast := addCollection(2000, "vc-east")
+			newEast := addCollection(3000, "vc-east")
+			west := addCollection(1500, "vc-west")
+
+			pinned := true
+			_, _, err := srv.Update(ctx, pinnedDB.ID, models.CollectionMetadataUpdate{Pinned: &pinned})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(srv.Prune(ctx, 1)).To(Succeed())
+
+			ids := []string{}
+			for _, db := range srv.List() {
+				ids = append(ids, db.ID)
+			}
+			Expect(ids).To(ConsistOf(pinnedDB.ID, newEast.ID, west.ID))
+			Expect(oldEast.Path).NotTo(BeAnExistingFile())
+		})
+
+		It("keeps everything when retention is disabled", func() {
+			addCollection(1000, "default")
+			addCollection(2000, "default")
+
+			Expect(srv.Prune(ctx, 0)).To(Succeed())
+			Expect(srv.List()).To(HaveLen(2))
+		})
+
+		It("keeps previous collections under the default retention", func() {
+			addCollection(1000, "default")
+			addCollection(2000, "default")
+			addCollection(3000, "default")
+
+			retain := config.NewConfigurationWithOptionsAndDefaults().Agent.RetainCollections
+			Expect(srv.Prune(ctx, retain)).To(Succeed())
+			Expect(srv.List()).To(HaveLen(3))
+		})
+	})
+})
@@ -34,38 +34,64 @@ type vCenterCollectorWorkFactory struct {
 	dataDir        string
 	validator      *opa.Validator
 	credentialsSrv *CredentialsService
+	vcenter        string
+	mode           models.CollectionMode
+	track          bool
+	trackers       *vmChangeTrackers
+	collections    *CollectionService
+	retain         int
 }
 
-func newVCenterCollectorWorkFactory(credSrv *CredentialsService, pool *store.Pool, dataDir string, validator *opa.Validator) (*vCenterCollectorWorkFactory, error) {
+// newVCenterCollectorWorkFactory returns a factory collecting the vCenter of the given credential profile.
+// An incremental factory requires a tracker for the profile in trackers. When track is set, a full
+// collection establishes a new tracker so that the next collection can be incremental.
+// Once a collection is added to the pool, older collections are pruned down to retain per vCenter.
+func newVCenterCollectorWorkFactory(credSrv *CredentialsService, pool *store.Pool, dataDir string, validator *opa.Validator, vcenter string, mode models.CollectionMode, track bool, trackers *vmChangeTrackers, collections *CollectionService, retain int) (*vCenterCollectorWorkFactory, error) {
 	return &vCenterCollectorWorkFactory{
 		pool:           pool,
 		dataDir:        dataDir,
 		credentialsSrv: credSrv,
 		validator:      validator,
+		vcenter:        vcenter,
+		mode:           mode,
+		track:          track,
+		trackers:       trackers,
+		collections:    collections,
+		retain:         retain,
 	}, nil
 }
 
 // Build creates the collector work pipeline for a single collection run.
 //
-// The pipeline executes 12 sequential work units against a dedicated collection
+// The pipeline executes 13 sequential work units against a dedicated collection
 // DuckDB database (one per run). On completion, Finalize either promotes the
-// collection DB into the pool (success), marks it failed (error), or cleans it
-// up (cancelled).
+// collection DB into the pool (success) and prunes collections beyond the retention,
+// marks it failed (error), or cleans it up (cancelled).
 //
 // Pipeline stages:
-//  1. Provision — record a collection marker, create and migrate the collection DB.
+//  1. Provision — record a collection marker, create and migrate the collection DB,
+//     tag it with the source vCenter.
 //  2. Verify — validate vCenter credentials and open a govmomi client for rightsizing.
 //  3. Collect — run the vSphere collector, producing a SQLite database of raw inventory.
 //  4. Ingest — import the SQLite output into the collection DuckDB, validate schema.
+//     4b. Lifecycle — read VM creation and boot times and snapshots from vCenter.
 //  5. Applications — match guest processes against known application definitions.
 //  6. Rightsizing:
-//     6a. Rightsizing: create report — read VMs from inventory, create the report shell.
+//     6a. Rightsizing: create report — read VMs from inventory, create the report shell, or
+//     compute the whole report from the accumulated samples of the vCenter.
 //     6b. Rightsizing: query + persist — query vCenter metrics, persist batches in a loop.
 //     6c. Rightsizing: warnings — persist VMs that returned no metrics data.
 //     6d. Rightsizing: utilization — compute per-VM utilization percentages.
-//  7. Sync with the previous collection — copy groups, labels and exclusions from the previous collection.
+//  7. Sync with the previous collection — copy groups, labels and exclusions from the previous
+//     collection of the same vCenter, then apply the label rules.
 //  8. Inventory — build the inventory JSON with embedded cluster utilization and persist.
 //  9. Publish — write an inventory-update event to the outbox.
+//
+// An incremental collection runs the same stages with these differences: Provision clones the
+// previous collection of the vCenter instead of creating an empty one, Collect reads the VMs
+// changed since that collection from the profile's change tracker, Ingest patches them into
+// the clone (lifecycles included, so Lifecycle is skipped), Rightsizing is skipped (the clone keeps the previous report) and Sync only moves
+// the New label and rebuilds the groups affected by the changed VMs.
 func (f *vCenterCollectorWorkFactory) Build() work.WorkBuilder2[models.CollectorStatus, models.CollectorResult] {
 	log := zap.S().Named("collector_service")
 
@@ -79,6 +105,25 @@ func (f *vCenterCollectorWorkFactory) Build() work.WorkBuilder2[models.Collector
 	var rsVMResults map[string]VMReport
 	var rsSvc *RightsizingService
 	var rsWindowStart, rsWindowEnd time.Time
+	var rsAccumulated bool
+
+	incremental := f.mode == models.CollectionModeIncremental
+	var tracker, newTracker *vmware.VMChangeTracker
+	if incremental {
+		tracker = f.trackers.Get(f.vcenter)
+	}
+	var delta models.CollectionDelta
+	var patches []models.VMPatch
+
+	labelRules := NewLabelRuleService(f.pool, NewSavedFilterService(f.pool))
+	applyLabelRules := func(ctx context.Context, st *store.Store2) error {
+		res, err := labelRules.Apply(ctx, st)
+		if err != nil {
+			return fmt.Errorf("sync: failed to apply label rules: %w", err)
+		}
+		log.Infow("label rules applied", "added", res.Added, "removed", res.Removed, "skipped", res.Skipped)
+		return nil
+	}
 
 	units := []work.WorkUnit[models.CollectorStatus, models.CollectorResult]{
 		// 1. Provision: record collection marker, create and migrate the collection DB.
@@ -87,7 +132,7 @@ func (f *vCenterCollectorWorkFactory) Build() work.WorkBuilder2[models.Collector
 				return models.CollectorStatus{State: models.CollectorStateConnecting}
 			},
 			Work: func(ctx context.Context, r models.CollectorResult) (models.CollectorResult, error) {
-				creds, err := f.credentialsSrv.Resolve(ctx)
+				creds, err := f.credentialsSrv.ResolveProfile(ctx, f.vcenter)
 				if err != nil {
 					r.Err = err
 					return r, err
@@ -124,11 +169,21 @@ func (f *vCenterCollectorWorkFactory) Build() work.WorkBuilder2[models.Collector
 				id := hex.EncodeToString(hash[:])[:6]
 
 				var dbError error
-				collectionDb, dbError = f.pool.NewDatabase(id, dbPath, time.Now(), store.EagerConnectionInitilization, 256, store.ReadWriteDatabase)
+				if incremental {
+					prevDB, err := f.pool.LatestFor(f.vcenter)
+					if err != nil {
+						r.Err = fmt.Errorf("getting previous collection of %s: %w", f.vcenter, err)
+						return r, r.Err
+					}
+					collectionDb, dbError = prevDB.CloneTo(ctx, id, dbPath)
+				} else {
+					collectionDb, dbError = f.pool.NewDatabase(id, dbPath, time.Now(), store.EagerConnectionInitilization, 256, store.ReadWriteDatabase)
+				}
 				if dbError != nil {
 					r.Err = fmt.Errorf("opening collection database %s: %w", database, dbError)
 					return r, r.Err
 				}
+				collectionDb.VCenter = f.vcenter
 
 				if err := collectionDb.Migrate(ctx, func(ctx context.Context, sqlDb *sql.DB) error {
 					st, err := collectionDb.Store()
@@ -141,7 +196,11 @@ func (f *vCenterCollectorWorkFactory) Build() work.WorkBuilder2[models.Collector
 						return err
 					}
 
-					return migrations.RunCollection(ctx, sqlDb, database)
+					if err := migrations.RunCollection(ctx, sqlDb, database); err != nil {
+						return err
+					}
+
+					return st.CollectionSource().Save(ctx, models.CollectionSource{VCenter: f.vcenter, URL: credentials.URL, Mode: f.mode})
 				}); err != nil {
 					_ = collectionDb.Close()
 					r.Err = fmt.Errorf("migrating collection database %s: %w", database, err)
@@ -177,8 +236,24 @@ func (f *vCenterCollectorWorkFactory) Build() work.WorkBuilder2[models.Collector
 					r.Err = err
 					return r, err
 				}
-
 				r.Client = client
+
+				// The tracker is created before the inventory is collected, so that changes
+				// made during the collection are picked up by the next incremental one.
+				if !incremental && f.track {
+					trackerClient, err := vmware.Connect(ctx, &credentials)
+					if err != nil {
+						r.Err = err
+						return r, err
+					}
+					newTracker, err = vmware.NewVMChangeTracker(ctx, trackerClient)
+					if err != nil {
+						_ = trackerClient.Logout(context.Background())
+						r.Err = fmt.Errorf("creating VM change tracker: %w", err)
+						return r, r.Err
+					}
+				}
+
 				return r, nil
 			},
 		},
@@ -192,6 +267,21 @@ func (f *vCenterCollectorWorkFactory) Build() work.WorkBuilder2[models.Collector
 					return r, nil
 				}
 
+				if incremental {
+					log.Info("reading vSphere changes since the previous collection")
+					var err error
+					delta, patches, err = readVMChanges(ctx, tracker)
+					if err != nil {
+						// The tracker's session may be gone: drop it so the next run is a full one.
+						f.trackers.Drop(context.Background(), f.vcenter)
+						log.Errorw("reading vSphere changes failed", "error", err)
+						r.Err = err
+						return r, err
+					}
+					log.Infow("vSphere changes read", "added", len(delta.Added), "modified", len(delta.Modified), "removed", len(delta.Removed))
+					return r, nil
+				}
+
 				dbPath := path.Join(f.dataDir, fmt.Sprintf("%s.db", uuid.New()))
 				vc := collector.NewVSphereCollector(dbPath)
 				defer vc.Close()
@@ -220,6 +310,23 @@ func (f *vCenterCollectorWorkFactory) Build() work.WorkBuilder2[models.Collector
 					return r, r.Err
 				}
 
+				if incremental {
+					log.Info("patching collection with vSphere changes")
+					var validator duckdb_parser.Validator
+					if f.validator != nil {
+						validator = f.validator
+					}
+					if err := ApplyDelta(ctx, st, delta, patches, validator); err != nil {
+						r.Err = err
+						return r, err
+					}
+					if err := st.Checkpoint(ctx); err != nil {
+						r.Err = fmt.Errorf("checkpoint failed: %w", err)
+						return r, r.Err
+					}
+					return r, nil
+				}
+
 				log.Info("ingesting sqlite data into duckdb")
 
 				if _, err := os.Stat(r.SQLitePath); err != nil {
@@ -260,6 +367,28 @@ func (f *vCenterCollectorWorkFactory) Build() work.WorkBuilder2[models.Collector
 				return r, nil
 			},
 		},
+		// 4b. Lifecycle: read VM creation and boot times and snapshots, which the vSphere
+		// collector does not report. They only feed filter fields, so a failure is logged
+		// and the collection goes on without them.
+		{
+			Status: func() models.CollectorStatus {
+				return models.CollectorStatus{State: models.CollectorStateCollecting}
+			},
+			Work: func(ctx context.Context, r models.CollectorResult) (models.CollectorResult, error) {
+				if incremental {
+					return r, nil
+				}
+				st, err := collectionDb.Store()
+				if err != nil {
+					r.Err = fmt.Errorf("getting collection store: %w", err)
+					return r, r.Err
+				}
+				if err := collectVMLifecycles(ctx, r.Client, st); err != nil {
+					log.Warnw("failed to collect VM lifecycles", "error", err)
+				}
+				return r, nil
+			},
+		},
 		// 5. Applications: match guest processes against known application definitions.
 		{
 			Status: func() models.CollectorStatus {
@@ -290,12 +419,35 @@ func (f *vCenterCollectorWorkFactory) Build() work.WorkBuilder2[models.Collector
 				return models.CollectorStatus{State: models.CollectorStateMetricsCollecting}
 			},
 			Work: func(ctx context.Context, r models.CollectorResult) (models.CollectorResult, error) {
+				if incremental {
+					return r, nil
+				}
 				st, err := collectionDb.Store()
 				if err != nil {
 					return r, fmt.Errorf("getting collection store: %w", err)
 				}
 				rsSvc = NewRightsizingService(st)
 
+				// With the accumulation enabled and samples accumulated for the vCenter, the
+				// report is computed from them rather than from the rollup of the lookback,
+				// leaving nothing for the next rightsizing stages to do.
+				acc := NewRightsizingAccumulationService(f.pool, f.credentialsSrv)
+				accStatus, err := acc.GetStatus(ctx)
+				if err != nil {
+					return r, err
+				}
+				if accStatus.Enabled {
+					lookback := time.Duration(rightsizingDefaultLookbackHours) * time.Hour
+					_, ok, err := acc.accumulatedReport(ctx, st, credentials.URL, lookback, time.Now())
+					if err != nil {
+						return r, err
+					}
+					rsAccumulated = ok
+					if ok {
+						return r, nil
+					}
+				}
+
 				id, vms, start, end, err := rsSvc.CreateReportFromInventory(ctx)
 				if err != nil {
 					return r, err
@@ -313,6 +465,9 @@ func (f *vCenterCollectorWorkFactory) Build() work.WorkBuilder2[models.Collector
 				return models.CollectorStatus{State: models.CollectorStateMetricsCollecting}
 			},
 			Work: func(ctx context.Context, r models.CollectorResult) (models.CollectorResult, error) {
+				if incremental || rsAccumulated {
+					return r, nil
+				}
 				results, err := rsSvc.QueryMetrics(ctx, r.Client, rsVMs, rsWindowStart, rsWindowEnd)
 				if err != nil {
 					return r, err
@@ -331,6 +486,9 @@ func (f *vCenterCollectorWorkFactory) Build() work.WorkBuilder2[models.Collector
 				return models.CollectorStatus{State: models.CollectorStateMetricsCollecting}
 			},
 			Work: func(ctx context.Context, r models.CollectorResult) (models.CollectorResult, error) {
+				if incremental || rsAccumulated {
+					return r, nil
+				}
 				if err := rsSvc.PersistVMWarnings(ctx, rsVMs, rsVMResults, rsReportID); err != nil {
 					return r, err
 				}
@@ -343,13 +501,16 @@ func (f *vCenterCollectorWorkFactory) Build() work.WorkBuilder2[models.Collector
 				return models.CollectorStatus{State: models.CollectorStateMetricsCollecting}
 			},
 			Work: func(ctx context.Context, r models.CollectorResult) (models.CollectorResult, error) {
+				if incremental || rsAccumulated {
+					return r, nil
+				}
 				if err := rsSvc.ComputeUtilization(ctx, rsReportID); err != nil {
 					return r, err
 				}
 				return r, nil
 			},
 		},
-		// 7. Sync user data from the previous collection into the new one.
+		// 7. Sync user data from the previous collection of the same vCenter into the new one.
 		// Clone the previous collection to a temp DB, close the new collection DB,
 		// attach it onto the clone, run cross-DB SQL to copy groups, labels, and
 		// exclusion flags, then detach, discard the clone, and reopen the new DB.
@@ -361,15 +522,63 @@ func (f *vCenterCollectorWorkFactory) Build() work.WorkBuilder2[models.Collector
 		// This runs before the Inventory stage so that the persisted/published
 		// inventory (which embeds per-VM MigrationExcluded and Labels) reflects
 		// the synced data instead of the new collection's pre-sync defaults.
+		//
+		// An incremental collection already carries the user data of the collection it was
+		// cloned from, so it only relabels the added VMs and refreshes the affected groups.
+		//
+		// The label rules are applied last, before the groups are refreshed, as group filters
+		// may use the labels.
 		{
 			Status: func() models.CollectorStatus {
 				return models.CollectorStatus{State: models.CollectorStateCollecting}
 			},
 			Work: func(ctx context.Context, result models.CollectorResult) (models.CollectorResult, error) {
-				prevDB, err := f.pool.Latest()
+				if incremental {
+					st, err := collectionDb.Store()
+					if err != nil {
+						result.Err = fmt.Errorf("getting collection store: %w", err)
+						return result, result.Err
+					}
+					lifted, err := SyncDelta(ctx, st, delta, time.Now())
+					if err != nil {
+						result.Err = fmt.Errorf("sync: %w", err)
+						return result, result.Err
+					}
+					if err := applyLabelRules(ctx, st); err != nil {
+						result.Err = err
+						return result, result.Err
+					}
+
+					parser = duckdb_parser.New(st.Querier(), f.validator)
+
+					changedGroups, err := RefreshGroupInventories(ctx, st, NewGroupService(st, parser).WithSavedFilters(NewSavedFilterService(f.pool)), append(delta.All(), lifted...))
+					if err != nil {
+						result.Err = fmt.Errorf("sync: failed to refresh group inventories: %w", err)
+						return result, result.Err
+					}
+					result.ChangedGroups = changedGroups
+
+					log.Infow("incremental collection sync completed", "changed_groups", len(changedGroups))
+					return result, nil
+				}
+
+				prevDB, err := f.pool.LatestFor(f.vcenter)
 				if err != nil {
 					if errors.IsResourceNotFoundError(err) {
-						log.Info("no previous collection found, skipping sync")
+						log.Infow("no previous collection found for vcenter, skipping sync", "vcenter", f.vcenter)
+						st, err := collectionDb.Store()
+						if err != nil {
+							result.Err = fmt.Errorf("getting collection store: %w", err)
+							return result, result.Err
+						}
+						if err := trackPoweredOff(ctx, st, time.Now()); err != nil {
+							result.Err = fmt.Errorf("sync: %w", err)
+							return result, result.Err
+						}
+						if err := applyLabelRules(ctx, st); err != nil {
+							result.Err = err
+							return result, result.Err
+						}
 						return result, nil
 					}
 					result.Err = err
@@ -434,9 +643,19 @@ func (f *vCenterCollectorWorkFactory) Build() work.WorkBuilder2[models.Collector
 					return result, result.Err
 				}
 
+				if err := trackPoweredOff(ctx, newSt, now); err != nil {
+					result.Err = fmt.Errorf("sync: %w", err)
+					return result, result.Err
+				}
+
+				if err := applyLabelRules(ctx, newSt); err != nil {
+					result.Err = err
+					return result, result.Err
+				}
+
 				parser = duckdb_parser.New(newSt.Querier(), f.validator)
 
-				changedGroups, err := RefreshGroupInventories(ctx, newSt, NewGroupService(newSt, parser))
+				changedGroups, err := RefreshGroupInventories(ctx, newSt, NewGroupService(newSt, parser).WithSavedFilters(NewSavedFilterService(f.pool)), nil)
 				if err != nil {
 					result.Err = fmt.Errorf("sync: failed to refresh group inventories: %w", err)
 					return result, result.Err
@@ -577,6 +796,15 @@ func (f *vCenterCollectorWorkFactory) Build() work.WorkBuilder2[models.Collector
 			_ = result.Client.Logout(context.Background())
 		}
 
+		switch {
+		case result.Completed && incremental:
+			tracker.Commit()
+		case result.Completed && newTracker != nil:
+			f.trackers.Set(context.Background(), f.vcenter, newTracker)
+		case newTracker != nil:
+			newTracker.Close(context.Background())
+		}
+
 		if database == "" {
 			return nil
 		}
@@ -615,6 +843,10 @@ func (f *vCenterCollectorWorkFactory) Build() work.WorkBuilder2[models.Collector
 				zap.S().Warnw("failed to delete collection marker", "error", err)
 			}
 			zap.S().Infow("collection database added to pool", "id", collectionDb.ID, "path", collectionDb.Path)
+
+			if err := f.collections.Prune(ctx, f.retain); err != nil {
+				zap.S().Warnw("failed to prune collections", "error", err)
+			}
 		case result.Err != nil:
 			zap.S().Infow("collection failed", "error", result.Err)
 			if err := mainSt.Collection().MarkFailed(ctx, database, result.Err.Error()); err != nil {
@@ -3,6 +3,7 @@ package v2
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/kubev2v/assisted-migration-agent/internal/models"
 	"github.com/kubev2v/assisted-migration-agent/internal/store"
@@ -11,16 +12,25 @@ import (
 // ComparisonService computes diffs between two collections.
 // Each collection is queried independently via its own Store2 connection.
 type ComparisonService struct {
-	storeA *store.Store2
-	storeB *store.Store2
-	metaA  models.CollectionMeta
-	metaB  models.CollectionMeta
+	storeA     *store.Store2
+	storeB     *store.Store2
+	metaA      models.CollectionMeta
+	metaB      models.CollectionMeta
+	expression string
 }
 
 func NewComparisonService(storeA, storeB *store.Store2, metaA, metaB models.CollectionMeta) *ComparisonService {
 	return &ComparisonService{storeA: storeA, storeB: storeB, metaA: metaA, metaB: metaB}
 }
 
+// WithExpression restricts the comparison to the VMs matching a filter expression, evaluated
+// in each collection. It applies to the VM dimensions (total, migratable, non-migratable and
+// changed); cluster counts and infrastructure dimensions cover the whole collections.
+func (s *ComparisonService) WithExpression(expression string) *ComparisonService {
+	s.expression = expression
+	return s
+}
+
 // Summary loads VM rows from both collections, computes all set differences in a
 // single pass, and returns aggregates + diff counts for every dimension.
 func (s *ComparisonService) Summary(ctx context.Context) (models.ComparisonSummary, error) {
@@ -40,6 +50,11 @@ func (s *ComparisonService) Summary(ctx context.Context) (models.ComparisonSumma
 
 	sets := computeDiffSets(aRows, bRows)
 
+	aStates, bStates, err := s.loadBothStates(ctx)
+	if err != nil {
+		return models.ComparisonSummary{}, err
+	}
+
 	aAgg := models.CollectionAggregate{
 		ID:            s.metaA.ID,
 		CreatedAt:     s.metaA.CreatedAt,
@@ -78,12 +93,22 @@ func (s *ComparisonService) Summary(ctx context.Context) (models.ComparisonSumma
 		Clusters: models.ComparisonDiffEntry{
 			Delta: bClusters - aClusters,
 		},
+		Changed: len(computeVMChanges(aStates, bStates)),
 	}, nil
 }
 
 // Diff returns paginated VM IDs for one dimension (onlyInA and onlyInB).
 // Both sides use the same page/pageSize but are paginated independently.
+// The changed dimension instead returns a page of VMs with their changed fields, and
+// infrastructure dimensions a page of the entities added, removed or changed.
 func (s *ComparisonService) Diff(ctx context.Context, dimension models.ComparisonDimension, page, pageSize int) (models.ComparisonDiff, error) {
+	if dimension == models.DimensionChanged {
+		return s.changedDiff(ctx, page, pageSize)
+	}
+	if dimension.IsInfrastructure() {
+		return s.infraDiff(ctx, dimension, page, pageSize)
+	}
+
 	aRows, bRows, err := s.loadBothCollections(ctx)
 	if err != nil {
 		return models.ComparisonDiff{}, err
@@ -112,6 +137,59 @@ func (s *ComparisonService) Diff(ctx context.Context, dimension models.Compariso
 
 // ── Private helpers ────────────────────────────────────────────────────────
 
+func (s *ComparisonService) changedDiff(ctx context.Context, page, pageSize int) (models.ComparisonDiff, error) {
+	aStates, bStates, err := s.loadBothStates(ctx)
+	if err != nil {
+		return models.ComparisonDiff{}, err
+	}
+
+	changed := paginateChanges(computeVMChanges(aStates, bStates), page, pageSize)
+	return models.ComparisonDiff{
+		Dimension: models.DimensionChanged,
+		OnlyInA:   paginateIDs(nil, page, pageSize),
+		OnlyInB:   paginateIDs(nil, page, pageSize),
+		Changed:   &changed,
+	}, nil
+}
+
+func (s *ComparisonService) infraDiff(ctx context.Context, dimension models.ComparisonDimension, page, pageSize int) (models.ComparisonDiff, error) {
+	aEntities, err := s.storeA.VM().ListInfraEntities(ctx, dimension)
+	if err != nil {
+		return models.ComparisonDiff{}, fmt.Errorf("loading collection A %s: %w", dimension, err)
+	}
+	bEntities, err := s.storeB.VM().ListInfraEntities(ctx, dimension)
+	if err != nil {
+		return models.ComparisonDiff{}, fmt.Errorf("loading collection B %s: %w", dimension, err)
+	}
+
+	infra := paginateInfra(computeInfraChanges(aEntities, bEntities), page, pageSize)
+	return models.ComparisonDiff{
+		Dimension:      dimension,
+		OnlyInA:        paginateIDs(nil, page, pageSize),
+		OnlyInB:        paginateIDs(nil, page, pageSize),
+		Infrastructure: &infra,
+	}, nil
+}
+
+func (s *ComparisonService) loadBothStates(ctx context.Context) (aStates, bStates []models.VMStateRow, err error) {
+	aStates, err = s.storeA.VM().ListStates(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading collection A VM states: %w", err)
+	}
+	bStates, err = s.storeB.VM().ListStates(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading collection B VM states: %w", err)
+	}
+
+	aMatched, bMatched, err := s.loadBothMatched(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	aStates = keepMatched(aStates, aMatched, func(r models.VMStateRow) string { return r.VMID })
+	bStates = keepMatched(bStates, bMatched, func(r models.VMStateRow) string { return r.VMID })
+	return aStates, bStates, nil
+}
+
 func (s *ComparisonService) loadBothCollections(ctx context.Context) (aRows, bRows []models.VMComparisonRow, err error) {
 	aRows, err = s.storeA.VM().ListForComparison(ctx)
 	if err != nil {
@@ -121,9 +199,51 @@ func (s *ComparisonService) loadBothCollections(ctx context.Context) (aRows, bRo
 	if err != nil {
 		return nil, nil, fmt.Errorf("loading collection B VMs: %w", err)
 	}
+
+	aMatched, bMatched, err := s.loadBothMatched(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	aRows = keepMatched(aRows, aMatched, func(r models.VMComparisonRow) string { return r.VMID })
+	bRows = keepMatched(bRows, bMatched, func(r models.VMComparisonRow) string { return r.VMID })
 	return aRows, bRows, nil
 }
 
+// loadBothMatched returns the IDs of the VMs matching the comparison filter in each
+// collection, or nil sets when the comparison is not filtered.
+func (s *ComparisonService) loadBothMatched(ctx context.Context) (aMatched, bMatched map[string]bool, err error) {
+	filter := store.ByFilter(s.expression)
+	if filter == nil {
+		return nil, nil, nil
+	}
+
+	aIDs, err := s.storeA.VM().ListIDs(ctx, filter)
+	if err != nil {
+		return nil, nil, fmt.Errorf("filtering collection A VMs: %w", err)
+	}
+	bIDs, err := s.storeB.VM().ListIDs(ctx, filter)
+	if err != nil {
+		return nil, nil, fmt.Errorf("filtering collection B VMs: %w", err)
+	}
+	return idSet(aIDs), idSet(bIDs), nil
+}
+
+// keepMatched returns the rows whose VM is in matched. A nil matched keeps every row.
+func keepMatched[T any](rows []T, matched map[string]bool, vmID func(T) string) []T {
+	if matched == nil {
+		return rows
+	}
+	return slices.DeleteFunc(rows, func(r T) bool { return !matched[vmID(r)] })
+}
+
+func idSet(ids []string) map[string]bool {
+	set := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	return set
+}
+
 type diffSets struct {
 	TotalOnlyInA         []string
 	TotalOnlyInB         []string
@@ -185,6 +305,131 @@ func computeDiffSets(aRows, bRows []models.VMComparisonRow) diffSets {
 	return sets
 }
 
+// computeVMChanges returns, in A order, the VMs present in both collections whose tracked
+// fields differ. VMs present in only one collection are covered by the total dimension.
+func computeVMChanges(aStates, bStates []models.VMStateRow) []models.VMChange {
+	bMap := make(map[string]models.VMStateRow, len(bStates))
+	for _, r := range bStates {
+		bMap[r.VMID] = r
+	}
+
+	var changes []models.VMChange
+	for _, a := range aStates {
+		b, inB := bMap[a.VMID]
+		if !inB {
+			continue
+		}
+		if fields := diffVMState(a, b); len(fields) > 0 {
+			changes = append(changes, models.VMChange{VMID: a.VMID, Name: b.Name, Changes: fields})
+		}
+	}
+	return changes
+}
+
+// diffVMState returns the fields that differ between two states of the same VM.
+func diffVMState(a, b models.VMStateRow) []models.VMFieldChange {
+	var fields []models.VMFieldChange
+	add := func(field models.VMField, before, after any) {
+		fields = append(fields, models.VMFieldChange{Field: field, Before: before, After: after})
+	}
+
+	if a.CPUs != b.CPUs {
+		add(models.VMFieldCPUs, a.CPUs, b.CPUs)
+	}
+	if a.MemoryMiB != b.MemoryMiB {
+		add(models.VMFieldMemoryMiB, a.MemoryMiB, b.MemoryMiB)
+	}
+	if a.DiskCapacityMiB != b.DiskCapacityMiB {
+		add(models.VMFieldDiskCapacityMiB, a.DiskCapacityMiB, b.DiskCapacityMiB)
+	}
+	if a.PowerState != b.PowerState {
+		add(models.VMFieldPowerState, a.PowerState, b.PowerState)
+	}
+	if a.Host != b.Host {
+		add(models.VMFieldHost, a.Host, b.Host)
+	}
+	if a.Cluster != b.Cluster {
+		add(models.VMFieldCluster, a.Cluster, b.Cluster)
+	}
+	if !slices.Equal(a.Datastores, b.Datastores) {
+		add(models.VMFieldDatastores, a.Datastores, b.Datastores)
+	}
+	if !slices.Equal(a.NICs, b.NICs) {
+		add(models.VMFieldNICs, a.NICs, b.NICs)
+	}
+	if !slices.Equal(a.Concerns, b.Concerns) {
+		add(models.VMFieldConcerns, a.Concerns, b.Concerns)
+	}
+	if !slices.Equal(a.Labels, b.Labels) {
+		add(models.VMFieldLabels, a.Labels, b.Labels)
+	}
+	return fields
+}
+
+// computeInfraChanges returns, sorted by name, the entities only in A (removed), only in B
+// (added), or in both with different members or capacity (changed).
+func computeInfraChanges(aEntities, bEntities []models.InfraEntity) []models.InfraEntityChange {
+	aMap := make(map[string]*models.InfraEntity, len(aEntities))
+	for i := range aEntities {
+		aMap[aEntities[i].Name] = &aEntities[i]
+	}
+	bMap := make(map[string]*models.InfraEntity, len(bEntities))
+	for i := range bEntities {
+		bMap[bEntities[i].Name] = &bEntities[i]
+	}
+
+	names := make([]string, 0, len(aMap)+len(bMap))
+	for name := range aMap {
+		names = append(names, name)
+	}
+	for name := range bMap {
+		if _, inA := aMap[name]; !inA {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	var changes []models.InfraEntityChange
+	for _, name := range names {
+		a, b := aMap[name], bMap[name]
+		change := models.InfraEntityChange{Name: name, Before: a, After: b}
+		switch {
+		case b == nil:
+			change.Change = models.InfraRemoved
+			change.VMsRemoved = a.VMIDs
+		case a == nil:
+			change.Change = models.InfraAdded
+			change.VMsAdded = b.VMIDs
+		default:
+			change.VMsAdded = setDifference(b.VMIDs, a.VMIDs)
+			change.VMsRemoved = setDifference(a.VMIDs, b.VMIDs)
+			if len(change.VMsAdded) == 0 && len(change.VMsRemoved) == 0 &&
+				a.Hosts == b.Hosts && a.CPUCores == b.CPUCores && a.MemoryMiB == b.MemoryMiB &&
+				a.CapacityMiB == b.CapacityMiB && a.FreeMiB == b.FreeMiB {
+				continue
+			}
+			change.Change = models.InfraChanged
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// setDifference returns the elements of a that are not in b, in a order.
+func setDifference(a, b []string) []string {
+	in := make(map[string]bool, len(b))
+	for _, id := range b {
+		in[id] = true
+	}
+	var diff []string
+	for _, id := range a {
+		if !in[id] {
+			diff = append(diff, id)
+		}
+	}
+	return diff
+}
+
 func countWhere(rows []models.VMComparisonRow, migratable bool) int {
 	n := 0
 	for _, r := range rows {
@@ -215,3 +460,35 @@ func paginateIDs(ids []string, page, pageSize int) models.ComparisonDiffPage {
 	end := min(start+pageSize, total)
 	return models.ComparisonDiffPage{Total: total, Page: page, PageCount: pageCount, VMIDs: ids[start:end]}
 }
+
+func paginateChanges(changes []models.VMChange, page, pageSize int) models.ComparisonChangedPage {
+	vms, total, page, pageCount := paginate(changes, page, pageSize)
+	return models.ComparisonChangedPage{Total: total, Page: page, PageCount: pageCount, VMs: vms}
+}
+
+func paginateInfra(changes []models.InfraEntityChange, page, pageSize int) models.ComparisonInfraPage {
+	entities, total, page, pageCount := paginate(changes, page, pageSize)
+	return models.ComparisonInfraPage{Total: total, Page: page, PageCount: pageCount, Entities: entities}
+}
+
+// paginate returns one page of items, never nil, with the total, the normalized page and
+// the page count, following paginateIDs.
+func paginate[T any](items []T, page, pageSize int) ([]T, int, int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 1
+	}
+	total := len(items)
+	if total == 0 {
+		return []T{}, 0, page, 0
+	}
+	pageCount := (total + pageSize - 1) / pageSize
+	if page > pageCount {
+		return []T{}, total, page, pageCount
+	}
+	start := (page - 1) * pageSize
+	end := min(start+pageSize, total)
+	return items[start:end], total, page, pageCount
+}
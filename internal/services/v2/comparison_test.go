@@ -422,5 +422,217 @@ var _ = Describe("ComparisonService", func() {
 			Expect(diff.OnlyInB.VMIDs).To(ConsistOf("vm-nm-b"))
 			Expect(diff.OnlyInB.Total).To(Equal(1))
 		})
+
+		It("reports per-field before and after values for the changed dimension", func() {
+			// vm-changed: remediated between A and B (memory, cluster, disk, NIC, concern, label)
+			// vm-same: identical in both → not changed
+			// vm-gone / vm-new: present in one collection only → not changed
+			aVMs := []struct {
+				id         string
+				cluster    string
+				migratable bool
+			}{
+				{"vm-changed", "cluster-a", false},
+				{"vm-same", "c", true},
+				{"vm-gone", "c", true},
+			}
+			bVMs := []struct {
+				id         string
+				cluster    string
+				migratable bool
+			}{
+				{"vm-changed", "cluster-b", true},
+				{"vm-same", "c", true},
+				{"vm-new", "c", true},
+			}
+
+			storeA, tmpDirA := buildTestStore(aVMs)
+			storeB, tmpDirB := buildTestStore(bVMs)
+			DeferCleanup(func() { os.RemoveAll(tmpDirA); os.RemoveAll(tmpDirB) }) //nolint:errcheck
+
+			for _, q := range []string{
+				`INSERT INTO vdisk ("VM ID", "Path", "Capacity MiB") VALUES ('vm-changed', '[ds-old] vm-changed/disk.vmdk', 1024)`,
+				`INSERT INTO vnetwork ("VM ID", "Network", "Mac Address") VALUES ('vm-changed', 'VM Network', '00:50:56:00:00:01')`,
+			} {
+				_, err := storeA.Querier().ExecContext(ctx, q)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			for _, q := range []string{
+				`UPDATE vinfo SET "Memory" = 2048, "labels" = '["remediated"]' WHERE "VM ID" = 'vm-changed'`,
+				`INSERT INTO vdisk ("VM ID", "Path", "Capacity MiB") VALUES ('vm-changed', '[ds-new] vm-changed/disk.vmdk', 1024)`,
+				`INSERT INTO vnetwork ("VM ID", "Network", "Mac Address") VALUES ('vm-changed', 'VM Network', '00:50:56:00:00:01')`,
+			} {
+				_, err := storeB.Querier().ExecContext(ctx, q)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			metaA := models.CollectionMeta{ID: "a", CreatedAt: time.Now()}
+			metaB := models.CollectionMeta{ID: "b", CreatedAt: time.Now()}
+			service := svc.NewComparisonService(storeA, storeB, metaA, metaB)
+
+			diff, err := service.Diff(ctx, models.DimensionChanged, 1, 20)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(diff.Dimension).To(Equal(models.DimensionChanged))
+			Expect(diff.OnlyInA.VMIDs).To(BeEmpty())
+			Expect(diff.OnlyInB.VMIDs).To(BeEmpty())
+			Expect(diff.Changed).NotTo(BeNil())
+			Expect(diff.Changed.Total).To(Equal(1))
+			Expect(diff.Changed.VMs).To(HaveLen(1))
+
+			vm := diff.Changed.VMs[0]
+			Expect(vm.VMID).To(Equal("vm-changed"))
+			changes := make(map[models.VMField]models.VMFieldChange)
+			for _, c := range vm.Changes {
+				changes[c.Field] = c
+			}
+			Expect(changes).To(HaveLen(5))
+			Expect(changes[models.VMFieldMemoryMiB].Before).To(BeEquivalentTo(1024))
+			Expect(changes[models.VMFieldMemoryMiB].After).To(BeEquivalentTo(2048))
+			Expect(changes[models.VMFieldCluster].Before).To(Equal("cluster-a"))
+			Expect(changes[models.VMFieldCluster].After).To(Equal("cluster-b"))
+			Expect(changes[models.VMFieldDatastores].Before).To(Equal([]string{"ds-old"}))
+			Expect(changes[models.VMFieldDatastores].After).To(Equal([]string{"ds-new"}))
+			Expect(changes[models.VMFieldConcerns].Before).To(Equal([]string{"concern-vm-changed"}))
+			Expect(changes[models.VMFieldConcerns].After).To(Equal([]string{}))
+			Expect(changes[models.VMFieldLabels].After).To(Equal([]string{"remediated"}))
+			Expect(changes).NotTo(HaveKey(models.VMFieldNICs))
+
+			summary, err := service.Summary(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(summary.Changed).To(Equal(1))
+		})
+
+		It("paginates changed VMs", func() {
+			aVMs := []struct {
+				id         string
+				cluster    string
+				migratable bool
+			}{
+				{"vm-1", "a", true},
+				{"vm-2", "a", true},
+				{"vm-3", "a", true},
+			}
+			bVMs := []struct {
+				id         string
+				cluster    string
+				migratable bool
+			}{
+				{"vm-1", "b", true},
+				{"vm-2", "b", true},
+				{"vm-3", "b", true},
+			}
+
+			storeA, tmpDirA := buildTestStore(aVMs)
+			storeB, tmpDirB := buildTestStore(bVMs)
+			DeferCleanup(func() { os.RemoveAll(tmpDirA); os.RemoveAll(tmpDirB) }) //nolint:errcheck
+
+			metaA := models.CollectionMeta{ID: "a", CreatedAt: time.Now()}
+			metaB := models.CollectionMeta{ID: "b", CreatedAt: time.Now()}
+			service := svc.NewComparisonService(storeA, storeB, metaA, metaB)
+
+			diff, err := service.Diff(ctx, models.DimensionChanged, 2, 2)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(diff.Changed.Total).To(Equal(3))
+			Expect(diff.Changed.PageCount).To(Equal(2))
+			Expect(diff.Changed.VMs).To(HaveLen(1))
+			Expect(diff.Changed.VMs[0].VMID).To(Equal("vm-3"))
+		})
+
+		It("reports added, removed and changed hosts with VM membership and capacity", func() {
+			vms := []struct {
+				id         string
+				cluster    string
+				migratable bool
+			}{
+				{"vm-1", "c", true},
+				{"vm-2", "c", true},
+			}
+			storeA, tmpDirA := buildTestStore(vms)
+			storeB, tmpDirB := buildTestStore(vms)
+			DeferCleanup(func() { os.RemoveAll(tmpDirA); os.RemoveAll(tmpDirB) }) //nolint:errcheck
+
+			for _, q := range []string{
+				`INSERT INTO vhost ("Object ID", "Host", "Cluster", "# Cores", "# Memory") VALUES ('host-1', 'esx-1', 'c', 16, 65536), ('host-2', 'esx-2', 'c', 16, 65536), ('host-3', 'esx-old', 'c', 8, 32768)`,
+				`UPDATE vinfo SET "Host" = 'esx-1'`,
+			} {
+				_, err := storeA.Querier().ExecContext(ctx, q)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			for _, q := range []string{
+				`INSERT INTO vhost ("Object ID", "Host", "Cluster", "# Cores", "# Memory") VALUES ('host-1', 'esx-1', 'c', 16, 131072), ('host-2', 'esx-2', 'c', 16, 65536), ('host-4', 'esx-new', 'c', 32, 262144)`,
+				`UPDATE vinfo SET "Host" = 'esx-1' WHERE "VM ID" = 'vm-1'`,
+				`UPDATE vinfo SET "Host" = 'esx-new' WHERE "VM ID" = 'vm-2'`,
+			} {
+				_, err := storeB.Querier().ExecContext(ctx, q)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			metaA := models.CollectionMeta{ID: "a", CreatedAt: time.Now()}
+			metaB := models.CollectionMeta{ID: "b", CreatedAt: time.Now()}
+			service := svc.NewComparisonService(storeA, storeB, metaA, metaB)
+
+			diff, err := service.Diff(ctx, models.DimensionHosts, 1, 20)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(diff.Infrastructure).NotTo(BeNil())
+			// esx-2 is unchanged and left out
+			Expect(diff.Infrastructure.Total).To(Equal(3))
+			entities := diff.Infrastructure.Entities
+
+			Expect(entities[0].Name).To(Equal("esx-1"))
+			Expect(entities[0].Change).To(Equal(models.InfraChanged))
+			Expect(entities[0].VMsRemoved).To(Equal([]string{"vm-2"}))
+			Expect(entities[0].VMsAdded).To(BeEmpty())
+			Expect(entities[0].Before.MemoryMiB).To(BeEquivalentTo(65536))
+			Expect(entities[0].After.MemoryMiB).To(BeEquivalentTo(131072))
+
+			Expect(entities[1].Name).To(Equal("esx-new"))
+			Expect(entities[1].Change).To(Equal(models.InfraAdded))
+			Expect(entities[1].Before).To(BeNil())
+			Expect(entities[1].VMsAdded).To(Equal([]string{"vm-2"}))
+
+			Expect(entities[2].Name).To(Equal("esx-old"))
+			Expect(entities[2].Change).To(Equal(models.InfraRemoved))
+			Expect(entities[2].After).To(BeNil())
+		})
+
+		It("reports datastore free space and membership moves", func() {
+			vms := []struct {
+				id         string
+				cluster    string
+				migratable bool
+			}{{"vm-1", "c", true}}
+			storeA, tmpDirA := buildTestStore(vms)
+			storeB, tmpDirB := buildTestStore(vms)
+			DeferCleanup(func() { os.RemoveAll(tmpDirA); os.RemoveAll(tmpDirB) }) //nolint:errcheck
+
+			for _, st := range []*store.Store2{storeA, storeB} {
+				_, err := st.Querier().ExecContext(ctx,
+					`INSERT INTO vdisk ("VM ID", "Path", "Capacity MiB") VALUES ('vm-1', '[ds-1] vm-1/vm-1.vmdk', 1024)`)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			_, err := storeA.Querier().ExecContext(ctx,
+				`INSERT INTO vdatastore ("Object ID", "Name", "Capacity MiB", "Free MiB") VALUES ('ds-1', 'ds-1', 10240, 8192)`)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = storeB.Querier().ExecContext(ctx,
+				`INSERT INTO vdatastore ("Object ID", "Name", "Capacity MiB", "Free MiB") VALUES ('ds-1', 'ds-1', 10240, 2048)`)
+			Expect(err).NotTo(HaveOccurred())
+
+			metaA := models.CollectionMeta{ID: "a", CreatedAt: time.Now()}
+			metaB := models.CollectionMeta{ID: "b", CreatedAt: time.Now()}
+			service := svc.NewComparisonService(storeA, storeB, metaA, metaB)
+
+			diff, err := service.Diff(ctx, models.DimensionDatastores, 1, 20)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(diff.Infrastructure.Entities).To(HaveLen(1))
+			ds := diff.Infrastructure.Entities[0]
+			Expect(ds.Change).To(Equal(models.InfraChanged))
+			Expect(ds.Before.VMIDs).To(Equal([]string{"vm-1"}))
+			Expect(ds.Before.FreeMiB).To(BeEquivalentTo(8192))
+			Expect(ds.After.FreeMiB).To(BeEquivalentTo(2048))
+		})
 	})
 })
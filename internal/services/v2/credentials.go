@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"regexp"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/vmware/govmomi"
@@ -21,8 +24,14 @@ import (
 	"github.com/kubev2v/assisted-migration-agent/pkg/vmware"
 )
 
+// credentialsRecordID is the record holding the default profile. It predates
+// named profiles and is shared with the v1 API, so it keeps its original ID.
 const credentialsRecordID = "credentials"
 
+// profileNameRe restricts profile names to DNS labels so they are safe to use in
+// URLs, filter expressions and file names.
+var profileNameRe = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
 type CredentialsService struct {
 	store  *store.Store2
 	crypto *crypto.Crypto
@@ -121,7 +130,18 @@ func (s *CredentialsService) VerifyMasterPassword(ctx context.Context, password
 	return s.crypto.Verify(password, stored)
 }
 
+// Store validates and stores the credentials of the default profile.
 func (s *CredentialsService) Store(ctx context.Context, creds models.Credentials) (string, error) {
+	return s.StoreProfile(ctx, models.DefaultCredentialProfile, creds)
+}
+
+// StoreProfile validates the credentials against vCenter and stores them under
+// the given profile name, replacing any previous credentials of that profile.
+func (s *CredentialsService) StoreProfile(ctx context.Context, profile string, creds models.Credentials) (string, error) {
+	if err := ValidateProfileName(profile); err != nil {
+		return creds.URL, err
+	}
+
 	normalizedURL, err := vmware.NormalizeAndValidateURL(creds.URL)
 	if err != nil {
 		return creds.URL, srvErrors.NewValidationError(fmt.Sprintf("invalid vCenter URL: %s", err))
@@ -147,7 +167,7 @@ func (s *CredentialsService) Store(ctx context.Context, creds models.Credentials
 		}
 		return creds.URL, err
 	}
-	if err := s.Save(ctx, s.keyMgr.Key(), credentialsRecordID, creds); err != nil {
+	if err := s.Save(ctx, s.keyMgr.Key(), profileRecordID(profile), creds); err != nil {
 		return creds.URL, fmt.Errorf("saving credentials: %w", err)
 	}
 
@@ -165,11 +185,18 @@ func (s *CredentialsService) Status(ctx context.Context) (url, username string,
 	return creds.URL, creds.Username, nil
 }
 
+// Resolve returns the credentials of the default profile.
 func (s *CredentialsService) Resolve(ctx context.Context) (models.Credentials, error) {
+	return s.ResolveProfile(ctx, models.DefaultCredentialProfile)
+}
+
+// ResolveProfile returns the decrypted credentials of a profile.
+// It returns a CredentialsNotSetError when the profile does not exist.
+func (s *CredentialsService) ResolveProfile(ctx context.Context, profile string) (models.Credentials, error) {
 	if s.keyMgr == nil {
 		return models.Credentials{}, srvErrors.NewCredentialsNotSetError()
 	}
-	creds, err := s.Get(ctx, s.keyMgr.Key(), credentialsRecordID)
+	creds, err := s.Get(ctx, s.keyMgr.Key(), profileRecordID(profile))
 	if err != nil {
 		if srvErrors.IsResourceNotFoundError(err) {
 			return models.Credentials{}, srvErrors.NewCredentialsNotSetError()
@@ -276,6 +303,48 @@ func (s *CredentialsService) GetCapabilities(ctx context.Context) (*models.Capab
 	return status, nil
 }
 
+// ListProfiles returns the stored credential profiles ordered by name.
+func (s *CredentialsService) ListProfiles(ctx context.Context) ([]models.CredentialProfile, error) {
+	ids, err := s.store.Credentials().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := make([]models.CredentialProfile, 0, len(ids))
+	for _, id := range ids {
+		p, err := s.GetProfile(ctx, profileName(id))
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, *p)
+	}
+	slices.SortFunc(profiles, func(a, b models.CredentialProfile) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return profiles, nil
+}
+
+// GetProfile returns the metadata of a stored profile.
+func (s *CredentialsService) GetProfile(ctx context.Context, profile string) (*models.CredentialProfile, error) {
+	creds, err := s.ResolveProfile(ctx, profile)
+	if err != nil {
+		if srvErrors.IsCredentialsNotSetError(err) {
+			return nil, srvErrors.NewResourceNotFoundError("credential profile", profile)
+		}
+		return nil, err
+	}
+	return &models.CredentialProfile{Name: profile, URL: creds.URL, Username: creds.Username}, nil
+}
+
+// DeleteProfile removes the credentials of a profile.
+func (s *CredentialsService) DeleteProfile(ctx context.Context, profile string) error {
+	if _, err := s.GetProfile(ctx, profile); err != nil {
+		return err
+	}
+	return s.store.Credentials().Delete(ctx, profileRecordID(profile))
+}
+
 func (s *CredentialsService) List(ctx context.Context) ([]string, error) {
 	return s.store.Credentials().List(ctx)
 }
@@ -314,6 +383,28 @@ func (s *CredentialsService) DeleteAll(ctx context.Context) error {
 	return s.store.Credentials().DeleteAll(ctx)
 }
 
+// ValidateProfileName returns a ValidationError if name is not a valid profile name.
+func ValidateProfileName(name string) error {
+	if !profileNameRe.MatchString(name) || name == credentialsRecordID {
+		return srvErrors.NewValidationError(fmt.Sprintf("invalid credential profile name %q: must be a lowercase DNS label", name))
+	}
+	return nil
+}
+
+func profileRecordID(profile string) string {
+	if profile == models.DefaultCredentialProfile {
+		return credentialsRecordID
+	}
+	return profile
+}
+
+func profileName(recordID string) string {
+	if recordID == credentialsRecordID {
+		return models.DefaultCredentialProfile
+	}
+	return recordID
+}
+
 type datacenterFolders struct {
 	allRefs      []types.ManagedObjectReference
 	vmFolderRefs []types.ManagedObjectReference
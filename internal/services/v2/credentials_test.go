@@ -11,6 +11,7 @@ import (
 
 	"github.com/kubev2v/assisted-migration-agent/internal/models"
 	"github.com/kubev2v/assisted-migration-agent/internal/services"
+	v2 "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
 	"github.com/kubev2v/assisted-migration-agent/internal/store"
 	"github.com/kubev2v/assisted-migration-agent/pkg/crypto"
 	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
@@ -338,3 +339,25 @@ var _ = Describe("CredentialsService", func() {
 		})
 	})
 })
+
+var _ = Describe("ValidateProfileName", func() {
+	DescribeTable("should accept DNS label names",
+		func(name string) {
+			Expect(v2.ValidateProfileName(name)).To(Succeed())
+		},
+		Entry("default profile", models.DefaultCredentialProfile),
+		Entry("simple name", "vc-east"),
+		Entry("digits", "vc01"),
+	)
+
+	DescribeTable("should reject invalid names",
+		func(name string) {
+			Expect(srvErrors.IsValidationError(v2.ValidateProfileName(name))).To(BeTrue())
+		},
+		Entry("empty", ""),
+		Entry("uppercase", "VC-East"),
+		Entry("leading dash", "-vc"),
+		Entry("path separator", "vc/east"),
+		Entry("reserved record id", "credentials"),
+	)
+})
@@ -0,0 +1,325 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/duckdb_parser"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	"github.com/kubev2v/assisted-migration-agent/pkg/vmware"
+)
+
+const bytesPerMiB = 1024 * 1024
+
+// vmChangeTrackers keeps the VM change tracker of each credential profile between
+// collections. A tracker is established by a full collection and consumed by the
+// incremental collections that follow it.
+type vmChangeTrackers struct {
+	mu       sync.Mutex
+	trackers map[string]*vmware.VMChangeTracker
+}
+
+func newVMChangeTrackers() *vmChangeTrackers {
+	return &vmChangeTrackers{trackers: make(map[string]*vmware.VMChangeTracker)}
+}
+
+// Get returns the tracker of the profile, or nil if none is established.
+func (t *vmChangeTrackers) Get(vcenter string) *vmware.VMChangeTracker {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.trackers[vcenter]
+}
+
+// Set installs the tracker of the profile, closing the one it replaces.
+func (t *vmChangeTrackers) Set(ctx context.Context, vcenter string, tracker *vmware.VMChangeTracker) {
+	t.mu.Lock()
+	prev := t.trackers[vcenter]
+	t.trackers[vcenter] = tracker
+	t.mu.Unlock()
+
+	if prev != nil && prev != tracker {
+		prev.Close(ctx)
+	}
+}
+
+// Drop closes and forgets the tracker of the profile.
+func (t *vmChangeTrackers) Drop(ctx context.Context, vcenter string) {
+	t.mu.Lock()
+	prev := t.trackers[vcenter]
+	delete(t.trackers, vcenter)
+	t.mu.Unlock()
+
+	if prev != nil {
+		prev.Close(ctx)
+	}
+}
+
+// Close closes every tracker.
+func (t *vmChangeTrackers) Close(ctx context.Context) {
+	t.mu.Lock()
+	trackers := t.trackers
+	t.trackers = make(map[string]*vmware.VMChangeTracker)
+	t.mu.Unlock()
+
+	for _, tracker := range trackers {
+		tracker.Close(ctx)
+	}
+}
+
+// readVMChanges reads the pending changes of tracker and fetches the current state of
+// every added or modified VM.
+func readVMChanges(ctx context.Context, tracker *vmware.VMChangeTracker) (models.CollectionDelta, []models.VMPatch, error) {
+	changes, err := tracker.Changes(ctx)
+	if err != nil {
+		return models.CollectionDelta{}, nil, err
+	}
+	delta := models.CollectionDelta{
+		Added:    changes.Added,
+		Modified: changes.Modified,
+		Removed:  changes.Removed,
+	}
+
+	vms, err := tracker.RetrieveVMs(ctx, changes.Changed())
+	if err != nil {
+		return models.CollectionDelta{}, nil, err
+	}
+
+	var networkRefs []types.ManagedObjectReference
+	for _, vm := range vms {
+		networkRefs = append(networkRefs, vm.Network...)
+	}
+	networks, err := tracker.RetrieveNetworkNames(ctx, networkRefs)
+	if err != nil {
+		return models.CollectionDelta{}, nil, err
+	}
+
+	patches := make([]models.VMPatch, 0, len(vms))
+	for _, vm := range vms {
+		patches = append(patches, newVMPatch(vm, networks))
+	}
+
+	return delta, patches, nil
+}
+
+// ApplyDelta patches an incremental collection: added and modified VMs are written from
+// their patches and validated again, so that their concerns match their new configuration,
+// and the delta is recorded. Removed VMs are deleted once the patches are committed, so a
+// failed patch leaves the collection untouched. A nil validator leaves concerns as they are.
+func ApplyDelta(ctx context.Context, st *store.Store2, delta models.CollectionDelta, patches []models.VMPatch, validator duckdb_parser.Validator) error {
+	if err := st.WithTx(ctx, func(txCtx context.Context) error {
+		if err := st.VM().ApplyPatches(txCtx, patches); err != nil {
+			return fmt.Errorf("applying VM patches: %w", err)
+		}
+		if validator != nil {
+			for _, id := range append(slices.Clone(delta.Added), delta.Modified...) {
+				if err := revalidateVM(txCtx, st, validator, id); err != nil {
+					return err
+				}
+			}
+		}
+		if err := st.CollectionDelta().Save(txCtx, delta); err != nil {
+			return fmt.Errorf("recording collection delta: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := st.VM().DeleteVMs(ctx, delta.Removed); err != nil {
+		return fmt.Errorf("deleting removed VMs: %w", err)
+	}
+	return nil
+}
+
+// revalidateVM runs the validator on a VM of the collection and replaces its concerns.
+func revalidateVM(ctx context.Context, st *store.Store2, validator duckdb_parser.Validator, id string) error {
+	vm, err := st.VM().GetParserVM(ctx, id)
+	if err != nil {
+		return fmt.Errorf("reading VM %s for validation: %w", id, err)
+	}
+	concerns, err := validator.Validate(ctx, *vm)
+	if err != nil {
+		return fmt.Errorf("validating VM %s: %w", id, err)
+	}
+	if err := st.VM().ReplaceConcerns(ctx, id, concerns); err != nil {
+		return fmt.Errorf("writing concerns of VM %s: %w", id, err)
+	}
+	return nil
+}
+
+// SyncDelta is the incremental counterpart of SyncAttached. Groups, labels and exclusion
+// flags are already present in the cloned collection, so only the New label is moved from
+// the VMs added by the previous collection to the ones added by this one, the exclusions
+// that expired at now are lifted and the VMs powered off since are recorded as such at now.
+// It returns the VMs whose exclusion was lifted.
+func SyncDelta(ctx context.Context, st *store.Store2, delta models.CollectionDelta, now time.Time) ([]string, error) {
+	ctx = store.WithLabelActor(ctx, systemLabelActor)
+	var lifted []string
+	err := st.WithTx(ctx, func(txCtx context.Context) error {
+		if _, err := st.VM().RemoveLabelGlobally(txCtx, LabelNew); err != nil {
+			return fmt.Errorf("clearing %s label: %w", LabelNew, err)
+		}
+		if err := st.VM().AddLabelBatch(txCtx, delta.Added, LabelNew); err != nil {
+			return fmt.Errorf("labeling new VMs: %w", err)
+		}
+		vmIDs, err := st.VM().LiftExpiredMigrationExclusions(txCtx, now)
+		if err != nil {
+			return fmt.Errorf("lifting expired migration exclusions: %w", err)
+		}
+		lifted = vmIDs
+		if err := st.VM().TrackPoweredOff(txCtx, now); err != nil {
+			return fmt.Errorf("tracking powered off VMs: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lifted, nil
+}
+
+// newVMPatch converts the vCenter state of a VM to a patch. networks maps network IDs and
+// distributed port group keys to network names.
+func newVMPatch(vm mo.VirtualMachine, networks map[string]string) models.VMPatch {
+	lifecycle := newVMLifecycle(vm)
+	p := models.VMPatch{
+		ID:         vm.Self.Value,
+		Name:       vm.Name,
+		PowerState: string(vm.Runtime.PowerState),
+		Lifecycle:  &lifecycle,
+	}
+	if vm.Runtime.Host != nil {
+		p.HostID = vm.Runtime.Host.Value
+	}
+	if g := vm.Guest; g != nil {
+		p.ToolsGuestOS = g.GuestFullName
+		p.DNSName = g.HostName
+		p.IPAddress = g.IpAddress
+	}
+	if s := vm.Summary.Storage; s != nil {
+		p.InUseMiB = s.Committed / bytesPerMiB
+		p.ProvisionedMiB = (s.Committed + s.Uncommitted) / bytesPerMiB
+	}
+
+	c := vm.Config
+	if c == nil {
+		return p
+	}
+	p.Template = c.Template
+	p.CPUs = c.Hardware.NumCPU
+	p.CoresPerSocket = c.Hardware.NumCoresPerSocket
+	p.MemoryMiB = c.Hardware.MemoryMB
+	p.CPUHotAdd = boolValue(c.CpuHotAddEnabled)
+	p.CPUHotRemove = boolValue(c.CpuHotRemoveEnabled)
+	p.MemoryHotAdd = boolValue(c.MemoryHotAddEnabled)
+	p.CBT = boolValue(c.ChangeTrackingEnabled)
+	p.UUID = c.Uuid
+	p.InstanceUUID = c.InstanceUuid
+	p.Firmware = c.Firmware
+	p.HWVersion = c.Version
+	p.GuestOS = c.GuestFullName
+
+	devices := object.VirtualDeviceList(c.Hardware.Device)
+	for _, device := range devices {
+		switch d := device.(type) {
+		case *types.VirtualDisk:
+			p.Disks = append(p.Disks, newVMPatchDisk(devices, d))
+		case types.BaseVirtualEthernetCard:
+			p.NICs = append(p.NICs, newVMPatchNIC(devices, d, vm.Guest, networks))
+		}
+	}
+
+	return p
+}
+
+func newVMPatchDisk(devices object.VirtualDeviceList, d *types.VirtualDisk) models.VMPatchDisk {
+	disk := models.VMPatchDisk{
+		Key:         d.Key,
+		Label:       deviceLabel(d),
+		CapacityMiB: d.CapacityInBytes / bytesPerMiB,
+	}
+	if d.UnitNumber != nil {
+		disk.UnitNumber = *d.UnitNumber
+	}
+	if controller := devices.FindByKey(d.ControllerKey); controller != nil {
+		disk.Controller = deviceLabel(controller)
+	}
+
+	switch b := d.Backing.(type) {
+	case *types.VirtualDiskFlatVer2BackingInfo:
+		disk.File = b.FileName
+		disk.Mode = b.DiskMode
+		disk.Sharing = b.Sharing
+		disk.UUID = b.Uuid
+		disk.Thin = boolValue(b.ThinProvisioned)
+	case *types.VirtualDiskRawDiskMappingVer1BackingInfo:
+		disk.File = b.FileName
+		disk.Mode = b.DiskMode
+		disk.Sharing = b.Sharing
+		disk.UUID = b.Uuid
+		disk.RDM = true
+	case types.BaseVirtualDeviceFileBackingInfo:
+		disk.File = b.GetVirtualDeviceFileBackingInfo().FileName
+	}
+
+	return disk
+}
+
+func newVMPatchNIC(devices object.VirtualDeviceList, card types.BaseVirtualEthernetCard, guest *types.GuestInfo, networks map[string]string) models.VMPatchNIC {
+	c := card.GetVirtualEthernetCard()
+	nic := models.VMPatchNIC{
+		Label:   deviceLabel(c),
+		Adapter: devices.Type(card.(types.BaseVirtualDevice)),
+		MAC:     c.MacAddress,
+	}
+	if c.Connectable != nil {
+		nic.Connected = c.Connectable.Connected
+		nic.StartConnected = c.Connectable.StartConnected
+	}
+
+	switch b := c.Backing.(type) {
+	case *types.VirtualEthernetCardNetworkBackingInfo:
+		nic.Network = b.DeviceName
+	case *types.VirtualEthernetCardDistributedVirtualPortBackingInfo:
+		nic.Network = networks[b.Port.PortgroupKey]
+	}
+
+	if guest != nil {
+		for _, n := range guest.Net {
+			if !strings.EqualFold(n.MacAddress, c.MacAddress) {
+				continue
+			}
+			for _, ip := range n.IpAddress {
+				if strings.Contains(ip, ":") {
+					if nic.IPv6 == "" {
+						nic.IPv6 = ip
+					}
+				} else if nic.IPv4 == "" {
+					nic.IPv4 = ip
+				}
+			}
+		}
+	}
+
+	return nic
+}
+
+func deviceLabel(d types.BaseVirtualDevice) string {
+	if info := d.GetVirtualDevice().DeviceInfo; info != nil {
+		return info.GetDescription().Label
+	}
+	return ""
+}
+
+func boolValue(b *bool) bool {
+	return b != nil && *b
+}
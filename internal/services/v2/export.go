@@ -14,12 +14,15 @@ import (
 
 	"github.com/xuri/excelize/v2"
 
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
 	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
 )
 
 type ExportService struct {
-	store     *store.Store2
-	mainStore *store.Store2
+	store      *store.Store2
+	mainStore  *store.Store2
+	expression string
 }
 
 func NewExportService(st *store.Store2) *ExportService {
@@ -30,6 +33,13 @@ func NewExportServiceWithMain(st, mainSt *store.Store2) *ExportService {
 	return &ExportService{store: st, mainStore: mainSt}
 }
 
+// WithExpression restricts the per-VM scopes of the export to the VMs matching a filter
+// expression.
+func (s *ExportService) WithExpression(expression string) *ExportService {
+	s.expression = expression
+	return s
+}
+
 func (s *ExportService) SupportedScopes() []string {
 	return s.store.Export().SupportedScopes()
 }
@@ -45,17 +55,22 @@ func (s *ExportService) WriteZip(ctx context.Context, scopes []string, w io.Writ
 	}
 	defer func() { _ = os.RemoveAll(tmpDir) }()
 
-	exportStore := s.store.Export()
+	exportStore := s.exportStore()
 	var mainExportStore *store.ExportStore
 	if s.mainStore != nil {
 		mainExportStore = s.mainStore.Export()
 	}
 
+	policy, err := s.recommendationPolicy(ctx)
+	if err != nil {
+		return err
+	}
+
 	for _, scope := range scopes {
 		if err := ctx.Err(); err != nil {
 			return err
 		}
-		if err := exportScope(ctx, exportStore, mainExportStore, scope, tmpDir); err != nil {
+		if err := exportScope(ctx, exportStore, mainExportStore, policy, scope, tmpDir); err != nil {
 			return fmt.Errorf("%s export failed: %w", scope, err)
 		}
 	}
@@ -75,17 +90,22 @@ func (s *ExportService) WriteExcel(ctx context.Context, scopes []string, w io.Wr
 	}
 	defer func() { _ = os.RemoveAll(tmpDir) }()
 
-	exportStore := s.store.Export()
+	exportStore := s.exportStore()
 	var mainExportStore *store.ExportStore
 	if s.mainStore != nil {
 		mainExportStore = s.mainStore.Export()
 	}
 
+	policy, err := s.recommendationPolicy(ctx)
+	if err != nil {
+		return err
+	}
+
 	for _, scope := range scopes {
 		if err := ctx.Err(); err != nil {
 			return err
 		}
-		if err := exportScope(ctx, exportStore, mainExportStore, scope, tmpDir); err != nil {
+		if err := exportScope(ctx, exportStore, mainExportStore, policy, scope, tmpDir); err != nil {
 			return fmt.Errorf("%s export failed: %w", scope, err)
 		}
 	}
@@ -97,6 +117,32 @@ func (s *ExportService) WriteExcel(ctx context.Context, scopes []string, w io.Wr
 	return writeXLSX(ctx, s.store.Export(), scopes, tmpDir, w)
 }
 
+// recommendationPolicy returns the rightsizing policy of the recommendations scope: the
+// default policy of the main database, or the built-in default without a main database.
+func (s *ExportService) recommendationPolicy(ctx context.Context) (models.RightsizingPolicy, error) {
+	if s.mainStore == nil {
+		return models.DefaultRightsizingPolicy, nil
+	}
+	policy, err := s.mainStore.RightsizingPolicy().Get(ctx, models.DefaultRightsizingPolicyName)
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			return models.DefaultRightsizingPolicy, nil
+		}
+		return models.RightsizingPolicy{}, fmt.Errorf("getting default rightsizing policy: %w", err)
+	}
+	return *policy, nil
+}
+
+// exportStore returns the export store of the collection, restricted to the VMs matching the
+// export expression if any.
+func (s *ExportService) exportStore() *store.ExportStore {
+	es := s.store.Export()
+	if filter := store.ByFilter(s.expression); filter != nil {
+		return es.Filtered(filter)
+	}
+	return es
+}
+
 func writeXLSX(ctx context.Context, exportStore *store.ExportStore, scopes []string, tmpDir string, w io.Writer) error {
 	f := excelize.NewFile()
 	defer func() { _ = f.Close() }()
@@ -149,6 +195,9 @@ var sheetNames = map[string]string{
 	"groups":           "Groups",
 	"inspection":       "Inspection",
 	"storage-forecast": "Storage Forecast",
+	"sizing":           "Sizing",
+	"recommendations":  "Recommendations",
+	"waste":            "Waste",
 }
 
 func scopeSheetName(scope string) string {
@@ -193,7 +242,7 @@ func addCSVAsSheet(f *excelize.File, sheet, csvPath string) error {
 	return nil
 }
 
-func exportScope(ctx context.Context, exportStore, mainExportStore *store.ExportStore, scope, tmpDir string) error {
+func exportScope(ctx context.Context, exportStore, mainExportStore *store.ExportStore, policy models.RightsizingPolicy, scope, tmpDir string) error {
 	// storage-forecast data lives in the main agent database
 	if scope == "storage-forecast" {
 		if mainExportStore == nil {
@@ -210,6 +259,9 @@ func exportScope(ctx context.Context, exportStore, mainExportStore *store.Export
 	if scope == "utilization" {
 		return exportStore.ExportUtilization(ctx, tmpDir)
 	}
+	if scope == "recommendations" {
+		return exportStore.ExportRecommendations(ctx, tmpDir, policy)
+	}
 	filename, ok := exportStore.ScopeFilename(scope)
 	if !ok {
 		return fmt.Errorf("unknown export scope: %s", scope)
@@ -0,0 +1,214 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	vmfilter "github.com/kubev2v/assisted-migration-agent/internal/filter"
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+	"github.com/kubev2v/assisted-migration-agent/pkg/filter"
+)
+
+// maxFieldExamples is the number of example values returned per field.
+const maxFieldExamples = 5
+
+// FilterService helps writing VM filter expressions: it validates and explains them and
+// lists the fields they can use. Matched VMs and field examples come from the latest
+// collection. Saved filter references are expanded first.
+type FilterService struct {
+	pool         *store.Pool
+	savedFilters *SavedFilterService
+}
+
+func NewFilterService(pool *store.Pool) *FilterService {
+	return &FilterService{pool: pool, savedFilters: NewSavedFilterService(pool)}
+}
+
+// Validate checks an expression against the VM filter DSL and DefaultMapper. Syntax errors
+// stop at the first one; unknown fields and unknown saved filters come with suggestions.
+// Offsets are those of the expression as written, an issue inside a saved filter being
+// reported at its reference.
+func (s *FilterService) Validate(ctx context.Context, expression string) (models.FilterValidation, error) {
+	exp, err := s.savedFilters.Expansion(ctx, expression)
+	if err != nil {
+		var re *filter.ReferenceError
+		if !errors.As(err, &re) {
+			return models.FilterValidation{}, err
+		}
+		issue, err := s.newReferenceIssue(ctx, re)
+		if err != nil {
+			return models.FilterValidation{}, err
+		}
+		return models.FilterValidation{Issues: []models.FilterIssue{issue}}, nil
+	}
+
+	if _, err := vmfilter.ParseWithDefaultMap(exp.Source); err != nil {
+		issue := newFilterIssue(err)
+		issue.Offset = exp.Position(issue.Offset)
+		return models.FilterValidation{Issues: []models.FilterIssue{issue}}, nil
+	}
+	return models.FilterValidation{Valid: true}, nil
+}
+
+// Explain returns the tree and the SQL of an expression, and the number of VMs of the latest
+// collection it matches. Saved filter references are shown expanded. An invalid expression
+// is a validation error.
+func (s *FilterService) Explain(ctx context.Context, expression string) (*models.FilterExplanation, error) {
+	expanded, err := s.savedFilters.Expand(ctx, expression)
+	if err != nil {
+		return nil, err
+	}
+	sqlizer, err := vmfilter.ParseWithDefaultMap([]byte(expanded))
+	if err != nil {
+		return nil, srvErrors.NewValidationError(fmt.Sprintf("expression filter is invalid: %v", err))
+	}
+	tree, err := filter.ParseTree([]byte(expanded))
+	if err != nil {
+		return nil, srvErrors.NewValidationError(fmt.Sprintf("expression filter is invalid: %v", err))
+	}
+	query, args, err := sqlizer.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building filter SQL: %w", err)
+	}
+
+	explanation := &models.FilterExplanation{Tree: tree, SQL: query, Args: args}
+
+	st, err := s.latestStore()
+	if err != nil {
+		return nil, err
+	}
+	if st == nil {
+		return explanation, nil
+	}
+	count, err := st.VM().Count(ctx, sqlizer)
+	if err != nil {
+		return nil, fmt.Errorf("counting matched VMs: %w", err)
+	}
+	explanation.MatchedVMs = &count
+
+	return explanation, nil
+}
+
+// Fields lists the fields of the VM filter DSL, with example values from the latest
+// collection when there is one.
+func (s *FilterService) Fields(ctx context.Context) ([]models.FilterField, error) {
+	fields := make([]models.FilterField, 0, len(vmfilter.DefaultFields))
+	columns := make([]store.FilterColumn, 0, len(vmfilter.DefaultFields))
+	for _, f := range vmfilter.DefaultFields {
+		col, ft, err := vmfilter.DefaultMapper(f.Name)
+		if err != nil {
+			return nil, fmt.Errorf("resolving filter field %s: %w", f.Name, err)
+		}
+		field := models.FilterField{
+			Name:     f.Name,
+			Aliases:  f.Aliases,
+			Type:     ft.String(),
+			Examples: []string{},
+		}
+		if prefix, _, ok := strings.Cut(f.Name, "."); ok {
+			if _, err := vmfilter.DefaultCollections(prefix); err == nil {
+				field.Collection = prefix
+			}
+		}
+		if f.Sized {
+			field.Units = vmfilter.SizeUnits
+		}
+		if ft == filter.AgeField {
+			field.Units = vmfilter.DurationUnits
+		}
+		fields = append(fields, field)
+		columns = append(columns, store.FilterColumn{SQL: col, Array: ft == filter.ArrayField})
+	}
+
+	st, err := s.latestStore()
+	if err != nil {
+		return nil, err
+	}
+	if st == nil {
+		return fields, nil
+	}
+	samples, err := st.VM().SampleFilterValues(ctx, columns, maxFieldExamples)
+	if err != nil {
+		return nil, err
+	}
+	for i := range fields {
+		// age fields sample timestamps, which are no valid values for them
+		if fields[i].Type == filter.AgeField.String() {
+			continue
+		}
+		fields[i].Examples = samples[i]
+	}
+
+	return fields, nil
+}
+
+// latestStore returns the store of the latest collection, or nil if there is none.
+func (s *FilterService) latestStore() (*store.Store2, error) {
+	db, err := s.pool.Latest()
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return db.Store()
+}
+
+// newReferenceIssue reports a saved filter reference that cannot be expanded, suggesting
+// saved filters with a close name.
+func (s *FilterService) newReferenceIssue(ctx context.Context, re *filter.ReferenceError) (models.FilterIssue, error) {
+	filters, err := s.savedFilters.List(ctx)
+	if err != nil {
+		return models.FilterIssue{}, err
+	}
+	names := make([]string, 0, len(filters))
+	for _, f := range filters {
+		if f.Name != re.Name {
+			names = append(names, f.Name)
+		}
+	}
+	return models.FilterIssue{
+		Kind:        models.FilterIssueReference,
+		Offset:      re.Position,
+		Message:     re.Error(),
+		Field:       re.Name,
+		Suggestions: vmfilter.SuggestFrom(re.Name, names),
+	}, nil
+}
+
+func newFilterIssue(err error) models.FilterIssue {
+	var pe filter.ParseError
+	if errors.As(err, &pe) {
+		return models.FilterIssue{Kind: models.FilterIssueSyntax, Offset: pe.Position, Message: pe.Message}
+	}
+
+	var fe *filter.FieldError
+	if errors.As(err, &fe) {
+		issue := models.FilterIssue{
+			Kind:    models.FilterIssueInvalidField,
+			Offset:  fe.Position,
+			Message: fe.Error(),
+			Field:   fe.Field,
+		}
+		if !isKnownFilterField(fe.Field) {
+			issue.Kind = models.FilterIssueUnknownField
+			issue.Suggestions = vmfilter.Suggest(fe.Field)
+		}
+		return issue
+	}
+
+	return models.FilterIssue{Kind: models.FilterIssueSyntax, Message: err.Error()}
+}
+
+// isKnownFilterField reports whether name is a field, or a collection of fields, of the DSL.
+func isKnownFilterField(name string) bool {
+	if _, _, err := vmfilter.DefaultMapper(name); err == nil {
+		return true
+	}
+	_, err := vmfilter.DefaultCollections(name)
+	return err == nil
+}
@@ -0,0 +1,187 @@
+package v2_test
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	v2 "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+var _ = Describe("FilterService", func() {
+	var (
+		ctx    context.Context
+		pool   *store.Pool
+		tmpDir string
+		srv    *v2.FilterService
+	)
+
+	// addCollection registers a collection with three VMs on two clusters.
+	addCollection := func() {
+		_, st := addTestCollection(pool, "col-1000", time.Unix(1000, 0))
+
+		_, err := st.Querier().ExecContext(ctx,
+			`INSERT INTO vinfo ("VM ID", "VM", "Cluster", "Powerstate", "Template", "Memory", "CPUs", "labels")
+			 VALUES ('vm-1', 'web-1', 'prod', 'poweredOn', false, 8192, 4, ['web']),
+			        ('vm-2', 'web-2', 'prod', 'poweredOff', false, 4096, 2, ['web', 'frontend']),
+			        ('vm-3', 'db-1', 'dev', 'poweredOn', false, 16384, 8, [])`)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		tmpDir, err = os.MkdirTemp("", "filter-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		pool = newTestPool(tmpDir)
+
+		srv = v2.NewFilterService(pool)
+	})
+
+	AfterEach(func() {
+		pool.Close()
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	Context("Validate", func() {
+		It("accepts a valid expression", func() {
+			v, err := srv.Validate(ctx, "memory >= 8GB and cluster = 'prod'")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(v.Valid).To(BeTrue())
+			Expect(v.Issues).To(BeEmpty())
+		})
+
+		It("reports syntax errors with their offset", func() {
+			v, err := srv.Validate(ctx, "memory >= ")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(v.Valid).To(BeFalse())
+			Expect(v.Issues).To(HaveLen(1))
+			Expect(v.Issues[0].Kind).To(Equal(models.FilterIssueSyntax))
+			Expect(v.Issues[0].Offset).To(Equal(10))
+		})
+
+		It("suggests fields for an unknown field", func() {
+			v, err := srv.Validate(ctx, "cluster = 'prod' and memroy > 8GB")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(v.Valid).To(BeFalse())
+			Expect(v.Issues).To(HaveLen(1))
+			issue := v.Issues[0]
+			Expect(issue.Kind).To(Equal(models.FilterIssueUnknownField))
+			Expect(issue.Offset).To(Equal(21))
+			Expect(issue.Field).To(Equal("memroy"))
+			Expect(issue.Suggestions).To(ContainElement("memory"))
+		})
+
+		It("reports known fields used with the wrong type", func() {
+			v, err := srv.Validate(ctx, "memory = 'big'")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(v.Valid).To(BeFalse())
+			Expect(v.Issues[0].Kind).To(Equal(models.FilterIssueInvalidField))
+			Expect(v.Issues[0].Field).To(Equal("memory"))
+			Expect(v.Issues[0].Suggestions).To(BeEmpty())
+		})
+
+		It("reports unknown saved filter references with suggestions", func() {
+			_, err := v2.NewSavedFilterService(pool).Create(ctx, models.SavedFilter{Name: "prod", Expression: "cluster = 'prod'"})
+			Expect(err).NotTo(HaveOccurred())
+
+			v, err := srv.Validate(ctx, "memory > 8GB and @prd")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(v.Valid).To(BeFalse())
+			Expect(v.Issues).To(HaveLen(1))
+			issue := v.Issues[0]
+			Expect(issue.Kind).To(Equal(models.FilterIssueReference))
+			Expect(issue.Offset).To(Equal(17))
+			Expect(issue.Field).To(Equal("prd"))
+			Expect(issue.Suggestions).To(ContainElement("prod"))
+		})
+
+		It("maps offsets of issues after a reference back to the expression", func() {
+			_, err := v2.NewSavedFilterService(pool).Create(ctx, models.SavedFilter{Name: "prod", Expression: "cluster = 'prod'"})
+			Expect(err).NotTo(HaveOccurred())
+
+			v, err := srv.Validate(ctx, "@prod and memroy > 8GB")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(v.Valid).To(BeFalse())
+			Expect(v.Issues[0].Kind).To(Equal(models.FilterIssueUnknownField))
+			Expect(v.Issues[0].Offset).To(Equal(10))
+		})
+	})
+
+	Context("Explain", func() {
+		It("returns the tree and SQL without a count when there is no collection", func() {
+			e, err := srv.Explain(ctx, "cluster = 'prod'")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(e.Tree.Operator).To(Equal("="))
+			Expect(e.SQL).To(ContainSubstring(`v."Cluster"`))
+			Expect(e.Args).To(Equal([]any{"prod"}))
+			Expect(e.MatchedVMs).To(BeNil())
+		})
+
+		It("counts the VMs of the latest collection matching the expression", func() {
+			addCollection()
+
+			e, err := srv.Explain(ctx, "cluster = 'prod' and memory >= 8GB")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(e.MatchedVMs).NotTo(BeNil())
+			Expect(*e.MatchedVMs).To(Equal(1))
+		})
+
+		It("rejects invalid expressions", func() {
+			_, err := srv.Explain(ctx, "memroy > 8GB")
+			Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+		})
+	})
+
+	Context("Fields", func() {
+		fieldByName := func(fields []models.FilterField, name string) models.FilterField {
+			for _, f := range fields {
+				if f.Name == name {
+					return f
+				}
+			}
+			Fail("field not found: " + name)
+			return models.FilterField{}
+		}
+
+		It("lists fields with types, units and collections", func() {
+			fields, err := srv.Fields(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			memory := fieldByName(fields, "memory")
+			Expect(memory.Type).To(Equal("numeric"))
+			Expect(memory.Units).To(Equal([]string{"KB", "MB", "GB", "TB"}))
+			Expect(memory.Examples).To(BeEmpty())
+
+			Expect(fieldByName(fields, "labels").Type).To(Equal("array"))
+			Expect(fieldByName(fields, "template").Type).To(Equal("boolean"))
+			Expect(fieldByName(fields, "powerstate").Aliases).To(Equal([]string{"status"}))
+			Expect(fieldByName(fields, "disk.thin").Collection).To(Equal("disk"))
+			Expect(fieldByName(fields, "datastore.name").Collection).To(BeEmpty())
+
+			age := fieldByName(fields, "snapshot.age")
+			Expect(age.Type).To(Equal("age"))
+			Expect(age.Units).To(Equal([]string{"s", "m", "h", "d", "w"}))
+			Expect(age.Collection).To(Equal("snapshot"))
+			Expect(fieldByName(fields, "created_at").Type).To(Equal("time"))
+		})
+
+		It("takes examples from the latest collection", func() {
+			addCollection()
+
+			fields, err := srv.Fields(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fieldByName(fields, "cluster").Examples).To(Equal([]string{"dev", "prod"}))
+			Expect(fieldByName(fields, "cpus").Examples).To(Equal([]string{"2", "4", "8"}))
+			Expect(fieldByName(fields, "labels").Examples).To(Equal([]string{"frontend", "web"}))
+		})
+	})
+})
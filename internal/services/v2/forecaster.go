@@ -376,6 +376,9 @@ func capStrings(caps []offload.Capability) []string {
 	return s
 }
 
+// oneTBinMB is the amount of data, in MB, ForecastStats.EstPer1TB is estimated for.
+const oneTBinMB = 1048576.0
+
 func computeForecastStats(pairName string, runs []models.BenchmarkRun) models.ForecastStats {
 	var successful []models.BenchmarkRun
 	for _, r := range runs {
@@ -418,7 +421,6 @@ func computeForecastStats(pairName string, runs []models.BenchmarkRun) models.Fo
 		stats.CI95Upper = stats.MeanMBps
 	}
 
-	const oneTBinMB = 1048576.0
 	stats.EstPer1TB = models.EstimateRange{
 		BestCase:  time.Duration(oneTBinMB / stats.MaxMBps * float64(time.Second)),
 		Expected:  time.Duration(oneTBinMB / stats.MedianMBps * float64(time.Second)),
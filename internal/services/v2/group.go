@@ -28,6 +28,7 @@ type GroupService struct {
 	store            *store.Store2
 	inventoryBuilder InventoryBuilder
 	eventSrv         *EventService
+	savedFilters     *SavedFilterService
 }
 
 func NewGroupService(st *store.Store2, builder InventoryBuilder) *GroupService {
@@ -38,6 +39,23 @@ func NewGroupService(st *store.Store2, builder InventoryBuilder) *GroupService {
 	}
 }
 
+// WithSavedFilters makes group filters resolve the saved filters they reference as @name.
+func (s *GroupService) WithSavedFilters(savedFilters *SavedFilterService) *GroupService {
+	s.savedFilters = savedFilters
+	return s
+}
+
+// expander returns the function expanding group filters. Saved filters live in the main
+// database, so they are read with ctx rather than the context of the collection transaction.
+func (s *GroupService) expander(ctx context.Context) store.FilterExpandFunc {
+	if s.savedFilters == nil {
+		return nil
+	}
+	return func(expr string) (string, error) {
+		return s.savedFilters.Expand(ctx, expr)
+	}
+}
+
 type GroupGetParams struct {
 	Sort   []SortField
 	Limit  uint64
@@ -128,7 +146,7 @@ func (s *GroupService) Create(ctx context.Context, group models.Group) (*models.
 			return err
 		}
 
-		if err := s.store.Group().RefreshMatches(txCtx, created.ID); err != nil {
+		if err := s.store.Group().RefreshMatchesWith(txCtx, s.expander(ctx), created.ID); err != nil {
 			return err
 		}
 
@@ -177,53 +195,80 @@ func (s *GroupService) Update(ctx context.Context, id uuid.UUID, group models.Gr
 			return err
 		}
 
-		if err := s.store.Group().RefreshMatches(txCtx, id); err != nil {
-			return err
-		}
+		return s.rebuild(txCtx, s.expander(ctx), updated)
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		vmIDs, err := s.store.Group().GetMatchedIDs(txCtx, id)
+	return updated, nil
+}
+
+// Refresh re-evaluates the filter of a group and rebuilds its inventory, e.g. after a saved
+// filter it references changed.
+func (s *GroupService) Refresh(ctx context.Context, id uuid.UUID) (*models.Group, error) {
+	var refreshed *models.Group
+
+	err := s.store.WithTx(ctx, func(txCtx context.Context) error {
+		var err error
+		refreshed, err = s.store.Group().Get(txCtx, id)
 		if err != nil {
-			return fmt.Errorf("getting matched VM IDs: %w", err)
+			return err
 		}
 
-		if len(vmIDs) == 0 {
-			if err := s.store.Group().UpdateInventory(txCtx, id, nil); err != nil {
-				return fmt.Errorf("clearing group inventory: %w", err)
-			}
-			updated.Inventory = nil
+		return s.rebuild(txCtx, s.expander(ctx), refreshed)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return refreshed, nil
+}
 
-			data, err := buildGroupInventoryDeleteEventData(updated)
-			if err != nil {
-				return fmt.Errorf("building inventory delete event data: %w", err)
-			}
+// rebuild refreshes the matches of an updated group, rebuilds its inventory and records the
+// matching outbox event.
+func (s *GroupService) rebuild(txCtx context.Context, expand store.FilterExpandFunc, updated *models.Group) error {
+	if err := s.store.Group().RefreshMatchesWith(txCtx, expand, updated.ID); err != nil {
+		return err
+	}
+
+	vmIDs, err := s.store.Group().GetMatchedIDs(txCtx, updated.ID)
+	if err != nil {
+		return fmt.Errorf("getting matched VM IDs: %w", err)
+	}
 
-			return s.eventSrv.AddGroupInventoryDeleteEvent(txCtx, data)
+	if len(vmIDs) == 0 {
+		if err := s.store.Group().UpdateInventory(txCtx, updated.ID, nil); err != nil {
+			return fmt.Errorf("clearing group inventory: %w", err)
 		}
+		updated.Inventory = nil
 
-		inv, err := s.inventoryBuilder.BuildInventory(txCtx, vmIDs)
+		data, err := buildGroupInventoryDeleteEventData(updated)
 		if err != nil {
-			return fmt.Errorf("building filtered inventory: %w", err)
+			return fmt.Errorf("building inventory delete event data: %w", err)
 		}
 
-		if err := s.store.Group().UpdateInventory(txCtx, id, inv); err != nil {
-			return fmt.Errorf("updating group inventory: %w", err)
-		}
+		return s.eventSrv.AddGroupInventoryDeleteEvent(txCtx, data)
+	}
 
-		updated.Inventory = inv
+	inv, err := s.inventoryBuilder.BuildInventory(txCtx, vmIDs)
+	if err != nil {
+		return fmt.Errorf("building filtered inventory: %w", err)
+	}
 
-		// Add outbox event for group update
-		data, err := buildGroupInventoryEventData(updated)
-		if err != nil {
-			return fmt.Errorf("building inventory event data: %w", err)
-		}
+	if err := s.store.Group().UpdateInventory(txCtx, updated.ID, inv); err != nil {
+		return fmt.Errorf("updating group inventory: %w", err)
+	}
 
-		return s.eventSrv.AddGroupInventoryEvent(txCtx, data)
-	})
+	updated.Inventory = inv
+
+	// Add outbox event for group update
+	data, err := buildGroupInventoryEventData(updated)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("building inventory event data: %w", err)
 	}
 
-	return updated, nil
+	return s.eventSrv.AddGroupInventoryEvent(txCtx, data)
 }
 
 func (s *GroupService) Delete(ctx context.Context, id uuid.UUID) error {
@@ -31,6 +31,7 @@ type InspectorService struct {
 	inspectionLimit int
 	vddkLibDir      string
 	credsSvc        *CredentialsService
+	labelRules      *LabelRuleService
 }
 
 func NewInspectorService(st *store.Store2, inspectionLimit int, dataDir string, credsSvc *CredentialsService) *InspectorService {
@@ -136,10 +137,17 @@ func (i *InspectorService) Start(ctx context.Context, vmIDs []string) (err error
 	}
 
 	pool := work.NewPool2(wb).WithWorkers(defaultInspectionWorkers, defaultInspectionWorkers).
-		WithFinalizer(func(_ context.Context) error {
+		WithFinalizer(func(ctx context.Context) error {
 			logoutCtx, cancel := context.WithTimeout(context.Background(), time.Second*10)
 			defer cancel()
 			_ = vClient.Logout(logoutCtx)
+
+			// Inspection results feed filter fields, so the label rules are evaluated again.
+			if i.labelRules != nil {
+				if _, err := i.labelRules.Apply(ctx, i.store); err != nil {
+					zap.S().Named("inspector_service").Warnw("failed to apply label rules after inspection", "error", err)
+				}
+			}
 			return nil
 		})
 
@@ -185,3 +193,9 @@ func (i *InspectorService) WithInspectionBuilder(builder inspectionBuilderFactor
 	i.buildFn = builder
 	return i
 }
+
+// WithLabelRules makes the inspector apply the label rules once an inspection run is over.
+func (i *InspectorService) WithLabelRules(labelRules *LabelRuleService) *InspectorService {
+	i.labelRules = labelRules
+	return i
+}
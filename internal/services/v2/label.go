@@ -0,0 +1,122 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+// labelColorRe is the syntax of label colors: a #rrggbb hex color.
+var labelColorRe = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// LabelService manages the definitions of VM labels: color, description and category.
+//
+// Definitions live in the main database and are shared by every collection. They are
+// optional: a label can be applied to VMs without a definition, and deleting a definition
+// leaves the label on its VMs.
+type LabelService struct {
+	pool *store.Pool
+}
+
+func NewLabelService(pool *store.Pool) *LabelService {
+	return &LabelService{pool: pool}
+}
+
+// List returns all label definitions ordered by category and name.
+func (s *LabelService) List(ctx context.Context) ([]models.Label, error) {
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	return st.Label().List(ctx)
+}
+
+// Get returns a label definition by name.
+func (s *LabelService) Get(ctx context.Context, name string) (*models.Label, error) {
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	return st.Label().Get(ctx, name)
+}
+
+// Create validates and stores a new label definition.
+func (s *LabelService) Create(ctx context.Context, l models.Label) (*models.Label, error) {
+	if strings.TrimSpace(l.Name) == "" {
+		return nil, srvErrors.NewValidationError("label name is required")
+	}
+	if len(l.Name) > maxLabelLength {
+		return nil, srvErrors.NewValidationError(fmt.Sprintf("label name is longer than %d characters", maxLabelLength))
+	}
+	if err := validateLabelColor(l.Color); err != nil {
+		return nil, err
+	}
+
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	return st.Label().Create(ctx, l)
+}
+
+// Update applies a partial update to a label definition.
+func (s *LabelService) Update(ctx context.Context, name string, update models.LabelUpdate) (*models.Label, error) {
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := st.Label().Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if update.Color != nil {
+		if err := validateLabelColor(*update.Color); err != nil {
+			return nil, err
+		}
+		l.Color = *update.Color
+	}
+	if update.Description != nil {
+		l.Description = *update.Description
+	}
+	if update.Category != nil {
+		l.Category = *update.Category
+	}
+	return st.Label().Update(ctx, *l)
+}
+
+// Delete removes a label definition.
+func (s *LabelService) Delete(ctx context.Context, name string) error {
+	st, err := s.mainStore()
+	if err != nil {
+		return err
+	}
+	return st.Label().Delete(ctx, name)
+}
+
+func (s *LabelService) mainStore() (*store.Store2, error) {
+	db, err := s.pool.Get(store.MainDatabaseID)
+	if err != nil {
+		return nil, err
+	}
+	return db.Store()
+}
+
+// WithLabelActor returns a context whose label changes are recorded in the label history as
+// made by hand by actor.
+func WithLabelActor(ctx context.Context, actor string) context.Context {
+	return store.WithLabelActor(ctx, models.LabelActor{Source: models.LabelSourceManual, Name: actor})
+}
+
+// validateLabelColor accepts an empty color or a #rrggbb hex color.
+func validateLabelColor(color string) error {
+	if color != "" && !labelColorRe.MatchString(color) {
+		return srvErrors.NewValidationError(fmt.Sprintf("invalid label color %q: use #rrggbb", color))
+	}
+	return nil
+}
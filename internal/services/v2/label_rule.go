@@ -0,0 +1,299 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"go.uber.org/zap"
+
+	vmfilter "github.com/kubev2v/assisted-migration-agent/internal/filter"
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+// labelRuleNameRe is the syntax of label rule names.
+var labelRuleNameRe = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// maxLabelLength is the longest label accepted, as for labels set by hand.
+const maxLabelLength = 100
+
+// LabelRuleService manages the rules labeling the VMs that match a filter expression.
+//
+// Rules live in the main database and are evaluated against a collection after every
+// collection and inspection run, and against the latest collection whenever a rule changes.
+// A rule adds its label to the VMs matching its expression and removes it from the VMs it
+// labeled that no longer match. The rule that applied each label is recorded in the
+// collection, which tells rule labels from manual ones: a label set by hand is never added,
+// removed or claimed by a rule, and a rule label the user adds again by hand becomes manual.
+type LabelRuleService struct {
+	pool         *store.Pool
+	savedFilters *SavedFilterService
+}
+
+func NewLabelRuleService(pool *store.Pool, savedFilters *SavedFilterService) *LabelRuleService {
+	return &LabelRuleService{pool: pool, savedFilters: savedFilters}
+}
+
+// List returns all label rules, highest priority first.
+func (s *LabelRuleService) List(ctx context.Context) ([]models.LabelRule, error) {
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	return st.LabelRule().List(ctx)
+}
+
+// Get returns a label rule by name.
+func (s *LabelRuleService) Get(ctx context.Context, name string) (*models.LabelRule, error) {
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	return st.LabelRule().Get(ctx, name)
+}
+
+// Create validates and stores a new label rule, then applies the rules to the latest collection.
+func (s *LabelRuleService) Create(ctx context.Context, r models.LabelRule) (*models.LabelRule, error) {
+	if !labelRuleNameRe.MatchString(r.Name) {
+		return nil, srvErrors.NewValidationError(fmt.Sprintf("invalid label rule name %q: use letters, digits, '_' and '-'", r.Name))
+	}
+	if err := s.validate(ctx, r); err != nil {
+		return nil, err
+	}
+
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	created, err := st.LabelRule().Create(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.applyLatest(ctx); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// Update applies a partial update to a label rule, then applies the rules to the latest collection.
+func (s *LabelRuleService) Update(ctx context.Context, name string, update models.LabelRuleUpdate) (*models.LabelRule, error) {
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := st.LabelRule().Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if update.Label != nil {
+		r.Label = *update.Label
+	}
+	if update.Expression != nil {
+		r.Expression = *update.Expression
+	}
+	if update.Priority != nil {
+		r.Priority = *update.Priority
+	}
+	if err := s.validate(ctx, *r); err != nil {
+		return nil, err
+	}
+
+	updated, err := st.LabelRule().Update(ctx, *r)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.applyLatest(ctx); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// Delete removes a label rule, then applies the remaining rules to the latest collection,
+// which removes the labels the rule applied.
+func (s *LabelRuleService) Delete(ctx context.Context, name string) error {
+	st, err := s.mainStore()
+	if err != nil {
+		return err
+	}
+	if err := st.LabelRule().Delete(ctx, name); err != nil {
+		return err
+	}
+	return s.applyLatest(ctx)
+}
+
+// Apply evaluates the label rules against the collection st, adding and removing the labels
+// they manage. When several rules apply the same label to a VM, the one with the highest
+// priority is recorded. Rules are evaluated against the labels as they were before, so a
+// rule matching on a label applied by another rule sees it from the next evaluation on.
+//
+// A rule whose expression cannot be evaluated, e.g. because a field it uses no longer
+// exists, is skipped and keeps the labels it applied before.
+func (s *LabelRuleService) Apply(ctx context.Context, st *store.Store2) (*models.LabelRuleResult, error) {
+	mainSt, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	rules, err := mainSt.LabelRule().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.LabelRuleResult{}
+	skipped := make(map[string]bool)
+	wanted := make(map[vmLabel]string)
+	for _, r := range rules {
+		vmIDs, err := s.match(ctx, st, r.Expression)
+		if err != nil {
+			zap.S().Named("label_rule_service").Warnw("skipping label rule", "rule", r.Name, "expression", r.Expression, "error", err)
+			skipped[r.Name] = true
+			result.Skipped = append(result.Skipped, r.Name)
+			continue
+		}
+		for _, id := range vmIDs {
+			key := vmLabel{vmID: id, label: r.Label}
+			// rules are listed by priority, the first rule applying a label keeps it
+			if _, ok := wanted[key]; !ok {
+				wanted[key] = r.Name
+			}
+		}
+	}
+
+	err = st.WithTx(ctx, func(txCtx context.Context) error {
+		labels, err := st.VM().ListLabels(txCtx)
+		if err != nil {
+			return fmt.Errorf("listing VM labels: %w", err)
+		}
+		applied, err := st.VM().ListRuleLabels(txCtx)
+		if err != nil {
+			return fmt.Errorf("listing rule labels: %w", err)
+		}
+
+		var add, remove []models.VMRuleLabel
+		current := make(map[vmLabel]string, len(applied))
+		for _, l := range applied {
+			key := vmLabel{vmID: l.VMID, label: l.Label}
+			current[key] = l.Rule
+			if _, ok := wanted[key]; !ok && !skipped[l.Rule] {
+				remove = append(remove, l)
+			}
+		}
+		for key, rule := range wanted {
+			if cur, ok := current[key]; ok {
+				if cur != rule && !skipped[cur] {
+					add = append(add, models.VMRuleLabel{VMID: key.vmID, Label: key.label, Rule: rule})
+				}
+				continue
+			}
+			// a label without a rule record was set by hand
+			if slices.Contains(labels[key.vmID], key.label) {
+				continue
+			}
+			add = append(add, models.VMRuleLabel{VMID: key.vmID, Label: key.label, Rule: rule})
+		}
+		slices.SortFunc(add, compareVMRuleLabels)
+
+		if err := st.VM().ApplyRuleLabels(txCtx, add, remove); err != nil {
+			return fmt.Errorf("applying rule labels: %w", err)
+		}
+		result.Added = len(add)
+		result.Removed = len(remove)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// match returns the IDs of the VMs of st matching expr.
+func (s *LabelRuleService) match(ctx context.Context, st *store.Store2, expr string) ([]string, error) {
+	if s.savedFilters != nil {
+		expanded, err := s.savedFilters.Expand(ctx, expr)
+		if err != nil {
+			return nil, err
+		}
+		expr = expanded
+	}
+	sqlizer, err := vmfilter.ParseWithDefaultMap([]byte(expr))
+	if err != nil {
+		return nil, err
+	}
+	return st.VM().ListIDs(ctx, sqlizer)
+}
+
+// validate checks the label and the expression of a rule.
+func (s *LabelRuleService) validate(ctx context.Context, r models.LabelRule) error {
+	label := strings.TrimSpace(r.Label)
+	if label == "" {
+		return srvErrors.NewValidationError("label rule label is required")
+	}
+	if len(r.Label) > maxLabelLength {
+		return srvErrors.NewValidationError(fmt.Sprintf("label rule label is longer than %d characters", maxLabelLength))
+	}
+	if systemLabels[r.Label] {
+		return srvErrors.NewValidationError(fmt.Sprintf("label %s is managed by the agent", r.Label))
+	}
+
+	if strings.TrimSpace(r.Expression) == "" {
+		return srvErrors.NewValidationError("label rule expression is required")
+	}
+	expr := r.Expression
+	if s.savedFilters != nil {
+		expanded, err := s.savedFilters.Expand(ctx, expr)
+		if err != nil {
+			return err
+		}
+		expr = expanded
+	}
+	if _, err := vmfilter.ParseWithDefaultMap([]byte(expr)); err != nil {
+		return srvErrors.NewValidationError(fmt.Sprintf("label rule expression is invalid: %v", err))
+	}
+	return nil
+}
+
+// applyLatest applies the rules to the latest collection, if any.
+func (s *LabelRuleService) applyLatest(ctx context.Context) error {
+	db, err := s.pool.Latest()
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	st, err := db.Store()
+	if err != nil {
+		return err
+	}
+	if _, err := s.Apply(ctx, st); err != nil {
+		return fmt.Errorf("applying label rules to collection %s: %w", db.ID, err)
+	}
+	return nil
+}
+
+func (s *LabelRuleService) mainStore() (*store.Store2, error) {
+	db, err := s.pool.Get(store.MainDatabaseID)
+	if err != nil {
+		return nil, err
+	}
+	return db.Store()
+}
+
+// vmLabel is a label of a VM.
+type vmLabel struct {
+	vmID  string
+	label string
+}
+
+func compareVMRuleLabels(a, b models.VMRuleLabel) int {
+	if c := strings.Compare(a.VMID, b.VMID); c != 0 {
+		return c
+	}
+	return strings.Compare(a.Label, b.Label)
+}
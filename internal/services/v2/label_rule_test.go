@@ -0,0 +1,183 @@
+package v2_test
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	v2 "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+var _ = Describe("LabelRuleService", func() {
+	var (
+		ctx          context.Context
+		pool         *store.Pool
+		tmpDir       string
+		savedFilters *v2.SavedFilterService
+		srv          *v2.LabelRuleService
+	)
+
+	strPtr := func(s string) *string { return &s }
+	intPtr := func(i int) *int { return &i }
+
+	// addCollection registers a collection with three VMs on two clusters.
+	addCollection := func() *store.Store2 {
+		_, st := addTestCollection(pool, "col-1000", time.Unix(1000, 0))
+
+		_, err := st.Querier().ExecContext(ctx,
+			`INSERT INTO vinfo ("VM ID", "VM", "Cluster", "Powerstate", "Template", "Memory", "CPUs")
+			 VALUES ('vm-1', 'web-1', 'prod', 'poweredOn', false, 8192, 4),
+			        ('vm-2', 'web-2', 'prod', 'poweredOff', false, 4096, 2),
+			        ('vm-3', 'db-1', 'dev', 'poweredOn', false, 16384, 8)`)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+		return st
+	}
+
+	ruleLabels := func(st *store.Store2) []models.VMRuleLabel {
+		labels, err := st.VM().ListRuleLabels(ctx)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return labels
+	}
+
+	vmLabels := func(st *store.Store2) map[string][]string {
+		labels, err := st.VM().ListLabels(ctx)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return labels
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		tmpDir, err = os.MkdirTemp("", "label-rule-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		pool = newTestPool(tmpDir)
+
+		savedFilters = v2.NewSavedFilterService(pool)
+		srv = v2.NewLabelRuleService(pool, savedFilters)
+	})
+
+	AfterEach(func() {
+		pool.Close()
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	Context("Create", func() {
+		It("stores a valid rule without any collection", func() {
+			created, err := srv.Create(ctx, models.LabelRule{Name: "legacy-os", Label: "needs-driver-update", Expression: "os_tools ~ /2008/"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created.Label).To(Equal("needs-driver-update"))
+
+			rules, err := srv.List(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rules).To(HaveLen(1))
+		})
+
+		It("rejects invalid names, labels and expressions", func() {
+			_, err := srv.Create(ctx, models.LabelRule{Name: "bad name", Label: "prod", Expression: "cluster = 'prod'"})
+			Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+
+			_, err = srv.Create(ctx, models.LabelRule{Name: "prod", Label: " ", Expression: "cluster = 'prod'"})
+			Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+
+			_, err = srv.Create(ctx, models.LabelRule{Name: "prod", Label: v2.LabelNew, Expression: "cluster = 'prod'"})
+			Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+
+			_, err = srv.Create(ctx, models.LabelRule{Name: "prod", Label: "prod", Expression: "memroy > 8GB"})
+			Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+
+			_, err = srv.Create(ctx, models.LabelRule{Name: "prod", Label: "prod", Expression: "@missing"})
+			Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+		})
+
+		It("labels the matching VMs of the latest collection", func() {
+			st := addCollection()
+
+			_, err := srv.Create(ctx, models.LabelRule{Name: "prod", Label: "production", Expression: "cluster = 'prod'"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ruleLabels(st)).To(Equal([]models.VMRuleLabel{
+				{VMID: "vm-1", Label: "production", Rule: "prod"},
+				{VMID: "vm-2", Label: "production", Rule: "prod"},
+			}))
+			Expect(vmLabels(st)).To(HaveKeyWithValue("vm-1", []string{"production"}))
+		})
+	})
+
+	Context("Apply", func() {
+		It("removes the labels of VMs that no longer match", func() {
+			st := addCollection()
+			_, err := srv.Create(ctx, models.LabelRule{Name: "prod", Label: "production", Expression: "cluster = 'prod'"})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = srv.Update(ctx, "prod", models.LabelRuleUpdate{Expression: strPtr("cluster = 'prod' and memory >= 8GB")})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ruleLabels(st)).To(Equal([]models.VMRuleLabel{{VMID: "vm-1", Label: "production", Rule: "prod"}}))
+			Expect(vmLabels(st)).NotTo(HaveKey("vm-2"))
+		})
+
+		It("never changes manual labels", func() {
+			st := addCollection()
+			Expect(st.VM().AddLabel(ctx, "vm-2", "production")).To(Succeed())
+
+			_, err := srv.Create(ctx, models.LabelRule{Name: "big", Label: "production", Expression: "memory >= 8GB"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ruleLabels(st)).To(Equal([]models.VMRuleLabel{
+				{VMID: "vm-1", Label: "production", Rule: "big"},
+				{VMID: "vm-3", Label: "production", Rule: "big"},
+			}))
+
+			Expect(srv.Delete(ctx, "big")).To(Succeed())
+
+			Expect(ruleLabels(st)).To(BeEmpty())
+			Expect(vmLabels(st)).To(Equal(map[string][]string{"vm-2": {"production"}}))
+		})
+
+		It("records the rule with the highest priority", func() {
+			st := addCollection()
+			_, err := srv.Create(ctx, models.LabelRule{Name: "prod", Label: "keep", Expression: "cluster = 'prod'"})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = srv.Create(ctx, models.LabelRule{Name: "on", Label: "keep", Expression: "powerstate = 'poweredOn'", Priority: 1})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ruleLabels(st)).To(Equal([]models.VMRuleLabel{
+				{VMID: "vm-1", Label: "keep", Rule: "on"},
+				{VMID: "vm-2", Label: "keep", Rule: "prod"},
+				{VMID: "vm-3", Label: "keep", Rule: "on"},
+			}))
+
+			_, err = srv.Update(ctx, "prod", models.LabelRuleUpdate{Priority: intPtr(2)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ruleLabels(st)).To(ContainElement(models.VMRuleLabel{VMID: "vm-1", Label: "keep", Rule: "prod"}))
+		})
+
+		It("keeps the labels of rules that cannot be evaluated", func() {
+			st := addCollection()
+			_, err := savedFilters.Create(ctx, models.SavedFilter{Name: "prod", Expression: "cluster = 'prod'"})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = srv.Create(ctx, models.LabelRule{Name: "prod", Label: "production", Expression: "@prod"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(srvErrors.IsValidationError(savedFilters.Delete(ctx, "prod"))).To(BeTrue())
+
+			mainDB, err := pool.Get(store.MainDatabaseID)
+			Expect(err).NotTo(HaveOccurred())
+			mainSt, err := mainDB.Store()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mainSt.SavedFilter().Delete(ctx, "prod")).To(Succeed())
+
+			result, err := srv.Apply(ctx, st)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Skipped).To(Equal([]string{"prod"}))
+			Expect(result.Removed).To(BeZero())
+			Expect(ruleLabels(st)).To(HaveLen(2))
+		})
+	})
+})
@@ -0,0 +1,69 @@
+package v2_test
+
+import (
+	"context"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	v2 "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+var _ = Describe("LabelService", func() {
+	var (
+		ctx    context.Context
+		pool   *store.Pool
+		tmpDir string
+		srv    *v2.LabelService
+	)
+
+	strPtr := func(s string) *string { return &s }
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		tmpDir, err = os.MkdirTemp("", "label-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		pool = newTestPool(tmpDir)
+
+		srv = v2.NewLabelService(pool)
+	})
+
+	AfterEach(func() {
+		pool.Close()
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	It("rejects missing names and invalid colors", func() {
+		_, err := srv.Create(ctx, models.Label{Name: " "})
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+
+		_, err = srv.Create(ctx, models.Label{Name: "wave-1", Color: "red"})
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+
+		_, err = srv.Create(ctx, models.Label{Name: "wave-1", Color: "#ff0000"})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = srv.Update(ctx, "wave-1", models.LabelUpdate{Color: strPtr("#ff00")})
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("updates only the given fields", func() {
+		_, err := srv.Create(ctx, models.Label{Name: "wave-1", Color: "#ff0000", Category: "wave", CreatedBy: "alice"})
+		Expect(err).NotTo(HaveOccurred())
+
+		updated, err := srv.Update(ctx, "wave-1", models.LabelUpdate{Description: strPtr("First wave"), Color: strPtr("")})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated.Description).To(Equal("First wave"))
+		Expect(updated.Color).To(BeEmpty())
+		Expect(updated.Category).To(Equal("wave"))
+		Expect(updated.CreatedBy).To(Equal("alice"))
+
+		_, err = srv.Update(ctx, "missing", models.LabelUpdate{})
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,74 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	"github.com/kubev2v/assisted-migration-agent/pkg/vmware"
+)
+
+// collectVMLifecycles reads the creation time, boot time and snapshots of every VM of the
+// vCenter and saves them into the collection. The vSphere collector does not report them.
+func collectVMLifecycles(ctx context.Context, client *govmomi.Client, st *store.Store2) error {
+	vms, err := vmware.RetrieveVMLifecycles(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	lifecycles := make([]models.VMLifecycle, 0, len(vms))
+	for _, vm := range vms {
+		lifecycles = append(lifecycles, newVMLifecycle(vm))
+	}
+
+	return st.WithTx(ctx, func(txCtx context.Context) error {
+		if err := st.VM().SaveLifecycles(txCtx, lifecycles); err != nil {
+			return fmt.Errorf("saving VM lifecycles: %w", err)
+		}
+		return nil
+	})
+}
+
+// newVMLifecycle converts the lifecycle properties of a VM, flattening its snapshot tree.
+// Times are converted to UTC, the zone of the collection timestamps.
+func newVMLifecycle(vm mo.VirtualMachine) models.VMLifecycle {
+	l := models.VMLifecycle{
+		VMID:     vm.Self.Value,
+		BootedAt: utcTime(vm.Runtime.BootTime),
+	}
+	if vm.Config != nil {
+		l.CreatedAt = utcTime(vm.Config.CreateDate)
+	}
+	if vm.Snapshot == nil {
+		return l
+	}
+
+	var walk func(trees []types.VirtualMachineSnapshotTree)
+	walk = func(trees []types.VirtualMachineSnapshotTree) {
+		for _, tree := range trees {
+			l.Snapshots = append(l.Snapshots, models.VMSnapshot{
+				ID:        tree.Snapshot.Value,
+				Name:      tree.Name,
+				CreatedAt: tree.CreateTime.UTC(),
+			})
+			walk(tree.ChildSnapshotList)
+		}
+	}
+	walk(vm.Snapshot.RootSnapshotList)
+
+	return l
+}
+
+func utcTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"sync"
 
+	"go.uber.org/zap"
+
 	"github.com/kubev2v/migration-planner/pkg/duckdb_parser"
 	"github.com/kubev2v/migration-planner/pkg/opa"
 
@@ -24,6 +26,7 @@ type ServiceManager struct {
 
 	console     *Console
 	collection  *CollectionService
+	bundle      *BundleService
 	credentials *CredentialsService
 	mu          sync.Mutex
 	inspector   *InspectorService
@@ -31,7 +34,21 @@ type ServiceManager struct {
 	forecaster  *ForecasterService
 	validator   *opa.Validator
 	collector   *CollectorService
-	workBuilder CollectorWorkBuilder
+	// collectorMode is the mode of the current collection, empty for RVTools imports.
+	collectorMode  models.CollectionMode
+	workBuilder    CollectorWorkBuilder
+	schedule       *ScheduleService
+	accumulation   *RightsizingAccumulationService
+	filter         *FilterService
+	savedFilter    *SavedFilterService
+	labelRule      *LabelRuleService
+	label          *LabelService
+	wave           *WaveService
+	mtv            *MTVService
+	sizing         *SizingService
+	recommendation *RecommendationService
+	waste          *WasteService
+	trackers       *vmChangeTrackers
 }
 
 type ServiceManagerOption func(*ServiceManager)
@@ -73,7 +90,7 @@ func WithCollectorWorkBuilder(fn CollectorWorkBuilder) ServiceManagerOption {
 }
 
 func NewServiceManager(opts ...ServiceManagerOption) *ServiceManager {
-	m := &ServiceManager{}
+	m := &ServiceManager{trackers: newVMChangeTrackers()}
 	for _, opt := range opts {
 		opt(m)
 	}
@@ -111,24 +128,47 @@ func (m *ServiceManager) Initialize() error {
 	}
 
 	m.collection = NewCollectionService(m.pool)
+	m.collection.trackers = m.trackers
+
+	m.bundle = NewBundleService(m.pool, m.cfg.Agent.DataFolder, m.cfg.Agent.Version, m.cfg.Agent.ID, m.keyMgr, m.cfg.Agent.TrustedBundleKeys)
+	zap.S().Named("bundle_service").Infow("collection bundles are signed with the agent key", "public_key", m.bundle.PublicKey())
 
 	m.credentials = NewCredentialsService(mainStore)
 	m.credentials.WithKeyManager(m.keyMgr)
 
 	m.vddk = NewVddkService(m.cfg.Agent.DataFolder, m.pool)
 
+	m.filter = NewFilterService(m.pool)
+	m.savedFilter = NewSavedFilterService(m.pool)
+	m.labelRule = NewLabelRuleService(m.pool, m.savedFilter)
+	m.label = NewLabelService(m.pool)
+
 	m.forecaster = NewForecasterService(m.pool, m.credentials)
+	m.wave = NewWaveService(m.pool, m.forecaster)
+	m.mtv = NewMTVService(m.pool)
+	m.sizing = NewSizingService(m.pool)
+	m.recommendation = NewRecommendationService(m.pool)
+	m.waste = NewWasteService(m.pool, m.recommendation)
+
+	m.schedule = NewScheduleService(mainStore, m.credentials, m)
+	m.accumulation = NewRightsizingAccumulationService(m.pool, m.credentials)
+	if !m.cfg.Agent.RVToolsMode {
+		m.schedule.Start()
+		m.accumulation.Start()
+	}
 
 	return nil
 }
 
 func (m *ServiceManager) GetCollectorStatus() models.CollectorStatus {
 	m.mu.Lock()
-	collector := m.collector
+	collector, mode := m.collector, m.collectorMode
 	m.mu.Unlock()
 
 	if collector != nil {
-		return collector.GetStatus()
+		status := collector.GetStatus()
+		status.Mode = mode
+		return status
 	}
 
 	invSvc, err := m.LatestInventoryService()
@@ -142,7 +182,19 @@ func (m *ServiceManager) GetCollectorStatus() models.CollectorStatus {
 	return models.CollectorStatus{State: models.CollectorStateReady}
 }
 
+// StartCollecting starts a full collection of the vCenter of the default credential profile.
 func (m *ServiceManager) StartCollecting(ctx context.Context) (models.CollectorStatus, error) {
+	return m.StartVCenterCollecting(ctx, models.DefaultCredentialProfile, models.CollectionModeFull)
+}
+
+// StartVCenterCollecting starts a collection of the vCenter of the given credential profile.
+// Only one collection runs at a time, whatever its vCenter.
+//
+// An incremental collection needs a change tracker established by a previous full collection
+// of the same vCenter in this agent process; without one, or without a previous collection in
+// the pool, it falls back to a full collection that establishes the tracker. The returned
+// status reports the mode actually run.
+func (m *ServiceManager) StartVCenterCollecting(ctx context.Context, vcenter string, mode models.CollectionMode) (models.CollectorStatus, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -154,22 +206,40 @@ func (m *ServiceManager) StartCollecting(ctx context.Context) (models.CollectorS
 		return models.CollectorStatus{}, srvErrors.NewCollectionInProgressError()
 	}
 
-	if m.workBuilder == nil {
-		factory, err := newVCenterCollectorWorkFactory(m.credentials, m.pool, m.cfg.Agent.DataFolder, m.validator)
+	track := m.trackers.Get(vcenter) != nil
+	if mode == models.CollectionModeIncremental {
+		_, err := m.pool.LatestFor(vcenter)
+		if !track || err != nil {
+			zap.S().Named("service_manager").Warnw("no baseline for incremental collection, falling back to a full collection",
+				"vcenter", vcenter, "change_tracker", track, "previous_collection", err == nil)
+			mode = models.CollectionModeFull
+			track = true
+		}
+	}
+
+	builder := m.workBuilder
+	if builder == nil {
+		if _, err := m.credentials.ResolveProfile(ctx, vcenter); err != nil {
+			return models.CollectorStatus{}, err
+		}
+		factory, err := newVCenterCollectorWorkFactory(m.credentials, m.pool, m.cfg.Agent.DataFolder, m.validator, vcenter, mode, track, m.trackers, m.collection, m.cfg.Agent.RetainCollections)
 		if err != nil {
 			return models.CollectorStatus{}, err
 		}
-		m.workBuilder = factory
+		builder = factory
 	}
 
-	m.collector = NewCollectorService(m.workBuilder)
+	m.collector = NewCollectorService(builder)
+	m.collectorMode = mode
 
 	if err := m.collector.Start(ctx); err != nil {
 		m.collector = nil
 		return models.CollectorStatus{}, err
 	}
 
-	return m.collector.GetStatus(), nil
+	status := m.collector.GetStatus()
+	status.Mode = mode
+	return status, nil
 }
 
 func (m *ServiceManager) StopCollecting() error {
@@ -202,6 +272,7 @@ func (m *ServiceManager) StartRVToolsCollecting(rvtoolFiles []string) (models.Co
 	}
 
 	m.collector = NewCollectorService(factory)
+	m.collectorMode = ""
 
 	if err := m.collector.Start(context.Background()); err != nil {
 		m.collector = nil
@@ -238,7 +309,7 @@ func (m *ServiceManager) InspectorService() (*InspectorService, error) {
 		return nil, err
 	}
 
-	m.inspector = NewInspectorService(store, 10, m.cfg.Agent.DataFolder, m.credentials)
+	m.inspector = NewInspectorService(store, 10, m.cfg.Agent.DataFolder, m.credentials).WithLabelRules(m.labelRule)
 
 	return m.inspector, nil
 }
@@ -255,6 +326,54 @@ func (m *ServiceManager) CollectionService() *CollectionService {
 	return m.collection
 }
 
+func (m *ServiceManager) BundleService() *BundleService {
+	return m.bundle
+}
+
+func (m *ServiceManager) ScheduleService() *ScheduleService {
+	return m.schedule
+}
+
+func (m *ServiceManager) RightsizingAccumulationService() *RightsizingAccumulationService {
+	return m.accumulation
+}
+
+func (m *ServiceManager) FilterService() *FilterService {
+	return m.filter
+}
+
+func (m *ServiceManager) SavedFilterService() *SavedFilterService {
+	return m.savedFilter
+}
+
+func (m *ServiceManager) LabelRuleService() *LabelRuleService {
+	return m.labelRule
+}
+
+func (m *ServiceManager) LabelService() *LabelService {
+	return m.label
+}
+
+func (m *ServiceManager) WaveService() *WaveService {
+	return m.wave
+}
+
+func (m *ServiceManager) MTVService() *MTVService {
+	return m.mtv
+}
+
+func (m *ServiceManager) SizingService() *SizingService {
+	return m.sizing
+}
+
+func (m *ServiceManager) RecommendationService() *RecommendationService {
+	return m.recommendation
+}
+
+func (m *ServiceManager) WasteService() *WasteService {
+	return m.waste
+}
+
 func (m *ServiceManager) CredentialsService() *CredentialsService {
 	return m.credentials
 }
@@ -351,6 +470,24 @@ func (m *ServiceManager) LatestInventoryService() (*InventoryService, error) {
 	return m.inventoryService(db)
 }
 
+// VCenterVirtualMachineService returns a VMService for the latest collection of a vCenter.
+func (m *ServiceManager) VCenterVirtualMachineService(vcenter string) (*VMService, error) {
+	db, err := m.pool.LatestFor(vcenter)
+	if err != nil {
+		return nil, err
+	}
+	return m.vmService(db)
+}
+
+// VCenterInventoryService returns an InventoryService for the latest collection of a vCenter.
+func (m *ServiceManager) VCenterInventoryService(vcenter string) (*InventoryService, error) {
+	db, err := m.pool.LatestFor(vcenter)
+	if err != nil {
+		return nil, err
+	}
+	return m.inventoryService(db)
+}
+
 func (m *ServiceManager) LatestRightsizingService() (*RightsizingService, error) {
 	db, err := m.pool.Latest()
 	if err != nil {
@@ -360,6 +497,13 @@ func (m *ServiceManager) LatestRightsizingService() (*RightsizingService, error)
 }
 
 func (m *ServiceManager) Stop(ctx context.Context) {
+	if m.schedule != nil {
+		m.schedule.Stop()
+	}
+	if m.accumulation != nil {
+		m.accumulation.Stop()
+	}
+
 	m.mu.Lock()
 	inspector := m.inspector
 	m.mu.Unlock()
@@ -378,6 +522,8 @@ func (m *ServiceManager) Stop(ctx context.Context) {
 	if m.forecaster != nil {
 		_ = m.forecaster.Stop()
 	}
+
+	m.trackers.Close(ctx)
 }
 
 func (m *ServiceManager) vmService(db *store.Database) (*VMService, error) {
@@ -393,7 +539,7 @@ func (m *ServiceManager) groupService(db *store.Database) (*GroupService, error)
 	if err != nil {
 		return nil, err
 	}
-	return NewGroupService(st, duckdb_parser.New(st.Querier(), nil)), nil
+	return NewGroupService(st, duckdb_parser.New(st.Querier(), nil)).WithSavedFilters(m.savedFilter), nil
 }
 
 func (m *ServiceManager) inventoryService(db *store.Database) (*InventoryService, error) {
@@ -113,6 +113,29 @@ var _ = Describe("ServiceManager", func() {
 		})
 	})
 
+	Describe("StartVCenterCollecting", func() {
+		// Given a manager without a change tracker for the vCenter, as after an agent restart
+		// When an incremental collection is requested
+		// Then a full collection runs and both the returned and the current status report it
+		It("reports the full collection it falls back to without a change tracker", func() {
+			ctx := context.Background()
+			mgr := v2.NewServiceManager(
+				v2.WithConfig(cfg),
+				v2.WithPool(pool),
+				v2.WithKeyManager(keyMgr),
+				v2.WithCollectorWorkBuilder(completingCollectorBuilder()),
+			)
+			Expect(mgr.Initialize()).To(Succeed())
+			defer mgr.Stop(ctx)
+
+			status, err := mgr.StartVCenterCollecting(ctx, models.DefaultCredentialProfile, models.CollectionModeIncremental)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Mode).To(Equal(models.CollectionModeFull))
+			Expect(mgr.GetCollectorStatus().Mode).To(Equal(models.CollectionModeFull))
+		})
+	})
+
 	Describe("GetCollectorStatus", func() {
 		var (
 			ctx context.Context
@@ -0,0 +1,451 @@
+package v2
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+// Formats of the generated MTV plan.
+const (
+	MTVPlanYAML = "yaml"
+	MTVPlanZip  = "zip"
+)
+
+// Defaults of MTVPlanOptions.
+const (
+	defaultMTVNamespace           = "openshift-mtv"
+	defaultMTVSourceProvider      = "vsphere"
+	defaultMTVDestinationProvider = "host"
+)
+
+const mtvAPIVersion = "forklift.konveyor.io/v1beta1"
+
+// dns1123LabelRe is the syntax of Kubernetes namespaces and of the names generated for the manifests.
+var dns1123LabelRe = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// MTVService generates Forklift/MTV manifests migrating the VMs of a group, and manages the
+// mapping tables they use. The manifests only depend on the latest collection and the
+// mapping tables, so they are generated without access to the target cluster.
+type MTVService struct {
+	pool *store.Pool
+}
+
+func NewMTVService(pool *store.Pool) *MTVService {
+	return &MTVService{pool: pool}
+}
+
+// GetMappings returns the network and datastore mapping tables.
+func (s *MTVService) GetMappings(ctx context.Context) (*models.MTVMappings, error) {
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	return st.MTVMapping().List(ctx)
+}
+
+// SetMappings validates and replaces the network and datastore mapping tables.
+func (s *MTVService) SetMappings(ctx context.Context, mappings models.MTVMappings) (*models.MTVMappings, error) {
+	if err := validateMTVMappings(mappings); err != nil {
+		return nil, err
+	}
+
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	if err := st.WithTx(ctx, func(txCtx context.Context) error {
+		return st.MTVMapping().Replace(txCtx, mappings)
+	}); err != nil {
+		return nil, err
+	}
+	return st.MTVMapping().List(ctx)
+}
+
+// GeneratePlan generates the NetworkMap, StorageMap and Plan migrating the VMs of a group of
+// the latest collection of the vCenter of opts, or of any vCenter when opts has none. VMs excluded from migration or with critical concerns are left out
+// of the plan, and networks and datastores without a mapping are left out of the maps; each
+// of them is reported as a warning. The output is deterministic: manifests carry no
+// timestamps and every list is sorted.
+func (s *MTVService) GeneratePlan(ctx context.Context, groupID uuid.UUID, opts models.MTVPlanOptions) (*models.MTVPlan, error) {
+	opts, err := mtvPlanOptionsWithDefaults(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	mainSt, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	mappings, err := mainSt.MTVMapping().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := latestCollection(s.pool, opts.VCenter)
+	if err != nil {
+		return nil, err
+	}
+	st, err := db.Store()
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := st.Group().Get(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := st.Group().GetMatchedIDs(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("getting matched IDs for group %s: %w", groupID, err)
+	}
+
+	plan := &models.MTVPlan{Name: mtvResourceName(group.Name, groupID), Warnings: []string{}}
+
+	var vmIDs []string
+	if len(ids) > 0 {
+		vms, err := st.VM().List(ctx, nil, store.WithVMIDs(ids))
+		if err != nil {
+			return nil, fmt.Errorf("listing group VMs: %w", err)
+		}
+		slices.SortFunc(vms, func(a, b models.VirtualMachineSummary) int { return strings.Compare(a.ID, b.ID) })
+		for _, vm := range vms {
+			switch {
+			case vm.MigrationExcluded:
+				plan.Warnings = append(plan.Warnings, fmt.Sprintf("VM %s (%s) is excluded from migration and left out of the plan", vm.Name, vm.ID))
+			case !vm.IsMigratable:
+				plan.Warnings = append(plan.Warnings, fmt.Sprintf("VM %s (%s) has critical concerns and is left out of the plan", vm.Name, vm.ID))
+			default:
+				vmIDs = append(vmIDs, vm.ID)
+			}
+		}
+	}
+	if len(vmIDs) == 0 {
+		plan.Warnings = append(plan.Warnings, fmt.Sprintf("group %s has no VM to migrate", group.Name))
+	}
+
+	placements, err := st.VM().ListPlacements(ctx, vmIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	networkMappings := make(map[string]models.MTVNetworkMapping, len(mappings.Networks))
+	for _, m := range mappings.Networks {
+		networkMappings[m.Source] = m
+	}
+	storageMappings := make(map[string]models.MTVStorageMapping, len(mappings.Storage))
+	for _, m := range mappings.Storage {
+		storageMappings[m.Source] = m
+	}
+
+	networkVMs := make(map[string]int)
+	datastoreVMs := make(map[string]int)
+	planVMs := make([]mtvSourceRef, 0, len(placements))
+	for _, p := range placements {
+		planVMs = append(planVMs, mtvSourceRef{ID: p.VMID, Name: p.Name})
+		for _, n := range p.Networks {
+			networkVMs[n]++
+		}
+		for _, d := range p.Datastores {
+			datastoreVMs[d]++
+		}
+	}
+
+	networkPairs := []mtvNetworkPair{}
+	for _, n := range sortedKeys(networkVMs) {
+		m, ok := networkMappings[n]
+		if !ok {
+			plan.Warnings = append(plan.Warnings, fmt.Sprintf("network %q of %d VM(s) is not mapped and left out of the NetworkMap", n, networkVMs[n]))
+			continue
+		}
+		dest := mtvNetworkDestination{Type: string(m.Type)}
+		if m.Type == models.MTVNetworkMultus {
+			dest.Namespace = m.Namespace
+			dest.Name = m.Name
+		}
+		networkPairs = append(networkPairs, mtvNetworkPair{Source: mtvSourceRef{Name: n}, Destination: dest})
+	}
+
+	storagePairs := []mtvStoragePair{}
+	for _, d := range sortedKeys(datastoreVMs) {
+		m, ok := storageMappings[d]
+		if !ok {
+			plan.Warnings = append(plan.Warnings, fmt.Sprintf("datastore %q of %d VM(s) is not mapped and left out of the StorageMap", d, datastoreVMs[d]))
+			continue
+		}
+		storagePairs = append(storagePairs, mtvStoragePair{Source: mtvSourceRef{Name: d}, Destination: mtvStorageDestination{StorageClass: m.StorageClass}})
+	}
+
+	providers := mtvProviderPair{
+		Source:      mtvObjectRef{Name: opts.SourceProvider, Namespace: opts.Namespace},
+		Destination: mtvObjectRef{Name: opts.DestinationProvider, Namespace: opts.Namespace},
+	}
+	mapRef := mtvObjectRef{Name: plan.Name, Namespace: opts.Namespace}
+
+	for _, r := range []mtvResource{
+		{
+			Kind: "NetworkMap",
+			Spec: mtvNetworkMapSpec{Provider: providers, Map: networkPairs},
+		},
+		{
+			Kind: "StorageMap",
+			Spec: mtvStorageMapSpec{Provider: providers, Map: storagePairs},
+		},
+		{
+			Kind: "Plan",
+			Spec: mtvPlanSpec{
+				Description:     fmt.Sprintf("Migration of group %s", group.Name),
+				Provider:        providers,
+				TargetNamespace: opts.TargetNamespace,
+				Map:             mtvPlanMap{Network: mapRef, Storage: mapRef},
+				VMs:             planVMs,
+			},
+		},
+	} {
+		r.APIVersion = mtvAPIVersion
+		r.Metadata = mapRef
+		content, err := marshalMTVResource(r)
+		if err != nil {
+			return nil, fmt.Errorf("rendering %s: %w", r.Kind, err)
+		}
+		plan.Manifests = append(plan.Manifests, models.MTVManifest{Kind: r.Kind, Name: plan.Name, Content: content})
+	}
+
+	return plan, nil
+}
+
+// WritePlan writes a generated plan as a multi-document YAML, with the warnings as leading
+// comments, or as a zip with one file per manifest and a warnings.txt file.
+func (s *MTVService) WritePlan(plan *models.MTVPlan, format string, w io.Writer) error {
+	switch format {
+	case MTVPlanYAML:
+		var buf bytes.Buffer
+		for _, warning := range plan.Warnings {
+			fmt.Fprintf(&buf, "# WARNING: %s\n", strings.ReplaceAll(warning, "\n", " "))
+		}
+		for _, m := range plan.Manifests {
+			buf.WriteString("---\n")
+			buf.Write(m.Content)
+		}
+		_, err := w.Write(buf.Bytes())
+		return err
+	case MTVPlanZip:
+		zw := zip.NewWriter(w)
+		for _, m := range plan.Manifests {
+			entry, err := zw.Create(fmt.Sprintf("%s-%s.yaml", m.Name, strings.ToLower(m.Kind)))
+			if err != nil {
+				return err
+			}
+			if _, err := entry.Write(m.Content); err != nil {
+				return err
+			}
+		}
+		if len(plan.Warnings) > 0 {
+			entry, err := zw.Create("warnings.txt")
+			if err != nil {
+				return err
+			}
+			if _, err := io.WriteString(entry, strings.Join(plan.Warnings, "\n")+"\n"); err != nil {
+				return err
+			}
+		}
+		return zw.Close()
+	default:
+		return srvErrors.NewValidationError(fmt.Sprintf("unsupported format %q: use %s or %s", format, MTVPlanYAML, MTVPlanZip))
+	}
+}
+
+func (s *MTVService) mainStore() (*store.Store2, error) {
+	db, err := s.pool.Get(store.MainDatabaseID)
+	if err != nil {
+		return nil, err
+	}
+	return db.Store()
+}
+
+// validateMTVMappings checks that every source is mapped once and that every destination is complete.
+func validateMTVMappings(mappings models.MTVMappings) error {
+	seen := make(map[string]bool)
+	for _, m := range mappings.Networks {
+		if strings.TrimSpace(m.Source) == "" {
+			return srvErrors.NewValidationError("network mapping source must not be empty")
+		}
+		if seen[m.Source] {
+			return srvErrors.NewValidationError(fmt.Sprintf("network %q is mapped more than once", m.Source))
+		}
+		seen[m.Source] = true
+		if !slices.Contains(models.MTVNetworkTypes, m.Type) {
+			return srvErrors.NewValidationError(fmt.Sprintf("invalid destination type %q for network %q", m.Type, m.Source))
+		}
+		if m.Type == models.MTVNetworkMultus {
+			if !dns1123LabelRe.MatchString(m.Namespace) || !dns1123LabelRe.MatchString(m.Name) {
+				return srvErrors.NewValidationError(fmt.Sprintf("network %q: multus destinations need the namespace and name of a NetworkAttachmentDefinition", m.Source))
+			}
+		}
+	}
+
+	seen = make(map[string]bool)
+	for _, m := range mappings.Storage {
+		if strings.TrimSpace(m.Source) == "" {
+			return srvErrors.NewValidationError("storage mapping source must not be empty")
+		}
+		if seen[m.Source] {
+			return srvErrors.NewValidationError(fmt.Sprintf("datastore %q is mapped more than once", m.Source))
+		}
+		seen[m.Source] = true
+		if strings.TrimSpace(m.StorageClass) == "" {
+			return srvErrors.NewValidationError(fmt.Sprintf("datastore %q: storage class must not be empty", m.Source))
+		}
+	}
+	return nil
+}
+
+// mtvPlanOptionsWithDefaults fills in the unset options and validates them.
+func mtvPlanOptionsWithDefaults(opts models.MTVPlanOptions) (models.MTVPlanOptions, error) {
+	if opts.Namespace == "" {
+		opts.Namespace = defaultMTVNamespace
+	}
+	if opts.SourceProvider == "" {
+		opts.SourceProvider = defaultMTVSourceProvider
+	}
+	if opts.DestinationProvider == "" {
+		opts.DestinationProvider = defaultMTVDestinationProvider
+	}
+	if opts.TargetNamespace == "" {
+		opts.TargetNamespace = opts.Namespace
+	}
+	for _, o := range []struct{ name, value string }{
+		{"namespace", opts.Namespace},
+		{"targetNamespace", opts.TargetNamespace},
+		{"sourceProvider", opts.SourceProvider},
+		{"destinationProvider", opts.DestinationProvider},
+	} {
+		if len(o.value) > 63 || !dns1123LabelRe.MatchString(o.value) {
+			return opts, srvErrors.NewValidationError(fmt.Sprintf("invalid %s %q: use lowercase letters, digits and '-'", o.name, o.value))
+		}
+	}
+	return opts, nil
+}
+
+// mtvResourceName derives the name of the manifests from a group name. Characters not
+// allowed in Kubernetes names are replaced by '-'; the group ID is used when nothing is left.
+func mtvResourceName(groupName string, groupID uuid.UUID) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(groupName) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('-')
+		}
+	}
+	name := strings.Trim(b.String(), "-")
+	if len(name) > 63 {
+		name = strings.TrimRight(name[:63], "-")
+	}
+	if name == "" {
+		return "group-" + groupID.String()[:8]
+	}
+	return name
+}
+
+func marshalMTVResource(r mtvResource) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(r); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// The types below render the subset of the forklift.konveyor.io/v1beta1 resources the
+// generated manifests use.
+
+type mtvResource struct {
+	APIVersion string       `yaml:"apiVersion"`
+	Kind       string       `yaml:"kind"`
+	Metadata   mtvObjectRef `yaml:"metadata"`
+	Spec       any          `yaml:"spec"`
+}
+
+type mtvObjectRef struct {
+	Name      string `yaml:"name"`
+	Namespace string `yaml:"namespace,omitempty"`
+}
+
+type mtvSourceRef struct {
+	ID   string `yaml:"id,omitempty"`
+	Name string `yaml:"name,omitempty"`
+}
+
+type mtvProviderPair struct {
+	Source      mtvObjectRef `yaml:"source"`
+	Destination mtvObjectRef `yaml:"destination"`
+}
+
+type mtvNetworkMapSpec struct {
+	Provider mtvProviderPair  `yaml:"provider"`
+	Map      []mtvNetworkPair `yaml:"map"`
+}
+
+type mtvNetworkPair struct {
+	Source      mtvSourceRef          `yaml:"source"`
+	Destination mtvNetworkDestination `yaml:"destination"`
+}
+
+type mtvNetworkDestination struct {
+	Type      string `yaml:"type"`
+	Namespace string `yaml:"namespace,omitempty"`
+	Name      string `yaml:"name,omitempty"`
+}
+
+type mtvStorageMapSpec struct {
+	Provider mtvProviderPair  `yaml:"provider"`
+	Map      []mtvStoragePair `yaml:"map"`
+}
+
+type mtvStoragePair struct {
+	Source      mtvSourceRef          `yaml:"source"`
+	Destination mtvStorageDestination `yaml:"destination"`
+}
+
+type mtvStorageDestination struct {
+	StorageClass string `yaml:"storageClass"`
+}
+
+type mtvPlanSpec struct {
+	Description     string          `yaml:"description"`
+	Provider        mtvProviderPair `yaml:"provider"`
+	TargetNamespace string          `yaml:"targetNamespace"`
+	Map             mtvPlanMap      `yaml:"map"`
+	VMs             []mtvSourceRef  `yaml:"vms"`
+}
+
+type mtvPlanMap struct {
+	Network mtvObjectRef `yaml:"network"`
+	Storage mtvObjectRef `yaml:"storage"`
+}
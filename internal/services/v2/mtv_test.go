@@ -0,0 +1,201 @@
+package v2_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	v2 "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+var _ = Describe("MTVService", func() {
+	var (
+		ctx     context.Context
+		pool    *store.Pool
+		tmpDir  string
+		srv     *v2.MTVService
+		groupID uuid.UUID
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		tmpDir, err = os.MkdirTemp("", "mtv-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		pool = newTestPool(tmpDir)
+
+		// a collection with three VMs of the prod cluster: web-2 is excluded and db-1 has a
+		// critical concern
+		db, st := addTestCollection(pool, "col-1000", time.Unix(1000, 0))
+		db.VCenter = "vc-a"
+		for _, q := range []string{
+			`INSERT INTO vinfo ("VM ID", "VM", "Cluster", "Powerstate", "Template", "Memory", "CPUs")
+			 VALUES ('vm-1', 'web-1', 'prod', 'poweredOn', false, 8192, 4),
+			        ('vm-2', 'web-2', 'prod', 'poweredOff', false, 4096, 2),
+			        ('vm-3', 'db-1', 'prod', 'poweredOn', false, 16384, 8),
+			        ('vm-4', 'app-1', 'prod', 'poweredOn', false, 4096, 2)`,
+			`INSERT INTO vdisk ("VM ID", "Path", "Capacity MiB")
+			 VALUES ('vm-1', '[ds-fast] vm-1/vm-1.vmdk', 1024),
+			        ('vm-1', '[ds-slow] vm-1/vm-1_1.vmdk', 1024),
+			        ('vm-2', '[ds-other] vm-2/vm-2.vmdk', 1024),
+			        ('vm-4', '[ds-fast] vm-4/vm-4.vmdk', 1024)`,
+			`INSERT INTO vnetwork ("VM ID", "Network", "Mac Address")
+			 VALUES ('vm-1', 'VM Network', '00:50:56:00:00:01'),
+			        ('vm-4', 'VM Network', '00:50:56:00:00:04'),
+			        ('vm-4', 'backup', '00:50:56:00:00:05')`,
+			`INSERT INTO concerns ("VM_ID", "Concern_ID", "Label", "Category", "Assessment")
+			 VALUES ('vm-3', 'concern-vm-3', 'Critical issue', 'Critical', 'Must fix before migration')`,
+			`UPDATE vinfo SET migration_excluded = true WHERE "VM ID" = 'vm-2'`,
+		} {
+			_, err = st.Querier().ExecContext(ctx, q)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		group, err := v2.NewGroupService(st, &mockInventoryBuilder{}).Create(ctx, models.Group{Name: "Prod Web", Filter: "cluster = 'prod'"})
+		Expect(err).NotTo(HaveOccurred())
+		groupID = group.ID
+
+		srv = v2.NewMTVService(pool)
+	})
+
+	AfterEach(func() {
+		pool.Close()
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	It("rejects invalid mappings", func() {
+		_, err := srv.SetMappings(ctx, models.MTVMappings{Networks: []models.MTVNetworkMapping{{Source: "VM Network", Type: "bridge"}}})
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+
+		_, err = srv.SetMappings(ctx, models.MTVMappings{Networks: []models.MTVNetworkMapping{{Source: "VM Network", Type: models.MTVNetworkMultus}}})
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+
+		_, err = srv.SetMappings(ctx, models.MTVMappings{Storage: []models.MTVStorageMapping{
+			{Source: "ds-fast", StorageClass: "fast"},
+			{Source: "ds-fast", StorageClass: "slow"},
+		}})
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("generates the maps and plan of the migratable VMs of a group", func() {
+		mappings, err := srv.SetMappings(ctx, models.MTVMappings{
+			Networks: []models.MTVNetworkMapping{
+				{Source: "VM Network", Type: models.MTVNetworkPod},
+				{Source: "unused", Type: models.MTVNetworkIgnored},
+			},
+			Storage: []models.MTVStorageMapping{{Source: "ds-fast", StorageClass: "ocs-storagecluster-ceph-rbd"}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mappings.Networks).To(HaveLen(2))
+
+		plan, err := srv.GeneratePlan(ctx, groupID, models.MTVPlanOptions{TargetNamespace: "prod-web"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.Name).To(Equal("prod-web"))
+		Expect(plan.Warnings).To(Equal([]string{
+			"VM web-2 (vm-2) is excluded from migration and left out of the plan",
+			"VM db-1 (vm-3) has critical concerns and is left out of the plan",
+			`network "backup" of 1 VM(s) is not mapped and left out of the NetworkMap`,
+			`datastore "ds-slow" of 1 VM(s) is not mapped and left out of the StorageMap`,
+		}))
+
+		Expect(plan.Manifests).To(HaveLen(3))
+		Expect(string(plan.Manifests[0].Content)).To(Equal(`apiVersion: forklift.konveyor.io/v1beta1
+kind: NetworkMap
+metadata:
+  name: prod-web
+  namespace: openshift-mtv
+spec:
+  provider:
+    source:
+      name: vsphere
+      namespace: openshift-mtv
+    destination:
+      name: host
+      namespace: openshift-mtv
+  map:
+    - source:
+        name: VM Network
+      destination:
+        type: pod
+`))
+		Expect(string(plan.Manifests[1].Content)).To(ContainSubstring("storageClass: ocs-storagecluster-ceph-rbd"))
+		Expect(string(plan.Manifests[2].Content)).To(ContainSubstring(`  targetNamespace: prod-web
+  map:
+    network:
+      name: prod-web
+      namespace: openshift-mtv
+    storage:
+      name: prod-web
+      namespace: openshift-mtv
+  vms:
+    - id: vm-1
+      name: web-1
+    - id: vm-4
+      name: app-1
+`))
+
+		again, err := srv.GeneratePlan(ctx, groupID, models.MTVPlanOptions{TargetNamespace: "prod-web"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(again).To(Equal(plan))
+	})
+
+	It("writes the plan as YAML documents or as a zip", func() {
+		plan, err := srv.GeneratePlan(ctx, groupID, models.MTVPlanOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		var yamlBuf bytes.Buffer
+		Expect(srv.WritePlan(plan, v2.MTVPlanYAML, &yamlBuf)).To(Succeed())
+		Expect(yamlBuf.String()).To(HavePrefix("# WARNING: VM web-2 (vm-2) is excluded from migration"))
+		Expect(bytes.Count(yamlBuf.Bytes(), []byte("---\n"))).To(Equal(3))
+
+		var zipBuf bytes.Buffer
+		Expect(srv.WritePlan(plan, v2.MTVPlanZip, &zipBuf)).To(Succeed())
+		zr, err := zip.NewReader(bytes.NewReader(zipBuf.Bytes()), int64(zipBuf.Len()))
+		Expect(err).NotTo(HaveOccurred())
+		var names []string
+		for _, f := range zr.File {
+			names = append(names, f.Name)
+		}
+		Expect(names).To(Equal([]string{"prod-web-networkmap.yaml", "prod-web-storagemap.yaml", "prod-web-plan.yaml", "warnings.txt"}))
+		f, err := zr.File[3].Open()
+		Expect(err).NotTo(HaveOccurred())
+		warnings, err := io.ReadAll(f)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(warnings)).To(ContainSubstring(`network "VM Network" of 2 VM(s) is not mapped`))
+
+		Expect(srvErrors.IsValidationError(srv.WritePlan(plan, "json", io.Discard))).To(BeTrue())
+	})
+
+	It("reads the group from the latest collection of the given vCenter", func() {
+		// a newer collection of another vCenter, without the group
+		db, st := addTestCollection(pool, "col-2000", time.Unix(2000, 0))
+		db.VCenter = "vc-b"
+		insertSyncTestVM(ctx, st, "vm-1", "web-1")
+
+		_, err := srv.GeneratePlan(ctx, groupID, models.MTVPlanOptions{})
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+
+		plan, err := srv.GeneratePlan(ctx, groupID, models.MTVPlanOptions{VCenter: "vc-a"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.Name).To(Equal("prod-web"))
+
+		_, err = srv.GeneratePlan(ctx, groupID, models.MTVPlanOptions{VCenter: "vc-c"})
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+	})
+
+	It("returns not found for an unknown group", func() {
+		_, err := srv.GeneratePlan(ctx, uuid.New(), models.MTVPlanOptions{})
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+	})
+})
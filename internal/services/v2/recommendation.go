@@ -0,0 +1,262 @@
+package v2
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+	"github.com/kubev2v/assisted-migration-agent/pkg/rightsizing"
+)
+
+// rightsizingPolicyNameRe is the syntax of rightsizing policy names.
+var rightsizingPolicyNameRe = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// RecommendationService manages rightsizing policies and recommends VM sizes under them.
+//
+// Policies live in the main database. Recommendations are computed on demand from the CPU and
+// memory statistics of the latest rightsizing report of the latest collection: each VM is sized
+// by the percentile of its policy plus headroom, within the policy bounds, and graded by the
+// share of the expected samples collected. The "default" policy is built in until a policy of
+// that name is stored.
+type RecommendationService struct {
+	pool *store.Pool
+}
+
+func NewRecommendationService(pool *store.Pool) *RecommendationService {
+	return &RecommendationService{pool: pool}
+}
+
+// ListPolicies returns all policies, ordered by name, with the built-in default policy unless
+// it is overridden.
+func (s *RecommendationService) ListPolicies(ctx context.Context) ([]models.RightsizingPolicy, error) {
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	policies, err := st.RightsizingPolicy().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.ContainsFunc(policies, func(p models.RightsizingPolicy) bool { return p.Name == models.DefaultRightsizingPolicyName }) {
+		policies = append(policies, models.DefaultRightsizingPolicy)
+		slices.SortFunc(policies, func(a, b models.RightsizingPolicy) int { return cmp.Compare(a.Name, b.Name) })
+	}
+	return policies, nil
+}
+
+// GetPolicy returns a policy by name, the built-in default policy for "default" unless it is
+// overridden.
+func (s *RecommendationService) GetPolicy(ctx context.Context, name string) (*models.RightsizingPolicy, error) {
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	p, err := st.RightsizingPolicy().Get(ctx, name)
+	if srvErrors.IsResourceNotFoundError(err) && name == models.DefaultRightsizingPolicyName {
+		p := models.DefaultRightsizingPolicy
+		return &p, nil
+	}
+	return p, err
+}
+
+// CreatePolicy validates and stores a new policy. Creating "default" overrides the built-in
+// default policy.
+func (s *RecommendationService) CreatePolicy(ctx context.Context, p models.RightsizingPolicy) (*models.RightsizingPolicy, error) {
+	if !rightsizingPolicyNameRe.MatchString(p.Name) {
+		return nil, srvErrors.NewValidationError(fmt.Sprintf("invalid rightsizing policy name %q: use letters, digits, '_' and '-'", p.Name))
+	}
+	if err := validateRightsizingPolicy(p); err != nil {
+		return nil, err
+	}
+
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	return st.RightsizingPolicy().Create(ctx, p)
+}
+
+// UpdatePolicy validates and replaces a policy. Updating the built-in default policy stores it.
+func (s *RecommendationService) UpdatePolicy(ctx context.Context, p models.RightsizingPolicy) (*models.RightsizingPolicy, error) {
+	if err := validateRightsizingPolicy(p); err != nil {
+		return nil, err
+	}
+
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	updated, err := st.RightsizingPolicy().Update(ctx, p)
+	if srvErrors.IsResourceNotFoundError(err) && p.Name == models.DefaultRightsizingPolicyName {
+		return st.RightsizingPolicy().Create(ctx, p)
+	}
+	return updated, err
+}
+
+// DeletePolicy removes a policy. Deleting "default" restores the built-in default policy.
+func (s *RecommendationService) DeletePolicy(ctx context.Context, name string) error {
+	st, err := s.mainStore()
+	if err != nil {
+		return err
+	}
+	return st.RightsizingPolicy().Delete(ctx, name)
+}
+
+// ListRecommendations recommends the size of the VMs of the latest rightsizing report under a
+// policy, the default one when policyName is empty. When groupID is not nil, only the VMs of
+// that group are recommended.
+func (s *RecommendationService) ListRecommendations(ctx context.Context, policyName string, groupID *uuid.UUID) (*models.RightsizingRecommendations, error) {
+	policy, err := s.policy(ctx, policyName)
+	if err != nil {
+		return nil, err
+	}
+	st, err := s.latestStore()
+	if err != nil {
+		return nil, err
+	}
+
+	var filter sq.Sqlizer
+	if groupID != nil {
+		if _, err := st.Group().Get(ctx, *groupID); err != nil {
+			return nil, err
+		}
+		ids, err := st.Group().GetMatchedIDs(ctx, *groupID)
+		if err != nil {
+			return nil, fmt.Errorf("getting matched IDs for group %s: %w", *groupID, err)
+		}
+		filter = sq.Eq{`v."VM ID"`: append([]string{}, ids...)}
+	}
+	return s.recommend(ctx, st, *policy, filter)
+}
+
+// GetVMRecommendation recommends the size of a VM of the latest rightsizing report under a
+// policy, the default one when policyName is empty.
+func (s *RecommendationService) GetVMRecommendation(ctx context.Context, vmID, policyName string) (*models.RightsizingRecommendation, error) {
+	policy, err := s.policy(ctx, policyName)
+	if err != nil {
+		return nil, err
+	}
+	st, err := s.latestStore()
+	if err != nil {
+		return nil, err
+	}
+
+	recs, err := s.recommend(ctx, st, *policy, sq.Eq{`v."VM ID"`: vmID})
+	if err != nil {
+		return nil, err
+	}
+	if len(recs.VMs) == 0 {
+		return nil, srvErrors.NewResourceNotFoundError("VM recommendation", vmID)
+	}
+	return &recs.VMs[0], nil
+}
+
+// ListClusterRecommendations totals the recommendations of the VMs of each cluster of the
+// latest rightsizing report under a policy, the default one when policyName is empty.
+func (s *RecommendationService) ListClusterRecommendations(ctx context.Context, policyName string) (string, *models.RightsizingPolicy, []models.RightsizingClusterRecommendations, error) {
+	policy, err := s.policy(ctx, policyName)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	st, err := s.latestStore()
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	recs, err := s.recommend(ctx, st, *policy, nil)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	clusters := []models.RightsizingClusterRecommendations{}
+	index := make(map[string]int)
+	for _, r := range recs.VMs {
+		i, ok := index[r.Cluster]
+		if !ok {
+			i = len(clusters)
+			index[r.Cluster] = i
+			clusters = append(clusters, models.RightsizingClusterRecommendations{Cluster: r.Cluster})
+		}
+		clusters[i].Summary.Add(r)
+	}
+	slices.SortFunc(clusters, func(a, b models.RightsizingClusterRecommendations) int { return cmp.Compare(a.Cluster, b.Cluster) })
+	return recs.ReportID, policy, clusters, nil
+}
+
+// recommend recommends the size of the VMs of the latest rightsizing report of st matching
+// filter.
+func (s *RecommendationService) recommend(ctx context.Context, st *store.Store2, policy models.RightsizingPolicy, filter sq.Sqlizer) (*models.RightsizingRecommendations, error) {
+	reportID, stats, err := st.RightSizing().ListLatestVMStats(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if reportID == "" {
+		return nil, srvErrors.NewResourceNotFoundError("rightsizing report", "latest")
+	}
+
+	recs := &models.RightsizingRecommendations{
+		ReportID: reportID,
+		Policy:   policy,
+		VMs:      make([]models.RightsizingRecommendation, 0, len(stats)),
+	}
+	for _, vm := range stats {
+		r := policy.Recommend(vm)
+		recs.VMs = append(recs.VMs, r)
+		recs.Summary.Add(r)
+	}
+	return recs, nil
+}
+
+// policy returns the named policy, the default one when name is empty.
+func (s *RecommendationService) policy(ctx context.Context, name string) (*models.RightsizingPolicy, error) {
+	if name == "" {
+		name = models.DefaultRightsizingPolicyName
+	}
+	return s.GetPolicy(ctx, name)
+}
+
+func (s *RecommendationService) latestStore() (*store.Store2, error) {
+	db, err := s.pool.Latest()
+	if err != nil {
+		return nil, err
+	}
+	return db.Store()
+}
+
+func (s *RecommendationService) mainStore() (*store.Store2, error) {
+	db, err := s.pool.Get(store.MainDatabaseID)
+	if err != nil {
+		return nil, err
+	}
+	return db.Store()
+}
+
+// validateRightsizingPolicy checks the percentile, headroom, bounds and rounding of a policy.
+func validateRightsizingPolicy(p models.RightsizingPolicy) error {
+	switch p.Percentile {
+	case rightsizing.PercentileAverage, rightsizing.PercentileP95, rightsizing.PercentileP99, rightsizing.PercentileMax:
+	default:
+		return srvErrors.NewValidationError(fmt.Sprintf("invalid percentile %q: use average, p95, p99 or max", p.Percentile))
+	}
+	switch {
+	case p.HeadroomPct < 0:
+		return srvErrors.NewValidationError("headroom must not be negative")
+	case p.MinCPUs < 0 || p.MaxCPUs < 0 || p.MinMemoryMB < 0 || p.MaxMemoryMB < 0:
+		return srvErrors.NewValidationError("bounds must not be negative")
+	case p.MaxCPUs > 0 && p.MaxCPUs < p.MinCPUs:
+		return srvErrors.NewValidationError("maximum vCPUs must not be less than the minimum")
+	case p.MaxMemoryMB > 0 && p.MaxMemoryMB < p.MinMemoryMB:
+		return srvErrors.NewValidationError("maximum memory must not be less than the minimum")
+	case p.MemoryStepMB < 0:
+		return srvErrors.NewValidationError("memory step must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package v2_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	v2 "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+var _ = Describe("RecommendationService", func() {
+	var (
+		ctx     context.Context
+		pool    *store.Pool
+		tmpDir  string
+		st      *store.Store2
+		srv     *v2.RecommendationService
+		groupID uuid.UUID
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		tmpDir, err = os.MkdirTemp("", "recommendation-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		pool = newTestPool(tmpDir)
+
+		// web-1 has every expected sample, db-1 60% of them and app-1 no memory samples;
+		// idle-1 is not in the report.
+		_, st = addTestCollection(pool, "col-1000", time.Unix(1000, 0))
+		for _, q := range []string{
+			`INSERT INTO vinfo ("VM ID", "VM", "Cluster", "Powerstate", "Template", "Memory", "CPUs")
+			 VALUES ('vm-1', 'web-1', 'prod', 'poweredOn', false, 16384, 8),
+			        ('vm-2', 'db-1', 'prod', 'poweredOn', false, 8192, 4),
+			        ('vm-3', 'app-1', 'dev', 'poweredOn', false, 4096, 2),
+			        ('vm-4', 'idle-1', 'prod', 'poweredOn', false, 4096, 2)`,
+			`INSERT INTO vcpu ("VM ID", "Cores p/s") VALUES ('vm-1', 2), ('vm-2', 1), ('vm-3', 1), ('vm-4', 1)`,
+			`INSERT INTO rightsizing_reports (id, vcenter, interval_id, window_start, window_end, expected_sample_count, expected_batch_count, written_batch_count)
+			 VALUES ('report-1', 'vc', 300, '2026-01-01', '2026-01-08', 10, 1, 1)`,
+			`INSERT INTO rightsizing_metrics (report_id, vm_name, moid, metric_key, sample_count, average, p95, p99, max, latest)
+			 VALUES ('report-1', 'web-1', 'vm-1', 'cpu.usage.average', 10, 2000, 3000, 4000, 5000, 2000),
+			        ('report-1', 'web-1', 'vm-1', 'mem.consumed.average', 10, 3072000, 4096000, 5120000, 6144000, 3072000),
+			        ('report-1', 'db-1', 'vm-2', 'cpu.usage.average', 6, 8000, 9000, 9500, 10000, 8000),
+			        ('report-1', 'db-1', 'vm-2', 'mem.consumed.average', 6, 7168000, 8192000, 8192000, 8192000, 8192000),
+			        ('report-1', 'app-1', 'vm-3', 'cpu.usage.average', 10, 200, 500, 600, 700, 200)`,
+		} {
+			_, err = st.Querier().ExecContext(ctx, q)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		group, err := v2.NewGroupService(st, &mockInventoryBuilder{}).Create(ctx, models.Group{Name: "Prod", Filter: "cluster = 'prod'"})
+		Expect(err).NotTo(HaveOccurred())
+		groupID = group.ID
+
+		srv = v2.NewRecommendationService(pool)
+	})
+
+	AfterEach(func() {
+		pool.Close()
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	It("manages named policies next to the built-in default", func() {
+		policies, err := srv.ListPolicies(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policies).To(Equal([]models.RightsizingPolicy{models.DefaultRightsizingPolicy}))
+
+		_, err = srv.CreatePolicy(ctx, models.RightsizingPolicy{Name: "bad name", Percentile: "p95"})
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+		_, err = srv.CreatePolicy(ctx, models.RightsizingPolicy{Name: "peak", Percentile: "p50"})
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+		_, err = srv.CreatePolicy(ctx, models.RightsizingPolicy{Name: "peak", Percentile: "max", MinCPUs: 4, MaxCPUs: 2})
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+
+		peak, err := srv.CreatePolicy(ctx, models.RightsizingPolicy{Name: "peak", Percentile: "max", MaxCPUs: 6})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(peak.CreatedAt).NotTo(BeZero())
+		_, err = srv.CreatePolicy(ctx, models.RightsizingPolicy{Name: "peak", Percentile: "max"})
+		Expect(srvErrors.IsDuplicateResourceError(err)).To(BeTrue())
+
+		def := models.DefaultRightsizingPolicy
+		def.HeadroomPct = 50
+		_, err = srv.UpdatePolicy(ctx, def)
+		Expect(err).NotTo(HaveOccurred())
+		got, err := srv.GetPolicy(ctx, models.DefaultRightsizingPolicyName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.HeadroomPct).To(Equal(50.0))
+
+		policies, err = srv.ListPolicies(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policies).To(HaveLen(2))
+		Expect(policies[0].Name).To(Equal("default"))
+		Expect(policies[1].Name).To(Equal("peak"))
+
+		Expect(srv.DeletePolicy(ctx, models.DefaultRightsizingPolicyName)).To(Succeed())
+		got, err = srv.GetPolicy(ctx, models.DefaultRightsizingPolicyName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.HeadroomPct).To(Equal(20.0))
+
+		_, err = srv.UpdatePolicy(ctx, models.RightsizingPolicy{Name: "missing", Percentile: "p95"})
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+		Expect(srvErrors.IsResourceNotFoundError(srv.DeletePolicy(ctx, "missing"))).To(BeTrue())
+	})
+
+	It("recommends VM sizes under the default policy", func() {
+		recs, err := srv.ListRecommendations(ctx, "", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recs.ReportID).To(Equal("report-1"))
+		Expect(recs.Policy.Name).To(Equal("default"))
+		Expect(recs.VMs).To(Equal([]models.RightsizingRecommendation{
+			// 8 × 30% × 1.2 = 2.88, rounded up to two sockets of 2 cores; 4000 MB × 1.2 up to the GiB
+			{VMID: "vm-1", Name: "web-1", Cluster: "prod", ProvisionedCPUs: 8, RecommendedCPUs: 4, SavedCPUs: 4,
+				ProvisionedMemoryMB: 16384, RecommendedMemoryMB: 5120, SavedMemoryMB: 11264, ConfidencePct: 100, Confidence: "high"},
+			{VMID: "vm-2", Name: "db-1", Cluster: "prod", ProvisionedCPUs: 4, RecommendedCPUs: 5, SavedCPUs: -1,
+				ProvisionedMemoryMB: 8192, RecommendedMemoryMB: 10240, SavedMemoryMB: -2048, ConfidencePct: 60, Confidence: "medium"},
+			{VMID: "vm-3", Name: "app-1", Cluster: "dev", ProvisionedCPUs: 2, RecommendedCPUs: 1, SavedCPUs: 1,
+				ProvisionedMemoryMB: 4096, RecommendedMemoryMB: 4096, SavedMemoryMB: 0, ConfidencePct: 0, Confidence: "low"},
+		}))
+		Expect(recs.Summary).To(Equal(models.RightsizingRecommendationSummary{
+			VMCount: 3, ProvisionedCPUs: 14, RecommendedCPUs: 10, SavedCPUs: 4,
+			ProvisionedMemoryMB: 28672, RecommendedMemoryMB: 19456, SavedMemoryMB: 9216,
+			HighConfidence: 1, MediumConfidence: 1, LowConfidence: 1,
+		}))
+
+		Expect(st.Export().ExportRecommendations(ctx, tmpDir, recs.Policy)).To(Succeed())
+		csv, err := os.ReadFile(filepath.Join(tmpDir, "recommendations.csv"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(csv)).To(Equal(`vm_name,vm_id,cluster,policy,provisioned_cpus,recommended_cpus,saved_cpus,provisioned_memory_mb,recommended_memory_mb,saved_memory_mb,confidence_pct,confidence,report_id
+web-1,vm-1,prod,default,8,4,4,16384,5120,11264,100.0,high,report-1
+db-1,vm-2,prod,default,4,5,-1,8192,10240,-2048,60.0,medium,report-1
+app-1,vm-3,dev,default,2,1,1,4096,4096,0,0.0,low,report-1
+`))
+	})
+
+	It("recommends per VM, group and cluster under a named policy", func() {
+		_, err := srv.CreatePolicy(ctx, models.RightsizingPolicy{Name: "peak", Percentile: "max"})
+		Expect(err).NotTo(HaveOccurred())
+
+		vm, err := srv.GetVMRecommendation(ctx, "vm-1", "peak")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vm.RecommendedCPUs).To(Equal(4))
+		Expect(vm.RecommendedMemoryMB).To(Equal(int64(6000)))
+
+		recs, err := srv.ListRecommendations(ctx, "peak", &groupID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recs.VMs).To(HaveLen(2))
+		Expect(recs.VMs[0].VMID).To(Equal("vm-1"))
+		Expect(recs.VMs[1].VMID).To(Equal("vm-2"))
+
+		reportID, policy, clusters, err := srv.ListClusterRecommendations(ctx, "peak")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reportID).To(Equal("report-1"))
+		Expect(policy.Name).To(Equal("peak"))
+		Expect(clusters).To(HaveLen(2))
+		Expect(clusters[0].Cluster).To(Equal("dev"))
+		Expect(clusters[0].Summary.VMCount).To(Equal(1))
+		Expect(clusters[1].Cluster).To(Equal("prod"))
+		Expect(clusters[1].Summary.VMCount).To(Equal(2))
+		Expect(clusters[1].Summary.RecommendedCPUs).To(Equal(4 + 4))
+	})
+
+	It("returns not found for unknown VMs, groups and policies", func() {
+		_, err := srv.GetVMRecommendation(ctx, "vm-4", "")
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+
+		unknown := uuid.New()
+		_, err = srv.ListRecommendations(ctx, "", &unknown)
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+
+		_, err = srv.ListRecommendations(ctx, "missing", nil)
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+	})
+})
@@ -14,12 +14,14 @@ import (
 
 	"github.com/kubev2v/assisted-migration-agent/internal/models"
 	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
 )
 
 const (
 	rightsizingDefaultLookbackHours   = 720  // 30 days
 	rightsizingDefaultIntervalSeconds = 7200 // monthly vSphere rollup
 	rightsizingDefaultBatchSize       = 25
+	rightsizingMaxSeriesPoints        = 10000 // per metric, in a VM utilization series
 )
 
 var desiredMetrics = []string{
@@ -29,8 +31,30 @@ var desiredMetrics = []string{
 	"mem.consumed.average",
 	"disk.used.latest",
 	"disk.provisioned.latest",
+	"disk.usage.average",
+	"net.usage.average",
+	"net.received.average",
+	"net.transmitted.average",
+	"virtualDisk.numberReadAveraged.average",
+	"virtualDisk.numberWriteAveraged.average",
+	"virtualDisk.totalReadLatency.average",
+	"virtualDisk.totalWriteLatency.average",
 }
 
+// instanceMetrics are the desired metrics vCenter only reports per virtual disk. They are
+// queried for every disk and combined into one series per VM: IOPS add up, latencies keep
+// the slowest disk.
+var instanceMetrics = map[string]func(a, b int64) int64{
+	"virtualDisk.numberReadAveraged.average":  addSamples,
+	"virtualDisk.numberWriteAveraged.average": addSamples,
+	"virtualDisk.totalReadLatency.average":    maxSamples,
+	"virtualDisk.totalWriteLatency.average":   maxSamples,
+}
+
+// rightsizingIOPSMetric is the derived metric holding the read and write IOPS of all the
+// virtual disks of a VM.
+const rightsizingIOPSMetric = "virtualDisk.iops.average"
+
 type VMInfo struct {
 	Name string
 	Ref  types.ManagedObjectReference
@@ -41,6 +65,7 @@ type VMReport struct {
 	MOID     string
 	Metrics  map[string]MetricStats
 	Warnings []string
+	Samples  []models.RightsizingSample // the samples Metrics are computed from
 }
 
 type MetricStats struct {
@@ -64,23 +89,62 @@ func (s *RightsizingService) GetVMUtilization(ctx context.Context, vmID string)
 	return s.store.RightSizing().GetVMUtilization(ctx, vmID)
 }
 
+// GetVMSeries returns the time series of the metrics of a VM in a rightsizing report, the
+// latest completed one when reportID is empty, aggregated into buckets. A zero bucket returns
+// the samples as they were collected; otherwise it must be a multiple of the report interval.
+// When metricKeys is not empty, only those metrics are returned.
+func (s *RightsizingService) GetVMSeries(ctx context.Context, vmID, reportID string, bucket time.Duration, metricKeys []string) (*models.VmUtilizationSeries, error) {
+	var (
+		report *models.RightsizingReportSummary
+		err    error
+	)
+	if reportID == "" {
+		report, err = s.store.RightSizing().GetLatestReportSummary(ctx)
+	} else {
+		report, err = s.store.RightSizing().GetReportSummary(ctx, reportID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	interval := time.Duration(report.IntervalID) * time.Second
+	if bucket == 0 {
+		bucket = interval
+	}
+	switch {
+	case bucket < 0 || interval <= 0 || bucket%interval != 0:
+		return nil, srvErrors.NewValidationError(fmt.Sprintf("bucket must be a multiple of the %d seconds interval of the report", report.IntervalID))
+	case report.WindowEnd.Sub(report.WindowStart)/bucket > rightsizingMaxSeriesPoints:
+		return nil, srvErrors.NewValidationError(fmt.Sprintf("bucket too small: the report window would span more than %d points", rightsizingMaxSeriesPoints))
+	}
+
+	metrics, err := s.store.RightSizing().GetVMSeries(ctx, report.ID, vmID, bucket, metricKeys)
+	if err != nil {
+		return nil, err
+	}
+	if len(metrics) == 0 {
+		return nil, srvErrors.NewResourceNotFoundError("vm utilization series", vmID)
+	}
+	return &models.VmUtilizationSeries{
+		ReportID:    report.ID,
+		MOID:        vmID,
+		IntervalID:  report.IntervalID,
+		Bucket:      bucket,
+		WindowStart: report.WindowStart,
+		WindowEnd:   report.WindowEnd,
+		Metrics:     metrics,
+	}, nil
+}
+
 func (s *RightsizingService) ListLatestClusterUtilization(ctx context.Context, clusterID string) (string, []models.RightsizingClusterUtilization, error) {
 	// TODO: after v1 removal fix this
 	return s.store.RightSizing().ListLatestClusterUtilization(ctx, fmt.Sprintf("cluster_id = '%s'", clusterID))
 }
 
 func (s *RightsizingService) CreateReportFromInventory(ctx context.Context) (string, []VMInfo, time.Time, time.Time, error) {
-	inventoryVMs, err := s.store.RightSizing().ListInventoryVMs(ctx)
+	vms, err := inventoryVMInfos(ctx, s.store)
 	if err != nil {
-		return "", nil, time.Time{}, time.Time{}, fmt.Errorf("reading VMs from inventory: %w", err)
-	}
-
-	var vms []VMInfo
-	for _, vm := range inventoryVMs {
-		vms = append(vms, VMInfo{
-			Name: vm.Name,
-			Ref:  types.ManagedObjectReference{Type: "VirtualMachine", Value: vm.ID},
-		})
+		return "", nil, time.Time{}, time.Time{}, err
 	}
 
 	lookback := time.Duration(rightsizingDefaultLookbackHours) * time.Hour
@@ -142,13 +206,57 @@ func (s *RightsizingService) QueryMetrics(ctx context.Context, client *govmomi.C
 			continue
 		}
 
-		for k, v := range parseBatchResults(raw, countersByKey, batch) {
+		batchResults := parseBatchResults(raw, countersByKey, batch)
+		for _, smp := range parseBatchSamples(raw, countersByKey, "") {
+			if r, ok := batchResults[smp.MOID]; ok {
+				r.Samples = append(r.Samples, smp)
+				batchResults[smp.MOID] = r
+			}
+		}
+		for k, v := range batchResults {
 			results[k] = v
 		}
 	}
 	return results, nil
 }
 
+// QuerySamples returns the samples of the desired metrics of VMs taken by vCenter every
+// intervalID seconds in (start, end]. Samples vCenter marks as missing are left out.
+func QuerySamples(ctx context.Context, client *govmomi.Client, vcenter string, vms []VMInfo, intervalID int, start, end time.Time) ([]models.RightsizingSample, error) {
+	pm := performance.NewManager(client.Client)
+
+	metricIDs, countersByKey, globalWarnings := resolveCounters(ctx, pm)
+	if len(metricIDs) == 0 {
+		return nil, fmt.Errorf("no desired metrics recognized by this vCenter: %v", globalWarnings)
+	}
+	if len(globalWarnings) > 0 {
+		zap.S().Named("rightsizing_service").Warnw("metric resolution warnings", "warnings", globalWarnings)
+	}
+
+	var samples []models.RightsizingSample
+	for i := 0; i < len(vms); i += rightsizingDefaultBatchSize {
+		batch := vms[i:min(i+rightsizingDefaultBatchSize, len(vms))]
+		specs := make([]types.PerfQuerySpec, len(batch))
+		for j, vm := range batch {
+			s, e := start, end
+			specs[j] = types.PerfQuerySpec{
+				Entity:     vm.Ref,
+				IntervalId: int32(intervalID),
+				MetricId:   metricIDs,
+				StartTime:  &s,
+				EndTime:    &e,
+			}
+		}
+
+		raw, err := pm.Query(ctx, specs)
+		if err != nil {
+			return nil, fmt.Errorf("querying samples of VMs %d-%d: %w", i+1, i+len(batch), err)
+		}
+		samples = append(samples, parseBatchSamples(raw, countersByKey, vcenter)...)
+	}
+	return samples, nil
+}
+
 func (s *RightsizingService) PersistMetrics(ctx context.Context, vms []VMInfo, vmResults map[string]VMReport, reportID string) error {
 	batchSize := rightsizingDefaultBatchSize
 	totalBatches := int(math.Ceil(float64(len(vms)) / float64(batchSize)))
@@ -156,11 +264,18 @@ func (s *RightsizingService) PersistMetrics(ctx context.Context, vms []VMInfo, v
 		batchNum := i/batchSize + 1
 		batchVMs := vms[i:min(i+batchSize, len(vms))]
 		metrics := toStoreMetrics(batchVMs, vmResults)
+		var samples []models.RightsizingSample
+		for _, vm := range batchVMs {
+			samples = append(samples, vmResults[vm.Ref.Value].Samples...)
+		}
 
 		if err := s.store.WithTx(ctx, func(txCtx context.Context) error {
 			if err := s.store.RightSizing().WriteBatch(txCtx, reportID, metrics); err != nil {
 				return err
 			}
+			if err := s.store.RightSizing().WriteSamples(txCtx, reportID, samples); err != nil {
+				return err
+			}
 			return s.store.RightSizing().IncrementWrittenBatchCount(txCtx, reportID)
 		}); err != nil {
 			return fmt.Errorf("persisting rightsizing batch %d/%d: %w", batchNum, totalBatches, err)
@@ -215,7 +330,11 @@ func resolveCounters(ctx context.Context, pm *performance.Manager) (metricIDs []
 			warnings = append(warnings, fmt.Sprintf("metric %q not recognized by this vCenter", name))
 			continue
 		}
-		metricIDs = append(metricIDs, types.PerfMetricId{CounterId: info.Key, Instance: ""})
+		instance := ""
+		if _, ok := instanceMetrics[name]; ok {
+			instance = "*"
+		}
+		metricIDs = append(metricIDs, types.PerfMetricId{CounterId: info.Key, Instance: instance})
 	}
 	return metricIDs, countersByKey, warnings
 }
@@ -231,24 +350,12 @@ func parseBatchResults(raw []types.BasePerfEntityMetricBase, countersByKey map[i
 		moid := em.Entity.Value
 		metrics := make(map[string]MetricStats)
 		var warnings []string
-		for _, v := range em.Value {
-			series, ok := v.(*types.PerfMetricIntSeries)
-			if !ok {
-				continue
-			}
-			info, ok := countersByKey[series.Id.CounterId]
-			if !ok {
-				continue
-			}
-			name := info.Name()
-			if _, exists := metrics[name]; exists {
-				continue
-			}
-			if len(series.Value) == 0 {
+		for name, values := range entitySeries(em, countersByKey) {
+			if len(values) == 0 {
 				warnings = append(warnings, fmt.Sprintf("metric %q returned no samples", name))
 				continue
 			}
-			metrics[name] = computeStats(series.Value)
+			metrics[name] = computeStats(values)
 		}
 		if len(metrics) == 0 && len(warnings) == 0 {
 			warnings = append(warnings, "query succeeded but returned no samples")
@@ -271,6 +378,89 @@ func parseBatchResults(raw []types.BasePerfEntityMetricBase, countersByKey map[i
 	return results
 }
 
+func parseBatchSamples(raw []types.BasePerfEntityMetricBase, countersByKey map[int32]*types.PerfCounterInfo, vcenter string) []models.RightsizingSample {
+	var samples []models.RightsizingSample
+	for _, base := range raw {
+		em, ok := base.(*types.PerfEntityMetric)
+		if !ok {
+			continue
+		}
+		for name, values := range entitySeries(em, countersByKey) {
+			for i, value := range values {
+				if i >= len(em.SampleInfo) || value < 0 {
+					continue
+				}
+				samples = append(samples, models.RightsizingSample{
+					VCenter:   vcenter,
+					MOID:      em.Entity.Value,
+					MetricKey: name,
+					SampledAt: em.SampleInfo[i].Timestamp.UTC(),
+					Interval:  time.Duration(em.SampleInfo[i].Interval) * time.Second,
+					Value:     float64(value),
+				})
+			}
+		}
+	}
+	return samples
+}
+
+// entitySeries returns the values of each metric of an entity, keyed by counter name. The
+// series of the instances of a per-disk metric are combined, and the derived IOPS series is
+// added when both the read and write IOPS are reported. Values vCenter marks as missing (-1)
+// stay missing unless another instance has a value.
+func entitySeries(em *types.PerfEntityMetric, countersByKey map[int32]*types.PerfCounterInfo) map[string][]int64 {
+	series := make(map[string][]int64)
+	for _, v := range em.Value {
+		s, ok := v.(*types.PerfMetricIntSeries)
+		if !ok {
+			continue
+		}
+		info, ok := countersByKey[s.Id.CounterId]
+		if !ok {
+			continue
+		}
+		name := info.Name()
+		values, exists := series[name]
+		if !exists {
+			series[name] = slices.Clone(s.Value)
+			continue
+		}
+		combine, ok := instanceMetrics[name]
+		if !ok {
+			continue
+		}
+		series[name] = combineSeries(values, s.Value, combine)
+	}
+
+	read, hasRead := series["virtualDisk.numberReadAveraged.average"]
+	write, hasWrite := series["virtualDisk.numberWriteAveraged.average"]
+	if hasRead && hasWrite {
+		series[rightsizingIOPSMetric] = combineSeries(slices.Clone(read), write, addSamples)
+	}
+	return series
+}
+
+// combineSeries combines b into a sample by sample, keeping the sample of one series where
+// the other is missing.
+func combineSeries(a, b []int64, combine func(a, b int64) int64) []int64 {
+	for i := range min(len(a), len(b)) {
+		switch {
+		case a[i] < 0:
+			a[i] = b[i]
+		case b[i] >= 0:
+			a[i] = combine(a[i], b[i])
+		}
+	}
+	if len(b) > len(a) {
+		a = append(a, b[len(a):]...)
+	}
+	return a
+}
+
+func addSamples(a, b int64) int64 { return a + b }
+
+func maxSamples(a, b int64) int64 { return max(a, b) }
+
 func computeStats(values []int64) MetricStats {
 	if len(values) == 0 {
 		return MetricStats{}
@@ -0,0 +1,439 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/vmware/govmomi/vim25/types"
+	"go.uber.org/zap"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+	"github.com/kubev2v/assisted-migration-agent/pkg/vmware"
+)
+
+const (
+	// accumulationCheckInterval is how often the accumulation checks whether a run is due.
+	accumulationCheckInterval = time.Minute
+	// accumulationSampleInterval is the vSphere interval samples are pulled at: the 5-minute
+	// samples of the past day.
+	accumulationSampleInterval = 300 * time.Second
+	// accumulationSampleAvailability is how long vCenter keeps 5-minute samples. Runs never
+	// reach further back, and raw samples are kept at least that long.
+	accumulationSampleAvailability = 24 * time.Hour
+	// minAccumulationInterval guards against runs pulling less than a single sample per VM.
+	minAccumulationInterval = 5 * time.Minute
+)
+
+// rightsizingSampler pulls the samples of VMs taken in (start, end] from vCenter.
+// Swappable via WithSampler for tests.
+type rightsizingSampler func(ctx context.Context, creds models.Credentials, vms []VMInfo, start, end time.Time) ([]models.RightsizingSample, error)
+
+// RightsizingAccumulationService accumulates short-interval rightsizing samples across runs.
+//
+// A collection queries the vSphere rollup of its whole lookback, which gets coarser the further
+// back it reaches. When the accumulation is enabled, a background loop instead pulls the
+// 5-minute samples of the VMs of the latest collection of the default credential profile's
+// vCenter every configured interval, from the newest sample already stored or the past day,
+// and appends them to a rolling store in the main database. Samples of overlapping runs are
+// stored once.
+//
+// After each run, raw samples older than the raw retention are downsampled into buckets of the
+// downsample interval, keeping their average or maximum, and samples older than the retention
+// are dropped. Rightsizing reports are then computed from weeks of accumulated samples: by
+// BuildReport on demand, and by every collection of a vCenter with accumulated samples.
+type RightsizingAccumulationService struct {
+	pool    *store.Pool
+	creds   credentialsResolver
+	sampler rightsizingSampler
+	mu      sync.Mutex // serializes runs and protects close
+	close   chan any
+}
+
+func NewRightsizingAccumulationService(pool *store.Pool, creds credentialsResolver) *RightsizingAccumulationService {
+	return &RightsizingAccumulationService{
+		pool:    pool,
+		creds:   creds,
+		sampler: vCenterSampler,
+	}
+}
+
+// WithSampler replaces the vCenter sampler. Used in tests to inject fake samples.
+func (s *RightsizingAccumulationService) WithSampler(fn rightsizingSampler) *RightsizingAccumulationService {
+	s.sampler = fn
+	return s
+}
+
+// Start launches the background loop. Calling Start twice is a no-op.
+func (s *RightsizingAccumulationService) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.close != nil {
+		return
+	}
+	s.close = make(chan any)
+	go s.loop(s.close)
+}
+
+// Stop terminates the background loop. It does not stop a run in progress.
+func (s *RightsizingAccumulationService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.close == nil {
+		return
+	}
+	close(s.close)
+	s.close = nil
+}
+
+func (s *RightsizingAccumulationService) loop(closeCh chan any) {
+	ticker := time.NewTicker(accumulationCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-closeCh:
+			return
+		case <-ticker.C:
+			if err := s.RunDue(context.Background(), time.Now()); err != nil {
+				zap.S().Named("rightsizing_accumulation").Errorw("accumulation run failed", "error", err)
+			}
+		}
+	}
+}
+
+// GetStatus returns the configuration, the last run and the extent of the accumulation.
+func (s *RightsizingAccumulationService) GetStatus(ctx context.Context) (*models.RightsizingAccumulationStatus, error) {
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	return st.RightsizingSample().GetAccumulation(ctx)
+}
+
+// UpdateConfig validates and replaces the configuration of the accumulation.
+func (s *RightsizingAccumulationService) UpdateConfig(ctx context.Context, cfg models.RightsizingAccumulation) (*models.RightsizingAccumulationStatus, error) {
+	switch {
+	case cfg.Interval < minAccumulationInterval || cfg.Interval >= accumulationSampleAvailability:
+		return nil, srvErrors.NewValidationError(fmt.Sprintf("interval must be at least %s and less than %s", minAccumulationInterval, accumulationSampleAvailability))
+	case cfg.RawRetention < accumulationSampleAvailability:
+		return nil, srvErrors.NewValidationError(fmt.Sprintf("raw retention must be at least %s", accumulationSampleAvailability))
+	case cfg.DownsampleInterval <= accumulationSampleInterval || cfg.DownsampleInterval%accumulationSampleInterval != 0:
+		return nil, srvErrors.NewValidationError(fmt.Sprintf("downsample interval must be a multiple of %s greater than it", accumulationSampleInterval))
+	case !cfg.Downsample.IsValid():
+		return nil, srvErrors.NewValidationError(fmt.Sprintf("invalid downsample function %q: must be one of avg, max", cfg.Downsample))
+	case cfg.Retention < cfg.RawRetention:
+		return nil, srvErrors.NewValidationError("retention must not be shorter than the raw retention")
+	}
+
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	if err := st.RightsizingSample().UpdateAccumulation(ctx, cfg); err != nil {
+		return nil, err
+	}
+	return st.RightsizingSample().GetAccumulation(ctx)
+}
+
+// RunDue runs the accumulation if it is enabled and its interval elapsed since the last run.
+// It is called by the background loop and is exported for tests.
+func (s *RightsizingAccumulationService) RunDue(ctx context.Context, now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, err := s.mainStore()
+	if err != nil {
+		return err
+	}
+	status, err := st.RightsizingSample().GetAccumulation(ctx)
+	if err != nil {
+		return err
+	}
+	if !status.Enabled || (status.LastRunAt != nil && status.LastRunAt.Add(status.Interval).After(now)) {
+		return nil
+	}
+	return s.run(ctx, st, status.RightsizingAccumulation, now)
+}
+
+// Run pulls the samples vCenter took since the newest accumulated one, appends them to the
+// rolling store and applies the retention, whether or not the accumulation is enabled.
+func (s *RightsizingAccumulationService) Run(ctx context.Context, now time.Time) (*models.RightsizingAccumulationStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	status, err := st.RightsizingSample().GetAccumulation(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.run(ctx, st, status.RightsizingAccumulation, now); err != nil {
+		return nil, err
+	}
+	return st.RightsizingSample().GetAccumulation(ctx)
+}
+
+// run performs one accumulation run and records its outcome.
+func (s *RightsizingAccumulationService) run(ctx context.Context, st *store.Store2, cfg models.RightsizingAccumulation, now time.Time) error {
+	appended, runErr := s.accumulate(ctx, st, cfg, now)
+
+	msg := ""
+	if runErr != nil {
+		msg = runErr.Error()
+	}
+	zap.S().Named("rightsizing_accumulation").Infow("accumulation run", "appended", appended, "error", msg)
+
+	if err := st.RightsizingSample().RecordRun(ctx, now, appended, msg); err != nil {
+		return errors.Join(runErr, err)
+	}
+	return runErr
+}
+
+func (s *RightsizingAccumulationService) accumulate(ctx context.Context, st *store.Store2, cfg models.RightsizingAccumulation, now time.Time) (int, error) {
+	creds, err := s.creds.ResolveProfile(ctx, models.DefaultCredentialProfile)
+	if err != nil {
+		return 0, err
+	}
+	latest, err := s.vcenterStore(models.DefaultCredentialProfile)
+	if err != nil {
+		return 0, err
+	}
+	vms, err := inventoryVMInfos(ctx, latest)
+	if err != nil {
+		return 0, err
+	}
+
+	start := now.Add(-accumulationSampleAvailability)
+	newest, err := st.RightsizingSample().NewestSampleAt(ctx, creds.URL)
+	if err != nil {
+		return 0, err
+	}
+	if newest != nil && newest.After(start) {
+		start = *newest
+	}
+
+	samples, err := s.sampler(ctx, creds, vms, start, now)
+	if err != nil {
+		return 0, fmt.Errorf("pulling samples: %w", err)
+	}
+
+	appended := 0
+	err = st.WithTx(ctx, func(txCtx context.Context) error {
+		n, err := st.RightsizingSample().Append(txCtx, samples)
+		if err != nil {
+			return err
+		}
+		appended = n
+
+		bucket := cfg.DownsampleInterval.Microseconds()
+		before := time.UnixMicro(now.Add(-cfg.RawRetention).UnixMicro() / bucket * bucket)
+		if _, err := st.RightsizingSample().Downsample(txCtx, before, cfg.DownsampleInterval, cfg.Downsample); err != nil {
+			return err
+		}
+		_, err = st.RightsizingSample().Prune(txCtx, now.Add(-cfg.Retention))
+		return err
+	})
+	return appended, err
+}
+
+// BuildReport computes a rightsizing report of the VMs of the latest collection from the
+// samples of its vCenter accumulated over the lookback.
+func (s *RightsizingAccumulationService) BuildReport(ctx context.Context, lookback time.Duration, now time.Time) (*models.RightsizingReportSummary, error) {
+	if lookback < accumulationSampleInterval {
+		return nil, srvErrors.NewValidationError(fmt.Sprintf("lookback must be at least %s", accumulationSampleInterval))
+	}
+	latest, err := s.latestStore()
+	if err != nil {
+		return nil, err
+	}
+	src, err := latest.CollectionSource().Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report, ok, err := s.accumulatedReport(ctx, latest, src.URL, lookback, now)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, srvErrors.NewResourceNotFoundError("accumulated rightsizing samples", src.URL)
+	}
+	return report, nil
+}
+
+// accumulatedReport computes a rightsizing report in the collection of st from the samples of
+// a vCenter accumulated over the lookback. It reports false, and creates nothing, when no VM of
+// the collection has accumulated samples.
+func (s *RightsizingAccumulationService) accumulatedReport(ctx context.Context, st *store.Store2, vcenter string, lookback time.Duration, now time.Time) (*models.RightsizingReportSummary, bool, error) {
+	mainSt, err := s.mainStore()
+	if err != nil {
+		return nil, false, err
+	}
+	cfg, err := mainSt.RightsizingSample().GetAccumulation(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	windowEnd := now.UTC()
+	windowStart := windowEnd.Add(-lookback)
+	stats, err := mainSt.RightsizingSample().Stats(ctx, vcenter, windowStart, windowEnd)
+	if err != nil {
+		return nil, false, err
+	}
+
+	vms, err := inventoryVMInfos(ctx, st)
+	if err != nil {
+		return nil, false, err
+	}
+	results := make(map[string]VMReport, len(vms))
+	for _, vm := range vms {
+		results[vm.Ref.Value] = VMReport{
+			Name:     vm.Name,
+			MOID:     vm.Ref.Value,
+			Metrics:  map[string]MetricStats{},
+			Warnings: []string{"no accumulated samples for this VM"},
+		}
+	}
+	found := false
+	for _, m := range stats {
+		r, ok := results[m.MOID]
+		if !ok {
+			continue
+		}
+		r.Metrics[m.MetricKey] = MetricStats{
+			SampleCount: m.SampleCount,
+			Average:     m.Average,
+			P95:         m.P95,
+			P99:         m.P99,
+			Max:         m.Max,
+			Latest:      m.Latest,
+		}
+		found = true
+	}
+	if !found {
+		return nil, false, nil
+	}
+
+	report := models.RightSizingReport{
+		VCenter:             vcenter,
+		IntervalID:          int(accumulationSampleInterval / time.Second),
+		WindowStart:         windowStart,
+		WindowEnd:           windowEnd,
+		ExpectedSampleCount: expectedAccumulatedSamples(cfg.RightsizingAccumulation, lookback),
+	}
+	id, createdAt, err := st.RightSizing().CreateReport(ctx, report, len(vms), rightsizingDefaultBatchSize)
+	if err != nil {
+		return nil, false, fmt.Errorf("creating rightsizing report shell: %w", err)
+	}
+
+	rsSvc := NewRightsizingService(st)
+	if err := rsSvc.PersistMetrics(ctx, vms, results, id); err != nil {
+		return nil, false, err
+	}
+	if err := rsSvc.PersistVMWarnings(ctx, vms, results, id); err != nil {
+		return nil, false, err
+	}
+	// the series are copied a batch at a time rather than held with the statistics
+	for i := 0; i < len(vms); i += rightsizingDefaultBatchSize {
+		batch := vms[i:min(i+rightsizingDefaultBatchSize, len(vms))]
+		moids := make([]string, len(batch))
+		for j, vm := range batch {
+			moids[j] = vm.Ref.Value
+		}
+		samples, err := mainSt.RightsizingSample().List(ctx, vcenter, moids, windowStart, windowEnd)
+		if err != nil {
+			return nil, false, err
+		}
+		if err := st.RightSizing().WriteSamples(ctx, id, samples); err != nil {
+			return nil, false, fmt.Errorf("persisting accumulated samples of VMs %d-%d: %w", i+1, i+len(batch), err)
+		}
+	}
+	if err := rsSvc.ComputeUtilization(ctx, id); err != nil {
+		return nil, false, err
+	}
+
+	return &models.RightsizingReportSummary{
+		ID:                  id,
+		VCenter:             vcenter,
+		WindowStart:         windowStart,
+		WindowEnd:           windowEnd,
+		IntervalID:          report.IntervalID,
+		ExpectedSampleCount: report.ExpectedSampleCount,
+		CreatedAt:           createdAt,
+	}, true, nil
+}
+
+func (s *RightsizingAccumulationService) latestStore() (*store.Store2, error) {
+	db, err := s.pool.Latest()
+	if err != nil {
+		return nil, err
+	}
+	return db.Store()
+}
+
+// vcenterStore returns the latest collection of a vCenter, so that the VMs sampled match the
+// vCenter the credentials of its profile connect to.
+func (s *RightsizingAccumulationService) vcenterStore(vcenter string) (*store.Store2, error) {
+	db, err := s.pool.LatestFor(vcenter)
+	if err != nil {
+		return nil, err
+	}
+	return db.Store()
+}
+
+func (s *RightsizingAccumulationService) mainStore() (*store.Store2, error) {
+	db, err := s.pool.Get(store.MainDatabaseID)
+	if err != nil {
+		return nil, err
+	}
+	return db.Store()
+}
+
+// expectedAccumulatedSamples returns the samples a VM metric has over the lookback once fully
+// accumulated: raw samples over the raw retention, downsampled ones beyond, none past the
+// retention.
+func expectedAccumulatedSamples(cfg models.RightsizingAccumulation, lookback time.Duration) int {
+	lookback = min(lookback, cfg.Retention)
+	raw := min(lookback, cfg.RawRetention)
+	expected := int(raw / accumulationSampleInterval)
+	if cfg.DownsampleInterval > 0 {
+		expected += int((lookback - raw) / cfg.DownsampleInterval)
+	}
+	return expected
+}
+
+// inventoryVMInfos returns the VMs of the inventory of a collection.
+func inventoryVMInfos(ctx context.Context, st *store.Store2) ([]VMInfo, error) {
+	inventoryVMs, err := st.RightSizing().ListInventoryVMs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reading VMs from inventory: %w", err)
+	}
+	vms := make([]VMInfo, 0, len(inventoryVMs))
+	for _, vm := range inventoryVMs {
+		vms = append(vms, VMInfo{
+			Name: vm.Name,
+			Ref:  types.ManagedObjectReference{Type: "VirtualMachine", Value: vm.ID},
+		})
+	}
+	return vms, nil
+}
+
+// vCenterSampler pulls 5-minute samples from the vCenter of the credentials.
+func vCenterSampler(ctx context.Context, creds models.Credentials, vms []VMInfo, start, end time.Time) ([]models.RightsizingSample, error) {
+	client, err := vmware.Connect(ctx, &creds)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = client.Logout(context.Background()) }()
+
+	return QuerySamples(ctx, client, creds.URL, vms, int(accumulationSampleInterval/time.Second), start, end)
+}
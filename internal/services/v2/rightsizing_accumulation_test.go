@@ -0,0 +1,235 @@
+package v2_test
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	v2 "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+var _ = Describe("RightsizingAccumulationService", func() {
+	const vcenterURL = "https://vcenter.local/sdk"
+
+	var (
+		ctx    context.Context
+		pool   *store.Pool
+		tmpDir string
+		st     *store.Store2
+		srv    *v2.RightsizingAccumulationService
+		starts []time.Time
+		moids  []string
+	)
+
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+
+	// sampler returns a 5-minute sample of the CPU and memory of vm-1 at every step of
+	// [start, end], start included so that consecutive runs overlap. vm-2 has no samples.
+	sampler := func(_ context.Context, creds models.Credentials, vms []v2.VMInfo, start, end time.Time) ([]models.RightsizingSample, error) {
+		starts = append(starts, start)
+		for _, vm := range vms {
+			moids = append(moids, vm.Ref.Value)
+		}
+		var samples []models.RightsizingSample
+		for t := start; !t.After(end); t = t.Add(5 * time.Minute) {
+			for _, vm := range vms {
+				if vm.Ref.Value != "vm-1" {
+					continue
+				}
+				for _, key := range []string{"cpu.usage.average", "mem.consumed.average"} {
+					samples = append(samples, models.RightsizingSample{
+						VCenter:   creds.URL,
+						MOID:      vm.Ref.Value,
+						MetricKey: key,
+						SampledAt: t,
+						Interval:  5 * time.Minute,
+						Value:     float64(1000 + t.Minute()),
+					})
+				}
+			}
+		}
+		return samples, nil
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		starts, moids = nil, nil
+		var err error
+		tmpDir, err = os.MkdirTemp("", "accumulation-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		pool = newTestPool(tmpDir)
+
+		var db *store.Database
+		db, st = addTestCollection(pool, "col-1000", time.Unix(1000, 0))
+		_, err = st.Querier().ExecContext(ctx, `INSERT INTO vinfo ("VM ID", "VM", "Cluster", "Memory", "CPUs")
+			VALUES ('vm-1', 'web-1', 'prod', 8192, 4), ('vm-2', 'db-1', 'prod', 4096, 2)`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(st.CollectionSource().Save(ctx, models.CollectionSource{VCenter: "default", URL: vcenterURL})).To(Succeed())
+		db.VCenter = models.DefaultCredentialProfile
+
+		srv = v2.NewRightsizingAccumulationService(pool, &fakeCredentialsResolver{}).WithSampler(sampler)
+	})
+
+	AfterEach(func() {
+		pool.Close()
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	It("is disabled by default and rejects invalid configurations", func() {
+		status, err := srv.GetStatus(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.Enabled).To(BeFalse())
+		Expect(status.Interval).To(Equal(time.Hour))
+		Expect(status.Downsample).To(Equal(models.DownsampleMax))
+		Expect(status.LastRunAt).To(BeNil())
+
+		Expect(srv.RunDue(ctx, now)).To(Succeed())
+		Expect(starts).To(BeEmpty())
+
+		valid := status.RightsizingAccumulation
+		for _, invalid := range []func(a *models.RightsizingAccumulation){
+			func(a *models.RightsizingAccumulation) { a.Interval = time.Minute },
+			func(a *models.RightsizingAccumulation) { a.Interval = 24 * time.Hour },
+			func(a *models.RightsizingAccumulation) { a.RawRetention = time.Hour },
+			func(a *models.RightsizingAccumulation) { a.DownsampleInterval = 7 * time.Minute },
+			func(a *models.RightsizingAccumulation) { a.Downsample = "median" },
+			func(a *models.RightsizingAccumulation) { a.Retention = a.RawRetention - time.Hour },
+		} {
+			cfg := valid
+			invalid(&cfg)
+			_, err := srv.UpdateConfig(ctx, cfg)
+			Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+		}
+
+		valid.Enabled = true
+		status, err = srv.UpdateConfig(ctx, valid)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.Enabled).To(BeTrue())
+	})
+
+	It("appends the samples of overlapping runs once", func() {
+		cfg := models.RightsizingAccumulation{
+			Enabled: true, Interval: time.Hour, RawRetention: 7 * 24 * time.Hour,
+			DownsampleInterval: time.Hour, Downsample: models.DownsampleMax, Retention: 30 * 24 * time.Hour,
+		}
+		_, err := srv.UpdateConfig(ctx, cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(srv.RunDue(ctx, now)).To(Succeed())
+		status, err := srv.GetStatus(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.LastRunAt).NotTo(BeNil())
+		Expect(status.LastSampleCount).To(Equal(2 * 289))
+		Expect(status.LastError).To(BeEmpty())
+		Expect(status.OldestSampleAt.Equal(now.Add(-24 * time.Hour))).To(BeTrue())
+		Expect(status.NewestSampleAt.Equal(now)).To(BeTrue())
+
+		// not due yet
+		Expect(srv.RunDue(ctx, now.Add(30*time.Minute))).To(Succeed())
+		Expect(starts).To(HaveLen(1))
+
+		// the second run starts at the newest sample, which it returns again
+		Expect(srv.RunDue(ctx, now.Add(time.Hour))).To(Succeed())
+		Expect(starts).To(HaveLen(2))
+		Expect(starts[1].Equal(now)).To(BeTrue())
+		status, err = srv.GetStatus(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.LastSampleCount).To(Equal(2 * 12))
+		Expect(status.SampleCount).To(Equal(int64(2 * (289 + 12))))
+	})
+
+	It("samples the VMs of the collection of the credentials' vCenter", func() {
+		// a newer collection of another vCenter must not be sampled with the default credentials
+		other, otherSt := addTestCollection(pool, "col-2000", time.Unix(2000, 0))
+		insertSyncTestVM(ctx, otherSt, "vm-9", "lab-1")
+		other.VCenter = "lab"
+
+		_, err := srv.Run(ctx, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(moids).To(ConsistOf("vm-1", "vm-2"))
+	})
+
+	It("downsamples raw samples and drops those past the retention", func() {
+		cfg := models.RightsizingAccumulation{
+			Interval: time.Hour, RawRetention: 24 * time.Hour,
+			DownsampleInterval: time.Hour, Downsample: models.DownsampleMax, Retention: 48 * time.Hour,
+		}
+		_, err := srv.UpdateConfig(ctx, cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = srv.Run(ctx, now)
+		Expect(err).NotTo(HaveOccurred())
+
+		// The raw samples up to now become 25 hourly buckets, the oldest of which is dropped;
+		// the second run adds a day of raw samples.
+		later := now.Add(25 * time.Hour)
+		status, err := srv.Run(ctx, later)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(starts[1].Equal(later.Add(-24 * time.Hour))).To(BeTrue())
+		Expect(status.SampleCount).To(Equal(int64(2 * (24 + 289))))
+		Expect(status.OldestSampleAt.Equal(now.Add(-23 * time.Hour))).To(BeTrue())
+
+		mainDB, err := pool.Get(store.MainDatabaseID)
+		Expect(err).NotTo(HaveOccurred())
+		mainSt, err := mainDB.Store()
+		Expect(err).NotTo(HaveOccurred())
+		stats, err := mainSt.RightsizingSample().Stats(ctx, vcenterURL, now.Add(-24*time.Hour), now.Add(time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stats).To(HaveLen(2))
+		Expect(stats[0].MetricKey).To(Equal("cpu.usage.average"))
+		Expect(stats[0].SampleCount).To(Equal(24))
+		// every full bucket keeps its highest sample; the newest only holds the sample at now
+		Expect(stats[0].Average).To(BeNumerically("~", (23*1055.0+1000)/24, 1e-9))
+	})
+
+	It("computes a rightsizing report from the accumulated samples", func() {
+		_, err := srv.BuildReport(ctx, 24*time.Hour, now)
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+
+		_, err = srv.Run(ctx, now)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = srv.BuildReport(ctx, time.Minute, now)
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+
+		summary, err := srv.BuildReport(ctx, 24*time.Hour, now.Add(time.Second))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(summary.VCenter).To(Equal(vcenterURL))
+		Expect(summary.IntervalID).To(Equal(300))
+		Expect(summary.ExpectedSampleCount).To(Equal(288))
+
+		report, err := st.RightSizing().GetReport(ctx, summary.ID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.VMs).To(HaveLen(2))
+		for _, vm := range report.VMs {
+			switch vm.MOID {
+			case "vm-1":
+				cpu := vm.Metrics["cpu.usage.average"]
+				Expect(cpu.SampleCount).To(Equal(288))
+				Expect(cpu.Average).To(Equal(1027.5))
+				Expect(cpu.Max).To(Equal(1055.0))
+			case "vm-2":
+				Expect(vm.Warnings).To(ConsistOf("no accumulated samples for this VM"))
+			}
+		}
+
+		util, err := st.RightSizing().GetVMUtilization(ctx, "vm-1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(util).NotTo(BeNil())
+
+		series, err := st.RightSizing().GetVMSeries(ctx, summary.ID, "vm-1", time.Hour, []string{"cpu.usage.average"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(series).To(HaveLen(1))
+		// the window starts a second past the hour, so the first and last buckets are partial
+		Expect(series[0].Points).To(HaveLen(25))
+		Expect(series[0].Points[0].SampleCount).To(Equal(11))
+		Expect(series[0].Points[1].SampleCount).To(Equal(12))
+	})
+})
@@ -190,6 +190,60 @@ var _ = Describe("RightsizingService", func() {
 		})
 	})
 
+	Describe("GetVMSeries", func() {
+		It("should return the persisted samples of a VM aggregated into buckets", func() {
+			vms := []v2.VMInfo{
+				{Name: "vm-a", Ref: types.ManagedObjectReference{Type: "VirtualMachine", Value: "vm-100"}},
+			}
+			start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+			var samples []models.RightsizingSample
+			for i := range 4 {
+				samples = append(samples, models.RightsizingSample{
+					MOID: "vm-100", MetricKey: "cpu.usagemhz.average",
+					SampledAt: start.Add(time.Duration(i) * 2 * time.Hour), Interval: 2 * time.Hour, Value: float64(i),
+				})
+			}
+			vmResults := map[string]v2.VMReport{
+				"vm-100": {
+					Name: "vm-a", MOID: "vm-100",
+					Metrics: map[string]v2.MetricStats{
+						"cpu.usagemhz.average": {SampleCount: 4, Average: 1.5, Max: 3, Latest: 3},
+					},
+					Samples: samples,
+				},
+			}
+			report := models.RightSizingReport{
+				VCenter:             "https://vcenter.example.com",
+				IntervalID:          7200,
+				WindowStart:         start,
+				WindowEnd:           start.Add(8 * time.Hour),
+				ExpectedSampleCount: 4,
+			}
+			reportID, _, err := st.RightSizing().CreateReport(ctx, report, 1, 25)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(svc.PersistMetrics(ctx, vms, vmResults, reportID)).To(Succeed())
+
+			series, err := svc.GetVMSeries(ctx, "vm-100", "", 0, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(series.ReportID).To(Equal(reportID))
+			Expect(series.Bucket).To(Equal(2 * time.Hour))
+			Expect(series.Metrics).To(HaveLen(1))
+			Expect(series.Metrics[0].Points).To(HaveLen(4))
+
+			series, err = svc.GetVMSeries(ctx, "vm-100", reportID, 4*time.Hour, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(series.Metrics[0].Points).To(HaveLen(2))
+			Expect(series.Metrics[0].Points[1].Max).To(Equal(3.0))
+
+			_, err = svc.GetVMSeries(ctx, "vm-100", "", 3*time.Hour, nil)
+			Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+			_, err = svc.GetVMSeries(ctx, "vm-200", "", 0, nil)
+			Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+			_, err = svc.GetVMSeries(ctx, "vm-100", "unknown", 0, nil)
+			Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+		})
+	})
+
 	Describe("PersistVMWarnings", func() {
 		It("should persist warnings for VMs with no metrics", func() {
 			vms := []v2.VMInfo{
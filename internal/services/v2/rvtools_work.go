@@ -80,6 +80,9 @@ func (f *rvtoolsWorkFactory) Build() work.WorkBuilder2[models.CollectorStatus, m
 					r.Err = fmt.Errorf("opening collection database %s: %w", database, dbError)
 					return r, r.Err
 				}
+				// RVTools collections are not tied to a credential profile; the collection
+				// migrations tag them with the default one.
+				collectionDb.VCenter = models.DefaultCredentialProfile
 
 				if err := collectionDb.Migrate(ctx, func(ctx context.Context, sqlDb *sql.DB) error {
 					st, err := collectionDb.Store()
@@ -152,7 +155,29 @@ func (f *rvtoolsWorkFactory) Build() work.WorkBuilder2[models.CollectorStatus, m
 		})
 	}
 
-	// 3. Inventory: build the inventory JSON and persist.
+	// 3. Label rules: label the imported VMs, before the inventory embeds their labels.
+	units = append(units, work.WorkUnit[models.CollectorStatus, models.CollectorResult]{
+		Status: func() models.CollectorStatus {
+			return models.CollectorStatus{State: models.CollectorStateCollecting}
+		},
+		Work: func(ctx context.Context, r models.CollectorResult) (models.CollectorResult, error) {
+			st, err := collectionDb.Store()
+			if err != nil {
+				r.Err = fmt.Errorf("getting collection store: %w", err)
+				return r, r.Err
+			}
+
+			res, err := NewLabelRuleService(f.pool, NewSavedFilterService(f.pool)).Apply(ctx, st)
+			if err != nil {
+				r.Err = fmt.Errorf("applying label rules: %w", err)
+				return r, r.Err
+			}
+			log.Infow("label rules applied", "added", res.Added, "removed", res.Removed, "skipped", res.Skipped)
+			return r, nil
+		},
+	})
+
+	// 4. Inventory: build the inventory JSON and persist.
 	units = append(units, work.WorkUnit[models.CollectorStatus, models.CollectorResult]{
 		Status: func() models.CollectorStatus {
 			return models.CollectorStatus{State: models.CollectorStateParsing}
@@ -188,7 +213,7 @@ func (f *rvtoolsWorkFactory) Build() work.WorkBuilder2[models.CollectorStatus, m
 		},
 	})
 
-	// 4. Publish: write an inventory-update event to the outbox.
+	// 5. Publish: write an inventory-update event to the outbox.
 	units = append(units, work.WorkUnit[models.CollectorStatus, models.CollectorResult]{
 		Status: func() models.CollectorStatus {
 			return models.CollectorStatus{State: models.CollectorStateCollected}
@@ -0,0 +1,320 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/kubev2v/migration-planner/pkg/duckdb_parser"
+
+	vmfilter "github.com/kubev2v/assisted-migration-agent/internal/filter"
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+	"github.com/kubev2v/assisted-migration-agent/pkg/filter"
+)
+
+// savedFilterNameRe is the syntax of saved filter names, as accepted after '@' by the DSL.
+var savedFilterNameRe = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// SavedFilterService manages named VM filter expressions.
+//
+// Saved filters live in the main database. Any filter expression (groups, VM lists, exports,
+// comparisons) can reference one as @name; Expand inlines the references before the
+// expression is parsed. Saved filters can reference each other, cycles are rejected.
+//
+// Groups keep their filter as written, so editing a saved filter changes the VMs of every
+// group built on it: Update re-evaluates those groups in every collection of the agent.
+type SavedFilterService struct {
+	pool *store.Pool
+}
+
+func NewSavedFilterService(pool *store.Pool) *SavedFilterService {
+	return &SavedFilterService{pool: pool}
+}
+
+// List returns all saved filters ordered by name.
+func (s *SavedFilterService) List(ctx context.Context) ([]models.SavedFilter, error) {
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	return st.SavedFilter().List(ctx)
+}
+
+// Get returns a saved filter by name.
+func (s *SavedFilterService) Get(ctx context.Context, name string) (*models.SavedFilter, error) {
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	return st.SavedFilter().Get(ctx, name)
+}
+
+// Create validates and stores a new saved filter.
+func (s *SavedFilterService) Create(ctx context.Context, f models.SavedFilter) (*models.SavedFilter, error) {
+	if !savedFilterNameRe.MatchString(f.Name) {
+		return nil, srvErrors.NewValidationError(fmt.Sprintf("invalid saved filter name %q: use letters, digits, '_' and '-'", f.Name))
+	}
+	if err := s.validate(ctx, f.Name, f.Expression); err != nil {
+		return nil, err
+	}
+
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	return st.SavedFilter().Create(ctx, f)
+}
+
+// Update applies a partial update to a saved filter. When the expression changes, the
+// groups using the saved filter, directly or through other saved filters, are refreshed.
+func (s *SavedFilterService) Update(ctx context.Context, name string, update models.SavedFilterUpdate) (*models.SavedFilter, error) {
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := st.SavedFilter().Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	expressionChanged := update.Expression != nil && *update.Expression != f.Expression
+	if update.Description != nil {
+		f.Description = *update.Description
+	}
+	if update.Owner != nil {
+		f.Owner = *update.Owner
+	}
+	if update.Expression != nil {
+		f.Expression = *update.Expression
+	}
+	if expressionChanged {
+		if err := s.validate(ctx, name, f.Expression); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := st.SavedFilter().Update(ctx, *f)
+	if err != nil {
+		return nil, err
+	}
+
+	if expressionChanged {
+		if err := s.refreshGroups(ctx, name); err != nil {
+			return nil, fmt.Errorf("refreshing groups using saved filter %s: %w", name, err)
+		}
+	}
+	return updated, nil
+}
+
+// Delete removes a saved filter. A saved filter still referenced by another saved filter, a
+// label rule or a group cannot be deleted.
+func (s *SavedFilterService) Delete(ctx context.Context, name string) error {
+	st, err := s.mainStore()
+	if err != nil {
+		return err
+	}
+
+	filters, err := st.SavedFilter().List(ctx)
+	if err != nil {
+		return err
+	}
+	for _, f := range filters {
+		if slices.Contains(filter.References([]byte(f.Expression)), name) {
+			return srvErrors.NewValidationError(fmt.Sprintf("saved filter %s is referenced by saved filter %s", name, f.Name))
+		}
+	}
+
+	rules, err := st.LabelRule().List(ctx)
+	if err != nil {
+		return err
+	}
+	for _, r := range rules {
+		if slices.Contains(filter.References([]byte(r.Expression)), name) {
+			return srvErrors.NewValidationError(fmt.Sprintf("saved filter %s is used by label rule %s", name, r.Name))
+		}
+	}
+
+	for db := range s.collections() {
+		cst, err := db.Store()
+		if err != nil {
+			return err
+		}
+		groups, err := cst.Group().List(ctx, nil, 0, 0)
+		if err != nil {
+			return err
+		}
+		for _, g := range groups {
+			if slices.Contains(filter.References([]byte(g.Filter)), name) {
+				return srvErrors.NewValidationError(fmt.Sprintf("saved filter %s is used by group %s of collection %s", name, g.Name, db.ID))
+			}
+		}
+	}
+
+	return st.SavedFilter().Delete(ctx, name)
+}
+
+// Expand inlines the saved filters referenced by expr. Expressions without references are
+// returned as is. References that cannot be expanded are validation errors.
+func (s *SavedFilterService) Expand(ctx context.Context, expr string) (string, error) {
+	exp, err := s.Expansion(ctx, expr)
+	if err != nil {
+		return "", asValidationError(err)
+	}
+	return string(exp.Source), nil
+}
+
+// Expansion is like Expand but returns the filter.Expansion, to map positions back to expr.
+// References that cannot be expanded are reported as a *filter.ReferenceError.
+func (s *SavedFilterService) Expansion(ctx context.Context, expr string) (*filter.Expansion, error) {
+	return s.expansion(ctx, expr, nil)
+}
+
+// expansion expands expr, resolving the saved filters of pending before the stored ones.
+func (s *SavedFilterService) expansion(ctx context.Context, expr string, pending map[string]string) (*filter.Expansion, error) {
+	src := []byte(expr)
+	if len(filter.References(src)) == 0 {
+		return filter.Expand(src, nil)
+	}
+
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+
+	var lookupErr error
+	exp, err := filter.Expand(src, func(name string) (string, error) {
+		if expr, ok := pending[name]; ok {
+			return expr, nil
+		}
+		f, err := st.SavedFilter().Get(ctx, name)
+		if err != nil {
+			if srvErrors.IsResourceNotFoundError(err) {
+				return "", errors.New("unknown saved filter")
+			}
+			lookupErr = err
+			return "", err
+		}
+		return f.Expression, nil
+	})
+	if lookupErr != nil {
+		return nil, lookupErr
+	}
+	return exp, err
+}
+
+// validate checks the expression of the saved filter name, as it would be stored.
+func (s *SavedFilterService) validate(ctx context.Context, name, expression string) error {
+	if strings.TrimSpace(expression) == "" {
+		return srvErrors.NewValidationError("saved filter expression is required")
+	}
+	// Expanding with the new expression in place of the stored one catches the cycles it would introduce.
+	exp, err := s.expansion(ctx, expression, map[string]string{name: expression})
+	if err != nil {
+		return asValidationError(err)
+	}
+	if _, err := vmfilter.ParseWithDefaultMap(exp.Source); err != nil {
+		return srvErrors.NewValidationError(fmt.Sprintf("saved filter expression is invalid: %v", err))
+	}
+	return nil
+}
+
+// refreshGroups refreshes, in every collection, the groups whose filter uses the saved
+// filter name.
+func (s *SavedFilterService) refreshGroups(ctx context.Context, name string) error {
+	st, err := s.mainStore()
+	if err != nil {
+		return err
+	}
+	filters, err := st.SavedFilter().List(ctx)
+	if err != nil {
+		return err
+	}
+	affected := dependentSavedFilters(filters, name)
+
+	for db := range s.collections() {
+		cst, err := db.Store()
+		if err != nil {
+			return err
+		}
+		groups, err := cst.Group().List(ctx, nil, 0, 0)
+		if err != nil {
+			return err
+		}
+
+		var ids []uuid.UUID
+		for _, g := range groups {
+			if slices.ContainsFunc(filter.References([]byte(g.Filter)), func(ref string) bool { return affected[ref] }) {
+				ids = append(ids, g.ID)
+			}
+		}
+		if len(ids) == 0 {
+			continue
+		}
+
+		groupSvc := NewGroupService(cst, duckdb_parser.New(cst.Querier(), nil)).WithSavedFilters(s)
+		for _, id := range ids {
+			if _, err := groupSvc.Refresh(ctx, id); err != nil {
+				return fmt.Errorf("refreshing group %s of collection %s: %w", id, db.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// collections returns the collection databases built by this agent, newest first.
+// Imported collections are snapshots of another agent and are left untouched.
+func (s *SavedFilterService) collections() iter.Seq[*store.Database] {
+	return func(yield func(*store.Database) bool) {
+		for db := range s.pool.All() {
+			if db.ID == store.MainDatabaseID || db.Imported {
+				continue
+			}
+			if !yield(db) {
+				return
+			}
+		}
+	}
+}
+
+func (s *SavedFilterService) mainStore() (*store.Store2, error) {
+	db, err := s.pool.Get(store.MainDatabaseID)
+	if err != nil {
+		return nil, err
+	}
+	return db.Store()
+}
+
+// asValidationError turns a *filter.ReferenceError into a validation error.
+func asValidationError(err error) error {
+	var re *filter.ReferenceError
+	if errors.As(err, &re) {
+		return srvErrors.NewValidationError(re.Error())
+	}
+	return err
+}
+
+// dependentSavedFilters returns name and the saved filters referencing it, directly or
+// through other saved filters.
+func dependentSavedFilters(filters []models.SavedFilter, name string) map[string]bool {
+	dependents := map[string]bool{name: true}
+	for changed := true; changed; {
+		changed = false
+		for _, f := range filters {
+			if dependents[f.Name] {
+				continue
+			}
+			if slices.ContainsFunc(filter.References([]byte(f.Expression)), func(ref string) bool { return dependents[ref] }) {
+				dependents[f.Name] = true
+				changed = true
+			}
+		}
+	}
+	return dependents
+}
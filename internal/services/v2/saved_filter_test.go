@@ -0,0 +1,159 @@
+package v2_test
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	v2 "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+var _ = Describe("SavedFilterService", func() {
+	var (
+		ctx    context.Context
+		pool   *store.Pool
+		tmpDir string
+		srv    *v2.SavedFilterService
+	)
+
+	strPtr := func(s string) *string { return &s }
+
+	// addCollection registers a collection with three VMs on two clusters.
+	addCollection := func() *store.Store2 {
+		_, st := addTestCollection(pool, "col-1000", time.Unix(1000, 0))
+
+		_, err := st.Querier().ExecContext(ctx, `INSERT INTO about ("InstanceUuid", "APIVersion") VALUES ('test-vcenter-id', '8.0.0')`)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		_, err = st.Querier().ExecContext(ctx,
+			`INSERT INTO vinfo ("VM ID", "VM", "Cluster", "Powerstate", "Template", "Memory", "CPUs")
+			 VALUES ('vm-1', 'web-1', 'prod', 'poweredOn', false, 8192, 4),
+			        ('vm-2', 'web-2', 'prod', 'poweredOff', false, 4096, 2),
+			        ('vm-3', 'db-1', 'dev', 'poweredOn', false, 16384, 8)`)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+		return st
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		tmpDir, err = os.MkdirTemp("", "saved-filter-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		pool = newTestPool(tmpDir)
+
+		srv = v2.NewSavedFilterService(pool)
+	})
+
+	AfterEach(func() {
+		pool.Close()
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	Context("Create", func() {
+		It("stores a valid saved filter", func() {
+			created, err := srv.Create(ctx, models.SavedFilter{Name: "prod", Expression: "cluster = 'prod'", Owner: "alice"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created.Name).To(Equal("prod"))
+			Expect(created.Owner).To(Equal("alice"))
+
+			filters, err := srv.List(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+		})
+
+		It("rejects invalid names and expressions", func() {
+			_, err := srv.Create(ctx, models.SavedFilter{Name: "bad name", Expression: "cluster = 'prod'"})
+			Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+
+			_, err = srv.Create(ctx, models.SavedFilter{Name: "prod", Expression: "memroy > 8GB"})
+			Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+		})
+
+		It("rejects unknown references", func() {
+			_, err := srv.Create(ctx, models.SavedFilter{Name: "big-prod", Expression: "@prod and memory > 16GB"})
+			Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("@prod"))
+		})
+
+		It("rejects duplicate names", func() {
+			_, err := srv.Create(ctx, models.SavedFilter{Name: "prod", Expression: "cluster = 'prod'"})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = srv.Create(ctx, models.SavedFilter{Name: "prod", Expression: "cluster = 'dev'"})
+			Expect(srvErrors.IsDuplicateResourceError(err)).To(BeTrue())
+		})
+	})
+
+	Context("Update", func() {
+		It("rejects changes introducing a reference cycle", func() {
+			_, err := srv.Create(ctx, models.SavedFilter{Name: "a", Expression: "cluster = 'prod'"})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = srv.Create(ctx, models.SavedFilter{Name: "b", Expression: "@a and memory > 4GB"})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = srv.Update(ctx, "a", models.SavedFilterUpdate{Expression: strPtr("@b")})
+			Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("cycle"))
+		})
+
+		It("returns not found for an unknown saved filter", func() {
+			_, err := srv.Update(ctx, "missing", models.SavedFilterUpdate{Description: strPtr("x")})
+			Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+		})
+
+		It("refreshes the groups built on the saved filter", func() {
+			st := addCollection()
+			_, err := srv.Create(ctx, models.SavedFilter{Name: "prod", Expression: "cluster = 'prod'"})
+			Expect(err).NotTo(HaveOccurred())
+
+			groupSvc := v2.NewGroupService(st, &mockInventoryBuilder{}).WithSavedFilters(srv)
+			group, err := groupSvc.Create(ctx, models.Group{Name: "big-prod", Filter: "@prod and memory >= 8GB"})
+			Expect(err).NotTo(HaveOccurred())
+			ids, err := st.Group().GetMatchedIDs(ctx, group.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ids).To(ConsistOf("vm-1"))
+
+			_, err = srv.Update(ctx, "prod", models.SavedFilterUpdate{Expression: strPtr("cluster = 'dev'")})
+			Expect(err).NotTo(HaveOccurred())
+
+			ids, err = st.Group().GetMatchedIDs(ctx, group.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ids).To(ConsistOf("vm-3"))
+		})
+	})
+
+	Context("Delete", func() {
+		It("refuses to delete a saved filter still referenced", func() {
+			_, err := srv.Create(ctx, models.SavedFilter{Name: "prod", Expression: "cluster = 'prod'"})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = srv.Create(ctx, models.SavedFilter{Name: "big-prod", Expression: "@prod and memory > 16GB"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(srvErrors.IsValidationError(srv.Delete(ctx, "prod"))).To(BeTrue())
+			Expect(srv.Delete(ctx, "big-prod")).To(Succeed())
+			Expect(srv.Delete(ctx, "prod")).To(Succeed())
+		})
+	})
+
+	Context("Expand", func() {
+		It("inlines references and leaves other expressions as is", func() {
+			_, err := srv.Create(ctx, models.SavedFilter{Name: "prod", Expression: "cluster = 'prod'"})
+			Expect(err).NotTo(HaveOccurred())
+
+			expanded, err := srv.Expand(ctx, "@prod and memory > 16GB")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(expanded).To(Equal("(cluster = 'prod') and memory > 16GB"))
+
+			expanded, err = srv.Expand(ctx, "memory > 16GB")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(expanded).To(Equal("memory > 16GB"))
+		})
+	})
+})
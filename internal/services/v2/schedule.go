@@ -0,0 +1,346 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	"github.com/kubev2v/assisted-migration-agent/pkg/cron"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+const (
+	// scheduleCheckInterval is how often due schedules are evaluated.
+	scheduleCheckInterval = 30 * time.Second
+	// minScheduleInterval guards against interval schedules that would start a new
+	// collection before the previous one could reasonably finish.
+	minScheduleInterval = 5 * time.Minute
+	// defaultScheduleRunsLimit caps the run history returned by ListRuns.
+	defaultScheduleRunsLimit = 100
+)
+
+// collectionStarter starts a vCenter collection. Implemented by ServiceManager.
+type collectionStarter interface {
+	StartVCenterCollecting(ctx context.Context, vcenter string, mode models.CollectionMode) (models.CollectorStatus, error)
+}
+
+// credentialsResolver returns the stored vCenter credentials. Implemented by CredentialsService.
+type credentialsResolver interface {
+	ResolveProfile(ctx context.Context, profile string) (models.Credentials, error)
+}
+
+// ScheduleService manages recurring vCenter collections.
+//
+// Schedules live in the main database. A background loop wakes up every
+// scheduleCheckInterval and triggers every schedule whose next run is due,
+// through the same path as POST /collector. Every trigger attempt is recorded
+// in the schedule's run history, including skipped and failed ones.
+//
+// Catch-up:
+//
+// When more than one occurrence of a schedule elapsed since its planned run
+// (typically because the agent was down), the schedule's catch-up policy decides
+// what happens: "skip" records the missed run as skipped and waits for the next
+// occurrence, "once" starts a single catch-up collection right away. In both
+// cases the next run is computed from the current time, so a long outage never
+// produces a burst of collections.
+type ScheduleService struct {
+	store   *store.Store2
+	creds   credentialsResolver
+	starter collectionStarter
+	mu      sync.Mutex // serializes RunDue and protects close
+	close   chan any
+}
+
+func NewScheduleService(mainStore *store.Store2, creds credentialsResolver, starter collectionStarter) *ScheduleService {
+	return &ScheduleService{
+		store:   mainStore,
+		creds:   creds,
+		starter: starter,
+	}
+}
+
+// Start launches the background loop. Calling Start twice is a no-op.
+func (s *ScheduleService) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.close != nil {
+		return
+	}
+	s.close = make(chan any)
+	go s.run(s.close)
+}
+
+// Stop terminates the background loop. It does not stop collections already started.
+func (s *ScheduleService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.close == nil {
+		return
+	}
+	close(s.close)
+	s.close = nil
+}
+
+func (s *ScheduleService) run(closeCh chan any) {
+	ticker := time.NewTicker(scheduleCheckInterval)
+	defer ticker.Stop()
+
+	// Evaluate immediately so runs missed during downtime are handled at startup.
+	s.tick()
+	for {
+		select {
+		case <-closeCh:
+			return
+		case <-ticker.C:
+			s.tick()
+		}
+	}
+}
+
+func (s *ScheduleService) tick() {
+	if err := s.RunDue(context.Background(), time.Now()); err != nil {
+		zap.S().Named("schedule_service").Errorw("failed to evaluate schedules", "error", err)
+	}
+}
+
+// List returns all schedules.
+func (s *ScheduleService) List(ctx context.Context) ([]models.CollectionSchedule, error) {
+	return s.store.Schedule().List(ctx)
+}
+
+// Get returns a schedule by ID.
+func (s *ScheduleService) Get(ctx context.Context, id string) (*models.CollectionSchedule, error) {
+	return s.store.Schedule().Get(ctx, id)
+}
+
+// Create validates and persists a new schedule. Exactly one of sch.Cron or
+// sch.Interval must be set. An empty catch-up policy defaults to skip, an empty
+// profile to the default credential profile, which must exist, and an empty mode
+// to a full collection.
+func (s *ScheduleService) Create(ctx context.Context, sch models.CollectionSchedule) (*models.CollectionSchedule, error) {
+	sch.Name = strings.TrimSpace(sch.Name)
+	sch.Cron = strings.TrimSpace(sch.Cron)
+	sch.Profile = strings.TrimSpace(sch.Profile)
+	if sch.Name == "" {
+		return nil, srvErrors.NewValidationError("schedule name is required")
+	}
+	if sch.CatchUp == "" {
+		sch.CatchUp = models.CatchUpSkip
+	}
+	if !sch.CatchUp.IsValid() {
+		return nil, srvErrors.NewValidationError(fmt.Sprintf("invalid catch-up policy %q: must be one of skip, once", sch.CatchUp))
+	}
+	if sch.Mode == "" {
+		sch.Mode = models.CollectionModeFull
+	}
+	if !sch.Mode.IsValid() {
+		return nil, srvErrors.NewValidationError(fmt.Sprintf("invalid mode %q: must be one of full, incremental", sch.Mode))
+	}
+	if sch.Profile == "" {
+		sch.Profile = models.DefaultCredentialProfile
+	}
+	if _, err := s.creds.ResolveProfile(ctx, sch.Profile); err != nil {
+		if srvErrors.IsCredentialsNotSetError(err) {
+			return nil, srvErrors.NewValidationError(fmt.Sprintf("credential profile %q does not exist", sch.Profile))
+		}
+		return nil, err
+	}
+
+	next, err := nextRunFunc(sch)
+	if err != nil {
+		return nil, srvErrors.NewValidationError(err.Error())
+	}
+
+	nextRunAt := next(time.Now())
+	if nextRunAt == nil {
+		return nil, srvErrors.NewValidationError(fmt.Sprintf("cron expression %q never matches", sch.Cron))
+	}
+
+	sch.ID = uuid.NewString()
+	if !sch.Paused {
+		sch.NextRunAt = nextRunAt
+	}
+
+	created, err := s.store.Schedule().Create(ctx, sch)
+	if err != nil {
+		return nil, err
+	}
+
+	zap.S().Named("schedule_service").Infow("schedule created", "id", created.ID, "name", created.Name, "profile", created.Profile, "mode", created.Mode, "next_run_at", created.NextRunAt)
+	return created, nil
+}
+
+// SetPaused pauses or resumes a schedule. Resuming plans the next run from now,
+// so occurrences that elapsed while paused are not treated as missed.
+func (s *ScheduleService) SetPaused(ctx context.Context, id string, paused bool) (*models.CollectionSchedule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sch, err := s.store.Schedule().Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextRunAt *time.Time
+	if !paused {
+		next, err := nextRunFunc(*sch)
+		if err != nil {
+			return nil, srvErrors.NewValidationError(err.Error())
+		}
+		nextRunAt = next(time.Now())
+	}
+
+	if err := s.store.Schedule().SetPaused(ctx, id, paused, nextRunAt); err != nil {
+		return nil, err
+	}
+
+	return s.store.Schedule().Get(ctx, id)
+}
+
+// Delete removes a schedule and its run history.
+func (s *ScheduleService) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.store.WithTx(ctx, func(txCtx context.Context) error {
+		return s.store.Schedule().Delete(txCtx, id)
+	})
+}
+
+// ListRuns returns the most recent runs of a schedule, newest first.
+func (s *ScheduleService) ListRuns(ctx context.Context, id string) ([]models.ScheduleRun, error) {
+	if _, err := s.store.Schedule().Get(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.store.Schedule().ListRuns(ctx, id, defaultScheduleRunsLimit)
+}
+
+// RunDue triggers every active schedule whose next run is at or before now.
+// It is called by the background loop and is exported for tests.
+func (s *ScheduleService) RunDue(ctx context.Context, now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schedules, err := s.store.Schedule().List(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, sch := range schedules {
+		if sch.Paused || sch.NextRunAt == nil || sch.NextRunAt.After(now) {
+			continue
+		}
+		if err := s.runSchedule(ctx, sch, now); err != nil {
+			errs = append(errs, fmt.Errorf("schedule %s: %w", sch.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *ScheduleService) runSchedule(ctx context.Context, sch models.CollectionSchedule, now time.Time) error {
+	log := zap.S().Named("schedule_service")
+
+	next, err := nextRunFunc(sch)
+	if err != nil {
+		return err
+	}
+	nextRunAt := next(now)
+	scheduledAt := *sch.NextRunAt
+
+	// More than one occurrence elapsed since the planned run: the agent was not
+	// running when it was due, so the catch-up policy applies.
+	missed := false
+	if following := next(scheduledAt); following != nil && !following.After(now) {
+		missed = true
+	}
+
+	if missed && sch.CatchUp == models.CatchUpSkip {
+		log.Infow("skipping missed scheduled collection", "id", sch.ID, "scheduled_at", scheduledAt)
+		if err := s.store.Schedule().InsertRun(ctx, models.ScheduleRun{
+			ScheduleID:  sch.ID,
+			ScheduledAt: scheduledAt,
+			TriggeredAt: now,
+			Status:      models.ScheduleRunSkipped,
+			CatchUp:     true,
+			Error:       "missed while the agent was not running",
+		}); err != nil {
+			return err
+		}
+		return s.store.Schedule().SetNextRunAt(ctx, sch.ID, nextRunAt)
+	}
+
+	run := models.ScheduleRun{
+		ScheduleID:  sch.ID,
+		ScheduledAt: scheduledAt,
+		TriggeredAt: now,
+		Status:      models.ScheduleRunStarted,
+		CatchUp:     missed,
+	}
+
+	if err := s.trigger(ctx, sch); err != nil {
+		run.Status = models.ScheduleRunFailed
+		if srvErrors.IsOperationInProgressError(err) {
+			run.Status = models.ScheduleRunSkipped
+		}
+		run.Error = err.Error()
+	}
+
+	log.Infow("scheduled collection", "id", sch.ID, "status", run.Status, "catch_up", run.CatchUp, "error", run.Error)
+
+	if err := s.store.Schedule().InsertRun(ctx, run); err != nil {
+		return err
+	}
+	return s.store.Schedule().UpdateRunTimes(ctx, sch.ID, now, nextRunAt)
+}
+
+func (s *ScheduleService) trigger(ctx context.Context, sch models.CollectionSchedule) error {
+	if _, err := s.creds.ResolveProfile(ctx, sch.Profile); err != nil {
+		return err
+	}
+	_, err := s.starter.StartVCenterCollecting(ctx, sch.Profile, sch.Mode)
+	return err
+}
+
+// nextRunFunc validates the schedule's timing and returns a function computing
+// the first run strictly after a given time.
+func nextRunFunc(sch models.CollectionSchedule) (func(time.Time) *time.Time, error) {
+	switch {
+	case sch.Cron != "" && sch.Interval != 0:
+		return nil, errors.New("cron and interval are mutually exclusive")
+	case sch.Cron != "":
+		spec, err := cron.Parse(sch.Cron)
+		if err != nil {
+			return nil, err
+		}
+		return func(t time.Time) *time.Time {
+			n := spec.Next(t)
+			if n.IsZero() {
+				return nil
+			}
+			return &n
+		}, nil
+	case sch.Interval != 0:
+		if sch.Interval < minScheduleInterval {
+			return nil, fmt.Errorf("interval must be at least %s", minScheduleInterval)
+		}
+		return func(t time.Time) *time.Time {
+			n := t.Add(sch.Interval)
+			return &n
+		}, nil
+	default:
+		return nil, errors.New("either cron or interval is required")
+	}
+}
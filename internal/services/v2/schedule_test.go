@@ -0,0 +1,363 @@
+package v2_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	v2 "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+type fakeCredentialsResolver struct {
+	err      error
+	profiles []string
+}
+
+func (f *fakeCredentialsResolver) ResolveProfile(_ context.Context, profile string) (models.Credentials, error) {
+	f.profiles = append(f.profiles, profile)
+	if f.err != nil {
+		return models.Credentials{}, f.err
+	}
+	return models.Credentials{URL: "https://vcenter.local/sdk", Username: "admin", Password: "pass"}, nil
+}
+
+type fakeCollectionStarter struct {
+	calls    int
+	err      error
+	vcenters []string
+	modes    []models.CollectionMode
+}
+
+func (f *fakeCollectionStarter) StartVCenterCollecting(_ context.Context, vcenter string, mode models.CollectionMode) (models.CollectorStatus, error) {
+	f.calls++
+	f.vcenters = append(f.vcenters, vcenter)
+	f.modes = append(f.modes, mode)
+	return models.CollectorStatus{}, f.err
+}
+
+var _ = Describe("ScheduleService", func() {
+	var (
+		ctx     context.Context
+		pool    *store.Pool
+		tmpDir  string
+		st      *store.Store2
+		creds   *fakeCredentialsResolver
+		starter *fakeCollectionStarter
+		srv     *v2.ScheduleService
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		var err error
+		tmpDir, err = os.MkdirTemp("", "schedule-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		pool = newTestPool(tmpDir)
+		mainDB, err := pool.Get(store.MainDatabaseID)
+		Expect(err).NotTo(HaveOccurred())
+		st, err = mainDB.Store()
+		Expect(err).NotTo(HaveOccurred())
+
+		creds = &fakeCredentialsResolver{}
+		starter = &fakeCollectionStarter{}
+		srv = v2.NewScheduleService(st, creds, starter)
+	})
+
+	AfterEach(func() {
+		if pool != nil {
+			pool.Close()
+		}
+		if tmpDir != "" {
+			_ = os.RemoveAll(tmpDir)
+		}
+	})
+
+	// dueSchedule creates a schedule and moves its next run to the given time.
+	dueSchedule := func(sch models.CollectionSchedule, nextRunAt time.Time) *models.CollectionSchedule {
+		created, err := srv.Create(ctx, sch)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(st.Schedule().SetNextRunAt(ctx, created.ID, &nextRunAt)).To(Succeed())
+		return created
+	}
+
+	Context("Create", func() {
+		// Given a valid cron schedule
+		// When we create it
+		// Then it should be persisted with a generated ID, default catch-up and a planned next run
+		It("should create a cron schedule with a next run", func() {
+			// Act
+			created, err := srv.Create(ctx, models.CollectionSchedule{Name: "nightly", Cron: "0 2 * * *"})
+
+			// Assert
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created.ID).NotTo(BeEmpty())
+			Expect(created.CatchUp).To(Equal(models.CatchUpSkip))
+			Expect(created.Profile).To(Equal(models.DefaultCredentialProfile))
+			Expect(created.Mode).To(Equal(models.CollectionModeFull))
+			Expect(created.NextRunAt).NotTo(BeNil())
+			Expect(created.NextRunAt.After(time.Now())).To(BeTrue())
+			Expect(created.NextRunAt.UTC().Hour()).To(Equal(2))
+		})
+
+		// Given a schedule created paused
+		// When we create it
+		// Then no next run should be planned
+		It("should not plan a run for a paused schedule", func() {
+			// Act
+			created, err := srv.Create(ctx, models.CollectionSchedule{Name: "paused", Interval: time.Hour, Paused: true})
+
+			// Assert
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created.Paused).To(BeTrue())
+			Expect(created.NextRunAt).To(BeNil())
+		})
+
+		// Given a schedule of a credential profile that does not exist
+		// When we create it
+		// Then a ValidationError is returned and nothing is persisted
+		It("should reject a schedule of an unknown credential profile", func() {
+			// Arrange
+			creds.err = srvErrors.NewCredentialsNotSetError()
+
+			// Act
+			_, err := srv.Create(ctx, models.CollectionSchedule{Name: "lab", Interval: time.Hour, Profile: "lab"})
+
+			// Assert
+			Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+			Expect(creds.profiles).To(Equal([]string{"lab"}))
+			schedules, err := srv.List(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(schedules).To(BeEmpty())
+		})
+
+		DescribeTable("should reject invalid schedules",
+			func(sch models.CollectionSchedule) {
+				// Act
+				_, err := srv.Create(ctx, sch)
+
+				// Assert
+				Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+			},
+			Entry("missing name", models.CollectionSchedule{Cron: "0 2 * * *"}),
+			Entry("neither cron nor interval", models.CollectionSchedule{Name: "x"}),
+			Entry("both cron and interval", models.CollectionSchedule{Name: "x", Cron: "0 2 * * *", Interval: time.Hour}),
+			Entry("malformed cron", models.CollectionSchedule{Name: "x", Cron: "not a cron"}),
+			Entry("interval below minimum", models.CollectionSchedule{Name: "x", Interval: time.Minute}),
+			Entry("unknown catch-up policy", models.CollectionSchedule{Name: "x", Interval: time.Hour, CatchUp: "always"}),
+			Entry("unknown mode", models.CollectionSchedule{Name: "x", Interval: time.Hour, Mode: "partial"}),
+		)
+	})
+
+	Context("RunDue", func() {
+		// Given a schedule whose next run has just elapsed
+		// When due schedules are evaluated
+		// Then a collection is started and recorded, and the next run moves forward
+		It("should start a collection for a due schedule", func() {
+			// Arrange
+			now := time.Now()
+			sch := dueSchedule(models.CollectionSchedule{Name: "hourly", Interval: time.Hour}, now.Add(-time.Minute))
+
+			// Act
+			Expect(srv.RunDue(ctx, now)).To(Succeed())
+
+			// Assert
+			Expect(starter.calls).To(Equal(1))
+			runs, err := srv.ListRuns(ctx, sch.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(runs).To(HaveLen(1))
+			Expect(runs[0].Status).To(Equal(models.ScheduleRunStarted))
+			Expect(runs[0].CatchUp).To(BeFalse())
+
+			got, err := srv.Get(ctx, sch.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.LastRunAt).NotTo(BeNil())
+			Expect(got.NextRunAt.After(now)).To(BeTrue())
+		})
+
+		// Given a due incremental schedule of the lab vCenter
+		// When due schedules are evaluated
+		// Then the lab credentials are resolved and an incremental collection of the lab vCenter is started
+		It("should collect the vCenter of the schedule in its mode", func() {
+			// Arrange
+			now := time.Now()
+			dueSchedule(models.CollectionSchedule{Name: "lab", Interval: time.Hour, Profile: "lab", Mode: models.CollectionModeIncremental}, now.Add(-time.Minute))
+			creds.profiles = nil
+
+			// Act
+			Expect(srv.RunDue(ctx, now)).To(Succeed())
+
+			// Assert
+			Expect(creds.profiles).To(Equal([]string{"lab"}))
+			Expect(starter.vcenters).To(Equal([]string{"lab"}))
+			Expect(starter.modes).To(Equal([]models.CollectionMode{models.CollectionModeIncremental}))
+		})
+
+		// Given a schedule whose next run is in the future
+		// When due schedules are evaluated
+		// Then nothing is started
+		It("should not start a schedule that is not due", func() {
+			// Arrange
+			now := time.Now()
+			dueSchedule(models.CollectionSchedule{Name: "hourly", Interval: time.Hour}, now.Add(time.Minute))
+
+			// Act
+			Expect(srv.RunDue(ctx, now)).To(Succeed())
+
+			// Assert
+			Expect(starter.calls).To(BeZero())
+		})
+
+		// Given a skip schedule that missed several occurrences
+		// When due schedules are evaluated
+		// Then the missed run is recorded as skipped and no collection is started
+		It("should skip missed runs with the skip policy", func() {
+			// Arrange
+			now := time.Now()
+			sch := dueSchedule(models.CollectionSchedule{Name: "hourly", Interval: time.Hour, CatchUp: models.CatchUpSkip}, now.Add(-5*time.Hour))
+
+			// Act
+			Expect(srv.RunDue(ctx, now)).To(Succeed())
+
+			// Assert
+			Expect(starter.calls).To(BeZero())
+			runs, err := srv.ListRuns(ctx, sch.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(runs).To(HaveLen(1))
+			Expect(runs[0].Status).To(Equal(models.ScheduleRunSkipped))
+			Expect(runs[0].CatchUp).To(BeTrue())
+
+			got, err := srv.Get(ctx, sch.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.NextRunAt.After(now)).To(BeTrue())
+		})
+
+		// Given a once schedule that missed several occurrences
+		// When due schedules are evaluated
+		// Then exactly one catch-up collection is started
+		It("should run a single catch-up with the once policy", func() {
+			// Arrange
+			now := time.Now()
+			sch := dueSchedule(models.CollectionSchedule{Name: "hourly", Interval: time.Hour, CatchUp: models.CatchUpOnce}, now.Add(-5*time.Hour))
+
+			// Act
+			Expect(srv.RunDue(ctx, now)).To(Succeed())
+			Expect(srv.RunDue(ctx, now)).To(Succeed())
+
+			// Assert
+			Expect(starter.calls).To(Equal(1))
+			runs, err := srv.ListRuns(ctx, sch.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(runs).To(HaveLen(1))
+			Expect(runs[0].Status).To(Equal(models.ScheduleRunStarted))
+			Expect(runs[0].CatchUp).To(BeTrue())
+		})
+
+		// Given a collection already in progress
+		// When a schedule becomes due
+		// Then the run is recorded as skipped
+		It("should record a skipped run when a collection is in progress", func() {
+			// Arrange
+			starter.err = srvErrors.NewCollectionInProgressError()
+			now := time.Now()
+			sch := dueSchedule(models.CollectionSchedule{Name: "hourly", Interval: time.Hour}, now.Add(-time.Minute))
+
+			// Act
+			Expect(srv.RunDue(ctx, now)).To(Succeed())
+
+			// Assert
+			runs, err := srv.ListRuns(ctx, sch.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(runs).To(HaveLen(1))
+			Expect(runs[0].Status).To(Equal(models.ScheduleRunSkipped))
+			Expect(runs[0].Error).NotTo(BeEmpty())
+		})
+
+		// Given a schedule whose credentials were removed after its creation
+		// When it becomes due
+		// Then the run is recorded as failed and no collection is started
+		It("should record a failed run when credentials cannot be resolved", func() {
+			// Arrange
+			now := time.Now()
+			sch := dueSchedule(models.CollectionSchedule{Name: "hourly", Interval: time.Hour}, now.Add(-time.Minute))
+			creds.err = errors.New("no credentials")
+
+			// Act
+			Expect(srv.RunDue(ctx, now)).To(Succeed())
+
+			// Assert
+			Expect(starter.calls).To(BeZero())
+			runs, err := srv.ListRuns(ctx, sch.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(runs).To(HaveLen(1))
+			Expect(runs[0].Status).To(Equal(models.ScheduleRunFailed))
+			Expect(runs[0].Error).To(ContainSubstring("no credentials"))
+		})
+	})
+
+	Context("SetPaused", func() {
+		// Given an active schedule
+		// When we pause it and evaluate due schedules
+		// Then nothing runs; resuming plans a new run in the future
+		It("should pause and resume a schedule", func() {
+			// Arrange
+			now := time.Now()
+			sch := dueSchedule(models.CollectionSchedule{Name: "hourly", Interval: time.Hour}, now.Add(-time.Minute))
+
+			// Act — pause
+			paused, err := srv.SetPaused(ctx, sch.ID, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(srv.RunDue(ctx, now)).To(Succeed())
+
+			// Assert — paused
+			Expect(paused.Paused).To(BeTrue())
+			Expect(paused.NextRunAt).To(BeNil())
+			Expect(starter.calls).To(BeZero())
+
+			// Act — resume
+			resumed, err := srv.SetPaused(ctx, sch.ID, false)
+
+			// Assert — resumed
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resumed.Paused).To(BeFalse())
+			Expect(resumed.NextRunAt).NotTo(BeNil())
+			Expect(resumed.NextRunAt.After(now)).To(BeTrue())
+		})
+
+		// Given no schedule with the requested ID
+		// When we pause it
+		// Then a ResourceNotFoundError is returned
+		It("should return ResourceNotFoundError for unknown schedule", func() {
+			// Act
+			_, err := srv.SetPaused(ctx, "missing", true)
+
+			// Assert
+			Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+		})
+
+		// Given a paused schedule stored with an interval below the minimum
+		// When we resume it
+		// Then a ValidationError is returned and the schedule stays paused
+		It("should return ValidationError when the schedule timing is invalid", func() {
+			// Arrange
+			_, err := st.Schedule().Create(ctx, models.CollectionSchedule{ID: "sch-1", Name: "fast", Interval: time.Minute, CatchUp: models.CatchUpSkip, Paused: true})
+			Expect(err).NotTo(HaveOccurred())
+
+			// Act
+			_, err = srv.SetPaused(ctx, "sch-1", false)
+
+			// Assert
+			Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+			got, err := srv.Get(ctx, "sch-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.Paused).To(BeTrue())
+		})
+	})
+})
@@ -1,13 +1,53 @@
 package v2_test
 
 import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/migration-planner/pkg/duckdb_parser"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	"github.com/kubev2v/assisted-migration-agent/internal/store/migrations"
 )
 
 func TestServices(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Services V2 Suite")
 }
+
+// newTestPool returns a pool holding a migrated main database stored in tmpDir.
+func newTestPool(tmpDir string) *store.Pool {
+	pool := store.NewPool(5 * time.Minute)
+	mainDB, err := pool.NewDatabase(store.MainDatabaseID, filepath.Join(tmpDir, "agent.duckdb"), time.Now(), store.EagerConnectionInitilization, 0, store.ReadWriteDatabase)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	ExpectWithOffset(1, mainDB.Migrate(context.Background(), migrations.RunMain)).To(Succeed())
+	pool.Add(mainDB)
+	return pool
+}
+
+// addTestCollection adds to a pool made by newTestPool an empty, migrated collection database
+// created at ts, stored next to the main database.
+func addTestCollection(pool *store.Pool, id string, ts time.Time) (*store.Database, *store.Store2) {
+	mainDB, err := pool.Get(store.MainDatabaseID)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	name := fmt.Sprintf("collection_%d", ts.Unix())
+	db, err := pool.NewDatabase(id, filepath.Join(filepath.Dir(mainDB.Path), name+".duckdb"), ts, store.EagerConnectionInitilization, 0, store.ReadWriteDatabase)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	st, err := db.Store()
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	ExpectWithOffset(1, duckdb_parser.New(st.Querier(), nil).Init()).To(Succeed())
+	ExpectWithOffset(1, db.Migrate(context.Background(), func(ctx context.Context, sqlDb *sql.DB) error {
+		return migrations.RunCollection(ctx, sqlDb, name)
+	})).To(Succeed())
+
+	pool.Add(db)
+	return db, st
+}
@@ -0,0 +1,200 @@
+package v2
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+	"github.com/kubev2v/assisted-migration-agent/pkg/rightsizing"
+)
+
+// defaultSizingHeadroomPct is the headroom added to the p95 utilization of rightsized VMs
+// when the request sets none.
+const defaultSizingHeadroomPct = 20
+
+// SizingService sizes the target OpenShift cluster of the latest collection.
+//
+// A simulation packs the VMs to migrate, excluded VMs and templates aside, onto nodes of each
+// requested profile and reports the node count, the placement of every VM and the capacity
+// stranded on each node. VMs are sized either by their provisioned vCPUs and memory or by
+// their p95 utilization in the latest rightsizing report plus headroom. Simulations are stored
+// in the collection, the latest being exported by the "sizing" scope.
+type SizingService struct {
+	pool *store.Pool
+}
+
+func NewSizingService(pool *store.Pool) *SizingService {
+	return &SizingService{pool: pool}
+}
+
+// Simulate runs and stores a sizing simulation against the latest collection of the vCenter of
+// req, or of any vCenter when req has none.
+func (s *SizingService) Simulate(ctx context.Context, req models.SizingRequest) (*models.SizingResult, error) {
+	req, err := sizingRequestWithDefaults(req)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := latestCollection(s.pool, req.VCenter)
+	if err != nil {
+		return nil, err
+	}
+	st, err := db.Store()
+	if err != nil {
+		return nil, err
+	}
+
+	var vmIDs []string
+	if req.GroupID != nil {
+		if _, err := st.Group().Get(ctx, *req.GroupID); err != nil {
+			return nil, err
+		}
+		ids, err := st.Group().GetMatchedIDs(ctx, *req.GroupID)
+		if err != nil {
+			return nil, fmt.Errorf("getting matched IDs for group %s: %w", *req.GroupID, err)
+		}
+		vmIDs = append([]string{}, ids...)
+	}
+	vms, err := st.Sizing().ListVMs(ctx, vmIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.SizingResult{
+		ID:          uuid.NewString(),
+		GroupID:     req.GroupID,
+		Source:      req.Source,
+		HeadroomPct: *req.HeadroomPct,
+		VMCount:     len(vms),
+		Profiles:    make([]models.SizingProfileResult, 0, len(req.Profiles)),
+		CreatedAt:   time.Now(),
+	}
+
+	demands := make([]rightsizing.Demand, 0, len(vms))
+	placements := make(map[string]models.SizingPlacement, len(vms))
+	for _, vm := range vms {
+		p := models.SizingPlacement{VMID: vm.VMID, Name: vm.Name, CPU: float64(vm.CPUs), MemoryMB: float64(vm.MemoryMB)}
+		if req.Source == models.SizingRightsized {
+			if vm.CPUP95Pct != nil && vm.MemP95Pct != nil {
+				p.CPU = rightsizing.RightsizedDemand(p.CPU, *vm.CPUP95Pct, *req.HeadroomPct)
+				p.MemoryMB = rightsizing.RightsizedDemand(p.MemoryMB, *vm.MemP95Pct, *req.HeadroomPct)
+			} else {
+				result.ProvisionedFallbackCount++
+			}
+		}
+		placements[vm.VMID] = p
+		demands = append(demands, rightsizing.Demand{ID: vm.VMID, CPU: p.CPU, MemoryMB: p.MemoryMB})
+	}
+
+	for _, profile := range req.Profiles {
+		nodes, unplaced := rightsizing.Pack(demands, rightsizing.Capacity{
+			CPU:      profile.AllocatableCPU(),
+			MemoryMB: profile.AllocatableMemoryMB(),
+		})
+
+		pr := models.SizingProfileResult{
+			Profile:   profile,
+			NodeCount: len(nodes),
+			Nodes:     make([]models.SizingNode, 0, len(nodes)),
+			Unplaced:  sizingPlacements(unplaced, placements),
+		}
+		for i, n := range nodes {
+			pr.Nodes = append(pr.Nodes, models.SizingNode{Index: i, VMs: sizingPlacements(n.Demands, placements)})
+		}
+		pr.Tally()
+		result.Profiles = append(result.Profiles, pr)
+	}
+
+	if err := st.WithTx(ctx, func(txCtx context.Context) error {
+		return st.Sizing().Save(txCtx, *result)
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetLatest returns the latest simulation stored in the latest collection of a vCenter, or of
+// any vCenter when vcenter is empty.
+func (s *SizingService) GetLatest(ctx context.Context, vcenter string) (*models.SizingResult, error) {
+	db, err := latestCollection(s.pool, vcenter)
+	if err != nil {
+		return nil, err
+	}
+	st, err := db.Store()
+	if err != nil {
+		return nil, err
+	}
+	return st.Sizing().GetLatest(ctx)
+}
+
+// sizingRequestWithDefaults validates a sizing request and fills in its defaults: provisioned
+// sizing, 20% headroom for rightsized VMs and no overcommit. Provisioned sizing has no headroom.
+func sizingRequestWithDefaults(req models.SizingRequest) (models.SizingRequest, error) {
+	switch req.Source {
+	case "":
+		req.Source = models.SizingProvisioned
+	case models.SizingProvisioned, models.SizingRightsized:
+	default:
+		return req, srvErrors.NewValidationError(fmt.Sprintf("invalid sizing source %q: use %s or %s", req.Source, models.SizingProvisioned, models.SizingRightsized))
+	}
+	if req.HeadroomPct != nil && *req.HeadroomPct < 0 {
+		return req, srvErrors.NewValidationError("headroom must not be negative")
+	}
+	headroom := float64(defaultSizingHeadroomPct)
+	if req.HeadroomPct != nil {
+		headroom = *req.HeadroomPct
+	}
+	if req.Source == models.SizingProvisioned {
+		headroom = 0
+	}
+	req.HeadroomPct = &headroom
+	if len(req.Profiles) == 0 {
+		return req, srvErrors.NewValidationError("at least one node profile is required")
+	}
+
+	profiles := make([]models.NodeProfile, 0, len(req.Profiles))
+	seen := make(map[string]bool, len(req.Profiles))
+	for _, p := range req.Profiles {
+		switch {
+		case p.Name == "":
+			return req, srvErrors.NewValidationError("node profile name is required")
+		case seen[p.Name]:
+			return req, srvErrors.NewValidationError(fmt.Sprintf("duplicate node profile %q", p.Name))
+		case p.Cores <= 0 || p.MemoryMB <= 0:
+			return req, srvErrors.NewValidationError(fmt.Sprintf("node profile %q needs cores and memory", p.Name))
+		case p.CPUOvercommit < 0 || p.MemoryOvercommit < 0:
+			return req, srvErrors.NewValidationError(fmt.Sprintf("node profile %q has a negative overcommit ratio", p.Name))
+		case p.ReservedCores < 0 || p.ReservedMemoryMB < 0:
+			return req, srvErrors.NewValidationError(fmt.Sprintf("node profile %q has negative reserved overhead", p.Name))
+		case p.ReservedCores >= float64(p.Cores) || p.ReservedMemoryMB >= p.MemoryMB:
+			return req, srvErrors.NewValidationError(fmt.Sprintf("node profile %q reserves all of its cores or memory", p.Name))
+		}
+		seen[p.Name] = true
+		if p.CPUOvercommit == 0 {
+			p.CPUOvercommit = 1
+		}
+		if p.MemoryOvercommit == 0 {
+			p.MemoryOvercommit = 1
+		}
+		profiles = append(profiles, p)
+	}
+	req.Profiles = profiles
+	return req, nil
+}
+
+// sizingPlacements returns the placements of demands, ordered by VM ID.
+func sizingPlacements(demands []rightsizing.Demand, placements map[string]models.SizingPlacement) []models.SizingPlacement {
+	out := make([]models.SizingPlacement, 0, len(demands))
+	for _, d := range demands {
+		out = append(out, placements[d.ID])
+	}
+	slices.SortFunc(out, func(a, b models.SizingPlacement) int { return cmp.Compare(a.VMID, b.VMID) })
+	return out
+}
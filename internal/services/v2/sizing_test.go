@@ -0,0 +1,185 @@
+package v2_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	v2 "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+var _ = Describe("SizingService", func() {
+	var (
+		ctx     context.Context
+		pool    *store.Pool
+		tmpDir  string
+		st      *store.Store2
+		srv     *v2.SizingService
+		groupID uuid.UUID
+	)
+
+	// small fits one 8 vCPU, 16 GiB VM per node; large fits every VM on one node.
+	small := models.NodeProfile{Name: "small", Cores: 8, MemoryMB: 16384}
+	large := models.NodeProfile{Name: "large", Cores: 16, MemoryMB: 65536, CPUOvercommit: 4, ReservedCores: 2, ReservedMemoryMB: 4096}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		tmpDir, err = os.MkdirTemp("", "sizing-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		pool = newTestPool(tmpDir)
+
+		// four VMs to size, web-2 being excluded; db-1 has no utilization in the report
+		var db *store.Database
+		db, st = addTestCollection(pool, "col-1000", time.Unix(1000, 0))
+		db.VCenter = "vc-a"
+		for _, q := range []string{
+			`INSERT INTO vinfo ("VM ID", "VM", "Cluster", "Powerstate", "Template", "Memory", "CPUs")
+			 VALUES ('vm-1', 'web-1', 'prod', 'poweredOn', false, 8192, 4),
+			        ('vm-2', 'web-2', 'prod', 'poweredOff', false, 4096, 2),
+			        ('vm-3', 'db-1', 'prod', 'poweredOn', false, 16384, 8),
+			        ('vm-4', 'app-1', 'prod', 'poweredOn', false, 4096, 2),
+			        ('vm-5', 'big-1', 'dev', 'poweredOn', false, 32768, 32)`,
+			`UPDATE vinfo SET migration_excluded = true WHERE "VM ID" = 'vm-2'`,
+			`INSERT INTO rightsizing_reports (id, vcenter, interval_id, window_start, window_end, expected_sample_count, expected_batch_count, written_batch_count)
+			 VALUES ('report-1', 'vc', 300, '2026-01-01', '2026-01-08', 10, 1, 1)`,
+			`INSERT INTO rightsizing_vm_utilization (report_id, moid, vm_name, cpu_p95_pct, mem_p95_pct)
+			 VALUES ('report-1', 'vm-1', 'web-1', 50, 50),
+			        ('report-1', 'vm-4', 'app-1', 25, 25),
+			        ('report-1', 'vm-5', 'big-1', 100, 100)`,
+		} {
+			_, err = st.Querier().ExecContext(ctx, q)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		group, err := v2.NewGroupService(st, &mockInventoryBuilder{}).Create(ctx, models.Group{Name: "Prod", Filter: "cluster = 'prod'"})
+		Expect(err).NotTo(HaveOccurred())
+		groupID = group.ID
+
+		srv = v2.NewSizingService(pool)
+	})
+
+	AfterEach(func() {
+		pool.Close()
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	It("rejects invalid requests", func() {
+		_, err := srv.Simulate(ctx, models.SizingRequest{})
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+
+		_, err = srv.Simulate(ctx, models.SizingRequest{Source: "p99", Profiles: []models.NodeProfile{small}})
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+
+		_, err = srv.Simulate(ctx, models.SizingRequest{Profiles: []models.NodeProfile{small, small}})
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+
+		_, err = srv.Simulate(ctx, models.SizingRequest{Profiles: []models.NodeProfile{{Name: "tiny", Cores: 2, MemoryMB: 4096, ReservedCores: 2}}})
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("packs the provisioned VMs on nodes of each profile", func() {
+		result, err := srv.Simulate(ctx, models.SizingRequest{Profiles: []models.NodeProfile{small, large}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Source).To(Equal(models.SizingProvisioned))
+		Expect(result.VMCount).To(Equal(4))
+		Expect(result.Profiles).To(HaveLen(2))
+
+		onSmall := result.Profiles[0]
+		Expect(onSmall.Profile.CPUOvercommit).To(Equal(1.0))
+		Expect(onSmall.NodeCount).To(Equal(2))
+		Expect(onSmall.Nodes[0].VMs).To(Equal([]models.SizingPlacement{{VMID: "vm-3", Name: "db-1", CPU: 8, MemoryMB: 16384}}))
+		Expect(onSmall.Nodes[0].StrandedCPU).To(BeZero())
+		Expect(onSmall.Nodes[1].VMs).To(HaveLen(2))
+		Expect(onSmall.Nodes[1].StrandedCPU).To(Equal(2.0))
+		Expect(onSmall.Nodes[1].StrandedMemoryMB).To(Equal(4096.0))
+		Expect(onSmall.Unplaced).To(Equal([]models.SizingPlacement{{VMID: "vm-5", Name: "big-1", CPU: 32, MemoryMB: 32768}}))
+		Expect(onSmall.StrandedCPU).To(Equal(2.0))
+
+		onLarge := result.Profiles[1]
+		Expect(onLarge.NodeCount).To(Equal(1))
+		Expect(onLarge.Nodes[0].VMs).To(HaveLen(4))
+		Expect(onLarge.Nodes[0].StrandedCPU).To(Equal(56.0 - 46))
+		Expect(onLarge.Nodes[0].StrandedMemoryMB).To(BeZero())
+		Expect(onLarge.Unplaced).To(BeEmpty())
+
+		latest, err := srv.GetLatest(ctx, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(latest.ID).To(Equal(result.ID))
+		Expect(latest.Profiles).To(Equal(result.Profiles))
+
+		path := filepath.Join(tmpDir, "sizing.csv")
+		Expect(st.Export().CopyScope(ctx, "sizing", path)).To(Succeed())
+		csv, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(csv)).To(Equal(`profile,node,vm_count,vms,used_cpu,used_memory_mb,allocatable_cpu,allocatable_memory_mb,stranded_cpu,stranded_memory_mb
+small,0,1,db-1,8.0,16384.0,8.0,16384.0,0.0,0.0
+small,1,2,web-1;app-1,6.0,12288.0,8.0,16384.0,2.0,4096.0
+small,unplaced,1,big-1,32.0,32768.0,8.0,16384.0,0.0,0.0
+large,0,4,web-1;db-1;app-1;big-1,46.0,61440.0,56.0,61440.0,10.0,0.0
+`))
+	})
+
+	It("sizes the VMs of a group by their p95 utilization plus headroom", func() {
+		result, err := srv.Simulate(ctx, models.SizingRequest{
+			GroupID:  &groupID,
+			Source:   models.SizingRightsized,
+			Profiles: []models.NodeProfile{small},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.HeadroomPct).To(Equal(20.0))
+		Expect(result.VMCount).To(Equal(3))
+		Expect(result.ProvisionedFallbackCount).To(Equal(1))
+
+		nodes := result.Profiles[0].Nodes
+		Expect(nodes).To(HaveLen(2))
+		Expect(nodes[0].VMs[0].VMID).To(Equal("vm-3"))
+		Expect(nodes[1].VMs).To(HaveLen(2))
+		Expect(nodes[1].VMs[0].CPU).To(BeNumerically("~", 2.4, 1e-9))
+		Expect(nodes[1].VMs[0].MemoryMB).To(BeNumerically("~", 4915.2, 1e-9))
+		Expect(nodes[1].VMs[1].CPU).To(BeNumerically("~", 0.6, 1e-9))
+	})
+
+	It("sizes the latest collection of the given vCenter", func() {
+		// a newer collection of another vCenter, with a single VM
+		db, other := addTestCollection(pool, "col-2000", time.Unix(2000, 0))
+		db.VCenter = "vc-b"
+		insertSyncTestVM(ctx, other, "vm-9", "other-1")
+
+		result, err := srv.Simulate(ctx, models.SizingRequest{Profiles: []models.NodeProfile{large}, VCenter: "vc-a"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.VMCount).To(Equal(4))
+
+		latest, err := srv.GetLatest(ctx, "vc-a")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(latest.ID).To(Equal(result.ID))
+
+		_, err = srv.GetLatest(ctx, "")
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+
+		result, err = srv.Simulate(ctx, models.SizingRequest{Profiles: []models.NodeProfile{large}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.VMCount).To(Equal(1))
+
+		_, err = srv.GetLatest(ctx, "vc-c")
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+	})
+
+	It("returns not found for an unknown group or without simulations", func() {
+		_, err := srv.GetLatest(ctx, "")
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+
+		unknown := uuid.New()
+		_, err = srv.Simulate(ctx, models.SizingRequest{GroupID: &unknown, Profiles: []models.NodeProfile{small}})
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+	})
+})
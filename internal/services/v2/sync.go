@@ -21,26 +21,53 @@ var systemLabels = map[string]bool{
 	LabelNew: true,
 }
 
+// systemLabelActor records the label changes made by the agent itself in the label history.
+var systemLabelActor = models.LabelActor{Source: models.LabelSourceSystem}
+
 // SyncAttached runs all cross-DB sync operations on the attached schema inside a single
 // transaction. prevSt must already have the new collection database attached under attachAlias
-// before calling. All four operations (groups, labels, exclusion flags, new-VM labeling) are
-// wrapped in a transaction so that a failure in any step rolls back all prior writes — this
-// prevents a partial sync where groups exist in the new collection but have no inventory_data
-// (which RefreshGroupInventories would have rebuilt had SyncAttached returned nil).
+// before calling. All operations (groups, labels with their history and the records of the
+// labels applied by rules, exclusion flags with their details, new-VM labeling, power-off times) are wrapped in a transaction so
+// that a failure in any step rolls back all prior writes — this prevents a partial sync where
+// groups exist in the new collection but have no inventory_data (which RefreshGroupInventories
+// would have rebuilt had SyncAttached returned nil). Exclusions that expired at now are not
+// copied, which lifts them.
 func SyncAttached(ctx context.Context, prevSt *store.Store2, attachAlias string, now time.Time) error {
+	ctx = store.WithLabelActor(ctx, systemLabelActor)
 	return prevSt.WithTx(ctx, func(txCtx context.Context) error {
 		if err := prevSt.Group().CopyToAttached(txCtx, attachAlias, now); err != nil {
 			return fmt.Errorf("copying groups: %w", err)
 		}
+		if err := prevSt.VM().CopyLabelHistoryToAttached(txCtx, attachAlias); err != nil {
+			return fmt.Errorf("copying VM label history: %w", err)
+		}
 		if err := prevSt.VM().CopyLabelsToAttached(txCtx, attachAlias, systemLabels); err != nil {
 			return fmt.Errorf("copying VM labels: %w", err)
 		}
-		if err := prevSt.VM().CopyMigrationExclusionToAttached(txCtx, attachAlias); err != nil {
-			return fmt.Errorf("copying migration exclusion flags: %w", err)
+		if err := prevSt.VM().CopyRuleLabelsToAttached(txCtx, attachAlias); err != nil {
+			return fmt.Errorf("copying VM rule labels: %w", err)
+		}
+		if err := prevSt.VM().CopyMigrationExclusionToAttached(txCtx, attachAlias, now); err != nil {
+			return fmt.Errorf("copying migration exclusions: %w", err)
 		}
 		if err := prevSt.VM().LabelNewVMsInAttached(txCtx, attachAlias, LabelNew); err != nil {
 			return fmt.Errorf("labeling new VMs: %w", err)
 		}
+		if err := prevSt.VM().CopyPoweredOffToAttached(txCtx, attachAlias); err != nil {
+			return fmt.Errorf("copying VM power-off times: %w", err)
+		}
+		return nil
+	})
+}
+
+// trackPoweredOff records now as the power-off time of the VMs of a full collection seen
+// powered off for the first time. It runs once the collection is synced from the previous
+// one, which carries the power-off times of the VMs still powered off.
+func trackPoweredOff(ctx context.Context, st *store.Store2, now time.Time) error {
+	return st.WithTx(ctx, func(txCtx context.Context) error {
+		if err := st.VM().TrackPoweredOff(txCtx, now); err != nil {
+			return fmt.Errorf("tracking powered off VMs: %w", err)
+		}
 		return nil
 	})
 }
@@ -50,18 +77,31 @@ func SyncAttached(ctx context.Context, prevSt *store.Store2, attachAlias string,
 // inventory actually changed in this refresh so the caller can emit the
 // appropriate upsert/delete event for just those groups — this function itself emits
 // no outbox events.
-func RefreshGroupInventories(ctx context.Context, newSt *store.Store2, groupSvc *GroupService) ([]models.Group, error) {
+//
+// changedVMs restricts the rebuild to groups affected by those VMs: a group whose matches
+// did not change and contain none of them keeps its inventory. A nil changedVMs rebuilds
+// every group, which is what a full collection needs.
+func RefreshGroupInventories(ctx context.Context, newSt *store.Store2, groupSvc *GroupService, changedVMs []string) ([]models.Group, error) {
 	groups, err := newSt.Group().List(ctx, nil, 0, 0)
 	if err != nil {
 		return nil, fmt.Errorf("listing groups in new collection: %w", err)
 	}
 
+	expand := groupSvc.expander(ctx)
 	var changed []models.Group
 	for _, g := range groups {
 		before := g.Inventory
 
 		if err := newSt.WithTx(ctx, func(txCtx context.Context) error {
-			if err := newSt.Group().RefreshMatches(txCtx, g.ID); err != nil {
+			var prevIDs []string
+			if changedVMs != nil {
+				ids, err := newSt.Group().GetMatchedIDs(txCtx, g.ID)
+				if err != nil {
+					return fmt.Errorf("getting previous matched IDs for group %s: %w", g.ID, err)
+				}
+				prevIDs = ids
+			}
+			if err := newSt.Group().RefreshMatchesWith(txCtx, expand, g.ID); err != nil {
 				return fmt.Errorf("refreshing matches for group %s: %w", g.ID, err)
 			}
 			vmIDs, err := newSt.Group().GetMatchedIDs(txCtx, g.ID)
@@ -69,6 +109,10 @@ func RefreshGroupInventories(ctx context.Context, newSt *store.Store2, groupSvc
 				return fmt.Errorf("getting matched IDs for group %s: %w", g.ID, err)
 			}
 
+			if changedVMs != nil && !groupAffected(prevIDs, vmIDs, changedVMs) {
+				return nil
+			}
+
 			var inv *inventory.Inventory
 			if len(vmIDs) > 0 {
 				inv, err = groupSvc.inventoryBuilder.BuildInventory(txCtx, vmIDs)
@@ -93,3 +137,26 @@ func RefreshGroupInventories(ctx context.Context, newSt *store.Store2, groupSvc
 	}
 	return changed, nil
 }
+
+// groupAffected reports whether a group must be rebuilt: its matches changed or include
+// one of the changed VMs.
+func groupAffected(prevIDs, vmIDs, changedVMs []string) bool {
+	if len(prevIDs) != len(vmIDs) {
+		return true
+	}
+	prev := make(map[string]struct{}, len(prevIDs))
+	for _, id := range prevIDs {
+		prev[id] = struct{}{}
+	}
+	for _, id := range vmIDs {
+		if _, ok := prev[id]; !ok {
+			return true
+		}
+	}
+	for _, id := range changedVMs {
+		if _, ok := prev[id]; ok {
+			return true
+		}
+	}
+	return false
+}
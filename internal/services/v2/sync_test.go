@@ -3,6 +3,7 @@ package v2_test
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"os"
 	"path/filepath"
 	"time"
@@ -12,10 +13,14 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/kubev2v/migration-planner/pkg/duckdb_parser"
+	duckdb_models "github.com/kubev2v/migration-planner/pkg/duckdb_parser/models"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
 
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
 	v2 "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
 	"github.com/kubev2v/assisted-migration-agent/internal/store"
 	"github.com/kubev2v/assisted-migration-agent/internal/store/migrations"
+	"github.com/kubev2v/assisted-migration-agent/test"
 )
 
 // newSyncTestDB creates a migrated collection DuckDB database for sync tests.
@@ -41,6 +46,30 @@ func insertSyncTestVM(ctx context.Context, st *store.Store2, vmID, name string)
 	ExpectWithOffset(1, err).NotTo(HaveOccurred())
 }
 
+// powerOffSyncTestVM marks a VM inserted by insertSyncTestVM as powered off.
+func powerOffSyncTestVM(ctx context.Context, st *store.Store2, vmID string) {
+	_, err := st.Querier().ExecContext(ctx, `UPDATE vinfo SET "Powerstate" = 'poweredOff' WHERE "VM ID" = ?`, vmID)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+}
+
+// poweredOffSince returns the recorded power-off times by VM ID.
+func poweredOffSince(ctx context.Context, st *store.Store2) map[string]time.Time {
+	rows, err := st.Querier().QueryContext(ctx, `SELECT vm_id, since FROM vm_powered_off`)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	defer func() { _ = rows.Close() }()
+
+	since := map[string]time.Time{}
+	for rows.Next() {
+		var (
+			id string
+			t  time.Time
+		)
+		ExpectWithOffset(1, rows.Scan(&id, &t)).To(Succeed())
+		since[id] = t.UTC()
+	}
+	return since
+}
+
 var _ = Describe("Collection sync", func() {
 	var (
 		ctx    context.Context
@@ -164,6 +193,41 @@ var _ = Describe("Collection sync", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(labelsMap).To(BeEmpty())
 			})
+
+			It("carries the label history over and records the system labels left behind", func() {
+				insertSyncTestVM(ctx, prevSt, "vm-1", "alpha")
+
+				newSt, err := newDB.Store()
+				Expect(err).NotTo(HaveOccurred())
+				insertSyncTestVM(ctx, newSt, "vm-1", "alpha")
+				insertSyncTestVM(ctx, newSt, "vm-2", "beta")
+
+				Expect(prevSt.VM().AddLabel(v2.WithLabelActor(ctx, "alice"), "vm-1", "prod")).To(Succeed())
+				Expect(prevSt.VM().AddLabel(ctx, "vm-1", v2.LabelNew)).To(Succeed())
+
+				detach := attachNew()
+				defer detach()
+				Expect(v2.SyncAttached(ctx, prevSt, attachedSchema, time.Now())).To(Succeed())
+				detach()
+
+				newSt, err = newDB.Store()
+				Expect(err).NotTo(HaveOccurred())
+				history, err := newSt.VM().ListLabelHistory(ctx, "vm-1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(history).To(HaveLen(3))
+				Expect(history[0].Label).To(Equal(v2.LabelNew))
+				Expect(history[0].Action).To(Equal(models.LabelRemoved))
+				Expect(history[0].Source).To(Equal(models.LabelSourceSystem))
+				Expect(history[2].Label).To(Equal("prod"))
+				Expect(history[2].Actor).To(Equal("alice"))
+
+				history, err = newSt.VM().ListLabelHistory(ctx, "vm-2")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(history).To(HaveLen(1))
+				Expect(history[0].Label).To(Equal(v2.LabelNew))
+				Expect(history[0].Action).To(Equal(models.LabelAdded))
+				Expect(history[0].Source).To(Equal(models.LabelSourceSystem))
+			})
 		})
 
 		Context("migration exclusion", func() {
@@ -189,6 +253,51 @@ var _ = Describe("Collection sync", func() {
 				Expect(states["vm-1"]).To(BeTrue())
 				Expect(states["vm-2"]).To(BeFalse())
 			})
+
+			It("copies the exclusion details and lifts the expired exclusions", func() {
+				insertSyncTestVM(ctx, prevSt, "vm-1", "alpha")
+				insertSyncTestVM(ctx, prevSt, "vm-2", "beta")
+
+				newSt, err := newDB.Store()
+				Expect(err).NotTo(HaveOccurred())
+				insertSyncTestVM(ctx, newSt, "vm-1", "alpha")
+				insertSyncTestVM(ctx, newSt, "vm-2", "beta")
+
+				syncTime := time.Now()
+				expired := syncTime.Add(-time.Hour)
+				Expect(prevSt.VM().UpdateMigrationExcludedBatch(ctx, []string{"vm-1", "vm-2"}, true)).To(Succeed())
+				Expect(prevSt.VM().SetMigrationExclusion(ctx, []string{"vm-1"}, models.MigrationExclusion{
+					Reason:        models.ExclusionReasonLicense,
+					Justification: "Oracle license bound to the host",
+					ExcludedBy:    "alice",
+					ExcludedAt:    syncTime.Add(-48 * time.Hour),
+				})).To(Succeed())
+				Expect(prevSt.VM().SetMigrationExclusion(ctx, []string{"vm-2"}, models.MigrationExclusion{
+					Reason:     models.ExclusionReasonBusiness,
+					ExcludedAt: syncTime.Add(-48 * time.Hour),
+					ExpiresAt:  &expired,
+				})).To(Succeed())
+
+				detach := attachNew()
+				defer detach()
+				Expect(v2.SyncAttached(ctx, prevSt, attachedSchema, syncTime)).To(Succeed())
+				detach()
+
+				newSt, err = newDB.Store()
+				Expect(err).NotTo(HaveOccurred())
+				states, err := newSt.VM().GetMigrationExcludedStates(ctx, []string{"vm-1", "vm-2"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(states["vm-1"]).To(BeTrue())
+				Expect(states["vm-2"]).To(BeFalse())
+
+				exclusions, err := newSt.VM().ListMigrationExclusions(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(exclusions).To(HaveLen(1))
+				Expect(exclusions[0].VMID).To(Equal("vm-1"))
+				Expect(exclusions[0].Reason).To(Equal(models.ExclusionReasonLicense))
+				Expect(exclusions[0].Justification).To(Equal("Oracle license bound to the host"))
+				Expect(exclusions[0].ExcludedBy).To(Equal("alice"))
+			})
 		})
 
 		Context("new VM labeling", func() {
@@ -213,6 +322,33 @@ var _ = Describe("Collection sync", func() {
 				Expect(labelsMap["vm-brand-new"]).To(ContainElement(v2.LabelNew))
 			})
 		})
+
+		Context("power-off times", func() {
+			It("keeps the power-off time of the VMs still powered off", func() {
+				since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+				for _, id := range []string{"vm-off", "vm-on-again"} {
+					insertSyncTestVM(ctx, prevSt, id, id)
+					powerOffSyncTestVM(ctx, prevSt, id)
+					_, err := prevSt.Querier().ExecContext(ctx, `INSERT INTO vm_powered_off (vm_id, since) VALUES (?, ?)`, id, since)
+					Expect(err).NotTo(HaveOccurred())
+				}
+
+				newSt, err := newDB.Store()
+				Expect(err).NotTo(HaveOccurred())
+				insertSyncTestVM(ctx, newSt, "vm-off", "vm-off")
+				powerOffSyncTestVM(ctx, newSt, "vm-off")
+				insertSyncTestVM(ctx, newSt, "vm-on-again", "vm-on-again")
+
+				detach := attachNew()
+				defer detach()
+				Expect(v2.SyncAttached(ctx, prevSt, attachedSchema, time.Now())).To(Succeed())
+				detach()
+
+				newSt, err = newDB.Store()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(poweredOffSince(ctx, newSt)).To(Equal(map[string]time.Time{"vm-off": since}))
+			})
+		})
 	})
 
 	Describe("RefreshGroupInventories", func() {
@@ -232,12 +368,189 @@ var _ = Describe("Collection sync", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			groupSvc := v2.NewGroupService(newSt, &mockInventoryBuilder{})
-			_, err = v2.RefreshGroupInventories(ctx, newSt, groupSvc)
+			_, err = v2.RefreshGroupInventories(ctx, newSt, groupSvc, nil)
 			Expect(err).To(BeNil())
 
 			vmIDs, err := newSt.Group().GetMatchedIDs(ctx, groupID)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(vmIDs).To(ContainElement("vm-prod-1"))
 		})
+
+		It("rebuilds only the groups affected by the changed VMs", func() {
+			newSt, err := newDB.Store()
+			Expect(err).NotTo(HaveOccurred())
+
+			insertSyncTestVM(ctx, newSt, "vm-prod-1", "prod-alpha")
+			insertSyncTestVM(ctx, newSt, "vm-dev-1", "dev-alpha")
+			_, err = newSt.Querier().ExecContext(ctx, `UPDATE vinfo SET "Cluster" = 'prod' WHERE "VM ID" = 'vm-prod-1'`)
+			Expect(err).NotTo(HaveOccurred())
+
+			now := time.Now()
+			prodID, devID := uuid.New(), uuid.New()
+			_, err = newSt.Querier().ExecContext(ctx,
+				`INSERT INTO groups (id, name, description, filter, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?)`,
+				prodID, "prod-group", "", "cluster = 'prod'", now, now,
+				devID, "dev-group", "", "cluster = 'cluster-a'", now, now)
+			Expect(err).NotTo(HaveOccurred())
+
+			builder := &countingInventoryBuilder{}
+			groupSvc := v2.NewGroupService(newSt, builder)
+			_, err = v2.RefreshGroupInventories(ctx, newSt, groupSvc, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(builder.calls).To(Equal(2))
+
+			builder.calls = 0
+			_, err = v2.RefreshGroupInventories(ctx, newSt, groupSvc, []string{"vm-prod-1"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(builder.calls).To(Equal(1))
+
+			builder.calls = 0
+			_, err = v2.RefreshGroupInventories(ctx, newSt, groupSvc, []string{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(builder.calls).To(BeZero())
+		})
+	})
+
+	Describe("SyncDelta", func() {
+		It("moves LabelNew to the VMs added by the delta", func() {
+			newSt, err := newDB.Store()
+			Expect(err).NotTo(HaveOccurred())
+			insertSyncTestVM(ctx, newSt, "vm-old-new", "alpha")
+			insertSyncTestVM(ctx, newSt, "vm-added", "beta")
+			Expect(newSt.VM().AddLabelBatch(ctx, []string{"vm-old-new"}, v2.LabelNew)).To(Succeed())
+
+			_, err = v2.SyncDelta(ctx, newSt, models.CollectionDelta{Added: []string{"vm-added"}}, time.Now())
+			Expect(err).NotTo(HaveOccurred())
+
+			labelsMap, err := newSt.VM().ListLabels(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(labelsMap).NotTo(HaveKey("vm-old-new"))
+			Expect(labelsMap["vm-added"]).To(ContainElement(v2.LabelNew))
+		})
+
+		It("records the VMs powered off since the previous collection", func() {
+			newSt, err := newDB.Store()
+			Expect(err).NotTo(HaveOccurred())
+			insertSyncTestVM(ctx, newSt, "vm-1", "alpha")
+			insertSyncTestVM(ctx, newSt, "vm-2", "beta")
+			powerOffSyncTestVM(ctx, newSt, "vm-2")
+
+			now := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+			_, err = v2.SyncDelta(ctx, newSt, models.CollectionDelta{Modified: []string{"vm-2"}}, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(poweredOffSince(ctx, newSt)).To(Equal(map[string]time.Time{"vm-2": now}))
+
+			// a later collection keeps the time the VM was first seen powered off
+			_, err = v2.SyncDelta(ctx, newSt, models.CollectionDelta{}, now.Add(24*time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(poweredOffSince(ctx, newSt)).To(Equal(map[string]time.Time{"vm-2": now}))
+		})
+
+		It("lifts the exclusions that expired", func() {
+			newSt, err := newDB.Store()
+			Expect(err).NotTo(HaveOccurred())
+			insertSyncTestVM(ctx, newSt, "vm-1", "alpha")
+			insertSyncTestVM(ctx, newSt, "vm-2", "beta")
+
+			now := time.Now()
+			expired, later := now.Add(-time.Minute), now.Add(time.Hour)
+			Expect(newSt.VM().UpdateMigrationExcludedBatch(ctx, []string{"vm-1", "vm-2"}, true)).To(Succeed())
+			Expect(newSt.VM().SetMigrationExclusion(ctx, []string{"vm-1"}, models.MigrationExclusion{
+				Reason: models.ExclusionReasonDecommission, ExcludedAt: now, ExpiresAt: &expired,
+			})).To(Succeed())
+			Expect(newSt.VM().SetMigrationExclusion(ctx, []string{"vm-2"}, models.MigrationExclusion{
+				Reason: models.ExclusionReasonDecommission, ExcludedAt: now, ExpiresAt: &later,
+			})).To(Succeed())
+
+			lifted, err := v2.SyncDelta(ctx, newSt, models.CollectionDelta{}, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lifted).To(Equal([]string{"vm-1"}))
+
+			states, err := newSt.VM().GetMigrationExcludedStates(ctx, []string{"vm-1", "vm-2"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(states).To(Equal(map[string]bool{"vm-1": false, "vm-2": true}))
+
+			exclusions, err := newSt.VM().ListMigrationExclusions(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exclusions).To(HaveLen(1))
+			Expect(exclusions[0].VMID).To(Equal("vm-2"))
+		})
+	})
+
+	Describe("ApplyDelta", func() {
+		concernsOf := func(st *store.Store2, vmID string) []string {
+			rows, err := st.Querier().QueryContext(ctx, `SELECT "Category" FROM concerns WHERE "VM_ID" = ? ORDER BY "Category"`, vmID)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			defer func() { _ = rows.Close() }()
+			var categories []string
+			for rows.Next() {
+				var c string
+				ExpectWithOffset(1, rows.Scan(&c)).To(Succeed())
+				categories = append(categories, c)
+			}
+			return categories
+		}
+
+		It("validates the patched VMs again and deletes the removed ones", func() {
+			newSt, err := newDB.Store()
+			Expect(err).NotTo(HaveOccurred())
+			insertSyncTestVM(ctx, newSt, "vm-1", "alpha")
+			insertSyncTestVM(ctx, newSt, "vm-2", "beta")
+			_, err = newSt.Querier().ExecContext(ctx,
+				`INSERT INTO concerns ("VM_ID", "Concern_ID", "Label", "Category", "Assessment") VALUES ('vm-1', 'stale', 'Stale', 'Warning', 'stale')`)
+			Expect(err).NotTo(HaveOccurred())
+
+			validator := test.NewMockValidator()
+			validator.Concerns = []duckdb_models.Concern{{Id: "cpu.hotplug", Label: "CPU hot plug", Category: "Critical", Assessment: "CPU hot plug is not supported"}}
+
+			delta := models.CollectionDelta{Added: []string{"vm-3"}, Modified: []string{"vm-1"}, Removed: []string{"vm-2"}}
+			patches := []models.VMPatch{
+				{ID: "vm-1", Name: "alpha", PowerState: "poweredOn", CPUs: 4, CPUHotAdd: true},
+				{ID: "vm-3", Name: "gamma", PowerState: "poweredOn", CPUs: 2, CPUHotAdd: true},
+			}
+			Expect(v2.ApplyDelta(ctx, newSt, delta, patches, validator)).To(Succeed())
+
+			Expect(concernsOf(newSt, "vm-1")).To(Equal([]string{"Critical"}))
+			Expect(concernsOf(newSt, "vm-3")).To(Equal([]string{"Critical"}))
+
+			var count int
+			Expect(newSt.Querier().QueryRowContext(ctx, `SELECT COUNT(*) FROM vinfo WHERE "VM ID" = 'vm-2'`).Scan(&count)).To(Succeed())
+			Expect(count).To(BeZero())
+		})
+
+		It("leaves the collection untouched when the validation fails", func() {
+			newSt, err := newDB.Store()
+			Expect(err).NotTo(HaveOccurred())
+			insertSyncTestVM(ctx, newSt, "vm-1", "alpha")
+			insertSyncTestVM(ctx, newSt, "vm-2", "beta")
+
+			validator := test.NewMockValidator()
+			validator.Err = errors.New("opa unavailable")
+
+			delta := models.CollectionDelta{Modified: []string{"vm-1"}, Removed: []string{"vm-2"}}
+			patches := []models.VMPatch{{ID: "vm-1", Name: "renamed", PowerState: "poweredOn"}}
+			Expect(v2.ApplyDelta(ctx, newSt, delta, patches, validator)).NotTo(Succeed())
+
+			var names []string
+			rows, err := newSt.Querier().QueryContext(ctx, `SELECT "VM" FROM vinfo ORDER BY "VM"`)
+			Expect(err).NotTo(HaveOccurred())
+			defer func() { _ = rows.Close() }()
+			for rows.Next() {
+				var n string
+				Expect(rows.Scan(&n)).To(Succeed())
+				names = append(names, n)
+			}
+			Expect(names).To(Equal([]string{"alpha", "beta"}))
+		})
 	})
 })
+
+// countingInventoryBuilder counts the inventories it builds.
+type countingInventoryBuilder struct {
+	calls int
+}
+
+func (b *countingInventoryBuilder) BuildInventory(_ context.Context, _ []string) (*inventory.Inventory, error) {
+	b.calls++
+	return &inventory.Inventory{VCenterID: "test-vcenter"}, nil
+}
@@ -0,0 +1,87 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+// Trends returns the readiness metrics of every collection, oldest first.
+//
+// A group is resolved in each collection by its ID, which groups keep when they are carried
+// over to the next collection; collections in which the group does not exist are left out of
+// the timeline, and an unknown group is not found.
+func (s *CollectionService) Trends(ctx context.Context, filter models.TrendFilter) ([]models.TrendPoint, error) {
+	var groupID uuid.UUID
+	if filter.GroupID != "" {
+		id, err := uuid.Parse(filter.GroupID)
+		if err != nil {
+			return nil, srvErrors.NewValidationError(fmt.Sprintf("invalid group ID: %s", filter.GroupID))
+		}
+		groupID = id
+	}
+
+	databases := slices.Collect(s.pool.All())
+	slices.Reverse(databases)
+
+	points := make([]models.TrendPoint, 0, len(databases))
+	for _, db := range databases {
+		if db.ID == store.MainDatabaseID {
+			continue
+		}
+		if filter.VCenter != "" && db.VCenter != filter.VCenter {
+			continue
+		}
+
+		st, err := db.Store()
+		if err != nil {
+			return nil, fmt.Errorf("opening collection %s: %w", db.ID, err)
+		}
+
+		var vmFilter sq.Sqlizer
+		switch {
+		case filter.GroupID != "":
+			group, err := st.Group().Get(ctx, groupID)
+			if err != nil {
+				if srvErrors.IsResourceNotFoundError(err) {
+					continue
+				}
+				return nil, fmt.Errorf("getting group %s in collection %s: %w", groupID, db.ID, err)
+			}
+			expr, err := s.savedFilters.Expand(ctx, group.Filter)
+			if err != nil {
+				if srvErrors.IsValidationError(err) {
+					continue
+				}
+				return nil, fmt.Errorf("expanding filter of group %s in collection %s: %w", groupID, db.ID, err)
+			}
+			vmFilter = store.ByFilter(expr)
+		case filter.Expression != "":
+			vmFilter = store.ByFilter(filter.Expression)
+		}
+
+		metrics, err := st.VM().TrendMetrics(ctx, vmFilter)
+		if err != nil {
+			return nil, fmt.Errorf("computing trend metrics of collection %s: %w", db.ID, err)
+		}
+		points = append(points, models.TrendPoint{
+			CollectionID: db.ID,
+			CreatedAt:    db.CreatedAt,
+			VCenter:      db.VCenter,
+			Imported:     db.Imported,
+			TrendMetrics: metrics,
+		})
+	}
+
+	if filter.GroupID != "" && len(points) == 0 {
+		return nil, srvErrors.NewResourceNotFoundError("group", filter.GroupID)
+	}
+	return points, nil
+}
@@ -0,0 +1,120 @@
+package v2_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	v2 "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+var _ = Describe("CollectionService Trends", func() {
+	var (
+		ctx    context.Context
+		pool   *store.Pool
+		tmpDir string
+		srv    *v2.CollectionService
+	)
+
+	// addCollection registers a collection of the given vCenter holding one 2 vCPU / 1 GiB VM
+	// per cluster entry; VMs whose cluster is "legacy" get a Critical concern.
+	addCollection := func(ts int64, vcenter string, clusters ...string) *store.Store2 {
+		db, st := addTestCollection(pool, fmt.Sprintf("col-%d", ts), time.Unix(ts, 0))
+		db.VCenter = vcenter
+
+		for i, cluster := range clusters {
+			id := fmt.Sprintf("vm-%d", i)
+			_, err := st.Querier().ExecContext(ctx,
+				`INSERT INTO vinfo ("VM ID", "VM", "Cluster", "Powerstate", "Template", "Memory", "CPUs", "Provisioned MiB")
+				 VALUES (?, ?, ?, 'poweredOn', false, 1024, 2, 10240)`, id, id, cluster)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			if cluster == "legacy" {
+				_, err = st.Querier().ExecContext(ctx,
+					`INSERT INTO concerns ("VM_ID", "Concern_ID", "Label", "Category", "Assessment")
+					 VALUES (?, 'rdm', 'RDM disk', 'Critical', 'Remove the RDM disk')`, id)
+				ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			}
+		}
+
+		return st
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		tmpDir, err = os.MkdirTemp("", "trend-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		pool = newTestPool(tmpDir)
+
+		srv = v2.NewCollectionService(pool)
+	})
+
+	AfterEach(func() {
+		pool.Close()
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	It("returns the metrics of every collection oldest first", func() {
+		addCollection(2000, "default", "prod", "prod", "legacy")
+		addCollection(1000, "default", "legacy", "legacy", "prod")
+
+		points, err := srv.Trends(ctx, models.TrendFilter{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(points).To(HaveLen(2))
+
+		Expect(points[0].CollectionID).To(Equal("col-1000"))
+		Expect(points[0].TotalVMs).To(Equal(3))
+		Expect(points[0].Migratable).To(Equal(1))
+		Expect(points[0].NonMigratable).To(Equal(2))
+		Expect(points[0].ConcernsByCategory).To(Equal(map[string]int{"Critical": 2}))
+		Expect(points[0].CPUs).To(BeEquivalentTo(6))
+		Expect(points[0].MemoryMiB).To(BeEquivalentTo(3072))
+		Expect(points[0].StorageMiB).To(BeEquivalentTo(30720))
+		Expect(points[0].Clusters).To(Equal(2))
+
+		Expect(points[1].CollectionID).To(Equal("col-2000"))
+		Expect(points[1].Migratable).To(Equal(2))
+		Expect(points[1].NonMigratable).To(Equal(1))
+	})
+
+	It("filters collections by vCenter and VMs by expression", func() {
+		addCollection(1000, "vc-east", "prod", "legacy")
+		addCollection(2000, "vc-west", "prod")
+
+		points, err := srv.Trends(ctx, models.TrendFilter{VCenter: "vc-east", Expression: "cluster = 'legacy'"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(points).To(HaveLen(1))
+		Expect(points[0].VCenter).To(Equal("vc-east"))
+		Expect(points[0].TotalVMs).To(Equal(1))
+		Expect(points[0].NonMigratable).To(Equal(1))
+	})
+
+	It("filters by group and skips collections without the group", func() {
+		addCollection(1000, "default", "prod", "legacy")
+		st := addCollection(2000, "default", "prod", "prod", "legacy")
+		group, err := st.Group().Create(ctx, models.Group{Name: "prod", Filter: "cluster = 'prod'"})
+		Expect(err).NotTo(HaveOccurred())
+
+		points, err := srv.Trends(ctx, models.TrendFilter{GroupID: group.ID.String()})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(points).To(HaveLen(1))
+		Expect(points[0].CollectionID).To(Equal("col-2000"))
+		Expect(points[0].TotalVMs).To(Equal(2))
+	})
+
+	It("returns not found for an unknown group", func() {
+		addCollection(1000, "default", "prod")
+
+		_, err := srv.Trends(ctx, models.TrendFilter{GroupID: uuid.NewString()})
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+	})
+})
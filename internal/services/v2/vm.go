@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/kubev2v/migration-planner/pkg/duckdb_parser"
@@ -12,8 +14,12 @@ import (
 
 	"github.com/kubev2v/assisted-migration-agent/internal/models"
 	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
 )
 
+// maxExclusionJustificationLength is the longest justification accepted for a migration exclusion.
+const maxExclusionJustificationLength = 1000
+
 type VMService struct {
 	store    *store.Store2
 	eventSrv *EventService
@@ -41,6 +47,25 @@ func (s *VMService) Get(ctx context.Context, id string) (*models.VM, error) {
 		return nil, err
 	}
 
+	ruleLabels, err := s.store.VM().ListRuleLabels(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(ruleLabels) > 0 {
+		vm.RuleLabels = make(map[string]string, len(ruleLabels))
+		for _, l := range ruleLabels {
+			vm.RuleLabels[l.Label] = l.Rule
+		}
+	}
+
+	exclusions, err := s.store.VM().ListMigrationExclusions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(exclusions) > 0 {
+		vm.Exclusion = &exclusions[0]
+	}
+
 	results, err := s.store.Inspection().ListResults(ctx, id)
 	if err != nil {
 		return nil, err
@@ -152,30 +177,85 @@ func (s *VMService) UpdateMigrationExcludedBatch(ctx context.Context, vmIDs []st
 			return fmt.Errorf("updating VMs migration_excluded: %w", err)
 		}
 
-		if err := s.buildAndSaveMainInventory(txCtx); err != nil {
+		return s.rebuildInventoriesOf(txCtx, uniqueIDs)
+	})
+}
+
+// ExcludeFromMigration excludes vmIDs from migration with the details of exclusion,
+// replacing the details of the VMs already excluded. The reason must be one of
+// models.MigrationExclusionReasons and the expiry, when set, in the future.
+// ExcludedAt defaults to now. As UpdateMigrationExcludedBatch, the inventories
+// containing the VMs are rebuilt in the same transaction.
+func (s *VMService) ExcludeFromMigration(ctx context.Context, vmIDs []string, exclusion models.MigrationExclusion) error {
+	uniqueIDs := deduplicateStrings(vmIDs)
+	if len(uniqueIDs) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	if err := validateMigrationExclusion(exclusion, now); err != nil {
+		return err
+	}
+	if exclusion.ExcludedAt.IsZero() {
+		exclusion.ExcludedAt = now
+	}
+
+	if _, err := s.store.VM().GetMigrationExcludedStates(ctx, uniqueIDs); err != nil {
+		return err
+	}
+
+	return s.store.WithTx(ctx, func(txCtx context.Context) error {
+		if err := s.store.VM().UpdateMigrationExcludedBatch(txCtx, uniqueIDs, true); err != nil {
+			return fmt.Errorf("updating VMs migration_excluded: %w", err)
+		}
+		if err := s.store.VM().SetMigrationExclusion(txCtx, uniqueIDs, exclusion); err != nil {
 			return err
 		}
 
-		// Find all groups containing any of these VMs
-		groupIDsMap := make(map[uuid.UUID]bool)
-		for _, vmID := range uniqueIDs {
-			vmGroups, err := s.store.Group().GetGroupsContainingVM(txCtx, vmID)
-			if err != nil {
-				return fmt.Errorf("finding groups containing VM %s: %w", vmID, err)
-			}
-			for _, gid := range vmGroups {
-				groupIDsMap[gid] = true
-			}
+		return s.rebuildInventoriesOf(txCtx, uniqueIDs)
+	})
+}
+
+// validateMigrationExclusion checks the reason, justification and expiry of an exclusion.
+func validateMigrationExclusion(e models.MigrationExclusion, now time.Time) error {
+	if !slices.Contains(models.MigrationExclusionReasons, e.Reason) {
+		return srvErrors.NewValidationError(fmt.Sprintf("invalid exclusion reason %q: must be one of %v", e.Reason, models.MigrationExclusionReasons))
+	}
+	if len(e.Justification) > maxExclusionJustificationLength {
+		return srvErrors.NewValidationError(fmt.Sprintf("exclusion justification exceeds maximum length of %d characters", maxExclusionJustificationLength))
+	}
+	if e.ExpiresAt != nil && !e.ExpiresAt.After(now) {
+		return srvErrors.NewValidationError("exclusion expiry must be in the future")
+	}
+	return nil
+}
+
+// rebuildInventoriesOf rebuilds the main inventory and the inventories of the groups
+// containing any of vmIDs. Must be called within a transaction.
+func (s *VMService) rebuildInventoriesOf(ctx context.Context, vmIDs []string) error {
+	if err := s.buildAndSaveMainInventory(ctx); err != nil {
+		return err
+	}
+
+	// Find all groups containing any of these VMs
+	groupIDsMap := make(map[uuid.UUID]bool)
+	for _, vmID := range vmIDs {
+		vmGroups, err := s.store.Group().GetGroupsContainingVM(ctx, vmID)
+		if err != nil {
+			return fmt.Errorf("finding groups containing VM %s: %w", vmID, err)
 		}
+		for _, gid := range vmGroups {
+			groupIDsMap[gid] = true
+		}
+	}
 
-		for gid := range groupIDsMap {
-			if err := s.buildAndSaveGroupInventory(txCtx, gid); err != nil {
-				return err
-			}
+	for gid := range groupIDsMap {
+		if err := s.buildAndSaveGroupInventory(ctx, gid); err != nil {
+			return err
 		}
+	}
 
-		return nil
-	})
+	return nil
 }
 
 // buildAndSaveMainInventory builds the main (ungrouped) inventory and saves it
@@ -260,6 +340,11 @@ func (s *VMService) UpdateLabels(ctx context.Context, id string, labels []string
 	return s.store.VM().UpdateLabels(ctx, id, labels)
 }
 
+// LabelHistory returns the labels added to and removed from a VM, most recent first.
+func (s *VMService) LabelHistory(ctx context.Context, id string) ([]models.LabelChange, error) {
+	return s.store.VM().ListLabelHistory(ctx, id)
+}
+
 // GetAllLabels returns all distinct labels in use across VMs along with their counts.
 // The labels and counts are returned in the same order (sorted alphabetically by label).
 func (s *VMService) GetAllLabels(ctx context.Context) ([]string, []int, error) {
@@ -0,0 +1,485 @@
+package v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+// Formats of the label and migration exclusion files.
+const (
+	VMFileCSV  = "csv"
+	VMFileXLSX = "xlsx"
+)
+
+// Columns of the label and migration exclusion files. A row refers to its VM by MoRef, UUID
+// or name; the first one filled in is used.
+const (
+	vmFileColID       = "VM ID"
+	vmFileColUUID     = "VM UUID"
+	vmFileColName     = "VM"
+	vmFileColLabels   = "Labels"
+	vmFileColExcluded = "Migration Excluded"
+
+	// vmFileLabelSeparator separates the labels of a VM in the Labels column.
+	vmFileLabelSeparator = ";"
+)
+
+// vmFileColumnAliases maps the accepted headers, lowercased, to the column they stand for.
+var vmFileColumnAliases = map[string]string{
+	"vm id":              vmFileColID,
+	"moref":              vmFileColID,
+	"vm uuid":            vmFileColUUID,
+	"uuid":               vmFileColUUID,
+	"smbios uuid":        vmFileColUUID,
+	"vm":                 vmFileColName,
+	"vm name":            vmFileColName,
+	"name":               vmFileColName,
+	"labels":             vmFileColLabels,
+	"label":              vmFileColLabels,
+	"migration excluded": vmFileColExcluded,
+	"excluded":           vmFileColExcluded,
+}
+
+// vmFileKeyColumn is a column a row can refer to its VM by.
+type vmFileKeyColumn struct {
+	column  string
+	keyType models.VMImportKeyType
+}
+
+// vmFileKeyColumns are the key columns, in the order they are looked at.
+var vmFileKeyColumns = []vmFileKeyColumn{
+	{vmFileColID, models.VMImportKeyID},
+	{vmFileColUUID, models.VMImportKeyUUID},
+	{vmFileColName, models.VMImportKeyName},
+}
+
+// xlsxSignature starts every XLSX file, which is a ZIP archive.
+var xlsxSignature = []byte("PK\x03\x04")
+
+// ImportLabels adds the labels of a CSV or XLSX file to the VMs its rows refer to. The file
+// has a Labels column, with labels separated by ';', and at least one of the VM ID, VM UUID
+// and VM columns. Rows without labels are skipped. The report tells the rows that matched
+// exactly one VM from the unmatched and ambiguous ones; unless dryRun is set, the labels of
+// the matched rows are added in a single transaction.
+func (s *VMService) ImportLabels(ctx context.Context, r io.Reader, dryRun bool) (*models.VMImportReport, error) {
+	rows, err := s.readVMFile(ctx, r, vmFileColLabels, func(line int, value string, row *models.VMImportRow) (bool, error) {
+		for _, l := range strings.Split(value, vmFileLabelSeparator) {
+			l = strings.TrimSpace(l)
+			if l == "" || slices.Contains(row.Labels, l) {
+				continue
+			}
+			if len(l) > maxLabelLength {
+				return false, srvErrors.NewValidationError(fmt.Sprintf("row %d: label is longer than %d characters", line, maxLabelLength))
+			}
+			if systemLabels[l] {
+				return false, srvErrors.NewValidationError(fmt.Sprintf("row %d: label %s is managed by the agent", line, l))
+			}
+			row.Labels = append(row.Labels, l)
+		}
+		return len(row.Labels) > 0, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report := newVMImportReport(rows)
+	if dryRun {
+		return report, nil
+	}
+
+	byLabel := make(map[string][]string)
+	var labels []string
+	for _, row := range report.Matched {
+		for _, l := range row.Labels {
+			if _, ok := byLabel[l]; !ok {
+				labels = append(labels, l)
+			}
+			if !slices.Contains(byLabel[l], row.VMIDs[0]) {
+				byLabel[l] = append(byLabel[l], row.VMIDs[0])
+			}
+		}
+	}
+	err = s.store.WithTx(ctx, func(txCtx context.Context) error {
+		for _, l := range labels {
+			if err := s.store.VM().AddLabelBatch(txCtx, byLabel[l], l); err != nil {
+				return fmt.Errorf("adding label %s: %w", l, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	report.Applied = true
+	return report, nil
+}
+
+// ImportMigrationExclusion sets the migration exclusion of the VMs the rows of a CSV or XLSX
+// file refer to. The file has a Migration Excluded column, with true/false or yes/no values,
+// and at least one of the VM ID, VM UUID and VM columns. Rows without a value are skipped;
+// when several rows refer to the same VM, the last one wins. Unless dryRun is set, the matched
+// rows are applied and the inventories rebuilt in a single transaction.
+func (s *VMService) ImportMigrationExclusion(ctx context.Context, r io.Reader, dryRun bool) (*models.VMImportReport, error) {
+	rows, err := s.readVMFile(ctx, r, vmFileColExcluded, func(line int, value string, row *models.VMImportRow) (bool, error) {
+		if value == "" {
+			return false, nil
+		}
+		excluded, err := parseVMFileBool(value)
+		if err != nil {
+			return false, srvErrors.NewValidationError(fmt.Sprintf("row %d: invalid %s value %q", line, vmFileColExcluded, value))
+		}
+		row.MigrationExcluded = &excluded
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report := newVMImportReport(rows)
+	if dryRun {
+		return report, nil
+	}
+
+	states := make(map[string]bool)
+	var vmIDs []string
+	for _, row := range report.Matched {
+		if _, ok := states[row.VMIDs[0]]; !ok {
+			vmIDs = append(vmIDs, row.VMIDs[0])
+		}
+		states[row.VMIDs[0]] = *row.MigrationExcluded
+	}
+	err = s.store.WithTx(ctx, func(txCtx context.Context) error {
+		if len(vmIDs) == 0 {
+			return nil
+		}
+		for _, excluded := range []bool{true, false} {
+			var ids []string
+			for _, id := range vmIDs {
+				if states[id] == excluded {
+					ids = append(ids, id)
+				}
+			}
+			if err := s.store.VM().UpdateMigrationExcludedBatch(txCtx, ids, excluded); err != nil {
+				return fmt.Errorf("updating VMs migration_excluded: %w", err)
+			}
+		}
+		return s.rebuildInventoriesOf(txCtx, vmIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	report.Applied = true
+	return report, nil
+}
+
+// ExportLabels writes the manual labels of every VM in the format ImportLabels reads. System
+// labels and the labels applied by label rules are left out: the import rejects the former
+// and would turn the latter into manual labels the rules no longer manage.
+func (s *VMService) ExportLabels(ctx context.Context, format string, w io.Writer) error {
+	identities, err := s.store.VM().ListIdentities(ctx)
+	if err != nil {
+		return err
+	}
+	labels, err := s.store.VM().ListLabels(ctx)
+	if err != nil {
+		return err
+	}
+	ruleLabels, err := s.store.VM().ListRuleLabels(ctx)
+	if err != nil {
+		return err
+	}
+	applied := make(map[string]map[string]bool)
+	for _, l := range ruleLabels {
+		if applied[l.VMID] == nil {
+			applied[l.VMID] = make(map[string]bool)
+		}
+		applied[l.VMID][l.Label] = true
+	}
+
+	rows := [][]string{{vmFileColID, vmFileColUUID, vmFileColName, vmFileColLabels}}
+	for _, id := range identities {
+		vmLabels := slices.Sorted(slices.Values(slices.DeleteFunc(slices.Clone(labels[id.ID]), func(l string) bool {
+			return systemLabels[l] || applied[id.ID][l]
+		})))
+		rows = append(rows, []string{id.ID, vmIdentityUUID(id), id.Name, strings.Join(vmLabels, vmFileLabelSeparator+" ")})
+	}
+	return writeVMFile(format, "Labels", rows, w)
+}
+
+// ExportMigrationExclusion writes the migration exclusion of every VM in the format
+// ImportMigrationExclusion reads.
+func (s *VMService) ExportMigrationExclusion(ctx context.Context, format string, w io.Writer) error {
+	identities, err := s.store.VM().ListIdentities(ctx)
+	if err != nil {
+		return err
+	}
+	ids := make([]string, 0, len(identities))
+	for _, id := range identities {
+		ids = append(ids, id.ID)
+	}
+	states, err := s.store.VM().GetMigrationExcludedStates(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	rows := [][]string{{vmFileColID, vmFileColUUID, vmFileColName, vmFileColExcluded}}
+	for _, id := range identities {
+		rows = append(rows, []string{id.ID, vmIdentityUUID(id), id.Name, strconv.FormatBool(states[id.ID])})
+	}
+	return writeVMFile(format, "Migration Exclusion", rows, w)
+}
+
+// readVMFile reads the rows of a label or exclusion file and matches them to the VMs.
+// parseValue reads the value column of a row into it, and reports whether the row has a
+// value at all; rows without one are left out.
+func (s *VMService) readVMFile(
+	ctx context.Context,
+	r io.Reader,
+	valueColumn string,
+	parseValue func(line int, value string, row *models.VMImportRow) (bool, error),
+) ([]models.VMImportRow, error) {
+	records, err := readVMFileRecords(r)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int)
+	for i, h := range records[0] {
+		if col, ok := vmFileColumnAliases[strings.ToLower(unsanitizeVMFileCell(h))]; ok {
+			if _, seen := columns[col]; !seen {
+				columns[col] = i
+			}
+		}
+	}
+	if _, ok := columns[valueColumn]; !ok {
+		return nil, srvErrors.NewValidationError(fmt.Sprintf("missing %s column", valueColumn))
+	}
+	if !slices.ContainsFunc(vmFileKeyColumns, func(k vmFileKeyColumn) bool {
+		_, ok := columns[k.column]
+		return ok
+	}) {
+		return nil, srvErrors.NewValidationError(fmt.Sprintf("missing %s, %s or %s column", vmFileColID, vmFileColUUID, vmFileColName))
+	}
+
+	identities, err := s.store.VM().ListIdentities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	index := newVMIdentityIndex(identities)
+
+	cell := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return unsanitizeVMFileCell(record[i])
+	}
+
+	var rows []models.VMImportRow
+	for i, record := range records[1:] {
+		line := i + 2
+		row := models.VMImportRow{Row: line}
+		ok, err := parseValue(line, cell(record, valueColumn), &row)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		for _, k := range vmFileKeyColumns {
+			if key := cell(record, k.column); key != "" {
+				row.Key = key
+				row.KeyType = k.keyType
+				row.VMIDs = index.match(k.keyType, key)
+				break
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// newVMImportReport sorts rows by the number of VMs they matched.
+func newVMImportReport(rows []models.VMImportRow) *models.VMImportReport {
+	report := &models.VMImportReport{
+		Matched:   []models.VMImportRow{},
+		Unmatched: []models.VMImportRow{},
+		Ambiguous: []models.VMImportRow{},
+	}
+	for _, row := range rows {
+		switch len(row.VMIDs) {
+		case 0:
+			report.Unmatched = append(report.Unmatched, row)
+		case 1:
+			report.Matched = append(report.Matched, row)
+		default:
+			report.Ambiguous = append(report.Ambiguous, row)
+		}
+	}
+	return report
+}
+
+// vmIdentityIndex finds VMs by MoRef, UUID or name. UUIDs are compared case-insensitively,
+// names exactly.
+type vmIdentityIndex struct {
+	byID   map[string]bool
+	byUUID map[string][]string
+	byName map[string][]string
+}
+
+func newVMIdentityIndex(identities []models.VMIdentity) *vmIdentityIndex {
+	idx := &vmIdentityIndex{
+		byID:   make(map[string]bool, len(identities)),
+		byUUID: make(map[string][]string, len(identities)),
+		byName: make(map[string][]string, len(identities)),
+	}
+	for _, id := range identities {
+		idx.byID[id.ID] = true
+		idx.byName[id.Name] = append(idx.byName[id.Name], id.ID)
+		for _, uuid := range []string{id.UUID, id.SMBIOSUUID} {
+			key := strings.ToLower(uuid)
+			if key != "" && !slices.Contains(idx.byUUID[key], id.ID) {
+				idx.byUUID[key] = append(idx.byUUID[key], id.ID)
+			}
+		}
+	}
+	return idx
+}
+
+func (idx *vmIdentityIndex) match(keyType models.VMImportKeyType, key string) []string {
+	switch keyType {
+	case models.VMImportKeyID:
+		if idx.byID[key] {
+			return []string{key}
+		}
+		return nil
+	case models.VMImportKeyUUID:
+		return idx.byUUID[strings.ToLower(key)]
+	default:
+		return idx.byName[key]
+	}
+}
+
+// readVMFileRecords reads the records of a CSV file, or of the first sheet of an XLSX file.
+// The first record is the header.
+func readVMFileRecords(r io.Reader) ([][]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading file: %w", err)
+	}
+
+	var records [][]string
+	if bytes.HasPrefix(data, xlsxSignature) {
+		f, err := excelize.OpenReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, srvErrors.NewValidationError(fmt.Sprintf("invalid XLSX file: %v", err))
+		}
+		defer func() { _ = f.Close() }()
+		sheets := f.GetSheetList()
+		if len(sheets) == 0 {
+			return nil, srvErrors.NewValidationError("XLSX file has no sheet")
+		}
+		records, err = f.GetRows(sheets[0])
+		if err != nil {
+			return nil, srvErrors.NewValidationError(fmt.Sprintf("invalid XLSX file: %v", err))
+		}
+	} else {
+		reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+		reader.FieldsPerRecord = -1
+		records, err = reader.ReadAll()
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				return nil, srvErrors.NewValidationError(fmt.Sprintf("invalid CSV file: %v", err))
+			}
+			return nil, err
+		}
+	}
+
+	if len(records) == 0 {
+		return nil, srvErrors.NewValidationError("file is empty")
+	}
+	return records, nil
+}
+
+// writeVMFile writes rows as CSV or as an XLSX workbook with a single sheet. Cells are
+// sanitized as in collection exports.
+func writeVMFile(format, sheet string, rows [][]string, w io.Writer) error {
+	switch format {
+	case VMFileCSV:
+		writer := csv.NewWriter(w)
+		for _, row := range rows {
+			sanitized := make([]string, len(row))
+			for i, cell := range row {
+				sanitized[i] = sanitizeCSVCell(cell)
+			}
+			if err := writer.Write(sanitized); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	case VMFileXLSX:
+		f := excelize.NewFile()
+		defer func() { _ = f.Close() }()
+		if err := f.SetSheetName("Sheet1", sheet); err != nil {
+			return err
+		}
+		for r, row := range rows {
+			for c, val := range row {
+				cell, err := excelize.CoordinatesToCellName(c+1, r+1)
+				if err != nil {
+					return err
+				}
+				if err := f.SetCellValue(sheet, cell, sanitizeCSVCell(val)); err != nil {
+					return err
+				}
+			}
+		}
+		_, err := f.WriteTo(w)
+		return err
+	default:
+		return srvErrors.NewValidationError(fmt.Sprintf("unsupported format %q: use %s or %s", format, VMFileCSV, VMFileXLSX))
+	}
+}
+
+// unsanitizeVMFileCell trims a cell and drops the quote sanitizeCSVCell prefixes to cells
+// that spreadsheets would read as formulas, so that exported files import back unchanged.
+func unsanitizeVMFileCell(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) > 1 && s[0] == '\'' && strings.ContainsRune("=+@-\t\r", rune(s[1])) {
+		return s[1:]
+	}
+	return s
+}
+
+// parseVMFileBool accepts true/false, yes/no, y/n and 1/0, in any case.
+func parseVMFileBool(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "yes", "y":
+		return true, nil
+	case "no", "n":
+		return false, nil
+	}
+	return strconv.ParseBool(strings.ToLower(s))
+}
+
+// vmIdentityUUID returns the VM UUID of a VM, or its SMBIOS UUID when it has none.
+func vmIdentityUUID(id models.VMIdentity) string {
+	if id.UUID != "" {
+		return id.UUID
+	}
+	return id.SMBIOSUUID
+}
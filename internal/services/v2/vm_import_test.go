@@ -0,0 +1,171 @@
+package v2_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/duckdb_parser"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	v2 "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	"github.com/kubev2v/assisted-migration-agent/internal/store/migrations"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+var _ = Describe("VMService imports", func() {
+	var (
+		ctx    context.Context
+		pool   *store.Pool
+		tmpDir string
+		st     *store.Store2
+		srv    *v2.VMService
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		tmpDir, err = os.MkdirTemp("", "vm-import-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		pool = store.NewPool(5 * time.Minute)
+		db, err := pool.NewDatabase("col-1", filepath.Join(tmpDir, "collection_1.duckdb"), time.Now(), store.EagerConnectionInitilization, 0, store.ReadWriteDatabase)
+		Expect(err).NotTo(HaveOccurred())
+		st, err = db.Store()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(duckdb_parser.New(st.Querier(), nil).Init()).To(Succeed())
+		Expect(db.Migrate(ctx, func(ctx context.Context, sqlDb *sql.DB) error {
+			return migrations.RunCollection(ctx, sqlDb, "collection_1")
+		})).To(Succeed())
+
+		_, err = st.Querier().ExecContext(ctx,
+			`INSERT INTO vinfo ("VM ID", "VM", "VM UUID", "Cluster", "Powerstate", "Template", "Memory", "CPUs")
+			 VALUES ('vm-1', 'web-1', '4201AAAA-0000-0000-0000-000000000001', 'prod', 'poweredOn', false, 8192, 4),
+			        ('vm-2', 'web-2', '4201AAAA-0000-0000-0000-000000000002', 'prod', 'poweredOn', false, 4096, 2),
+			        ('vm-3', 'db', '4201AAAA-0000-0000-0000-000000000003', 'prod', 'poweredOn', false, 4096, 2),
+			        ('vm-4', 'db', '4201AAAA-0000-0000-0000-000000000004', 'dev', 'poweredOn', false, 4096, 2)`)
+		Expect(err).NotTo(HaveOccurred())
+
+		srv = v2.NewVMService(st)
+	})
+
+	AfterEach(func() {
+		pool.Close()
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	vmLabels := func() map[string][]string {
+		labels, err := st.VM().ListLabels(ctx)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return labels
+	}
+
+	It("reports matched, unmatched and ambiguous rows and applies only the matched ones", func() {
+		file := strings.Join([]string{
+			"VM,VM UUID,VM ID,Labels",
+			"web-1,,,wave-1; owner-alice",
+			",4201aaaa-0000-0000-0000-000000000002,,wave-2",
+			"ignored-name,,vm-3,wave-2",
+			"db,,,wave-3",
+			"missing,,,wave-1",
+			"web-2,,,",
+		}, "\n")
+
+		report, err := srv.ImportLabels(ctx, strings.NewReader(file), true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Applied).To(BeFalse())
+		Expect(report.Matched).To(Equal([]models.VMImportRow{
+			{Row: 2, Key: "web-1", KeyType: models.VMImportKeyName, VMIDs: []string{"vm-1"}, Labels: []string{"wave-1", "owner-alice"}},
+			{Row: 3, Key: "4201aaaa-0000-0000-0000-000000000002", KeyType: models.VMImportKeyUUID, VMIDs: []string{"vm-2"}, Labels: []string{"wave-2"}},
+			{Row: 4, Key: "vm-3", KeyType: models.VMImportKeyID, VMIDs: []string{"vm-3"}, Labels: []string{"wave-2"}},
+		}))
+		Expect(report.Ambiguous).To(HaveLen(1))
+		Expect(report.Ambiguous[0].VMIDs).To(ConsistOf("vm-3", "vm-4"))
+		Expect(report.Unmatched).To(HaveLen(1))
+		Expect(report.Unmatched[0].Row).To(Equal(6))
+		Expect(vmLabels()).To(BeEmpty())
+
+		report, err = srv.ImportLabels(ctx, strings.NewReader(file), false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Applied).To(BeTrue())
+		labels := vmLabels()
+		Expect(labels).To(HaveLen(3))
+		Expect(labels["vm-1"]).To(ConsistOf("wave-1", "owner-alice"))
+		Expect(labels["vm-2"]).To(ConsistOf("wave-2"))
+		Expect(labels["vm-3"]).To(ConsistOf("wave-2"))
+	})
+
+	It("rejects files without the needed columns or with invalid values", func() {
+		_, err := srv.ImportLabels(ctx, strings.NewReader("VM,Owner\nweb-1,alice"), true)
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+
+		_, err = srv.ImportLabels(ctx, strings.NewReader("Owner,Labels\nalice,wave-1"), true)
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+
+		_, err = srv.ImportLabels(ctx, strings.NewReader("VM,Labels\nweb-1,"+v2.LabelNew), true)
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+
+		_, err = srv.ImportMigrationExclusion(ctx, strings.NewReader("VM,Migration Excluded\nweb-1,maybe"), true)
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("exports labels and exclusions in the format it imports", func() {
+		Expect(st.VM().AddLabel(ctx, "vm-1", "-risky")).To(Succeed())
+		Expect(st.VM().UpdateMigrationExcluded(ctx, "vm-2", true)).To(Succeed())
+
+		var buf bytes.Buffer
+		Expect(srv.ExportLabels(ctx, v2.VMFileCSV, &buf)).To(Succeed())
+		Expect(buf.String()).To(HavePrefix("VM ID,VM UUID,VM,Labels\n"))
+		Expect(buf.String()).To(ContainSubstring("vm-1,4201AAAA-0000-0000-0000-000000000001,web-1,'-risky\n"))
+
+		report, err := srv.ImportLabels(ctx, &buf, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Matched).To(HaveLen(1))
+		Expect(report.Matched[0].Labels).To(Equal([]string{"-risky"}))
+
+		buf.Reset()
+		Expect(srv.ExportMigrationExclusion(ctx, v2.VMFileXLSX, &buf)).To(Succeed())
+
+		report, err = srv.ImportMigrationExclusion(ctx, &buf, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Matched).To(HaveLen(4))
+		excluded := map[string]bool{}
+		for _, row := range report.Matched {
+			excluded[row.Key] = *row.MigrationExcluded
+		}
+		Expect(excluded).To(Equal(map[string]bool{"vm-1": false, "vm-2": true, "vm-3": false, "vm-4": false}))
+
+		Expect(srvErrors.IsValidationError(srv.ExportLabels(ctx, "pdf", &buf))).To(BeTrue())
+	})
+
+	It("round-trips the manual labels, leaving out system and rule labels", func() {
+		Expect(st.VM().AddLabel(ctx, "vm-1", "owner-alice")).To(Succeed())
+		Expect(st.VM().AddLabelBatch(ctx, []string{"vm-1", "vm-2"}, v2.LabelNew)).To(Succeed())
+		Expect(st.VM().ApplyRuleLabels(ctx, []models.VMRuleLabel{{VMID: "vm-2", Label: "tier-1", Rule: "prod"}}, nil)).To(Succeed())
+
+		var buf bytes.Buffer
+		Expect(srv.ExportLabels(ctx, v2.VMFileCSV, &buf)).To(Succeed())
+		Expect(buf.String()).NotTo(ContainSubstring(v2.LabelNew))
+		Expect(buf.String()).NotTo(ContainSubstring("tier-1"))
+
+		report, err := srv.ImportLabels(ctx, &buf, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Applied).To(BeTrue())
+		Expect(report.Matched).To(HaveLen(1))
+		Expect(report.Matched[0].Labels).To(Equal([]string{"owner-alice"}))
+
+		labels := vmLabels()
+		Expect(labels["vm-1"]).To(ConsistOf("owner-alice", v2.LabelNew))
+		Expect(labels["vm-2"]).To(ConsistOf("tier-1", v2.LabelNew))
+		ruleLabels, err := st.VM().ListRuleLabels(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleLabels).To(Equal([]models.VMRuleLabel{{VMID: "vm-2", Label: "tier-1", Rule: "prod"}}))
+	})
+})
@@ -0,0 +1,166 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+// WasteService finds the VMs of the latest collection to retire rather than migrate.
+//
+// An analysis classifies every VM, templates aside, as idle, powered off for long, orphaned or
+// oversized from its inventory, its lifecycle and its utilization in the latest rightsizing
+// report, under the thresholds configured in the main database. Oversized VMs are those whose
+// recommendation under the default rightsizing policy saves enough of their resources. The
+// analysis is stored in the collection with the evidence of every class, where the filter DSL
+// (waste.class) and the "waste" export scope read it.
+type WasteService struct {
+	pool           *store.Pool
+	recommendation *RecommendationService
+}
+
+func NewWasteService(pool *store.Pool, recommendation *RecommendationService) *WasteService {
+	return &WasteService{pool: pool, recommendation: recommendation}
+}
+
+// GetThresholds returns the thresholds of the analysis.
+func (s *WasteService) GetThresholds(ctx context.Context) (*models.WasteThresholds, error) {
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	return st.WasteThresholds().Get(ctx)
+}
+
+// UpdateThresholds validates and replaces the thresholds of the analysis. They apply from the
+// next analysis.
+func (s *WasteService) UpdateThresholds(ctx context.Context, t models.WasteThresholds) (*models.WasteThresholds, error) {
+	switch {
+	case t.IdleCPUP95Pct < 0 || t.IdleCPUP95Pct > 100:
+		return nil, srvErrors.NewValidationError("idle CPU p95 must be between 0 and 100")
+	case t.IdleNetP95KBps < 0:
+		return nil, srvErrors.NewValidationError("idle network p95 must not be negative")
+	case t.PoweredOffDays < 1:
+		return nil, srvErrors.NewValidationError("powered off days must be at least 1")
+	case t.OrphanedIOPSP95 < 0:
+		return nil, srvErrors.NewValidationError("orphaned IOPS p95 must not be negative")
+	case t.OversizedSavedPct <= 0 || t.OversizedSavedPct > 100:
+		return nil, srvErrors.NewValidationError("oversized saved percentage must be greater than 0 and at most 100")
+	}
+
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	if err := st.WasteThresholds().Update(ctx, t); err != nil {
+		return nil, err
+	}
+	return st.WasteThresholds().Get(ctx)
+}
+
+// Analyze classifies the VMs of the latest collection of a vCenter, or of any vCenter when
+// vcenter is empty, under the current thresholds and stores the analysis in place of the
+// previous one.
+func (s *WasteService) Analyze(ctx context.Context, now time.Time, vcenter string) (*models.WasteAnalysis, error) {
+	thresholds, err := s.GetThresholds(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := s.recommendation.GetPolicy(ctx, models.DefaultRightsizingPolicyName)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := s.latestStore(vcenter)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := st.Waste().ListCandidates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reportID, stats, err := st.RightSizing().ListLatestVMStats(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	recs := make(map[string]models.RightsizingRecommendation, len(stats))
+	for _, vm := range stats {
+		recs[vm.MOID] = policy.Recommend(vm)
+	}
+
+	analysis := &models.WasteAnalysis{
+		ReportID:   reportID,
+		Thresholds: *thresholds,
+		AnalyzedAt: now.UTC(),
+		VMs:        []models.VMWaste{},
+	}
+	for _, vm := range candidates {
+		var rec *models.RightsizingRecommendation
+		if r, ok := recs[vm.VMID]; ok {
+			rec = &r
+		}
+		if vm.PowerState == "poweredOff" && vm.PoweredOffSince == nil {
+			analysis.PoweredOffUnknown++
+		}
+		analysis.VMs = append(analysis.VMs, thresholds.Classify(vm, rec, now)...)
+	}
+	analysis.Tally()
+
+	if err := st.WithTx(ctx, func(txCtx context.Context) error {
+		return st.Waste().Save(txCtx, *analysis)
+	}); err != nil {
+		return nil, err
+	}
+	return analysis, nil
+}
+
+// GetLatest returns the analysis stored in the latest collection of a vCenter, or of any vCenter
+// when vcenter is empty. When class is not empty, only the VMs of that class are returned; the
+// summary always covers every class.
+func (s *WasteService) GetLatest(ctx context.Context, class models.WasteClass, vcenter string) (*models.WasteAnalysis, error) {
+	if class != "" && !class.IsValid() {
+		return nil, srvErrors.NewValidationError(fmt.Sprintf("invalid waste class %q: must be one of %v", class, models.WasteClasses))
+	}
+
+	st, err := s.latestStore(vcenter)
+	if err != nil {
+		return nil, err
+	}
+	analysis, err := st.Waste().Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	analysis.Tally()
+	if class == "" {
+		return analysis, nil
+	}
+
+	vms := make([]models.VMWaste, 0, len(analysis.VMs))
+	for _, vm := range analysis.VMs {
+		if vm.Class == class {
+			vms = append(vms, vm)
+		}
+	}
+	analysis.VMs = vms
+	return analysis, nil
+}
+
+func (s *WasteService) latestStore(vcenter string) (*store.Store2, error) {
+	db, err := latestCollection(s.pool, vcenter)
+	if err != nil {
+		return nil, err
+	}
+	return db.Store()
+}
+
+func (s *WasteService) mainStore() (*store.Store2, error) {
+	db, err := s.pool.Get(store.MainDatabaseID)
+	if err != nil {
+		return nil, err
+	}
+	return db.Store()
+}
@@ -0,0 +1,190 @@
+package v2_test
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	v2 "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+var _ = Describe("WasteService", func() {
+	var (
+		ctx    context.Context
+		pool   *store.Pool
+		tmpDir string
+		st     *store.Store2
+		srv    *v2.WasteService
+	)
+
+	now := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	classesOf := func(a *models.WasteAnalysis) map[string][]models.WasteClass {
+		classes := map[string][]models.WasteClass{}
+		for _, vm := range a.VMs {
+			classes[vm.Name] = append(classes[vm.Name], vm.Class)
+		}
+		return classes
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		tmpDir, err = os.MkdirTemp("", "waste-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		pool = newTestPool(tmpDir)
+
+		// web-1 is busy but oversized, idle-1 idle, ghost-1 idle without disk activity nor
+		// VMware Tools, old-1 powered off for 90 days and new-1 for 12; tpl-1 is a template.
+		// lost-1, created years ago, was never seen powered off by a collection: vSphere
+		// clears the boot time of powered off VMs, so its power-off time is unknown.
+		var db *store.Database
+		db, st = addTestCollection(pool, "col-1000", time.Unix(1000, 0))
+		db.VCenter = "vc-a"
+		for _, q := range []string{
+			`INSERT INTO vinfo ("VM ID", "VM", "Cluster", "Powerstate", "Template", "Memory", "CPUs", "OS according to the VMware Tools", "PowerOn", "Creation date")
+			 VALUES ('vm-1', 'web-1', 'prod', 'poweredOn', false, 16384, 8, 'Red Hat Enterprise Linux 9', '2026-01-01', '2023-01-01'),
+			        ('vm-2', 'idle-1', 'prod', 'poweredOn', false, 8192, 4, 'Red Hat Enterprise Linux 9', '2026-01-01', '2023-01-01'),
+			        ('vm-3', 'ghost-1', 'dev', 'poweredOn', false, 4096, 2, NULL, '2026-01-01', '2023-01-01'),
+			        ('vm-4', 'old-1', 'dev', 'poweredOff', false, 4096, 2, NULL, NULL, '2023-01-01'),
+			        ('vm-5', 'new-1', 'dev', 'poweredOff', false, 4096, 2, NULL, NULL, '2023-01-01'),
+			        ('vm-6', 'tpl-1', 'dev', 'poweredOff', true, 4096, 2, NULL, NULL, '2023-01-01'),
+			        ('vm-7', 'lost-1', 'dev', 'poweredOff', false, 2048, 1, NULL, NULL, '2023-01-01')`,
+			`INSERT INTO vm_powered_off (vm_id, since) VALUES ('vm-4', '2026-01-01'), ('vm-5', '2026-03-20')`,
+			`INSERT INTO vcpu ("VM ID", "Cores p/s") VALUES ('vm-1', 2), ('vm-2', 1), ('vm-3', 1)`,
+			`INSERT INTO rightsizing_reports (id, vcenter, interval_id, window_start, window_end, expected_sample_count, expected_batch_count, written_batch_count)
+			 VALUES ('report-1', 'vc', 300, '2026-01-01', '2026-01-08', 10, 1, 1)`,
+			`INSERT INTO rightsizing_metrics (report_id, vm_name, moid, metric_key, sample_count, average, p95, p99, max, latest)
+			 VALUES ('report-1', 'web-1', 'vm-1', 'cpu.usage.average', 10, 2000, 3000, 4000, 5000, 2000),
+			        ('report-1', 'web-1', 'vm-1', 'mem.consumed.average', 10, 3072000, 4096000, 5120000, 6144000, 3072000)`,
+			`INSERT INTO rightsizing_vm_utilization (report_id, moid, vm_name, cpu_p95_pct, net_p95_kbps, iops_p95)
+			 VALUES ('report-1', 'vm-1', 'web-1', 40, 500, 120),
+			        ('report-1', 'vm-2', 'idle-1', 2, 0.5, 30),
+			        ('report-1', 'vm-3', 'ghost-1', 3, 0, 0)`,
+		} {
+			_, err = st.Querier().ExecContext(ctx, q)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		srv = v2.NewWasteService(pool, v2.NewRecommendationService(pool))
+	})
+
+	AfterEach(func() {
+		pool.Close()
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	It("manages the thresholds", func() {
+		t, err := srv.GetThresholds(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*t).To(Equal(models.DefaultWasteThresholds))
+
+		for _, bad := range []models.WasteThresholds{
+			{IdleCPUP95Pct: 101, PoweredOffDays: 30, OversizedSavedPct: 50},
+			{IdleCPUP95Pct: 5, IdleNetP95KBps: -1, PoweredOffDays: 30, OversizedSavedPct: 50},
+			{IdleCPUP95Pct: 5, PoweredOffDays: 0, OversizedSavedPct: 50},
+			{IdleCPUP95Pct: 5, PoweredOffDays: 30, OrphanedIOPSP95: -1, OversizedSavedPct: 50},
+			{IdleCPUP95Pct: 5, PoweredOffDays: 30, OversizedSavedPct: 0},
+		} {
+			_, err = srv.UpdateThresholds(ctx, bad)
+			Expect(srvErrors.IsValidationError(err)).To(BeTrue(), "%+v", bad)
+		}
+
+		updated := models.WasteThresholds{IdleCPUP95Pct: 10, IdleNetP95KBps: 2, PoweredOffDays: 7, OrphanedIOPSP95: 5, OversizedSavedPct: 25}
+		t, err = srv.UpdateThresholds(ctx, updated)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(t.UpdatedAt).NotTo(BeNil())
+		t.UpdatedAt = nil
+		Expect(*t).To(Equal(updated))
+	})
+
+	It("returns a not found error before the first analysis", func() {
+		_, err := srv.GetLatest(ctx, "", "")
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+	})
+
+	It("classifies the VMs with their evidence", func() {
+		a, err := srv.Analyze(ctx, now, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(a.ReportID).To(Equal("report-1"))
+		Expect(a.Thresholds).To(Equal(models.DefaultWasteThresholds))
+		Expect(a.PoweredOffUnknown).To(Equal(1))
+		Expect(classesOf(a)).To(Equal(map[string][]models.WasteClass{
+			"web-1":   {models.WasteOversized},
+			"idle-1":  {models.WasteIdle},
+			"ghost-1": {models.WasteIdle, models.WasteOrphaned},
+			"old-1":   {models.WastePoweredOff},
+		}))
+		Expect(a.Summary).To(Equal([]models.WasteClassSummary{
+			{Class: models.WasteIdle, VMCount: 2, ProvisionedCPUs: 6, ProvisionedMemoryMB: 12288},
+			{Class: models.WastePoweredOff, VMCount: 1, ProvisionedCPUs: 2, ProvisionedMemoryMB: 4096},
+			{Class: models.WasteOrphaned, VMCount: 1, ProvisionedCPUs: 2, ProvisionedMemoryMB: 4096},
+			{Class: models.WasteOversized, VMCount: 1, ProvisionedCPUs: 8, ProvisionedMemoryMB: 16384},
+		}))
+
+		latest, err := srv.GetLatest(ctx, models.WastePoweredOff, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(latest.AnalyzedAt).To(Equal(now))
+		Expect(latest.PoweredOffUnknown).To(Equal(1))
+		Expect(latest.Summary).To(Equal(a.Summary))
+		Expect(latest.VMs).To(HaveLen(1))
+		Expect(latest.VMs[0].Evidence).To(Equal(map[string]any{
+			"powered_off_since":    "2026-01-01T00:00:00Z",
+			"days_since":           90.0,
+			"min_powered_off_days": 30.0,
+		}))
+
+		latest, err = srv.GetLatest(ctx, models.WasteOversized, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(latest.VMs).To(HaveLen(1))
+		Expect(latest.VMs[0].Evidence).To(HaveKeyWithValue("recommended_cpus", 4.0))
+		Expect(latest.VMs[0].Evidence).To(HaveKeyWithValue("saved_cpus_pct", 50.0))
+
+		_, err = srv.GetLatest(ctx, "unused", "")
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("classifies under the configured thresholds", func() {
+		_, err := srv.UpdateThresholds(ctx, models.WasteThresholds{
+			IdleCPUP95Pct: 2.5, IdleNetP95KBps: 1, PoweredOffDays: 10, OrphanedIOPSP95: 1, OversizedSavedPct: 80,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		a, err := srv.Analyze(ctx, now, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(classesOf(a)).To(Equal(map[string][]models.WasteClass{
+			"idle-1":  {models.WasteIdle},
+			"ghost-1": {models.WasteOrphaned},
+			"old-1":   {models.WastePoweredOff},
+			"new-1":   {models.WastePoweredOff},
+		}))
+	})
+
+	It("analyzes the latest collection of the given vCenter", func() {
+		// a newer collection of another vCenter, with a single powered on VM
+		db, other := addTestCollection(pool, "col-2000", time.Unix(2000, 0))
+		db.VCenter = "vc-b"
+		insertSyncTestVM(ctx, other, "vm-9", "other-1")
+
+		a, err := srv.Analyze(ctx, now, "vc-a")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(classesOf(a)).To(HaveKey("old-1"))
+
+		latest, err := srv.GetLatest(ctx, "", "vc-a")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(latest.VMs).To(HaveLen(len(a.VMs)))
+
+		_, err = srv.GetLatest(ctx, "", "")
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+
+		_, err = srv.Analyze(ctx, now, "vc-c")
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+	})
+})
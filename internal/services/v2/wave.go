@@ -0,0 +1,336 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+// waveNameRe is the syntax of wave names.
+var waveNameRe = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// WaveService manages migration waves and plans them against the latest collection of a
+// vCenter.
+//
+// Waves live in the main database and reference groups by ID and VMs by ID, both of which
+// are kept from one collection of a vCenter to the next. The planner resolves them against
+// the latest collection of the vCenter, flags the VMs planned in several waves and the VMs
+// that cannot be migrated, and estimates the transfer of each wave from the benchmark runs of
+// its forecast pair.
+type WaveService struct {
+	pool       *store.Pool
+	forecaster *ForecasterService
+}
+
+func NewWaveService(pool *store.Pool, forecaster *ForecasterService) *WaveService {
+	return &WaveService{pool: pool, forecaster: forecaster}
+}
+
+// List returns all waves in order.
+func (s *WaveService) List(ctx context.Context) ([]models.Wave, error) {
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	return st.Wave().List(ctx)
+}
+
+// Get returns a wave by name.
+func (s *WaveService) Get(ctx context.Context, name string) (*models.Wave, error) {
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	return st.Wave().Get(ctx, name)
+}
+
+// Create validates and stores a new wave.
+func (s *WaveService) Create(ctx context.Context, w models.Wave) (*models.Wave, error) {
+	if !waveNameRe.MatchString(w.Name) {
+		return nil, srvErrors.NewValidationError(fmt.Sprintf("invalid wave name %q: use letters, digits, '_' and '-'", w.Name))
+	}
+	if err := validateWave(w); err != nil {
+		return nil, err
+	}
+
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+	return st.Wave().Create(ctx, w)
+}
+
+// Update applies a partial update to a wave.
+func (s *WaveService) Update(ctx context.Context, name string, update models.WaveUpdate) (*models.Wave, error) {
+	st, err := s.mainStore()
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := st.Wave().Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if update.Description != nil {
+		w.Description = *update.Description
+	}
+	if update.Position != nil {
+		w.Position = *update.Position
+	}
+	if update.WindowStart != nil {
+		w.WindowStart = update.WindowStart
+	}
+	if update.WindowEnd != nil {
+		w.WindowEnd = update.WindowEnd
+	}
+	if update.ForecastPair != nil {
+		w.ForecastPair = *update.ForecastPair
+	}
+	if update.GroupIDs != nil {
+		w.GroupIDs = *update.GroupIDs
+	}
+	if update.VMIDs != nil {
+		w.VMIDs = *update.VMIDs
+	}
+	if err := validateWave(*w); err != nil {
+		return nil, err
+	}
+
+	return st.Wave().Update(ctx, *w)
+}
+
+// Delete removes a wave.
+func (s *WaveService) Delete(ctx context.Context, name string) error {
+	st, err := s.mainStore()
+	if err != nil {
+		return err
+	}
+	return st.Wave().Delete(ctx, name)
+}
+
+// Plan plans every wave against the latest collection of a vCenter, or the latest collection
+// of any vCenter when vcenter is empty. Waves should be planned against the vCenter their VMs
+// and groups belong to, or they are reported missing. Each wave is summarized by the
+// readiness of its VMs, its total disk and the estimated duration of its transfer, along
+// with the issues found:
+//   - a VM planned in several waves is flagged in each of them;
+//   - excluded VMs and VMs with critical concerns are flagged, and the disk of excluded VMs
+//     is not transferred;
+//   - groups and VMs missing from the collection are flagged;
+//   - a wave whose disk does not fit its window at the expected throughput of its forecast
+//     pair is flagged, as is a wave whose transfer cannot be estimated.
+func (s *WaveService) Plan(ctx context.Context, vcenter string) (*models.WavePlan, error) {
+	waves, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := latestCollection(s.pool, vcenter)
+	if err != nil {
+		return nil, err
+	}
+	st, err := db.Store()
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := st.Group().List(ctx, nil, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("listing groups: %w", err)
+	}
+	groupIDs := make(map[uuid.UUID]bool, len(groups))
+	for _, g := range groups {
+		groupIDs[g.ID] = true
+	}
+
+	plan := &models.WavePlan{CollectionID: db.ID, Waves: make([]models.WaveSummary, 0, len(waves))}
+
+	// resolve the VMs of each wave and the waves of each VM
+	waveVMs := make([][]string, len(waves))
+	vmWaves := make(map[string][]string)
+	var allIDs []string
+	for i, w := range waves {
+		summary := models.WaveSummary{Wave: w, Issues: []models.WaveIssue{}}
+		seen := make(map[string]bool)
+		add := func(id string) {
+			if seen[id] {
+				return
+			}
+			seen[id] = true
+			waveVMs[i] = append(waveVMs[i], id)
+			vmWaves[id] = append(vmWaves[id], w.Name)
+			allIDs = append(allIDs, id)
+		}
+
+		for _, gid := range w.GroupIDs {
+			if !groupIDs[gid] {
+				summary.Issues = append(summary.Issues, models.WaveIssue{
+					Kind:    models.WaveIssueMissingGroup,
+					Message: fmt.Sprintf("group %s is not in collection %s", gid, db.ID),
+					GroupID: &gid,
+				})
+				continue
+			}
+			ids, err := st.Group().GetMatchedIDs(ctx, gid)
+			if err != nil {
+				return nil, fmt.Errorf("getting matched IDs for group %s: %w", gid, err)
+			}
+			for _, id := range ids {
+				add(id)
+			}
+		}
+		for _, id := range w.VMIDs {
+			add(id)
+		}
+		plan.Waves = append(plan.Waves, summary)
+	}
+
+	vms := make(map[string]models.VirtualMachineSummary)
+	if len(allIDs) > 0 {
+		list, err := st.VM().List(ctx, nil, store.WithVMIDs(deduplicateStrings(allIDs)))
+		if err != nil {
+			return nil, fmt.Errorf("listing wave VMs: %w", err)
+		}
+		for _, vm := range list {
+			vms[vm.ID] = vm
+		}
+	}
+
+	for i := range plan.Waves {
+		summary := &plan.Waves[i]
+		for _, id := range waveVMs[i] {
+			vm, ok := vms[id]
+			if !ok {
+				summary.Issues = append(summary.Issues, models.WaveIssue{
+					Kind:    models.WaveIssueMissingVM,
+					Message: fmt.Sprintf("VM %s is not in collection %s", id, db.ID),
+					VMID:    id,
+				})
+				continue
+			}
+			summary.VMCount++
+
+			if others := otherWaves(vmWaves[id], summary.Wave.Name); len(others) > 0 {
+				summary.Issues = append(summary.Issues, models.WaveIssue{
+					Kind:      models.WaveIssueDuplicateVM,
+					Message:   fmt.Sprintf("VM %s (%s) is also planned in wave %s", vm.Name, id, others[0]),
+					VMID:      id,
+					OtherWave: others[0],
+				})
+			}
+
+			switch {
+			case vm.MigrationExcluded:
+				summary.Readiness.Excluded++
+				summary.Issues = append(summary.Issues, models.WaveIssue{
+					Kind:    models.WaveIssueExcludedVM,
+					Message: fmt.Sprintf("VM %s (%s) is excluded from migration", vm.Name, id),
+					VMID:    id,
+				})
+				continue
+			case !vm.IsMigratable:
+				summary.Readiness.Blocked++
+				summary.Issues = append(summary.Issues, models.WaveIssue{
+					Kind:    models.WaveIssueNotMigratableVM,
+					Message: fmt.Sprintf("VM %s (%s) has critical concerns", vm.Name, id),
+					VMID:    id,
+				})
+			case vm.IssueCount > 0:
+				summary.Readiness.Review++
+			default:
+				summary.Readiness.Ready++
+			}
+			summary.DiskMB += vm.DiskSize
+		}
+
+		if err := s.estimate(ctx, summary); err != nil {
+			return nil, err
+		}
+	}
+
+	return plan, nil
+}
+
+// estimate sets the transfer estimate and window capacity of a wave from the forecast
+// stats of its pair, and flags the wave when they are missing or its disk does not fit.
+func (s *WaveService) estimate(ctx context.Context, summary *models.WaveSummary) error {
+	w := summary.Wave
+	if w.ForecastPair == "" {
+		summary.Issues = append(summary.Issues, models.WaveIssue{
+			Kind:    models.WaveIssueNoForecast,
+			Message: "wave has no forecast pair",
+		})
+		return nil
+	}
+
+	stats, err := s.forecaster.GetStats(ctx, w.ForecastPair)
+	if err != nil {
+		if srvErrors.IsResourceNotFoundError(err) {
+			summary.Issues = append(summary.Issues, models.WaveIssue{
+				Kind:    models.WaveIssueNoForecast,
+				Message: fmt.Sprintf("forecast pair %s has no successful benchmark runs", w.ForecastPair),
+			})
+			return nil
+		}
+		return fmt.Errorf("getting forecast stats of pair %s: %w", w.ForecastPair, err)
+	}
+
+	scale := float64(summary.DiskMB) / oneTBinMB
+	summary.Transfer = &models.EstimateRange{
+		BestCase:  time.Duration(float64(stats.EstPer1TB.BestCase) * scale),
+		Expected:  time.Duration(float64(stats.EstPer1TB.Expected) * scale),
+		WorstCase: time.Duration(float64(stats.EstPer1TB.WorstCase) * scale),
+	}
+
+	if w.WindowStart == nil || w.WindowEnd == nil || stats.EstPer1TB.Expected <= 0 {
+		return nil
+	}
+	window := w.WindowEnd.Sub(*w.WindowStart)
+	capacity := int64(float64(window) / float64(stats.EstPer1TB.Expected) * oneTBinMB)
+	summary.WindowCapacityMB = &capacity
+	if summary.DiskMB > capacity {
+		summary.Issues = append(summary.Issues, models.WaveIssue{
+			Kind: models.WaveIssueExceedsWindow,
+			Message: fmt.Sprintf("%d MB of disk takes %s at the expected throughput, longer than the %s window",
+				summary.DiskMB, summary.Transfer.Expected.Round(time.Minute), window),
+		})
+	}
+	return nil
+}
+
+func (s *WaveService) mainStore() (*store.Store2, error) {
+	db, err := s.pool.Get(store.MainDatabaseID)
+	if err != nil {
+		return nil, err
+	}
+	return db.Store()
+}
+
+// validateWave checks the window of a wave.
+func validateWave(w models.Wave) error {
+	if (w.WindowStart == nil) != (w.WindowEnd == nil) {
+		return srvErrors.NewValidationError("wave window needs both a start and an end")
+	}
+	if w.WindowStart != nil && !w.WindowEnd.After(*w.WindowStart) {
+		return srvErrors.NewValidationError("wave window must end after it starts")
+	}
+	return nil
+}
+
+// otherWaves returns the waves other than wave.
+func otherWaves(waves []string, wave string) []string {
+	var others []string
+	for _, w := range waves {
+		if w != wave {
+			others = append(others, w)
+		}
+	}
+	return others
+}
@@ -0,0 +1,179 @@
+package v2_test
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	v2 "github.com/kubev2v/assisted-migration-agent/internal/services/v2"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+var _ = Describe("WaveService", func() {
+	var (
+		ctx    context.Context
+		pool   *store.Pool
+		tmpDir string
+		srv    *v2.WaveService
+	)
+
+	// addCollection registers a collection of vCenter vc-a with a 1 TiB VM, an excluded VM and
+	// a VM with a critical concern.
+	addCollection := func() {
+		db, st := addTestCollection(pool, "col-1000", time.Unix(1000, 0))
+		db.VCenter = "vc-a"
+
+		for _, q := range []string{
+			`INSERT INTO vinfo ("VM ID", "VM", "Cluster", "Powerstate", "Template", "Memory", "CPUs")
+			 VALUES ('vm-1', 'web-1', 'prod', 'poweredOn', false, 8192, 4),
+			        ('vm-2', 'web-2', 'prod', 'poweredOff', false, 4096, 2),
+			        ('vm-3', 'db-1', 'dev', 'poweredOn', false, 16384, 8)`,
+			`INSERT INTO vdisk ("VM ID", "Path", "Capacity MiB")
+			 VALUES ('vm-1', '[ds-1] vm-1/vm-1.vmdk', 1048576),
+			        ('vm-2', '[ds-1] vm-2/vm-2.vmdk', 4096),
+			        ('vm-3', '[ds-1] vm-3/vm-3.vmdk', 2048)`,
+			`INSERT INTO concerns ("VM_ID", "Concern_ID", "Label", "Category", "Assessment")
+			 VALUES ('vm-3', 'concern-vm-3', 'Critical issue', 'Critical', 'Must fix before migration')`,
+			`UPDATE vinfo SET migration_excluded = true WHERE "VM ID" = 'vm-2'`,
+		} {
+			_, err := st.Querier().ExecContext(ctx, q)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		}
+	}
+
+	timePtr := func(t time.Time) *time.Time { return &t }
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		tmpDir, err = os.MkdirTemp("", "wave-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		pool = newTestPool(tmpDir)
+
+		srv = v2.NewWaveService(pool, v2.NewForecasterService(pool, nil))
+	})
+
+	AfterEach(func() {
+		pool.Close()
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	It("rejects invalid names and windows", func() {
+		_, err := srv.Create(ctx, models.Wave{Name: "wave 1"})
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+
+		start := time.Date(2026, 3, 1, 22, 0, 0, 0, time.UTC)
+		_, err = srv.Create(ctx, models.Wave{Name: "wave-1", WindowStart: &start})
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+
+		_, err = srv.Create(ctx, models.Wave{Name: "wave-1", WindowStart: &start, WindowEnd: timePtr(start.Add(-time.Hour))})
+		Expect(srvErrors.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("reports the readiness, transfer and issues of each wave", func() {
+		addCollection()
+
+		main, err := pool.Get(store.MainDatabaseID)
+		Expect(err).NotTo(HaveOccurred())
+		mainStore, err := main.Store()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mainStore.Forecast().InsertRun(ctx, models.BenchmarkRun{
+			SessionID: 1, PairName: "ds-1-to-ds-2", SourceDS: "ds-1", TargetDS: "ds-2",
+			Iteration: 1, DiskSizeGB: 10, DurationSec: 100, ThroughputMBps: 100, Method: "copy",
+		})).To(Succeed())
+
+		start := time.Date(2026, 3, 1, 22, 0, 0, 0, time.UTC)
+		missingGroup := uuid.New()
+		_, err = srv.Create(ctx, models.Wave{
+			Name:         "wave-1",
+			Position:     1,
+			WindowStart:  &start,
+			WindowEnd:    timePtr(start.Add(time.Hour)),
+			ForecastPair: "ds-1-to-ds-2",
+			VMIDs:        []string{"vm-1", "vm-2", "vm-gone"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = srv.Create(ctx, models.Wave{
+			Name:     "wave-2",
+			Position: 2,
+			GroupIDs: []uuid.UUID{missingGroup},
+			VMIDs:    []string{"vm-1", "vm-3"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		plan, err := srv.Plan(ctx, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.CollectionID).To(Equal("col-1000"))
+		Expect(plan.Waves).To(HaveLen(2))
+
+		kinds := func(s models.WaveSummary) []models.WaveIssueKind {
+			var out []models.WaveIssueKind
+			for _, i := range s.Issues {
+				out = append(out, i.Kind)
+			}
+			return out
+		}
+
+		first := plan.Waves[0]
+		Expect(first.Wave.Name).To(Equal("wave-1"))
+		Expect(first.VMCount).To(Equal(2))
+		Expect(first.DiskMB).To(Equal(int64(1048576)))
+		Expect(first.Readiness).To(Equal(models.WaveReadiness{Ready: 1, Excluded: 1}))
+		Expect(first.Transfer).NotTo(BeNil())
+		Expect(first.Transfer.Expected).To(BeNumerically("~", 10485760*time.Millisecond, time.Second))
+		Expect(first.WindowCapacityMB).NotTo(BeNil())
+		Expect(*first.WindowCapacityMB).To(BeNumerically("~", 360000, 1))
+		Expect(kinds(first)).To(Equal([]models.WaveIssueKind{
+			models.WaveIssueDuplicateVM,
+			models.WaveIssueExcludedVM,
+			models.WaveIssueMissingVM,
+			models.WaveIssueExceedsWindow,
+		}))
+		Expect(first.Issues[0].OtherWave).To(Equal("wave-2"))
+		Expect(first.Ready()).To(BeFalse())
+
+		second := plan.Waves[1]
+		Expect(second.VMCount).To(Equal(2))
+		Expect(second.DiskMB).To(Equal(int64(1048576 + 2048)))
+		Expect(second.Readiness).To(Equal(models.WaveReadiness{Ready: 1, Blocked: 1}))
+		Expect(second.Transfer).To(BeNil())
+		Expect(kinds(second)).To(Equal([]models.WaveIssueKind{
+			models.WaveIssueMissingGroup,
+			models.WaveIssueDuplicateVM,
+			models.WaveIssueNotMigratableVM,
+			models.WaveIssueNoForecast,
+		}))
+		Expect(*second.Issues[0].GroupID).To(Equal(missingGroup))
+	})
+
+	It("plans against the latest collection of the given vCenter", func() {
+		addCollection()
+		other, _ := addTestCollection(pool, "col-2000", time.Unix(2000, 0))
+		other.VCenter = "vc-b"
+
+		_, err := srv.Create(ctx, models.Wave{Name: "wave-1", Position: 1, VMIDs: []string{"vm-1"}})
+		Expect(err).NotTo(HaveOccurred())
+
+		plan, err := srv.Plan(ctx, "vc-a")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.CollectionID).To(Equal("col-1000"))
+		Expect(plan.Waves[0].VMCount).To(Equal(1))
+		Expect(plan.Waves[0].Issues).NotTo(ContainElement(HaveField("Kind", models.WaveIssueMissingVM)))
+
+		// the newer collection of vc-b does not have the VMs of vc-a
+		plan, err = srv.Plan(ctx, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.CollectionID).To(Equal("col-2000"))
+		Expect(plan.Waves[0].Issues).To(ContainElement(HaveField("Kind", models.WaveIssueMissingVM)))
+
+		_, err = srv.Plan(ctx, "vc-c")
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+	})
+})
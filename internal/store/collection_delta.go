@@ -0,0 +1,92 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+)
+
+const (
+	collectionDeltaTable     = "collection_delta"
+	collectionDeltaColVMID   = "vm_id"
+	collectionDeltaColChange = "change"
+)
+
+// CollectionDeltaStore records which VMs an incremental collection changed.
+type CollectionDeltaStore struct {
+	db QueryInterceptor
+}
+
+func NewCollectionDeltaStore(db QueryInterceptor) *CollectionDeltaStore {
+	return &CollectionDeltaStore{db: db}
+}
+
+// Get returns the recorded delta. It is empty for full collections.
+func (s *CollectionDeltaStore) Get(ctx context.Context) (models.CollectionDelta, error) {
+	query, args, err := sq.Select(collectionDeltaColVMID, collectionDeltaColChange).
+		From(collectionDeltaTable).
+		OrderBy(collectionDeltaColVMID).
+		ToSql()
+	if err != nil {
+		return models.CollectionDelta{}, fmt.Errorf("building get collection delta query: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return models.CollectionDelta{}, fmt.Errorf("querying collection delta: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var delta models.CollectionDelta
+	for rows.Next() {
+		var id, change string
+		if err := rows.Scan(&id, &change); err != nil {
+			return models.CollectionDelta{}, fmt.Errorf("scanning collection delta: %w", err)
+		}
+		switch models.VMChangeKind(change) {
+		case models.VMChangeAdded:
+			delta.Added = append(delta.Added, id)
+		case models.VMChangeModified:
+			delta.Modified = append(delta.Modified, id)
+		case models.VMChangeRemoved:
+			delta.Removed = append(delta.Removed, id)
+		}
+	}
+
+	return delta, rows.Err()
+}
+
+// Save replaces the recorded delta. A collection cloned from an incremental one
+// inherits its delta, so the previous rows are always dropped first.
+func (s *CollectionDeltaStore) Save(ctx context.Context, delta models.CollectionDelta) error {
+	if _, err := s.db.ExecContext(ctx, "DELETE FROM "+collectionDeltaTable); err != nil {
+		return fmt.Errorf("clearing collection delta: %w", err)
+	}
+
+	if len(delta.All()) == 0 {
+		return nil
+	}
+
+	builder := sq.Insert(collectionDeltaTable).Columns(collectionDeltaColVMID, collectionDeltaColChange)
+	for _, id := range delta.Added {
+		builder = builder.Values(id, string(models.VMChangeAdded))
+	}
+	for _, id := range delta.Modified {
+		builder = builder.Values(id, string(models.VMChangeModified))
+	}
+	for _, id := range delta.Removed {
+		builder = builder.Values(id, string(models.VMChangeRemoved))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("building save collection delta query: %w", err)
+	}
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("saving collection delta: %w", err)
+	}
+	return nil
+}
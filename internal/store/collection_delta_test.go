@@ -0,0 +1,72 @@
+package store_test
+
+import (
+	"context"
+	"database/sql"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	"github.com/kubev2v/assisted-migration-agent/test"
+)
+
+var _ = Describe("CollectionDeltaStore", func() {
+	var (
+		ctx context.Context
+		s   *store.Store
+		db  *sql.DB
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		var err error
+		db, err = store.NewConnection(nil, ":memory:")
+		Expect(err).NotTo(HaveOccurred())
+
+		s = store.NewStore(db, test.NewMockValidator())
+		Expect(s.InitCollection(ctx)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		if db != nil {
+			_ = db.Close()
+		}
+	})
+
+	// Given a freshly migrated collection database
+	// When we read its delta
+	// Then it should be empty
+	It("should be empty for a full collection", func() {
+		// Act
+		delta, err := s.CollectionDelta().Get(ctx)
+
+		// Assert
+		Expect(err).NotTo(HaveOccurred())
+		Expect(delta.All()).To(BeEmpty())
+	})
+
+	// Given a delta inherited from the collection a database was cloned from
+	// When a new delta is saved
+	// Then only the new delta should be returned
+	It("should replace the previous delta on save", func() {
+		// Arrange
+		Expect(s.CollectionDelta().Save(ctx, models.CollectionDelta{Added: []string{"vm-old"}})).To(Succeed())
+
+		// Act
+		Expect(s.CollectionDelta().Save(ctx, models.CollectionDelta{
+			Added:    []string{"vm-1"},
+			Modified: []string{"vm-2"},
+			Removed:  []string{"vm-3"},
+		})).To(Succeed())
+
+		// Assert
+		delta, err := s.CollectionDelta().Get(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(delta.Added).To(Equal([]string{"vm-1"}))
+		Expect(delta.Modified).To(Equal([]string{"vm-2"}))
+		Expect(delta.Removed).To(Equal([]string{"vm-3"}))
+	})
+})
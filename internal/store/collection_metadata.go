@@ -0,0 +1,161 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+)
+
+const (
+	collectionMetadataTable = "agent.main.collection_metadata"
+
+	colCollectionMetadataDatabase    = `"database"`
+	colCollectionMetadataDisplayName = "display_name"
+	colCollectionMetadataNotes       = "notes"
+	colCollectionMetadataPinned      = "pinned"
+	colCollectionMetadataUpdatedAt   = "updated_at"
+)
+
+var collectionMetadataSelectColumns = []string{
+	colCollectionMetadataDatabase,
+	colCollectionMetadataDisplayName,
+	colCollectionMetadataNotes,
+	colCollectionMetadataPinned,
+	colCollectionMetadataUpdatedAt,
+}
+
+// CollectionMetadataStore persists the user metadata of collection databases
+// (display name, notes, pinned flag) in the main database.
+type CollectionMetadataStore struct {
+	db QueryInterceptor
+}
+
+func NewCollectionMetadataStore(db QueryInterceptor) *CollectionMetadataStore {
+	return &CollectionMetadataStore{db: db}
+}
+
+// List returns the metadata of every collection that has some, keyed by database name.
+func (s *CollectionMetadataStore) List(ctx context.Context) (map[string]models.CollectionMetadata, error) {
+	query, args, err := sq.Select(collectionMetadataSelectColumns...).
+		From(collectionMetadataTable).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building list collection metadata query: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying collection metadata: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	metadata := make(map[string]models.CollectionMetadata)
+	for rows.Next() {
+		m, err := scanCollectionMetadata(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning collection metadata: %w", err)
+		}
+		metadata[m.Database] = *m
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating collection metadata rows: %w", err)
+	}
+	return metadata, nil
+}
+
+// Get returns the metadata of a collection database. A collection without metadata
+// gets zero values, not an error.
+func (s *CollectionMetadataStore) Get(ctx context.Context, database string) (models.CollectionMetadata, error) {
+	query, args, err := sq.Select(collectionMetadataSelectColumns...).
+		From(collectionMetadataTable).
+		Where(sq.Eq{colCollectionMetadataDatabase: database}).
+		ToSql()
+	if err != nil {
+		return models.CollectionMetadata{}, fmt.Errorf("building get collection metadata query: %w", err)
+	}
+
+	m, err := scanCollectionMetadata(s.db.QueryRowContext(ctx, query, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.CollectionMetadata{Database: database}, nil
+	}
+	if err != nil {
+		return models.CollectionMetadata{}, fmt.Errorf("scanning collection metadata: %w", err)
+	}
+	return *m, nil
+}
+
+// Update applies a partial update to the metadata of a collection database, creating
+// it if needed, and returns the result.
+func (s *CollectionMetadataStore) Update(ctx context.Context, database string, upd models.CollectionMetadataUpdate) (models.CollectionMetadata, error) {
+	m, err := s.Get(ctx, database)
+	if err != nil {
+		return models.CollectionMetadata{}, err
+	}
+	if upd.DisplayName != nil {
+		m.DisplayName = *upd.DisplayName
+	}
+	if upd.Notes != nil {
+		m.Notes = *upd.Notes
+	}
+	if upd.Pinned != nil {
+		m.Pinned = *upd.Pinned
+	}
+	m.UpdatedAt = time.Now()
+
+	query, args, err := sq.Insert(collectionMetadataTable).
+		Columns(collectionMetadataSelectColumns...).
+		Values(
+			database,
+			sql.NullString{String: m.DisplayName, Valid: m.DisplayName != ""},
+			sql.NullString{String: m.Notes, Valid: m.Notes != ""},
+			m.Pinned,
+			m.UpdatedAt,
+		).
+		Suffix(`ON CONFLICT ("database") DO UPDATE SET display_name = EXCLUDED.display_name, notes = EXCLUDED.notes, pinned = EXCLUDED.pinned, updated_at = EXCLUDED.updated_at`).
+		ToSql()
+	if err != nil {
+		return models.CollectionMetadata{}, fmt.Errorf("building update collection metadata query: %w", err)
+	}
+
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return models.CollectionMetadata{}, fmt.Errorf("updating collection metadata: %w", err)
+	}
+	return m, nil
+}
+
+// Delete removes the metadata of a collection database. Deleting missing metadata is not an error.
+func (s *CollectionMetadataStore) Delete(ctx context.Context, database string) error {
+	query, args, err := sq.Delete(collectionMetadataTable).
+		Where(sq.Eq{colCollectionMetadataDatabase: database}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete collection metadata query: %w", err)
+	}
+
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("deleting collection metadata: %w", err)
+	}
+	return nil
+}
+
+// scanCollectionMetadata scans one row into a *models.CollectionMetadata.
+// Column order must match collectionMetadataSelectColumns exactly.
+func scanCollectionMetadata(row rowScanner) (*models.CollectionMetadata, error) {
+	var (
+		m           models.CollectionMetadata
+		displayName sql.NullString
+		notes       sql.NullString
+	)
+	if err := row.Scan(&m.Database, &displayName, &notes, &m.Pinned, &m.UpdatedAt); err != nil {
+		return nil, err
+	}
+	m.DisplayName = displayName.String
+	m.Notes = notes.String
+	return &m, nil
+}
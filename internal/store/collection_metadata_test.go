@@ -0,0 +1,103 @@
+package store_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	"github.com/kubev2v/assisted-migration-agent/test"
+)
+
+var _ = Describe("CollectionMetadataStore", func() {
+	var (
+		ctx    context.Context
+		s      *store.Store
+		db     *sql.DB
+		tmpDir string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		var err error
+		tmpDir, err = os.MkdirTemp("", "collection-metadata-store-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		db, err = store.NewConnection(nil, filepath.Join(tmpDir, "agent.duckdb"))
+		Expect(err).NotTo(HaveOccurred())
+
+		s = store.NewStore(db, test.NewMockValidator())
+		Expect(s.Migrate(ctx, "")).To(Succeed())
+	})
+
+	AfterEach(func() {
+		if db != nil {
+			_ = db.Close()
+		}
+		if tmpDir != "" {
+			_ = os.RemoveAll(tmpDir)
+		}
+	})
+
+	// Given a collection without metadata
+	// When we read its metadata
+	// Then zero values should be returned
+	It("should return empty metadata for an unknown collection", func() {
+		// Act
+		meta, err := s.CollectionMetadata().Get(ctx, "collection_1")
+
+		// Assert
+		Expect(err).NotTo(HaveOccurred())
+		Expect(meta.Database).To(Equal("collection_1"))
+		Expect(meta.DisplayName).To(BeEmpty())
+		Expect(meta.Pinned).To(BeFalse())
+	})
+
+	// Given a collection with a name and notes
+	// When we only pin it
+	// Then the name and notes should be kept
+	It("should apply partial updates", func() {
+		// Arrange
+		name, notes, pinned := "before remediation", "baseline for wave 1", true
+		_, err := s.CollectionMetadata().Update(ctx, "collection_1", models.CollectionMetadataUpdate{DisplayName: &name, Notes: &notes})
+		Expect(err).NotTo(HaveOccurred())
+
+		// Act
+		meta, err := s.CollectionMetadata().Update(ctx, "collection_1", models.CollectionMetadataUpdate{Pinned: &pinned})
+
+		// Assert
+		Expect(err).NotTo(HaveOccurred())
+		Expect(meta.DisplayName).To(Equal(name))
+		Expect(meta.Notes).To(Equal(notes))
+		Expect(meta.Pinned).To(BeTrue())
+
+		all, err := s.CollectionMetadata().List(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(all).To(HaveLen(1))
+		Expect(all["collection_1"].Pinned).To(BeTrue())
+	})
+
+	// Given a collection with metadata
+	// When we delete it
+	// Then it should no longer be listed
+	It("should delete metadata", func() {
+		// Arrange
+		pinned := true
+		_, err := s.CollectionMetadata().Update(ctx, "collection_1", models.CollectionMetadataUpdate{Pinned: &pinned})
+		Expect(err).NotTo(HaveOccurred())
+
+		// Act
+		Expect(s.CollectionMetadata().Delete(ctx, "collection_1")).To(Succeed())
+
+		// Assert
+		all, err := s.CollectionMetadata().List(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(all).To(BeEmpty())
+	})
+})
@@ -0,0 +1,90 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+const (
+	collectionSourceTable       = "collection_source"
+	collectionSourceColID       = "id"
+	collectionSourceColVCenter  = "vcenter"
+	collectionSourceColURL      = "url"
+	collectionSourceColMode     = "mode"
+	collectionSourceColImported = "imported"
+	collectionSourceColSigner   = "signer"
+)
+
+// CollectionSourceStore reads and writes the source vCenter of a collection database.
+type CollectionSourceStore struct {
+	db QueryInterceptor
+}
+
+func NewCollectionSourceStore(db QueryInterceptor) *CollectionSourceStore {
+	return &CollectionSourceStore{db: db}
+}
+
+// Get returns the source vCenter of the collection.
+func (s *CollectionSourceStore) Get(ctx context.Context) (*models.CollectionSource, error) {
+	query, args, err := sq.Select(collectionSourceColVCenter, collectionSourceColURL, collectionSourceColMode, collectionSourceColImported, collectionSourceColSigner).
+		From(collectionSourceTable).
+		Where(sq.Eq{collectionSourceColID: 1}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building get collection source query: %w", err)
+	}
+
+	var (
+		src      models.CollectionSource
+		url      sql.NullString
+		mode     sql.NullString
+		imported sql.NullBool
+		signer   sql.NullString
+	)
+	err = s.db.QueryRowContext(ctx, query, args...).Scan(&src.VCenter, &url, &mode, &imported, &signer)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, srvErrors.NewResourceNotFoundError("collection source", "")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("scanning collection source: %w", err)
+	}
+	src.URL = url.String
+	src.Imported = imported.Bool
+	src.Signer = signer.String
+	src.Mode = models.CollectionModeFull
+	if mode.Valid && mode.String != "" {
+		src.Mode = models.CollectionMode(mode.String)
+	}
+
+	return &src, nil
+}
+
+// Save records the source vCenter of the collection, replacing any previous value.
+// An empty mode is recorded as a full collection.
+func (s *CollectionSourceStore) Save(ctx context.Context, src models.CollectionSource) error {
+	mode := src.Mode
+	if mode == "" {
+		mode = models.CollectionModeFull
+	}
+
+	query, args, err := sq.Insert(collectionSourceTable).
+		Columns(collectionSourceColID, collectionSourceColVCenter, collectionSourceColURL, collectionSourceColMode, collectionSourceColImported, collectionSourceColSigner).
+		Values(1, src.VCenter, src.URL, string(mode), src.Imported, src.Signer).
+		Suffix("ON CONFLICT (id) DO UPDATE SET vcenter = EXCLUDED.vcenter, url = EXCLUDED.url, mode = EXCLUDED.mode, imported = EXCLUDED.imported, signer = EXCLUDED.signer").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building save collection source query: %w", err)
+	}
+
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("saving collection source: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package store_test
+
+import (
+	"context"
+	"database/sql"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	"github.com/kubev2v/assisted-migration-agent/test"
+)
+
+var _ = Describe("CollectionSourceStore", func() {
+	var (
+		ctx context.Context
+		s   *store.Store
+		db  *sql.DB
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		var err error
+		db, err = store.NewConnection(nil, ":memory:")
+		Expect(err).NotTo(HaveOccurred())
+
+		s = store.NewStore(db, test.NewMockValidator())
+		Expect(s.InitCollection(ctx)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		if db != nil {
+			_ = db.Close()
+		}
+	})
+
+	// Given a freshly migrated collection database
+	// When we read its source
+	// Then it should default to the default credential profile
+	It("should default to the default profile", func() {
+		// Act
+		src, err := s.CollectionSource().Get(ctx)
+
+		// Assert
+		Expect(err).NotTo(HaveOccurred())
+		Expect(src.VCenter).To(Equal(models.DefaultCredentialProfile))
+		Expect(src.URL).To(BeEmpty())
+	})
+
+	// Given a collection database
+	// When we save its source twice
+	// Then the last saved source should be returned
+	It("should replace the source on save", func() {
+		// Arrange
+		Expect(s.CollectionSource().Save(ctx, models.CollectionSource{VCenter: "vc-east", URL: "https://vc-east.local/sdk"})).To(Succeed())
+
+		// Act
+		Expect(s.CollectionSource().Save(ctx, models.CollectionSource{VCenter: "vc-west", URL: "https://vc-west.local/sdk"})).To(Succeed())
+
+		// Assert
+		src, err := s.CollectionSource().Get(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(src.VCenter).To(Equal("vc-west"))
+		Expect(src.URL).To(Equal("https://vc-west.local/sdk"))
+	})
+})
@@ -3,37 +3,57 @@ package store
 import (
 	"context"
 	"database/sql"
+	"encoding/csv"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"slices"
+	"strconv"
 	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+)
+
+// copyQueryPrefix and copyQuerySuffix delimit the SELECT of the export COPY queries.
+const (
+	copyQueryPrefix = "COPY ("
+	copyQuerySuffix = ") TO ?"
 )
 
 // ExportStore runs DuckDB COPY queries for CSV export scopes.
 type ExportStore struct {
-	db QueryInterceptor
+	db       QueryInterceptor
+	vmFilter sq.Sqlizer
 }
 
 func NewExportStore(db QueryInterceptor) *ExportStore {
 	return &ExportStore{db: db}
 }
 
+// Filtered returns a copy of the store whose per-VM scopes (overview, vms, inspection, waste and
+// the VM rows of utilization) only export the VMs matching filter. Scopes about hosts,
+// clusters, datastores, networks, applications and groups are not filtered.
+func (s *ExportStore) Filtered(filter sq.Sqlizer) *ExportStore {
+	return &ExportStore{db: s.db, vmFilter: filter}
+}
+
 // SupportedScopes returns all export scope names.
 func (s *ExportStore) SupportedScopes() []string {
-	scopes := make([]string, 0, len(exportScopes)+1)
+	scopes := make([]string, 0, len(exportScopes)+len(nonCopyScopes))
 	for scope := range exportScopes {
 		scopes = append(scopes, scope)
 	}
-	scopes = append(scopes, "utilization")
+	scopes = append(scopes, nonCopyScopes...)
 	slices.Sort(scopes)
 	return scopes
 }
 
 // IsValidScope reports whether scope is a known export scope.
 func (s *ExportStore) IsValidScope(scope string) bool {
-	if scope == "utilization" {
+	if slices.Contains(nonCopyScopes, scope) {
 		return true
 	}
 	_, ok := exportScopes[scope]
@@ -50,8 +70,11 @@ func IsExportScope(name string) bool {
 	return (&ExportStore{}).IsValidScope(name)
 }
 
-// ScopeFilename returns the CSV filename for a COPY scope.
+// ScopeFilename returns the CSV filename for a COPY scope or for the recommendations scope.
 func (s *ExportStore) ScopeFilename(scope string) (string, bool) {
+	if scope == "recommendations" {
+		return recommendationsFilename, true
+	}
 	spec, ok := exportScopes[scope]
 	if !ok {
 		return "", false
@@ -65,7 +88,11 @@ func (s *ExportStore) CopyScope(ctx context.Context, scope, path string) error {
 	if !ok {
 		return fmt.Errorf("unknown export scope: %s", scope)
 	}
-	return s.copyQueryToFile(ctx, spec.query, path, spec.filename)
+	query, args, err := s.restrict(spec.query, spec.vmColumn)
+	if err != nil {
+		return fmt.Errorf("%s CSV generation failed: %w", spec.filename, err)
+	}
+	return s.copyQueryToFile(ctx, query, args, path, spec.filename)
 }
 
 // ExportUtilization writes vm_utilization.csv and cluster_utilization.csv into dir.
@@ -80,22 +107,106 @@ func (s *ExportStore) ExportUtilization(ctx context.Context, dir string) error {
 
 	reportIDLiteral := duckDBStringLiteral(reportID)
 
-	vmQuery := fmt.Sprintf(vmUtilizationCopyQueryTmpl, reportIDLiteral)
-	if err := s.copyQueryToFile(ctx, vmQuery, filepath.Join(dir, "vm_utilization.csv"), "vm_utilization.csv"); err != nil {
+	vmQuery, args, err := s.restrict(fmt.Sprintf(vmUtilizationCopyQueryTmpl, reportIDLiteral), "vm_id")
+	if err != nil {
+		return fmt.Errorf("vm_utilization.csv CSV generation failed: %w", err)
+	}
+	if err := s.copyQueryToFile(ctx, vmQuery, args, filepath.Join(dir, "vm_utilization.csv"), "vm_utilization.csv"); err != nil {
 		return err
 	}
 
 	clusterQuery := fmt.Sprintf(clusterUtilizationCopyQueryTmpl, reportIDLiteral)
-	return s.copyQueryToFile(ctx, clusterQuery, filepath.Join(dir, "cluster_utilization.csv"), "cluster_utilization.csv")
+	return s.copyQueryToFile(ctx, clusterQuery, nil, filepath.Join(dir, "cluster_utilization.csv"), "cluster_utilization.csv")
 }
 
-func (s *ExportStore) copyQueryToFile(ctx context.Context, query, path, label string) error {
-	if _, err := s.db.ExecContext(ctx, query, path); err != nil {
+// copyQueryToFile runs a COPY query whose last placeholder is the output path. DuckDB binds
+// the COPY target before the placeholders of its query, so they are numbered to keep the
+// filter arguments of a restricted query in place.
+func (s *ExportStore) copyQueryToFile(ctx context.Context, query string, args []any, path, label string) error {
+	query, err := sq.Dollar.ReplacePlaceholders(query)
+	if err != nil {
+		return fmt.Errorf("%s CSV generation failed: %w", label, err)
+	}
+	if _, err := s.db.ExecContext(ctx, query, append(args, path)...); err != nil {
 		return fmt.Errorf("%s CSV generation failed: %w", label, err)
 	}
 	return nil
 }
 
+// recommendationsFilename is the CSV of the recommendations scope.
+const recommendationsFilename = "recommendations.csv"
+
+// ExportRecommendations writes recommendations.csv into dir: the recommended size of the VMs
+// of the latest rightsizing report under policy. Only the VMs matching the store's filter are
+// exported.
+func (s *ExportStore) ExportRecommendations(ctx context.Context, dir string, policy models.RightsizingPolicy) error {
+	var filter sq.Sqlizer
+	if s.vmFilter != nil {
+		subSQL, subArgs, err := vmFilterSubquery.Where(s.vmFilter).ToSql()
+		if err != nil {
+			return fmt.Errorf("building filter query: %w", err)
+		}
+		filter = sq.Expr(`v."VM ID" IN (`+subSQL+`)`, subArgs...)
+	}
+	reportID, stats, err := NewRightSizingStore(s.db).ListLatestVMStats(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("%s CSV generation failed: %w", recommendationsFilename, err)
+	}
+
+	f, err := os.Create(filepath.Join(dir, recommendationsFilename))
+	if err != nil {
+		return fmt.Errorf("%s CSV generation failed: %w", recommendationsFilename, err)
+	}
+	defer func() { _ = f.Close() }()
+
+	w := csv.NewWriter(f)
+	_ = w.Write([]string{
+		"vm_name", "vm_id", "cluster", "policy",
+		"provisioned_cpus", "recommended_cpus", "saved_cpus",
+		"provisioned_memory_mb", "recommended_memory_mb", "saved_memory_mb",
+		"confidence_pct", "confidence", "report_id",
+	})
+	for _, vm := range stats {
+		r := policy.Recommend(vm)
+		_ = w.Write([]string{
+			r.Name, r.VMID, r.Cluster, policy.Name,
+			strconv.Itoa(r.ProvisionedCPUs), strconv.Itoa(r.RecommendedCPUs), strconv.Itoa(r.SavedCPUs),
+			strconv.FormatInt(r.ProvisionedMemoryMB, 10), strconv.FormatInt(r.RecommendedMemoryMB, 10), strconv.FormatInt(r.SavedMemoryMB, 10),
+			strconv.FormatFloat(r.ConfidencePct, 'f', 1, 64), r.Confidence, reportID,
+		})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return fmt.Errorf("%s CSV generation failed: %w", recommendationsFilename, err)
+	}
+	return nil
+}
+
+// restrict wraps the SELECT of a COPY query so that it only keeps the rows whose vmColumn
+// is the ID of a VM matching the store's filter. Queries without a VM column, or a store
+// without filter, are returned as is.
+func (s *ExportStore) restrict(query, vmColumn string) (string, []any, error) {
+	if s.vmFilter == nil || vmColumn == "" {
+		return query, nil, nil
+	}
+
+	start := strings.Index(query, copyQueryPrefix)
+	end := strings.LastIndex(query, copyQuerySuffix)
+	if start < 0 || end < start {
+		return "", nil, errors.New("query is not a COPY query")
+	}
+	inner := query[start+len(copyQueryPrefix) : end]
+
+	subSQL, subArgs, err := vmFilterSubquery.Where(s.vmFilter).ToSql()
+	if err != nil {
+		return "", nil, fmt.Errorf("building filter query: %w", err)
+	}
+
+	restricted := fmt.Sprintf("%sSELECT * FROM (%s) WHERE %s IN (%s)%s",
+		query[:start+len(copyQueryPrefix)], inner, vmColumn, subSQL, query[end:])
+	return restricted, subArgs, nil
+}
+
 func (s *ExportStore) latestRightsizingReportID(ctx context.Context) (string, error) {
 	var id string
 	err := s.db.QueryRowContext(ctx, latestRightsizingReportIDQuery).Scan(&id)
@@ -113,8 +224,8 @@ func duckDBStringLiteral(s string) string {
 }
 
 func writeUtilizationHeaderCSVs(dir string) error {
-	vmHeader := "vm_name,vm_id,cluster,cpu_avg_pct,cpu_p95_pct,cpu_max_pct,cpu_latest_pct,mem_avg_pct,mem_p95_pct,mem_max_pct,mem_latest_pct,disk_pct,confidence_pct,provisioned_cpus,provisioned_memory_mb,provisioned_disk_kb,report_timestamp\n"
-	clusterHeader := "cluster,vm_count,cpu_avg_pct,cpu_p95_pct,cpu_max_pct,mem_avg_pct,mem_p95_pct,mem_max_pct,disk_pct,confidence_pct,total_provisioned_cpus,total_provisioned_memory_mb,total_provisioned_disk_kb,report_timestamp\n"
+	vmHeader := "vm_name,vm_id,cluster,cpu_avg_pct,cpu_p95_pct,cpu_max_pct,cpu_latest_pct,mem_avg_pct,mem_p95_pct,mem_max_pct,mem_latest_pct,disk_pct,confidence_pct,provisioned_cpus,provisioned_memory_mb,provisioned_disk_kb,net_avg_kbps,net_p95_kbps,net_max_kbps,net_rx_p95_kbps,net_tx_p95_kbps,iops_avg,iops_p95,iops_max,read_latency_p95_ms,write_latency_p95_ms,report_timestamp\n"
+	clusterHeader := "cluster,vm_count,cpu_avg_pct,cpu_p95_pct,cpu_max_pct,mem_avg_pct,mem_p95_pct,mem_max_pct,disk_pct,confidence_pct,total_provisioned_cpus,total_provisioned_memory_mb,total_provisioned_disk_kb,net_avg_kbps,net_p95_kbps,net_max_kbps,iops_avg,iops_p95,iops_max,read_latency_p95_ms,write_latency_p95_ms,report_timestamp\n"
 
 	if err := os.WriteFile(filepath.Join(dir, "vm_utilization.csv"), []byte(vmHeader), 0o644); err != nil {
 		return fmt.Errorf("vm_utilization CSV generation failed: %w", err)
@@ -7,6 +7,9 @@ package store
 type exportScopeSpec struct {
 	filename string
 	query    string
+	// vmColumn is the output column holding the VM ID of each row, set on the scopes
+	// that a VM filter restricts.
+	vmColumn string
 }
 
 // Shared SQL for overview and vms scopes: total disk per VM from vdisk.
@@ -28,7 +31,7 @@ const vmMigrationStatusExpr = `
 				END AS migration_status`
 
 // vmAssessmentSelectCols adds migration readiness fields joined from concerns, inspection,
-// latest rightsizing utilization, groups, and VM labels.
+// latest rightsizing utilization, groups, VM labels, and migration exclusion details.
 const vmAssessmentSelectCols = `
 ` + vmMigrationStatusExpr + `,
 				COALESCE(c_issues.critical_issues, '') AS critical_issues,
@@ -39,9 +42,14 @@ const vmAssessmentSelectCols = `
 				COALESCE(u.mem_p95, 0) AS mem_p95,
 				COALESCE(u.utilization_confidence, 'none') AS utilization_confidence,
 				COALESCE(grps.groups, '') AS groups,
-				COALESCE(array_to_string(v."labels", '; '), '') AS labels`
-
-// vmAssessmentJoins aggregates concerns, inspection status/concerns, utilization, and group membership per VM.
+				COALESCE(array_to_string(v."labels", '; '), '') AS labels,
+				COALESCE(ex.reason, '') AS exclusion_reason,
+				COALESCE(ex.justification, '') AS exclusion_justification,
+				COALESCE(ex.excluded_by, '') AS excluded_by,
+				ex.expires_at AS exclusion_expires_at`
+
+// vmAssessmentJoins aggregates concerns, inspection status/concerns, utilization, and group membership per VM,
+// and joins the migration exclusion details.
 const vmAssessmentJoins = `
 			LEFT JOIN (
 				SELECT "VM_ID", COUNT(*) AS issues_count
@@ -108,6 +116,8 @@ const vmAssessmentJoins = `
 				, UNNEST(gm.vm_ids) AS g(vm_id)
 				GROUP BY g.vm_id
 			) grps ON v."VM ID" = grps.vm_id
+
+			LEFT JOIN vm_migration_exclusions ex ON v."VM ID" = ex.vm_id
 `
 
 // overviewQuery — scope "overview": one row per VM with core inventory and migration readiness summary.
@@ -449,6 +459,42 @@ const storageForecastQuery = `
 	
 `
 
+// sizingQuery — scope "sizing": nodes of each profile of the latest sizing simulation, with the VMs
+// placed on them and their stranded capacity. VMs too large for a node of the profile come last, on
+// an "unplaced" row.
+const sizingQuery = `
+
+		COPY (
+			SELECT
+				p.name AS profile,
+				COALESCE(sp.node::VARCHAR, 'unplaced') AS node,
+				COUNT(*) AS vm_count,
+				string_agg(sp.vm_name, ';' ORDER BY sp.vm_id) AS vms,
+				SUM(sp.cpu) AS used_cpu,
+				SUM(sp.memory_mb) AS used_memory_mb,
+				p.allocatable_cpu,
+				p.allocatable_memory_mb,
+				CASE WHEN sp.node IS NULL THEN 0 ELSE p.allocatable_cpu - SUM(sp.cpu) END AS stranded_cpu,
+				CASE WHEN sp.node IS NULL THEN 0 ELSE p.allocatable_memory_mb - SUM(sp.memory_mb) END AS stranded_memory_mb
+
+			FROM sizing_placements sp
+
+			JOIN sizing_profiles p ON sp.run_id = p.run_id AND sp.profile = p.name
+
+			-- Only the latest simulation
+			WHERE sp.run_id = (
+				SELECT id FROM sizing_runs
+				ORDER BY created_at DESC
+				LIMIT 1
+			)
+
+			GROUP BY p.name, p.position, sp.node, p.allocatable_cpu, p.allocatable_memory_mb
+
+			ORDER BY p.position, sp.node NULLS LAST
+		) TO ? (FORMAT CSV, HEADER TRUE)
+	
+`
+
 // vmUtilizationCopyQueryTmpl — scope "utilization" (vm_utilization.csv): per-VM metrics from the latest
 // completed rightsizing report. %s is a DuckDB string literal for report_id (see duckDBStringLiteral).
 const vmUtilizationCopyQueryTmpl = `
@@ -471,6 +517,16 @@ const vmUtilizationCopyQueryTmpl = `
 				COALESCE(u.provisioned_cpus, 0) AS provisioned_cpus,
 				COALESCE(u.provisioned_memory_mb, 0) AS provisioned_memory_mb,
 				COALESCE(u.provisioned_disk_kb, 0) AS provisioned_disk_kb,
+				COALESCE(u.net_avg_kbps, 0) AS net_avg_kbps,
+				COALESCE(u.net_p95_kbps, 0) AS net_p95_kbps,
+				COALESCE(u.net_max_kbps, 0) AS net_max_kbps,
+				COALESCE(u.net_rx_p95_kbps, 0) AS net_rx_p95_kbps,
+				COALESCE(u.net_tx_p95_kbps, 0) AS net_tx_p95_kbps,
+				COALESCE(u.iops_avg, 0) AS iops_avg,
+				COALESCE(u.iops_p95, 0) AS iops_p95,
+				COALESCE(u.iops_max, 0) AS iops_max,
+				COALESCE(u.read_latency_p95_ms, 0) AS read_latency_p95_ms,
+				COALESCE(u.write_latency_p95_ms, 0) AS write_latency_p95_ms,
 				COALESCE(r.created_at::VARCHAR, '') AS report_timestamp
 
 			FROM rightsizing_vm_utilization u
@@ -485,7 +541,8 @@ const vmUtilizationCopyQueryTmpl = `
 `
 
 // clusterUtilizationCopyQueryTmpl — scope "utilization" (cluster_utilization.csv): cluster rollups with
-// provisioned-resource-weighted CPU/memory/disk averages from the same report as vmUtilizationCopyQueryTmpl.
+// provisioned-resource-weighted CPU/memory/disk averages, summed network throughput and IOPS and the
+// slowest disk latencies, from the same report as vmUtilizationCopyQueryTmpl.
 const clusterUtilizationCopyQueryTmpl = `
 
 		COPY (
@@ -524,6 +581,14 @@ const clusterUtilizationCopyQueryTmpl = `
 				COALESCE(SUM(u.provisioned_cpus), 0) AS total_provisioned_cpus,
 				COALESCE(SUM(u.provisioned_memory_mb), 0) AS total_provisioned_memory_mb,
 				COALESCE(SUM(u.provisioned_disk_kb), 0) AS total_provisioned_disk_kb,
+				COALESCE(SUM(u.net_avg_kbps), 0) AS net_avg_kbps,
+				COALESCE(SUM(u.net_p95_kbps), 0) AS net_p95_kbps,
+				COALESCE(SUM(u.net_max_kbps), 0) AS net_max_kbps,
+				COALESCE(SUM(u.iops_avg), 0) AS iops_avg,
+				COALESCE(SUM(u.iops_p95), 0) AS iops_p95,
+				COALESCE(SUM(u.iops_max), 0) AS iops_max,
+				COALESCE(MAX(u.read_latency_p95_ms), 0) AS read_latency_p95_ms,
+				COALESCE(MAX(u.write_latency_p95_ms), 0) AS write_latency_p95_ms,
 				COALESCE(MAX(r.created_at)::VARCHAR, '') AS report_timestamp
 
 			FROM rightsizing_vm_utilization u
@@ -539,6 +604,33 @@ const clusterUtilizationCopyQueryTmpl = `
 	
 `
 
+// wasteQuery — scope "waste": the waste classes of the VMs in the latest waste analysis, one row
+// per VM and class, with the evidence of the class as a JSON object.
+const wasteQuery = `
+
+		COPY (
+			SELECT
+				w.vm_id,
+				COALESCE(v."VM", '') AS vm_name,
+				COALESCE(v."Cluster", '') AS cluster,
+				COALESCE(v."Powerstate", '') AS power_state,
+				COALESCE(v."CPUs", 0) AS cpus,
+				COALESCE(v."Memory", 0) AS memory_mb,
+				w.class,
+				w.evidence,
+				a.analyzed_at
+
+			FROM vm_waste w
+
+			LEFT JOIN vinfo v ON w.vm_id = v."VM ID"
+
+			CROSS JOIN waste_analysis a
+
+			ORDER BY w.class, w.vm_id
+		) TO ? (FORMAT CSV, HEADER TRUE)
+	
+`
+
 // latestRightsizingReportIDQuery selects the most recent rightsizing report with written batches.
 const latestRightsizingReportIDQuery = `
 		SELECT id
@@ -548,15 +640,22 @@ const latestRightsizingReportIDQuery = `
 		LIMIT 1
 	`
 
+// nonCopyScopes are the export scopes not written by a single COPY query of exportScopes:
+// "utilization" writes two CSVs from the latest rightsizing report and "recommendations"
+// sizes the VMs of that report under a rightsizing policy.
+var nonCopyScopes = []string{"utilization", "recommendations"}
+
 var exportScopes = map[string]exportScopeSpec{
-	"overview":         {filename: "overview.csv", query: overviewQuery},
+	"overview":         {filename: "overview.csv", query: overviewQuery, vmColumn: "id"},
 	"hosts":            {filename: "hosts.csv", query: hostsQuery},
 	"clusters":         {filename: "clusters.csv", query: clustersQuery},
 	"datastores":       {filename: "datastores.csv", query: datastoresQuery},
-	"vms":              {filename: "vms.csv", query: vmsQuery},
+	"vms":              {filename: "vms.csv", query: vmsQuery, vmColumn: "id"},
 	"network":          {filename: "networks.csv", query: networkQuery},
 	"applications":     {filename: "applications.csv", query: applicationsQuery},
 	"groups":           {filename: "groups.csv", query: groupsQuery},
-	"inspection":       {filename: "inspection.csv", query: inspectionQuery},
+	"inspection":       {filename: "inspection.csv", query: inspectionQuery, vmColumn: "vm_id"},
 	"storage-forecast": {filename: "storage-forecast.csv", query: storageForecastQuery},
+	"sizing":           {filename: "sizing.csv", query: sizingQuery},
+	"waste":            {filename: "waste.csv", query: wasteQuery, vmColumn: "vm_id"},
 }
@@ -1,11 +1,16 @@
 package store
 
-import "testing"
+import (
+	"strings"
+	"testing"
+
+	sq "github.com/Masterminds/squirrel"
+)
 
 func TestExportStore_SupportedScopes(t *testing.T) {
 	st := NewExportStore(nil)
 	scopes := st.SupportedScopes()
-	want := len(exportScopes) + 1
+	want := len(exportScopes) + len(nonCopyScopes)
 	if len(scopes) != want {
 		t.Fatalf("got %d scopes, want %d", len(scopes), want)
 	}
@@ -17,6 +22,9 @@ func TestExportStore_SupportedScopes(t *testing.T) {
 	if !st.IsValidScope("utilization") {
 		t.Fatal("expected utilization scope to be valid")
 	}
+	if !st.IsValidScope("recommendations") {
+		t.Fatal("expected recommendations scope to be valid")
+	}
 	if st.IsValidScope("bogus") {
 		t.Fatal("expected bogus scope to be invalid")
 	}
@@ -31,3 +39,28 @@ func TestExportScopes_uniqueFilenames(t *testing.T) {
 		seen[spec.filename] = scope
 	}
 }
+
+func TestExportStore_restrict(t *testing.T) {
+	st := NewExportStore(nil).Filtered(sq.Eq{`v."Cluster"`: "prod"})
+	for scope, spec := range exportScopes {
+		query, args, err := st.restrict(spec.query, spec.vmColumn)
+		if err != nil {
+			t.Fatalf("scope %q: %v", scope, err)
+		}
+		if spec.vmColumn == "" {
+			if query != spec.query || len(args) != 0 {
+				t.Fatalf("scope %q has no VM column but was restricted", scope)
+			}
+			continue
+		}
+		if !strings.Contains(query, "SELECT * FROM (") || !strings.Contains(query, spec.vmColumn+" IN (SELECT") {
+			t.Fatalf("scope %q was not restricted: %s", scope, query)
+		}
+		if !strings.HasSuffix(strings.TrimSpace(query), "TO ? (FORMAT CSV, HEADER TRUE)") {
+			t.Fatalf("scope %q lost its COPY target: %s", scope, query)
+		}
+		if len(args) != 1 || args[0] != "prod" {
+			t.Fatalf("scope %q: got args %v", scope, args)
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 
 	"github.com/kubev2v/migration-planner/pkg/inventory"
 
@@ -283,10 +284,21 @@ func isUniqueConstraintError(err error) bool {
 		strings.Contains(err.Error(), "Duplicate key")
 }
 
+// FilterExpandFunc rewrites a group filter before it is evaluated, e.g. to inline the
+// saved filters it references. It is called inside the transaction refreshing the matches,
+// so it must not query this store through the transaction context.
+type FilterExpandFunc func(expr string) (string, error)
+
 // RefreshMatches rebuilds group_matches rows by evaluating each group's filter
 // against the VM data. When groupIDs are provided, only those groups are
 // refreshed. When none are provided, all groups are refreshed.
 func (s *GroupStore) RefreshMatches(ctx context.Context, groupIDs ...uuid.UUID) error {
+	return s.RefreshMatchesWith(ctx, nil, groupIDs...)
+}
+
+// RefreshMatchesWith is like RefreshMatches but passes each filter through expand first.
+// A group whose filter cannot be expanded matches no VM, like one whose filter does not parse.
+func (s *GroupStore) RefreshMatchesWith(ctx context.Context, expand FilterExpandFunc, groupIDs ...uuid.UUID) error {
 	var groups []models.Group
 
 	if len(groupIDs) == 0 {
@@ -324,7 +336,17 @@ func (s *GroupStore) RefreshMatches(ctx context.Context, groupIDs ...uuid.UUID)
 	}
 
 	for _, g := range groups {
-		filterSQL := ByFilter(g.Filter)
+		expr := g.Filter
+		if expand != nil {
+			expanded, err := expand(g.Filter)
+			if err != nil {
+				zap.S().Named("group_store").Warnw("failed to expand group filter", "group", g.ID, "filter", g.Filter, "error", err)
+				continue
+			}
+			expr = expanded
+		}
+
+		filterSQL := ByFilter(expr)
 		if filterSQL == nil {
 			continue
 		}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 
@@ -12,12 +13,13 @@ import (
 
 // Column name constants for vm_inspection_status table
 const (
-	inspectionTable       = "vm_inspection_status"
-	inspectionColVmID     = `"VM ID"`
-	inspectionColStatus   = "status"
-	inspectionColError    = "error"
-	inspectionColDetails  = "details"
-	inspectionColSequence = "sequence"
+	inspectionTable        = "vm_inspection_status"
+	inspectionColVmID      = `"VM ID"`
+	inspectionColStatus    = "status"
+	inspectionColError     = "error"
+	inspectionColDetails   = "details"
+	inspectionColSequence  = "sequence"
+	inspectionColCompleted = "completed_at"
 )
 
 // Column name constants for vm_inspection_concerns table
@@ -39,7 +41,8 @@ func NewInspectionStore(db QueryInterceptor) *InspectionStore {
 	return &InspectionStore{db: db}
 }
 
-// Update upserts the inspection status for a VM.
+// Update upserts the inspection status for a VM. A completed status records the completion
+// time; other states keep the time the previous inspection completed.
 func (s *InspectionStore) Update(ctx context.Context, vmID string, status models.InspectionStatus) error {
 	var errStr *string
 	if status.Error != nil {
@@ -47,13 +50,20 @@ func (s *InspectionStore) Update(ctx context.Context, vmID string, status models
 		errStr = &e
 	}
 
+	var completedAt *time.Time
+	if status.State == models.InspectionStateCompleted {
+		now := time.Now().UTC()
+		completedAt = &now
+	}
+
 	query, args, err := sq.Insert(inspectionTable).
-		Columns(inspectionColVmID, inspectionColStatus, inspectionColError, inspectionColDetails).
-		Values(vmID, status.State.Value(), errStr, status.Details).
+		Columns(inspectionColVmID, inspectionColStatus, inspectionColError, inspectionColDetails, inspectionColCompleted).
+		Values(vmID, status.State.Value(), errStr, status.Details, completedAt).
 		Suffix("ON CONFLICT (" + inspectionColVmID + ") DO UPDATE SET " +
 			inspectionColStatus + " = EXCLUDED." + inspectionColStatus + ", " +
 			inspectionColError + " = EXCLUDED." + inspectionColError + ", " +
-			inspectionColDetails + " = EXCLUDED." + inspectionColDetails).
+			inspectionColDetails + " = EXCLUDED." + inspectionColDetails + ", " +
+			inspectionColCompleted + " = COALESCE(EXCLUDED." + inspectionColCompleted + ", " + inspectionTable + "." + inspectionColCompleted + ")").
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("building update query for vm %s: %w", vmID, err)
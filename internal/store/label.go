@@ -0,0 +1,189 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+const (
+	labelTable = "agent.main.labels"
+
+	labelColName        = "name"
+	labelColColor       = "color"
+	labelColDescription = "description"
+	labelColCategory    = "category"
+	labelColCreatedBy   = "created_by"
+	labelColCreatedAt   = "created_at"
+	labelColUpdatedAt   = "updated_at"
+)
+
+var labelSelectColumns = []string{
+	labelColName,
+	labelColColor,
+	labelColDescription,
+	labelColCategory,
+	labelColCreatedBy,
+	labelColCreatedAt,
+	labelColUpdatedAt,
+}
+
+// LabelStore persists label definitions in the main database.
+type LabelStore struct {
+	db QueryInterceptor
+}
+
+func NewLabelStore(db QueryInterceptor) *LabelStore {
+	return &LabelStore{db: db}
+}
+
+// List returns all label definitions ordered by category and name.
+func (s *LabelStore) List(ctx context.Context) ([]models.Label, error) {
+	query, args, err := sq.Select(labelSelectColumns...).
+		From(labelTable).
+		OrderBy(labelColCategory+" ASC", labelColName+" ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building list labels query: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying labels: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	labels := []models.Label{}
+	for rows.Next() {
+		l, err := scanLabel(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning label: %w", err)
+		}
+		labels = append(labels, *l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating label rows: %w", err)
+	}
+	return labels, nil
+}
+
+// Get returns a label definition by name.
+func (s *LabelStore) Get(ctx context.Context, name string) (*models.Label, error) {
+	query, args, err := sq.Select(labelSelectColumns...).
+		From(labelTable).
+		Where(sq.Eq{labelColName: name}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building get label query: %w", err)
+	}
+
+	l, err := scanLabel(s.db.QueryRowContext(ctx, query, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, srvErrors.NewResourceNotFoundError("label", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("scanning label: %w", err)
+	}
+	return l, nil
+}
+
+// Create inserts a new label definition and returns the persisted record.
+func (s *LabelStore) Create(ctx context.Context, l models.Label) (*models.Label, error) {
+	query, args, err := sq.Insert(labelTable).
+		Columns(
+			labelColName,
+			labelColColor,
+			labelColDescription,
+			labelColCategory,
+			labelColCreatedBy,
+		).
+		Values(l.Name, l.Color, l.Description, l.Category, l.CreatedBy).
+		Suffix(fmt.Sprintf("RETURNING %s", strings.Join(labelSelectColumns, ", "))).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building create label query: %w", err)
+	}
+
+	created, err := scanLabel(s.db.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return nil, srvErrors.NewDuplicateResourceError("label", "name", l.Name)
+		}
+		return nil, fmt.Errorf("creating label: %w", err)
+	}
+	return created, nil
+}
+
+// Update replaces the color, description and category of a label definition.
+func (s *LabelStore) Update(ctx context.Context, l models.Label) (*models.Label, error) {
+	query, args, err := sq.Update(labelTable).
+		Set(labelColColor, l.Color).
+		Set(labelColDescription, l.Description).
+		Set(labelColCategory, l.Category).
+		Set(labelColUpdatedAt, time.Now()).
+		Where(sq.Eq{labelColName: l.Name}).
+		Suffix(fmt.Sprintf("RETURNING %s", strings.Join(labelSelectColumns, ", "))).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building update label query: %w", err)
+	}
+
+	updated, err := scanLabel(s.db.QueryRowContext(ctx, query, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, srvErrors.NewResourceNotFoundError("label", l.Name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("updating label: %w", err)
+	}
+	return updated, nil
+}
+
+// Delete removes a label definition. The label stays on the VMs it is applied to.
+func (s *LabelStore) Delete(ctx context.Context, name string) error {
+	query, args, err := sq.Delete(labelTable).
+		Where(sq.Eq{labelColName: name}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete label query: %w", err)
+	}
+
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("deleting label: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("checking rows affected: %w", err)
+	}
+	if n == 0 {
+		return srvErrors.NewResourceNotFoundError("label", name)
+	}
+	return nil
+}
+
+// scanLabel scans one row into a *models.Label.
+// Column order must match labelSelectColumns exactly.
+func scanLabel(row rowScanner) (*models.Label, error) {
+	var l models.Label
+	err := row.Scan(
+		&l.Name,
+		&l.Color,
+		&l.Description,
+		&l.Category,
+		&l.CreatedBy,
+		&l.CreatedAt,
+		&l.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
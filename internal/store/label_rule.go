@@ -0,0 +1,185 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+const (
+	labelRuleTable = "agent.main.label_rules"
+
+	labelRuleColName       = "name"
+	labelRuleColLabel      = "label"
+	labelRuleColExpression = "expression"
+	labelRuleColPriority   = "priority"
+	labelRuleColCreatedAt  = "created_at"
+	labelRuleColUpdatedAt  = "updated_at"
+)
+
+var labelRuleSelectColumns = []string{
+	labelRuleColName,
+	labelRuleColLabel,
+	labelRuleColExpression,
+	labelRuleColPriority,
+	labelRuleColCreatedAt,
+	labelRuleColUpdatedAt,
+}
+
+// LabelRuleStore persists label rules in the main database.
+type LabelRuleStore struct {
+	db QueryInterceptor
+}
+
+func NewLabelRuleStore(db QueryInterceptor) *LabelRuleStore {
+	return &LabelRuleStore{db: db}
+}
+
+// List returns all label rules, highest priority first and then by name.
+func (s *LabelRuleStore) List(ctx context.Context) ([]models.LabelRule, error) {
+	query, args, err := sq.Select(labelRuleSelectColumns...).
+		From(labelRuleTable).
+		OrderBy(labelRuleColPriority+" DESC", labelRuleColName+" ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building list label rules query: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying label rules: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	rules := []models.LabelRule{}
+	for rows.Next() {
+		r, err := scanLabelRule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning label rule: %w", err)
+		}
+		rules = append(rules, *r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating label rule rows: %w", err)
+	}
+	return rules, nil
+}
+
+// Get returns a label rule by name.
+func (s *LabelRuleStore) Get(ctx context.Context, name string) (*models.LabelRule, error) {
+	query, args, err := sq.Select(labelRuleSelectColumns...).
+		From(labelRuleTable).
+		Where(sq.Eq{labelRuleColName: name}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building get label rule query: %w", err)
+	}
+
+	r, err := scanLabelRule(s.db.QueryRowContext(ctx, query, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, srvErrors.NewResourceNotFoundError("label rule", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("scanning label rule: %w", err)
+	}
+	return r, nil
+}
+
+// Create inserts a new label rule and returns the persisted record.
+func (s *LabelRuleStore) Create(ctx context.Context, r models.LabelRule) (*models.LabelRule, error) {
+	query, args, err := sq.Insert(labelRuleTable).
+		Columns(
+			labelRuleColName,
+			labelRuleColLabel,
+			labelRuleColExpression,
+			labelRuleColPriority,
+		).
+		Values(r.Name, r.Label, r.Expression, r.Priority).
+		Suffix(fmt.Sprintf("RETURNING %s", strings.Join(labelRuleSelectColumns, ", "))).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building create label rule query: %w", err)
+	}
+
+	created, err := scanLabelRule(s.db.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return nil, srvErrors.NewDuplicateResourceError("label rule", "name", r.Name)
+		}
+		return nil, fmt.Errorf("creating label rule: %w", err)
+	}
+	return created, nil
+}
+
+// Update replaces the label, expression and priority of a label rule.
+func (s *LabelRuleStore) Update(ctx context.Context, r models.LabelRule) (*models.LabelRule, error) {
+	query, args, err := sq.Update(labelRuleTable).
+		Set(labelRuleColLabel, r.Label).
+		Set(labelRuleColExpression, r.Expression).
+		Set(labelRuleColPriority, r.Priority).
+		Set(labelRuleColUpdatedAt, time.Now()).
+		Where(sq.Eq{labelRuleColName: r.Name}).
+		Suffix(fmt.Sprintf("RETURNING %s", strings.Join(labelRuleSelectColumns, ", "))).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building update label rule query: %w", err)
+	}
+
+	updated, err := scanLabelRule(s.db.QueryRowContext(ctx, query, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, srvErrors.NewResourceNotFoundError("label rule", r.Name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("updating label rule: %w", err)
+	}
+	return updated, nil
+}
+
+// Delete removes a label rule.
+func (s *LabelRuleStore) Delete(ctx context.Context, name string) error {
+	query, args, err := sq.Delete(labelRuleTable).
+		Where(sq.Eq{labelRuleColName: name}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete label rule query: %w", err)
+	}
+
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("deleting label rule: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("checking rows affected: %w", err)
+	}
+	if n == 0 {
+		return srvErrors.NewResourceNotFoundError("label rule", name)
+	}
+	return nil
+}
+
+// scanLabelRule scans one row into a *models.LabelRule.
+// Column order must match labelRuleSelectColumns exactly.
+func scanLabelRule(row rowScanner) (*models.LabelRule, error) {
+	var r models.LabelRule
+	err := row.Scan(
+		&r.Name,
+		&r.Label,
+		&r.Expression,
+		&r.Priority,
+		&r.CreatedAt,
+		&r.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
@@ -0,0 +1,111 @@
+package store_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+	"github.com/kubev2v/assisted-migration-agent/test"
+)
+
+var _ = Describe("LabelRuleStore", func() {
+	var (
+		ctx    context.Context
+		s      *store.Store
+		db     *sql.DB
+		tmpDir string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		var err error
+		tmpDir, err = os.MkdirTemp("", "label-rule-store-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		db, err = store.NewConnection(nil, filepath.Join(tmpDir, "agent.duckdb"))
+		Expect(err).NotTo(HaveOccurred())
+
+		s = store.NewStore(db, test.NewMockValidator())
+		Expect(s.Migrate(ctx, "")).To(Succeed())
+	})
+
+	AfterEach(func() {
+		if db != nil {
+			_ = db.Close()
+		}
+		if tmpDir != "" {
+			_ = os.RemoveAll(tmpDir)
+		}
+	})
+
+	// Given label rules of different priorities
+	// When we list them
+	// Then they should be returned highest priority first, then by name
+	It("should create and list label rules by priority", func() {
+		// Arrange
+		_, err := s.LabelRule().Create(ctx, models.LabelRule{Name: "legacy-os", Label: "needs-driver-update", Expression: "os_tools ~ /2008/"})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = s.LabelRule().Create(ctx, models.LabelRule{Name: "big", Label: "large", Expression: "memory > 64GB"})
+		Expect(err).NotTo(HaveOccurred())
+		created, err := s.LabelRule().Create(ctx, models.LabelRule{Name: "prod", Label: "production", Expression: "cluster = 'prod'", Priority: 10})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(created.CreatedAt).NotTo(BeZero())
+
+		// Act
+		rules, err := s.LabelRule().List(ctx)
+
+		// Assert
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rules).To(HaveLen(3))
+		Expect(rules[0].Name).To(Equal("prod"))
+		Expect(rules[0].Priority).To(Equal(10))
+		Expect(rules[1].Name).To(Equal("big"))
+		Expect(rules[2].Label).To(Equal("needs-driver-update"))
+	})
+
+	// Given a label rule
+	// When we create another one with the same name
+	// Then a duplicate error should be returned
+	It("should reject duplicate names", func() {
+		// Arrange
+		_, err := s.LabelRule().Create(ctx, models.LabelRule{Name: "prod", Label: "production", Expression: "cluster = 'prod'"})
+		Expect(err).NotTo(HaveOccurred())
+
+		// Act
+		_, err = s.LabelRule().Create(ctx, models.LabelRule{Name: "prod", Label: "prod", Expression: "cluster = 'staging'"})
+
+		// Assert
+		Expect(srvErrors.IsDuplicateResourceError(err)).To(BeTrue())
+	})
+
+	// Given a label rule
+	// When we update and then delete it
+	// Then the update should be returned and the rule should no longer be found
+	It("should update and delete label rules", func() {
+		// Arrange
+		_, err := s.LabelRule().Create(ctx, models.LabelRule{Name: "prod", Label: "production", Expression: "cluster = 'prod'"})
+		Expect(err).NotTo(HaveOccurred())
+
+		// Act
+		updated, err := s.LabelRule().Update(ctx, models.LabelRule{Name: "prod", Label: "prod", Expression: "cluster in ['prod', 'prod-2']", Priority: 5})
+
+		// Assert
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated.Label).To(Equal("prod"))
+		Expect(updated.Expression).To(Equal("cluster in ['prod', 'prod-2']"))
+		Expect(updated.Priority).To(Equal(5))
+
+		Expect(s.LabelRule().Delete(ctx, "prod")).To(Succeed())
+		_, err = s.LabelRule().Get(ctx, "prod")
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+		Expect(srvErrors.IsResourceNotFoundError(s.LabelRule().Delete(ctx, "prod"))).To(BeTrue())
+	})
+})
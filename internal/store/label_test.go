@@ -0,0 +1,100 @@
+package store_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+	"github.com/kubev2v/assisted-migration-agent/test"
+)
+
+var _ = Describe("LabelStore", func() {
+	var (
+		ctx    context.Context
+		s      *store.Store
+		db     *sql.DB
+		tmpDir string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		var err error
+		tmpDir, err = os.MkdirTemp("", "label-store-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		db, err = store.NewConnection(nil, filepath.Join(tmpDir, "agent.duckdb"))
+		Expect(err).NotTo(HaveOccurred())
+
+		s = store.NewStore(db, test.NewMockValidator())
+		Expect(s.Migrate(ctx, "")).To(Succeed())
+	})
+
+	AfterEach(func() {
+		if db != nil {
+			_ = db.Close()
+		}
+		if tmpDir != "" {
+			_ = os.RemoveAll(tmpDir)
+		}
+	})
+
+	// Given labels of different categories
+	// When we list them
+	// Then they should be returned by category, then by name, and names should be unique
+	It("should create and list labels by category", func() {
+		// Arrange
+		_, err := s.Label().Create(ctx, models.Label{Name: "wave-2", Category: "wave", Color: "#00ff00"})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = s.Label().Create(ctx, models.Label{Name: "high-risk", Category: "risk", Description: "Needs a rollback plan"})
+		Expect(err).NotTo(HaveOccurred())
+		created, err := s.Label().Create(ctx, models.Label{Name: "wave-1", Category: "wave", Color: "#ff0000", CreatedBy: "alice"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(created.CreatedAt).NotTo(BeZero())
+
+		// Act
+		labels, err := s.Label().List(ctx)
+
+		// Assert
+		Expect(err).NotTo(HaveOccurred())
+		Expect(labels).To(HaveLen(3))
+		Expect(labels[0].Name).To(Equal("high-risk"))
+		Expect(labels[0].Description).To(Equal("Needs a rollback plan"))
+		Expect(labels[1].Name).To(Equal("wave-1"))
+		Expect(labels[1].CreatedBy).To(Equal("alice"))
+		Expect(labels[2].Color).To(Equal("#00ff00"))
+
+		_, err = s.Label().Create(ctx, models.Label{Name: "wave-1"})
+		Expect(srvErrors.IsDuplicateResourceError(err)).To(BeTrue())
+	})
+
+	// Given a label
+	// When we update and then delete it
+	// Then the update should be returned and the label should no longer be found
+	It("should update and delete labels", func() {
+		// Arrange
+		_, err := s.Label().Create(ctx, models.Label{Name: "wave-1", Category: "wave", CreatedBy: "alice"})
+		Expect(err).NotTo(HaveOccurred())
+
+		// Act
+		updated, err := s.Label().Update(ctx, models.Label{Name: "wave-1", Category: "wave", Color: "#123abc", Description: "First wave"})
+
+		// Assert
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated.Color).To(Equal("#123abc"))
+		Expect(updated.Description).To(Equal("First wave"))
+		Expect(updated.CreatedBy).To(Equal("alice"))
+
+		Expect(s.Label().Delete(ctx, "wave-1")).To(Succeed())
+		_, err = s.Label().Get(ctx, "wave-1")
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+		Expect(srvErrors.IsResourceNotFoundError(s.Label().Delete(ctx, "wave-1"))).To(BeTrue())
+	})
+})
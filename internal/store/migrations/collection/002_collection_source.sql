@@ -0,0 +1,11 @@
+-- Source vCenter of the collection: single row holding the credential profile
+-- name and the vCenter URL. Collections created before multi-vCenter support
+-- were always collected with the default profile.
+CREATE TABLE IF NOT EXISTS collection_source (
+    id INTEGER PRIMARY KEY DEFAULT 1 CHECK (id = 1),
+    vcenter VARCHAR NOT NULL,
+    url VARCHAR DEFAULT ''
+);
+
+INSERT INTO collection_source (id, vcenter)
+SELECT 1, 'default' WHERE NOT EXISTS (SELECT 1 FROM collection_source);
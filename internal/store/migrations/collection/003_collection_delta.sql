@@ -0,0 +1,10 @@
+-- How the collection was built: 'full' runs the vSphere collector over the whole
+-- vCenter, 'incremental' clones the previous collection and patches changed VMs.
+ALTER TABLE collection_source ADD COLUMN IF NOT EXISTS mode VARCHAR DEFAULT 'full';
+
+-- VMs an incremental collection changed relative to the collection it was cloned
+-- from. Empty for full collections.
+CREATE TABLE IF NOT EXISTS collection_delta (
+    vm_id VARCHAR PRIMARY KEY,
+    change VARCHAR NOT NULL CHECK (change IN ('added', 'modified', 'removed'))
+);
@@ -0,0 +1,3 @@
+-- Set on collections imported from a bundle exported by another agent. Imported
+-- collections never become the latest collection of the agent.
+ALTER TABLE collection_source ADD COLUMN IF NOT EXISTS imported BOOLEAN DEFAULT false;
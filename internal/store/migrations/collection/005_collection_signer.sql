@@ -0,0 +1,2 @@
+-- Fingerprint of the key that signed the bundle of an imported collection.
+ALTER TABLE collection_source ADD COLUMN IF NOT EXISTS signer VARCHAR;
@@ -0,0 +1,19 @@
+-- Lifecycle timestamps of each VM, read from vSphere (config.createDate and
+-- runtime.bootTime). NULL when vCenter does not report them.
+CREATE TABLE IF NOT EXISTS vm_lifecycle (
+    vm_id VARCHAR PRIMARY KEY,
+    created_at TIMESTAMP,
+    booted_at TIMESTAMP
+);
+
+-- Snapshots of each VM, the snapshot tree flattened.
+CREATE TABLE IF NOT EXISTS vm_snapshots (
+    vm_id VARCHAR NOT NULL,
+    snapshot_id VARCHAR NOT NULL,
+    name VARCHAR,
+    created_at TIMESTAMP,
+    PRIMARY KEY (vm_id, snapshot_id)
+);
+
+-- Time the last inspection of the VM completed.
+ALTER TABLE vm_inspection_status ADD COLUMN IF NOT EXISTS completed_at TIMESTAMP;
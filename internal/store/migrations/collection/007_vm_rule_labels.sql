@@ -0,0 +1,8 @@
+-- Labels applied by label rules. Labels of vinfo without a row here were set manually.
+CREATE TABLE IF NOT EXISTS vm_rule_labels (
+    vm_id VARCHAR NOT NULL,
+    label VARCHAR NOT NULL,
+    rule VARCHAR NOT NULL,
+    applied_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (vm_id, label)
+);
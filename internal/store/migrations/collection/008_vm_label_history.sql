@@ -0,0 +1,9 @@
+-- Every label added to or removed from a VM, carried over from collection to collection.
+CREATE TABLE IF NOT EXISTS vm_label_history (
+    vm_id VARCHAR NOT NULL,
+    label VARCHAR NOT NULL,
+    action VARCHAR NOT NULL,
+    source VARCHAR NOT NULL,
+    actor VARCHAR NOT NULL DEFAULT '',
+    changed_at TIMESTAMP NOT NULL
+);
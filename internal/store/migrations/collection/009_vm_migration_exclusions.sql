@@ -0,0 +1,10 @@
+-- Why, by whom and until when each VM excluded from migration was excluded. VMs excluded
+-- without these details have no row.
+CREATE TABLE IF NOT EXISTS vm_migration_exclusions (
+    vm_id VARCHAR PRIMARY KEY,
+    reason VARCHAR NOT NULL,
+    justification VARCHAR NOT NULL DEFAULT '',
+    excluded_by VARCHAR NOT NULL DEFAULT '',
+    excluded_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP
+);
@@ -0,0 +1,39 @@
+-- Target cluster sizing simulations: the VMs of a collection, or of a group, bin-packed on
+-- nodes of each target node profile.
+CREATE TABLE IF NOT EXISTS sizing_runs (
+    id VARCHAR PRIMARY KEY,
+    source VARCHAR NOT NULL,
+    headroom_pct DOUBLE NOT NULL,
+    group_id VARCHAR,
+    vm_count INTEGER NOT NULL,
+    provisioned_fallback_count INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS sizing_profiles (
+    run_id VARCHAR NOT NULL,
+    name VARCHAR NOT NULL,
+    position INTEGER NOT NULL,
+    cores INTEGER NOT NULL,
+    memory_mb BIGINT NOT NULL,
+    cpu_overcommit DOUBLE NOT NULL,
+    memory_overcommit DOUBLE NOT NULL,
+    reserved_cores DOUBLE NOT NULL,
+    reserved_memory_mb BIGINT NOT NULL,
+    allocatable_cpu DOUBLE NOT NULL,
+    allocatable_memory_mb DOUBLE NOT NULL,
+    node_count INTEGER NOT NULL,
+    PRIMARY KEY (run_id, name)
+);
+
+-- node is NULL for the VMs that fit on no node of the profile.
+CREATE TABLE IF NOT EXISTS sizing_placements (
+    run_id VARCHAR NOT NULL,
+    profile VARCHAR NOT NULL,
+    node INTEGER,
+    vm_id VARCHAR NOT NULL,
+    vm_name VARCHAR NOT NULL,
+    cpu DOUBLE NOT NULL,
+    memory_mb DOUBLE NOT NULL,
+    PRIMARY KEY (run_id, profile, vm_id)
+);
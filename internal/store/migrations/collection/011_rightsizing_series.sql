@@ -0,0 +1,12 @@
+-- The samples each rightsizing report computed its statistics from, as vCenter returned them
+-- or, for reports of accumulated samples, as the rolling store kept them.
+CREATE TABLE IF NOT EXISTS rightsizing_vm_series (
+    report_id VARCHAR NOT NULL,
+    moid VARCHAR NOT NULL,
+    metric_key VARCHAR NOT NULL,
+    sampled_at TIMESTAMP NOT NULL,
+    interval_sec INTEGER NOT NULL,
+    value DOUBLE NOT NULL,
+    PRIMARY KEY (report_id, moid, metric_key, sampled_at),
+    FOREIGN KEY (report_id) REFERENCES rightsizing_reports(id)
+);
@@ -0,0 +1,12 @@
+-- Network throughput (KBps), disk IOPS and disk latency (ms) of each VM. IOPS add up the read
+-- and write operations of all the virtual disks of a VM; latencies are those of its slowest disk.
+ALTER TABLE rightsizing_vm_utilization ADD COLUMN IF NOT EXISTS net_avg_kbps DOUBLE;
+ALTER TABLE rightsizing_vm_utilization ADD COLUMN IF NOT EXISTS net_p95_kbps DOUBLE;
+ALTER TABLE rightsizing_vm_utilization ADD COLUMN IF NOT EXISTS net_max_kbps DOUBLE;
+ALTER TABLE rightsizing_vm_utilization ADD COLUMN IF NOT EXISTS net_rx_p95_kbps DOUBLE;
+ALTER TABLE rightsizing_vm_utilization ADD COLUMN IF NOT EXISTS net_tx_p95_kbps DOUBLE;
+ALTER TABLE rightsizing_vm_utilization ADD COLUMN IF NOT EXISTS iops_avg DOUBLE;
+ALTER TABLE rightsizing_vm_utilization ADD COLUMN IF NOT EXISTS iops_p95 DOUBLE;
+ALTER TABLE rightsizing_vm_utilization ADD COLUMN IF NOT EXISTS iops_max DOUBLE;
+ALTER TABLE rightsizing_vm_utilization ADD COLUMN IF NOT EXISTS read_latency_p95_ms DOUBLE;
+ALTER TABLE rightsizing_vm_utilization ADD COLUMN IF NOT EXISTS write_latency_p95_ms DOUBLE;
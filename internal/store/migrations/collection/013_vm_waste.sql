@@ -0,0 +1,20 @@
+-- The latest waste analysis of the collection: the thresholds it applied, a single row, and
+-- the waste classes of each VM with the evidence they were classified by, a JSON object.
+-- A VM may have several classes; VMs without any have no row.
+CREATE TABLE IF NOT EXISTS waste_analysis (
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    report_id VARCHAR,
+    idle_cpu_p95_pct DOUBLE NOT NULL,
+    idle_net_p95_kbps DOUBLE NOT NULL,
+    powered_off_days INTEGER NOT NULL,
+    orphaned_iops_p95 DOUBLE NOT NULL,
+    oversized_saved_pct DOUBLE NOT NULL,
+    analyzed_at TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS vm_waste (
+    vm_id VARCHAR NOT NULL,
+    class VARCHAR NOT NULL,
+    evidence VARCHAR NOT NULL DEFAULT '{}',
+    PRIMARY KEY (vm_id, class)
+);
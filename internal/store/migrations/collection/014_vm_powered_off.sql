@@ -0,0 +1,10 @@
+-- First collection each powered off VM was seen powered off in, carried across the
+-- collections of its vCenter while it stays powered off. vSphere clears the boot time of a
+-- powered off VM, so this is the only known lower bound of how long it has been off.
+CREATE TABLE IF NOT EXISTS vm_powered_off (
+    vm_id VARCHAR PRIMARY KEY,
+    since TIMESTAMP NOT NULL
+);
+
+-- Powered off VMs the latest waste analysis could not classify, their power-off time unknown.
+ALTER TABLE waste_analysis ADD COLUMN IF NOT EXISTS powered_off_unknown INTEGER DEFAULT 0;
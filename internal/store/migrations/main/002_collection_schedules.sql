@@ -0,0 +1,32 @@
+-- Recurring vCenter collection schedules.
+-- Exactly one of cron_expr or interval_sec is set.
+-- catch_up controls what happens with runs missed while the agent was down:
+--   skip — drop missed runs and wait for the next occurrence
+--   once — run a single catch-up collection as soon as possible
+CREATE TABLE IF NOT EXISTS collection_schedules (
+    id VARCHAR PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    cron_expr VARCHAR,
+    interval_sec BIGINT,
+    catch_up VARCHAR NOT NULL DEFAULT 'skip' CHECK (catch_up IN ('skip', 'once')),
+    paused BOOLEAN NOT NULL DEFAULT false,
+    next_run_at TIMESTAMP,
+    last_run_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    CHECK ((cron_expr IS NULL) <> (interval_sec IS NULL))
+);
+
+CREATE SEQUENCE IF NOT EXISTS collection_schedule_run_seq START 1;
+
+-- Run history: one row per trigger attempt, including skipped and failed ones.
+CREATE TABLE IF NOT EXISTS collection_schedule_runs (
+    id INTEGER PRIMARY KEY DEFAULT nextval('collection_schedule_run_seq'),
+    schedule_id VARCHAR NOT NULL,
+    scheduled_at TIMESTAMP NOT NULL,
+    triggered_at TIMESTAMP NOT NULL DEFAULT now(),
+    status VARCHAR NOT NULL CHECK (status IN ('started', 'skipped', 'failed')),
+    catch_up BOOLEAN NOT NULL DEFAULT false,
+    error VARCHAR
+);
+
+CREATE INDEX IF NOT EXISTS idx_collection_schedule_runs_schedule ON collection_schedule_runs (schedule_id);
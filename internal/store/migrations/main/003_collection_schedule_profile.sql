@@ -0,0 +1,3 @@
+-- The vCenter a schedule collects, by credential profile name. Schedules created
+-- before collect the default profile's vCenter.
+ALTER TABLE collection_schedules ADD COLUMN IF NOT EXISTS profile VARCHAR DEFAULT 'default';
@@ -0,0 +1,3 @@
+-- How a schedule collects its vCenter (full or incremental). Schedules created
+-- before run full collections.
+ALTER TABLE collection_schedules ADD COLUMN IF NOT EXISTS mode VARCHAR DEFAULT 'full';
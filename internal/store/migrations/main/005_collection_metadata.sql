@@ -0,0 +1,9 @@
+-- User metadata of collection databases, keyed by database name (collection_<ts>).
+-- Pinned collections are never removed by retention pruning.
+CREATE TABLE IF NOT EXISTS collection_metadata (
+    "database" VARCHAR PRIMARY KEY,
+    display_name VARCHAR,
+    notes VARCHAR,
+    pinned BOOLEAN NOT NULL DEFAULT false,
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
@@ -0,0 +1,9 @@
+-- Named VM filter expressions, referenced from other expressions as @name.
+CREATE TABLE IF NOT EXISTS saved_filters (
+    name VARCHAR PRIMARY KEY,
+    description VARCHAR NOT NULL DEFAULT '',
+    expression VARCHAR NOT NULL,
+    owner VARCHAR NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
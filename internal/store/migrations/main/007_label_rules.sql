@@ -0,0 +1,9 @@
+-- Rules labeling the VMs that match a filter expression.
+CREATE TABLE IF NOT EXISTS label_rules (
+    name VARCHAR PRIMARY KEY,
+    label VARCHAR NOT NULL,
+    expression VARCHAR NOT NULL,
+    priority INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
@@ -0,0 +1,10 @@
+-- Definitions of the labels applied to VMs.
+CREATE TABLE IF NOT EXISTS labels (
+    name VARCHAR PRIMARY KEY,
+    color VARCHAR NOT NULL DEFAULT '',
+    description VARCHAR NOT NULL DEFAULT '',
+    category VARCHAR NOT NULL DEFAULT '',
+    created_by VARCHAR NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
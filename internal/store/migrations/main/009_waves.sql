@@ -0,0 +1,13 @@
+-- Migration waves: ordered sets of groups and VMs migrated together within a target window.
+CREATE TABLE IF NOT EXISTS waves (
+    name VARCHAR PRIMARY KEY,
+    description VARCHAR NOT NULL DEFAULT '',
+    position INTEGER NOT NULL DEFAULT 0,
+    window_start TIMESTAMP,
+    window_end TIMESTAMP,
+    forecast_pair VARCHAR NOT NULL DEFAULT '',
+    group_ids VARCHAR[] NOT NULL DEFAULT [],
+    vm_ids VARCHAR[] NOT NULL DEFAULT [],
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
@@ -0,0 +1,12 @@
+-- Mapping tables used to generate Forklift/MTV NetworkMap and StorageMap manifests.
+CREATE TABLE IF NOT EXISTS mtv_network_mappings (
+    source VARCHAR PRIMARY KEY,
+    destination_type VARCHAR NOT NULL,
+    destination_namespace VARCHAR NOT NULL DEFAULT '',
+    destination_name VARCHAR NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS mtv_storage_mappings (
+    source VARCHAR PRIMARY KEY,
+    storage_class VARCHAR NOT NULL
+);
@@ -0,0 +1,15 @@
+-- Rightsizing policies: named profiles of how recommendations size VMs from their utilization.
+CREATE TABLE IF NOT EXISTS rightsizing_policies (
+    name VARCHAR PRIMARY KEY,
+    description VARCHAR NOT NULL DEFAULT '',
+    percentile VARCHAR NOT NULL DEFAULT 'p95',
+    headroom_pct DOUBLE NOT NULL DEFAULT 0,
+    min_cpus INTEGER NOT NULL DEFAULT 0,
+    max_cpus INTEGER NOT NULL DEFAULT 0,
+    min_memory_mb BIGINT NOT NULL DEFAULT 0,
+    max_memory_mb BIGINT NOT NULL DEFAULT 0,
+    round_to_sockets BOOLEAN NOT NULL DEFAULT false,
+    memory_step_mb BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
@@ -0,0 +1,32 @@
+-- Rolling rightsizing samples: short-interval vCenter performance samples appended by every
+-- accumulation run. A sample is identified by its vCenter, VM, metric and timestamp, so the
+-- windows of overlapping runs are stored once. Samples older than the raw retention are
+-- downsampled into buckets of downsample_interval_sec, whose interval_sec is that of the bucket.
+CREATE TABLE IF NOT EXISTS rightsizing_samples (
+    vcenter VARCHAR NOT NULL,
+    moid VARCHAR NOT NULL,
+    metric_key VARCHAR NOT NULL,
+    sampled_at TIMESTAMP NOT NULL,
+    interval_sec INTEGER NOT NULL,
+    value DOUBLE NOT NULL,
+    PRIMARY KEY (vcenter, moid, metric_key, sampled_at, interval_sec)
+);
+
+-- Configuration and state of the accumulation, a single row.
+-- downsample controls how a bucket aggregates its raw samples:
+--   avg — the average of the samples
+--   max — the highest sample, keeping the peaks percentiles are sensitive to
+CREATE TABLE IF NOT EXISTS rightsizing_accumulation (
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    enabled BOOLEAN NOT NULL DEFAULT false,
+    interval_sec BIGINT NOT NULL DEFAULT 3600,
+    raw_retention_sec BIGINT NOT NULL DEFAULT 604800,
+    downsample_interval_sec BIGINT NOT NULL DEFAULT 3600,
+    downsample VARCHAR NOT NULL DEFAULT 'max' CHECK (downsample IN ('avg', 'max')),
+    retention_sec BIGINT NOT NULL DEFAULT 7776000,
+    last_run_at TIMESTAMP,
+    last_sample_count INTEGER NOT NULL DEFAULT 0,
+    last_error VARCHAR
+);
+
+INSERT INTO rightsizing_accumulation (id) VALUES (1) ON CONFLICT DO NOTHING;
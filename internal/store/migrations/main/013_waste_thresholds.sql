@@ -0,0 +1,13 @@
+-- Thresholds of the waste analysis, a single row. See models.WasteThresholds for how each one
+-- classifies VMs as idle, powered off, orphaned or oversized.
+CREATE TABLE IF NOT EXISTS waste_thresholds (
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    idle_cpu_p95_pct DOUBLE NOT NULL DEFAULT 5,
+    idle_net_p95_kbps DOUBLE NOT NULL DEFAULT 1,
+    powered_off_days INTEGER NOT NULL DEFAULT 30,
+    orphaned_iops_p95 DOUBLE NOT NULL DEFAULT 1,
+    oversized_saved_pct DOUBLE NOT NULL DEFAULT 50,
+    updated_at TIMESTAMP
+);
+
+INSERT INTO waste_thresholds (id) VALUES (1) ON CONFLICT DO NOTHING;
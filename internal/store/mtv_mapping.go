@@ -0,0 +1,125 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+)
+
+const (
+	mtvNetworkMappingTable = "agent.main.mtv_network_mappings"
+	mtvStorageMappingTable = "agent.main.mtv_storage_mappings"
+)
+
+// MTVMappingStore persists the network and datastore mapping tables used to generate
+// Forklift/MTV manifests in the main database.
+type MTVMappingStore struct {
+	db QueryInterceptor
+}
+
+func NewMTVMappingStore(db QueryInterceptor) *MTVMappingStore {
+	return &MTVMappingStore{db: db}
+}
+
+// List returns both mapping tables, ordered by source.
+func (s *MTVMappingStore) List(ctx context.Context) (*models.MTVMappings, error) {
+	mappings := &models.MTVMappings{
+		Networks: []models.MTVNetworkMapping{},
+		Storage:  []models.MTVStorageMapping{},
+	}
+
+	query, args, err := sq.Select("source", "destination_type", "destination_namespace", "destination_name").
+		From(mtvNetworkMappingTable).
+		OrderBy("source").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building list network mappings query: %w", err)
+	}
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying network mappings: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+	for rows.Next() {
+		var m models.MTVNetworkMapping
+		if err := rows.Scan(&m.Source, &m.Type, &m.Namespace, &m.Name); err != nil {
+			return nil, fmt.Errorf("scanning network mapping: %w", err)
+		}
+		mappings.Networks = append(mappings.Networks, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating network mapping rows: %w", err)
+	}
+
+	query, args, err = sq.Select("source", "storage_class").
+		From(mtvStorageMappingTable).
+		OrderBy("source").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building list storage mappings query: %w", err)
+	}
+	storageRows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying storage mappings: %w", err)
+	}
+	defer func() { _ = storageRows.Close() }()
+	for storageRows.Next() {
+		var m models.MTVStorageMapping
+		if err := storageRows.Scan(&m.Source, &m.StorageClass); err != nil {
+			return nil, fmt.Errorf("scanning storage mapping: %w", err)
+		}
+		mappings.Storage = append(mappings.Storage, m)
+	}
+	if err := storageRows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating storage mapping rows: %w", err)
+	}
+
+	return mappings, nil
+}
+
+// Replace replaces both mapping tables. Callers should run it inside a transaction.
+func (s *MTVMappingStore) Replace(ctx context.Context, mappings models.MTVMappings) error {
+	for _, table := range []string{mtvNetworkMappingTable, mtvStorageMappingTable} {
+		query, args, err := sq.Delete(table).ToSql()
+		if err != nil {
+			return fmt.Errorf("building clear %s query: %w", table, err)
+		}
+		if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("clearing %s: %w", table, err)
+		}
+	}
+
+	if len(mappings.Networks) > 0 {
+		builder := sq.Insert(mtvNetworkMappingTable).
+			Columns("source", "destination_type", "destination_namespace", "destination_name")
+		for _, m := range mappings.Networks {
+			builder = builder.Values(m.Source, string(m.Type), m.Namespace, m.Name)
+		}
+		query, args, err := builder.ToSql()
+		if err != nil {
+			return fmt.Errorf("building insert network mappings query: %w", err)
+		}
+		if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("inserting network mappings: %w", err)
+		}
+	}
+
+	if len(mappings.Storage) > 0 {
+		builder := sq.Insert(mtvStorageMappingTable).Columns("source", "storage_class")
+		for _, m := range mappings.Storage {
+			builder = builder.Values(m.Source, m.StorageClass)
+		}
+		query, args, err := builder.ToSql()
+		if err != nil {
+			return fmt.Errorf("building insert storage mappings query: %w", err)
+		}
+		if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("inserting storage mappings: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package store_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	"github.com/kubev2v/assisted-migration-agent/test"
+)
+
+var _ = Describe("MTVMappingStore", func() {
+	var (
+		ctx    context.Context
+		s      *store.Store
+		db     *sql.DB
+		tmpDir string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		var err error
+		tmpDir, err = os.MkdirTemp("", "mtv-mapping-store-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		db, err = store.NewConnection(nil, filepath.Join(tmpDir, "agent.duckdb"))
+		Expect(err).NotTo(HaveOccurred())
+
+		s = store.NewStore(db, test.NewMockValidator())
+		Expect(s.Migrate(ctx, "")).To(Succeed())
+	})
+
+	AfterEach(func() {
+		if db != nil {
+			_ = db.Close()
+		}
+		if tmpDir != "" {
+			_ = os.RemoveAll(tmpDir)
+		}
+	})
+
+	// Given mapping tables replaced twice
+	// When we list them
+	// Then only the last tables should be returned, ordered by source
+	It("should replace the mapping tables", func() {
+		// Arrange
+		Expect(s.MTVMapping().Replace(ctx, models.MTVMappings{
+			Networks: []models.MTVNetworkMapping{{Source: "old", Type: models.MTVNetworkPod}},
+			Storage:  []models.MTVStorageMapping{{Source: "old", StorageClass: "standard"}},
+		})).To(Succeed())
+
+		// Act
+		Expect(s.MTVMapping().Replace(ctx, models.MTVMappings{
+			Networks: []models.MTVNetworkMapping{
+				{Source: "VM Network", Type: models.MTVNetworkPod},
+				{Source: "DMZ", Type: models.MTVNetworkMultus, Namespace: "dmz", Name: "dmz-net"},
+			},
+		})).To(Succeed())
+		mappings, err := s.MTVMapping().List(ctx)
+
+		// Assert
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mappings.Networks).To(Equal([]models.MTVNetworkMapping{
+			{Source: "DMZ", Type: models.MTVNetworkMultus, Namespace: "dmz", Name: "dmz-net"},
+			{Source: "VM Network", Type: models.MTVNetworkPod},
+		}))
+		Expect(mappings.Storage).To(BeEmpty())
+	})
+})
@@ -92,6 +92,9 @@ type Database struct {
 	ID          string
 	Path        string
 	CreatedAt   time.Time
+	VCenter     string // credential profile the collection was built from; empty for main
+	Imported    bool   // imported from a bundle; never the latest collection
+	Signer      string // fingerprint of the signer of an imported bundle
 	mu          sync.Mutex
 	store       *Store2
 	accessMode  DatabaseAccessMode
@@ -148,9 +151,17 @@ func (d *Database) LastAccess() int64 {
 	return s.LastAccess()
 }
 
-// Clone exports this database and imports it into a new database at dstPath.
+// Clone exports this database and imports it into a new database next to it.
 // The source connection stays open and is not modified.
 func (d *Database) Clone(ctx context.Context) (*Database, error) {
+	cloneID := string(uuid.NewString()[:6])
+	clonePath := filepath.Join(filepath.Dir(d.Path), fmt.Sprintf("%s-%s.duckdb", filepath.Base(d.Path), cloneID))
+	return d.CloneTo(ctx, cloneID, clonePath)
+}
+
+// CloneTo exports this database and imports it into a new database with the given ID at dstPath.
+// The source connection stays open and is not modified.
+func (d *Database) CloneTo(ctx context.Context, id, dstPath string) (*Database, error) {
 	d.mu.Lock()
 	if d.connection == nil {
 		conn, err := newDatabase(NewDefaultExtentionLoader(), d.Path, d.memoryLimit, d.accessMode)
@@ -177,9 +188,7 @@ func (d *Database) Clone(ctx context.Context) (*Database, error) {
 		return nil, fmt.Errorf("exporting database: %w", err)
 	}
 
-	cloneID := string(uuid.NewString()[:6])
-	clonePath := filepath.Join(filepath.Dir(d.Path), fmt.Sprintf("%s-%s.duckdb", filepath.Base(d.Path), cloneID))
-	dstConn, err := newDatabase(NewDefaultExtentionLoader(), clonePath, d.memoryLimit, ReadWriteDatabase)
+	dstConn, err := newDatabase(NewDefaultExtentionLoader(), dstPath, d.memoryLimit, ReadWriteDatabase)
 	if err != nil {
 		return nil, fmt.Errorf("opening destination database: %w", err)
 	}
@@ -189,9 +198,12 @@ func (d *Database) Clone(ctx context.Context) (*Database, error) {
 	}
 
 	clone := Database{
-		ID:          cloneID,
-		Path:        clonePath,
+		ID:          id,
+		Path:        dstPath,
 		CreatedAt:   time.Now(),
+		VCenter:     d.VCenter,
+		Imported:    d.Imported,
+		Signer:      d.Signer,
 		connection:  dstConn,
 		accessMode:  ReadWriteDatabase,
 		memoryLimit: d.memoryLimit,
@@ -256,7 +268,7 @@ func (p *Pool) NewDatabase(id string, dbPath string, createdAt time.Time, initTy
 }
 
 func (p *Pool) Add(db *Database) {
-	if db.ID != MainDatabaseID {
+	if db.ID != MainDatabaseID && !db.Imported {
 		p.latestCollectionDatabase.Store(db)
 	}
 
@@ -286,6 +298,36 @@ func (p *Pool) Get(id string) (*Database, error) {
 	return db, nil
 }
 
+// Remove unregisters a collection database from the pool without closing it.
+// If it was the latest collection, the most recent remaining collection becomes the latest.
+func (p *Pool) Remove(id string) (*Database, error) {
+	if id == MainDatabaseID {
+		return nil, fmt.Errorf("the main database cannot be removed from the pool")
+	}
+
+	p.mu.Lock()
+	db, ok := p.databases[id]
+	if !ok {
+		p.mu.Unlock()
+		return nil, errors.NewResourceNotFoundError("database", id)
+	}
+	delete(p.databases, id)
+	p.mu.Unlock()
+
+	if p.latestCollectionDatabase.Load() == db {
+		var latest *Database
+		for candidate := range p.All() {
+			if candidate.ID != MainDatabaseID && !candidate.Imported {
+				latest = candidate
+				break
+			}
+		}
+		p.latestCollectionDatabase.Store(latest)
+	}
+
+	return db, nil
+}
+
 func (p *Pool) List() []*Database {
 	p.mu.Lock()
 	defer p.mu.Unlock()
@@ -305,6 +347,20 @@ func (p *Pool) Latest() (*Database, error) {
 	return nil, errors.NewResourceNotFoundError("collection", "latest")
 }
 
+// LatestFor returns the most recent collection database built from the given vCenter.
+// Imported collections are skipped.
+func (p *Pool) LatestFor(vcenter string) (*Database, error) {
+	for db := range p.All() {
+		if db.ID == MainDatabaseID || db.Imported {
+			continue
+		}
+		if db.VCenter == vcenter {
+			return db, nil
+		}
+	}
+	return nil, errors.NewResourceNotFoundError("collection", "latest for vcenter "+vcenter)
+}
+
 // All returns an iterator on sorted list of databases based on createdAt
 func (p *Pool) All() iter.Seq[*Database] {
 	dbs := p.List()
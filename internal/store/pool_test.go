@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
 )
 
 var _ = Describe("Pool", func() {
@@ -258,6 +259,87 @@ var _ = Describe("Pool", func() {
 		})
 	})
 
+	Context("LatestFor", func() {
+		It("returns the newest collection database of the given vCenter", func() {
+			east, err := pool.NewDatabase("col-east", filepath.Join(tmpDir, "col-east.duckdb"), time.Now().Add(-2*time.Hour), store.LazyConnectionInitilization, 0, store.ReadWriteDatabase)
+			Expect(err).NotTo(HaveOccurred())
+			east.VCenter = "vc-east"
+			pool.Add(east)
+
+			west, err := pool.NewDatabase("col-west", filepath.Join(tmpDir, "col-west.duckdb"), time.Now().Add(-1*time.Hour), store.LazyConnectionInitilization, 0, store.ReadWriteDatabase)
+			Expect(err).NotTo(HaveOccurred())
+			west.VCenter = "vc-west"
+			pool.Add(west)
+
+			db, err := pool.LatestFor("vc-east")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(db.ID).To(Equal("col-east"))
+
+			latest, err := pool.Latest()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(latest.ID).To(Equal("col-west"))
+		})
+
+		It("skips imported collections", func() {
+			own, err := pool.NewDatabase("col-own", filepath.Join(tmpDir, "col-own.duckdb"), time.Now().Add(-2*time.Hour), store.LazyConnectionInitilization, 0, store.ReadWriteDatabase)
+			Expect(err).NotTo(HaveOccurred())
+			own.VCenter = "vc-east"
+			pool.Add(own)
+
+			imported, err := pool.NewDatabase("col-imported", filepath.Join(tmpDir, "col-imported.duckdb"), time.Now(), store.LazyConnectionInitilization, 0, store.ReadWriteDatabase)
+			Expect(err).NotTo(HaveOccurred())
+			imported.VCenter = "vc-east"
+			imported.Imported = true
+			pool.Add(imported)
+
+			db, err := pool.LatestFor("vc-east")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(db.ID).To(Equal("col-own"))
+
+			latest, err := pool.Latest()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(latest.ID).To(Equal("col-own"))
+		})
+
+		It("returns not found for a vCenter without collections", func() {
+			db, err := pool.NewDatabase("col-east", filepath.Join(tmpDir, "col-east.duckdb"), time.Now(), store.LazyConnectionInitilization, 0, store.ReadWriteDatabase)
+			Expect(err).NotTo(HaveOccurred())
+			db.VCenter = "vc-east"
+			pool.Add(db)
+
+			_, err = pool.LatestFor("vc-west")
+			Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+		})
+	})
+
+	Context("Remove", func() {
+		It("unregisters the database and falls back to the newest remaining one as latest", func() {
+			older, err := pool.NewDatabase("col-old", filepath.Join(tmpDir, "col-old.duckdb"), time.Now().Add(-1*time.Hour), store.LazyConnectionInitilization, 0, store.ReadWriteDatabase)
+			Expect(err).NotTo(HaveOccurred())
+			pool.Add(older)
+
+			newer, err := pool.NewDatabase("col-new", filepath.Join(tmpDir, "col-new.duckdb"), time.Now(), store.LazyConnectionInitilization, 0, store.ReadWriteDatabase)
+			Expect(err).NotTo(HaveOccurred())
+			pool.Add(newer)
+
+			removed, err := pool.Remove("col-new")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removed).To(BeIdenticalTo(newer))
+
+			_, err = pool.Get("col-new")
+			Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+
+			latest, err := pool.Latest()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(latest.ID).To(Equal("col-old"))
+		})
+
+		It("returns not found for an unknown database", func() {
+			_, err := pool.Remove("missing")
+			Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+		})
+	})
+
 	Context("Close", func() {
 		It("should close all connections without removing entries", func() {
 			dbPath := filepath.Join(tmpDir, "close.duckdb")
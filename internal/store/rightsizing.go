@@ -53,6 +53,14 @@ const (
 	rsWarningsColWarning  = "warning"
 
 	rsUtilizationTable = "rightsizing_vm_utilization"
+
+	rsSeriesTable          = "rightsizing_vm_series"
+	rsSeriesColReportID    = "report_id"
+	rsSeriesColMOID        = "moid"
+	rsSeriesColMetricKey   = "metric_key"
+	rsSeriesColSampledAt   = "sampled_at"
+	rsSeriesColIntervalSec = "interval_sec"
+	rsSeriesColValue       = "value"
 )
 
 // RightSizingStore persists rightsizing report metadata and per-VM metric aggregates.
@@ -199,6 +207,27 @@ func (s *RightSizingStore) WriteVMWarnings(ctx context.Context, reportID string,
 	return nil
 }
 
+// WriteSamples persists the samples a report computed its statistics from. VCenter is ignored
+// and duplicate rows (same report_id/moid/metric_key/sampled_at) are silently ignored.
+func (s *RightSizingStore) WriteSamples(ctx context.Context, reportID string, samples []models.RightsizingSample) error {
+	for i := 0; i < len(samples); i += rsSampleInsertChunk {
+		builder := sq.Insert(rsSeriesTable).
+			Columns(rsSeriesColReportID, rsSeriesColMOID, rsSeriesColMetricKey, rsSeriesColSampledAt, rsSeriesColIntervalSec, rsSeriesColValue).
+			Suffix("ON CONFLICT DO NOTHING")
+		for _, smp := range samples[i:min(i+rsSampleInsertChunk, len(samples))] {
+			builder = builder.Values(reportID, smp.MOID, smp.MetricKey, smp.SampledAt.UTC(), int64(smp.Interval/time.Second), smp.Value)
+		}
+		query, args, err := builder.ToSql()
+		if err != nil {
+			return fmt.Errorf("building write samples query: %w", err)
+		}
+		if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("inserting samples: %w", err)
+		}
+	}
+	return nil
+}
+
 // ComputeAndStoreUtilization computes per-VM utilization percentages from collected
 // metrics and the vinfo inventory, persisting them to rightsizing_vm_utilization.
 // Uses a single SQL pivot query; idempotent via ON CONFLICT DO NOTHING.
@@ -210,7 +239,9 @@ INSERT INTO rightsizing_vm_utilization
      cpu_avg_pct, cpu_p95_pct, cpu_max_pct, cpu_latest_pct,
      mem_avg_pct, mem_p95_pct, mem_max_pct, mem_latest_pct,
      disk_pct, confidence_pct,
-     cluster_id, cluster_name, provisioned_cpus, provisioned_memory_mb, provisioned_disk_kb)
+     cluster_id, cluster_name, provisioned_cpus, provisioned_memory_mb, provisioned_disk_kb,
+     net_avg_kbps, net_p95_kbps, net_max_kbps, net_rx_p95_kbps, net_tx_p95_kbps,
+     iops_avg, iops_p95, iops_max, read_latency_p95_ms, write_latency_p95_ms)
 SELECT
     rm.report_id,
     rm.moid,
@@ -232,7 +263,17 @@ SELECT
     v."Cluster"                                                                   AS cluster_name,
     CAST(v."CPUs" AS INTEGER)                                                     AS provisioned_cpus,
     CAST(v."Memory" AS INTEGER)                                                   AS provisioned_memory_mb,
-    MAX(CASE WHEN rm.metric_key = 'disk.provisioned.latest' THEN rm.latest END)  AS provisioned_disk_kb
+    MAX(CASE WHEN rm.metric_key = 'disk.provisioned.latest' THEN rm.latest END)  AS provisioned_disk_kb,
+    MAX(CASE WHEN rm.metric_key = 'net.usage.average'       THEN rm.average END) AS net_avg_kbps,
+    MAX(CASE WHEN rm.metric_key = 'net.usage.average'       THEN rm.p95     END) AS net_p95_kbps,
+    MAX(CASE WHEN rm.metric_key = 'net.usage.average'       THEN rm.max     END) AS net_max_kbps,
+    MAX(CASE WHEN rm.metric_key = 'net.received.average'    THEN rm.p95     END) AS net_rx_p95_kbps,
+    MAX(CASE WHEN rm.metric_key = 'net.transmitted.average' THEN rm.p95     END) AS net_tx_p95_kbps,
+    MAX(CASE WHEN rm.metric_key = 'virtualDisk.iops.average' THEN rm.average END) AS iops_avg,
+    MAX(CASE WHEN rm.metric_key = 'virtualDisk.iops.average' THEN rm.p95     END) AS iops_p95,
+    MAX(CASE WHEN rm.metric_key = 'virtualDisk.iops.average' THEN rm.max     END) AS iops_max,
+    MAX(CASE WHEN rm.metric_key = 'virtualDisk.totalReadLatency.average'  THEN rm.p95 END) AS read_latency_p95_ms,
+    MAX(CASE WHEN rm.metric_key = 'virtualDisk.totalWriteLatency.average' THEN rm.p95 END) AS write_latency_p95_ms
 FROM rightsizing_metrics rm
 LEFT JOIN vinfo v ON v."VM ID" = rm.moid
 LEFT JOIN vcluster vc ON vc."Name" = v."Cluster"
@@ -250,6 +291,7 @@ ON CONFLICT DO NOTHING`
 // clusterUtilizationRows executes the cluster aggregation query and scans results.
 // Utilization uses pure weighted averages (no confidence multiplier).
 // Confidence is reported separately as a vCPU-weighted score.
+// Network throughput and IOPS add up across VMs; disk latencies are those of the slowest VM.
 // NULLIF guards prevent division-by-zero when provisioned resource data is absent.
 func (s *RightSizingStore) clusterUtilizationRows(ctx context.Context, reportID, filterExpr string) ([]models.RightsizingClusterUtilization, error) {
 	builder := sq.Select(
@@ -267,6 +309,14 @@ func (s *RightSizingStore) clusterUtilizationRows(ctx context.Context, reportID,
 		"COALESCE(SUM(provisioned_cpus), 0) AS total_provisioned_cpus",
 		"COALESCE(SUM(provisioned_memory_mb), 0) AS total_provisioned_memory_mb",
 		"COALESCE(SUM(provisioned_disk_kb), 0) AS total_provisioned_disk_kb",
+		"SUM(net_avg_kbps) AS net_avg",
+		"SUM(net_p95_kbps) AS net_p95",
+		"SUM(net_max_kbps) AS net_max",
+		"SUM(iops_avg) AS iops_avg",
+		"SUM(iops_p95) AS iops_p95",
+		"SUM(iops_max) AS iops_max",
+		"MAX(read_latency_p95_ms) AS read_latency_p95",
+		"MAX(write_latency_p95_ms) AS write_latency_p95",
 	).From(rsUtilizationTable).
 		Where(sq.Eq{"report_id": reportID}).
 		Where(sq.NotEq{"cluster_name": nil}).
@@ -301,6 +351,10 @@ func (s *RightSizingStore) clusterUtilizationRows(ctx context.Context, reportID,
 			cpuAvg, cpuP95, cpuMax sql.NullFloat64
 			memAvg, memP95, memMax sql.NullFloat64
 			disk, confidence       sql.NullFloat64
+			netAvg, netP95, netMax sql.NullFloat64
+			iopsAvg, iopsP95       sql.NullFloat64
+			iopsMax                sql.NullFloat64
+			readLat, writeLat      sql.NullFloat64
 		)
 		if err := rows.Scan(
 			&c.ClusterID, &c.ClusterName, &c.VMCount,
@@ -308,6 +362,9 @@ func (s *RightSizingStore) clusterUtilizationRows(ctx context.Context, reportID,
 			&memAvg, &memP95, &memMax,
 			&disk, &confidence,
 			&c.TotalProvisionedCpus, &c.TotalProvisionedMemoryMb, &c.TotalProvisionedDiskKb,
+			&netAvg, &netP95, &netMax,
+			&iopsAvg, &iopsP95, &iopsMax,
+			&readLat, &writeLat,
 		); err != nil {
 			return nil, fmt.Errorf("scanning cluster utilization row: %w", err)
 		}
@@ -319,6 +376,14 @@ func (s *RightSizingStore) clusterUtilizationRows(ctx context.Context, reportID,
 		c.MemMax = memMax.Float64
 		c.Disk = disk.Float64
 		c.Confidence = confidence.Float64
+		c.NetAvg = netAvg.Float64
+		c.NetP95 = netP95.Float64
+		c.NetMax = netMax.Float64
+		c.IopsAvg = iopsAvg.Float64
+		c.IopsP95 = iopsP95.Float64
+		c.IopsMax = iopsMax.Float64
+		c.ReadLatencyP95 = readLat.Float64
+		c.WriteLatencyP95 = writeLat.Float64
 		result = append(result, c)
 	}
 	return result, rows.Err()
@@ -355,6 +420,106 @@ func (s *RightSizingStore) ListLatestClusterUtilization(ctx context.Context, fil
 	return reportID, clusters, err
 }
 
+// ListLatestVMStats returns the provisioned resources and the CPU and memory statistics of
+// the VMs of the latest report with written batches, ordered by VM ID, along with the report
+// ID. VMs without CPU or memory samples are left out. filter, when not nil, restricts the VMs
+// and may refer to vinfo as v. It returns an empty report ID when there is no report.
+func (s *RightSizingStore) ListLatestVMStats(ctx context.Context, filter sq.Sqlizer) (string, []models.RightsizingVMStats, error) {
+	latestReportSQL, latestReportArgs, err := sq.Select(rsReportsColID).
+		From(rsReportsTable).
+		Where(sq.Gt{rsReportsColWrittenBatchCount: 0}).
+		OrderBy(rsReportsColCreatedAt + " DESC").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return "", nil, fmt.Errorf("building latest report query: %w", err)
+	}
+	var reportID string
+	err = s.db.QueryRowContext(ctx, latestReportSQL, latestReportArgs...).Scan(&reportID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil, nil
+		}
+		return "", nil, fmt.Errorf("finding latest report: %w", err)
+	}
+
+	builder := sq.Select(
+		`v."VM ID"`,
+		`COALESCE(v."VM", '')`,
+		`COALESCE(v."Cluster", '')`,
+		`COALESCE(v."CPUs", 0)`,
+		`COALESCE(c."Cores p/s", 0)`,
+		`COALESCE(v."Memory", 0)`,
+		"r.expected_sample_count",
+		"cpu.sample_count", "cpu.average", "cpu.p95", "cpu.p99", "cpu.max", "cpu.latest",
+		"mem.sample_count", "mem.average", "mem.p95", "mem.p99", "mem.max", "mem.latest",
+	).
+		From("vinfo v").
+		Join(rsReportsTable+" r ON r.id = ?", reportID).
+		LeftJoin(rsMetricsTable + ` cpu ON cpu.report_id = r.id AND cpu.moid = v."VM ID" AND cpu.metric_key = 'cpu.usage.average'`).
+		LeftJoin(rsMetricsTable + ` mem ON mem.report_id = r.id AND mem.moid = v."VM ID" AND mem.metric_key = 'mem.consumed.average'`).
+		LeftJoin(`vcpu c ON c."VM ID" = v."VM ID"`).
+		Where("(cpu.moid IS NOT NULL OR mem.moid IS NOT NULL)").
+		OrderBy(`v."VM ID"`)
+	if filter != nil {
+		builder = builder.Where(filter)
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return "", nil, fmt.Errorf("building VM stats query: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return "", nil, fmt.Errorf("querying VM stats: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	stats := []models.RightsizingVMStats{}
+	for rows.Next() {
+		var (
+			vm       models.RightsizingVMStats
+			cpu, mem nullMetricStats
+		)
+		if err := rows.Scan(
+			&vm.MOID, &vm.Name, &vm.Cluster, &vm.ProvisionedCPUs, &vm.CoresPerSocket, &vm.ProvisionedMemoryMB,
+			&vm.ExpectedSampleCount,
+			&cpu.sampleCount, &cpu.average, &cpu.p95, &cpu.p99, &cpu.max, &cpu.latest,
+			&mem.sampleCount, &mem.average, &mem.p95, &mem.p99, &mem.max, &mem.latest,
+		); err != nil {
+			return "", nil, fmt.Errorf("scanning VM stats: %w", err)
+		}
+		vm.CPU = cpu.stats()
+		vm.Memory = mem.stats()
+		stats = append(stats, vm)
+	}
+	if err := rows.Err(); err != nil {
+		return "", nil, fmt.Errorf("iterating VM stats rows: %w", err)
+	}
+	return reportID, stats, nil
+}
+
+// nullMetricStats scans the statistics of a left-joined metric row.
+type nullMetricStats struct {
+	sampleCount                    sql.NullInt64
+	average, p95, p99, max, latest sql.NullFloat64
+}
+
+// stats returns the scanned statistics, or nil when the metric row is missing.
+func (n nullMetricStats) stats() *models.RightsizingMetricStats {
+	if !n.sampleCount.Valid {
+		return nil
+	}
+	return &models.RightsizingMetricStats{
+		SampleCount: int(n.sampleCount.Int64),
+		Average:     n.average.Float64,
+		P95:         n.p95.Float64,
+		P99:         n.p99.Float64,
+		Max:         n.max.Float64,
+		Latest:      n.latest.Float64,
+	}
+}
+
 // GetVMUtilization returns the full utilization breakdown for a VM from the latest
 // completed report (written_batch_count > 0). Returns ResourceNotFoundError if no
 // rightsizing data exists for this VM.
@@ -374,6 +539,8 @@ func (s *RightSizingStore) GetVMUtilization(ctx context.Context, moid string) (*
 		"cpu_avg_pct", "cpu_p95_pct", "cpu_max_pct", "cpu_latest_pct",
 		"mem_avg_pct", "mem_p95_pct", "mem_max_pct", "mem_latest_pct",
 		"disk_pct", "confidence_pct",
+		"net_avg_kbps", "net_p95_kbps", "net_max_kbps", "net_rx_p95_kbps", "net_tx_p95_kbps",
+		"iops_avg", "iops_p95", "iops_max", "read_latency_p95_ms", "write_latency_p95_ms",
 	).From(rsUtilizationTable).
 		Where(sq.Eq{"moid": moid}).
 		Where("report_id = ("+subSQL+")", subArgs...).
@@ -390,6 +557,10 @@ func (s *RightSizingStore) GetVMUtilization(ctx context.Context, moid string) (*
 		cpuAvg, cpuP95, cpuMax, cpuLatest sql.NullFloat64
 		memAvg, memP95, memMax, memLatest sql.NullFloat64
 		disk, confidence                  sql.NullFloat64
+		netAvg, netP95, netMax            sql.NullFloat64
+		netRxP95, netTxP95                sql.NullFloat64
+		iopsAvg, iopsP95, iopsMax         sql.NullFloat64
+		readLat, writeLat                 sql.NullFloat64
 	)
 	err = s.db.QueryRowContext(ctx, query, args...).Scan(
 		&d.MOID, &d.VMName,
@@ -397,6 +568,8 @@ func (s *RightSizingStore) GetVMUtilization(ctx context.Context, moid string) (*
 		&cpuAvg, &cpuP95, &cpuMax, &cpuLatest,
 		&memAvg, &memP95, &memMax, &memLatest,
 		&disk, &confidence,
+		&netAvg, &netP95, &netMax, &netRxP95, &netTxP95,
+		&iopsAvg, &iopsP95, &iopsMax, &readLat, &writeLat,
 	)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, srvErrors.NewResourceNotFoundError("vm rightsizing", moid)
@@ -417,9 +590,119 @@ func (s *RightSizingStore) GetVMUtilization(ctx context.Context, moid string) (*
 	d.MemLatest = memLatest.Float64
 	d.Disk = disk.Float64
 	d.Confidence = confidence.Float64
+	d.NetAvg = netAvg.Float64
+	d.NetP95 = netP95.Float64
+	d.NetMax = netMax.Float64
+	d.NetRxP95 = netRxP95.Float64
+	d.NetTxP95 = netTxP95.Float64
+	d.IopsAvg = iopsAvg.Float64
+	d.IopsP95 = iopsP95.Float64
+	d.IopsMax = iopsMax.Float64
+	d.ReadLatencyP95 = readLat.Float64
+	d.WriteLatencyP95 = writeLat.Float64
 	return &d, nil
 }
 
+// GetReportSummary returns the metadata of a report. Returns a ResourceNotFoundError if the ID
+// does not exist.
+func (s *RightSizingStore) GetReportSummary(ctx context.Context, id string) (*models.RightsizingReportSummary, error) {
+	r, err := s.reportSummary(ctx, sq.Eq{rsReportsColID: id})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, srvErrors.NewResourceNotFoundError("rightsizing report", id)
+	}
+	return r, err
+}
+
+// GetLatestReportSummary returns the metadata of the latest completed report
+// (written_batch_count > 0). Returns a ResourceNotFoundError if there is none.
+func (s *RightSizingStore) GetLatestReportSummary(ctx context.Context) (*models.RightsizingReportSummary, error) {
+	r, err := s.reportSummary(ctx, sq.Gt{rsReportsColWrittenBatchCount: 0})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, srvErrors.NewResourceNotFoundError("rightsizing report", "latest")
+	}
+	return r, err
+}
+
+func (s *RightSizingStore) reportSummary(ctx context.Context, where sq.Sqlizer) (*models.RightsizingReportSummary, error) {
+	query, args, err := sq.Select(
+		rsReportsColID, rsReportsColVCenter, rsReportsColClusterID, rsReportsColIntervalID,
+		rsReportsColWindowStart, rsReportsColWindowEnd, rsReportsColExpectedSampleCount, rsReportsColCreatedAt,
+	).From(rsReportsTable).
+		Where(where).
+		OrderBy(rsReportsColCreatedAt + " DESC").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building report summary query: %w", err)
+	}
+
+	var r models.RightsizingReportSummary
+	err = s.db.QueryRowContext(ctx, query, args...).Scan(
+		&r.ID, &r.VCenter, &r.ClusterID, &r.IntervalID,
+		&r.WindowStart, &r.WindowEnd, &r.ExpectedSampleCount, &r.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("scanning report summary: %w", err)
+	}
+	return &r, nil
+}
+
+// GetVMSeries returns the samples of a VM in a report aggregated into buckets aligned on the
+// Unix epoch, one series per metric ordered by metric key, with points ordered by time. When
+// metricKeys is not empty, only those metrics are returned. Returns an empty slice when the
+// report holds no samples of the VM.
+func (s *RightSizingStore) GetVMSeries(ctx context.Context, reportID, moid string, bucket time.Duration, metricKeys []string) ([]models.RightsizingMetricSeries, error) {
+	bucketUs := bucket.Microseconds()
+	builder := sq.Select(
+		rsSeriesColMetricKey,
+		fmt.Sprintf("make_timestamp(epoch_us(%s) // %d * %d) AS bucket", rsSeriesColSampledAt, bucketUs, bucketUs),
+		"COUNT(*)",
+		fmt.Sprintf("AVG(%s)", rsSeriesColValue),
+		fmt.Sprintf("MIN(%s)", rsSeriesColValue),
+		fmt.Sprintf("MAX(%s)", rsSeriesColValue),
+	).
+		From(rsSeriesTable).
+		Where(sq.Eq{rsSeriesColReportID: reportID, rsSeriesColMOID: moid}).
+		GroupBy("1", "2").
+		OrderBy("1", "2")
+	if len(metricKeys) > 0 {
+		builder = builder.Where(sq.Eq{rsSeriesColMetricKey: metricKeys})
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building VM series query: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying VM series: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	series := []models.RightsizingMetricSeries{}
+	for rows.Next() {
+		var (
+			key string
+			p   models.RightsizingSeriesPoint
+		)
+		if err := rows.Scan(&key, &p.Timestamp, &p.SampleCount, &p.Average, &p.Min, &p.Max); err != nil {
+			return nil, fmt.Errorf("scanning VM series point: %w", err)
+		}
+		p.Timestamp = p.Timestamp.UTC()
+		if n := len(series); n == 0 || series[n-1].MetricKey != key {
+			series = append(series, models.RightsizingMetricSeries{MetricKey: key})
+		}
+		series[len(series)-1].Points = append(series[len(series)-1].Points, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating VM series rows: %w", err)
+	}
+	return series, nil
+}
+
 // ListInventoryVMs reads VM IDs and names from the local inventory (vinfo table).
 // "VM ID" is the MoRef value; "VM" is the display name.
 // Returns all entries ordered by name.
@@ -0,0 +1,219 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+const (
+	rsPolicyTable = "agent.main.rightsizing_policies"
+
+	rsPolicyColName           = "name"
+	rsPolicyColDescription    = "description"
+	rsPolicyColPercentile     = "percentile"
+	rsPolicyColHeadroomPct    = "headroom_pct"
+	rsPolicyColMinCPUs        = "min_cpus"
+	rsPolicyColMaxCPUs        = "max_cpus"
+	rsPolicyColMinMemoryMB    = "min_memory_mb"
+	rsPolicyColMaxMemoryMB    = "max_memory_mb"
+	rsPolicyColRoundToSockets = "round_to_sockets"
+	rsPolicyColMemoryStepMB   = "memory_step_mb"
+	rsPolicyColCreatedAt      = "created_at"
+	rsPolicyColUpdatedAt      = "updated_at"
+)
+
+var rsPolicySelectColumns = []string{
+	rsPolicyColName,
+	rsPolicyColDescription,
+	rsPolicyColPercentile,
+	rsPolicyColHeadroomPct,
+	rsPolicyColMinCPUs,
+	rsPolicyColMaxCPUs,
+	rsPolicyColMinMemoryMB,
+	rsPolicyColMaxMemoryMB,
+	rsPolicyColRoundToSockets,
+	rsPolicyColMemoryStepMB,
+	rsPolicyColCreatedAt,
+	rsPolicyColUpdatedAt,
+}
+
+// RightsizingPolicyStore persists rightsizing policies in the main database.
+type RightsizingPolicyStore struct {
+	db QueryInterceptor
+}
+
+func NewRightsizingPolicyStore(db QueryInterceptor) *RightsizingPolicyStore {
+	return &RightsizingPolicyStore{db: db}
+}
+
+// List returns all policies, ordered by name.
+func (s *RightsizingPolicyStore) List(ctx context.Context) ([]models.RightsizingPolicy, error) {
+	query, args, err := sq.Select(rsPolicySelectColumns...).
+		From(rsPolicyTable).
+		OrderBy(rsPolicyColName).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building list rightsizing policies query: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying rightsizing policies: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	policies := []models.RightsizingPolicy{}
+	for rows.Next() {
+		p, err := scanRightsizingPolicy(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning rightsizing policy: %w", err)
+		}
+		policies = append(policies, *p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating rightsizing policy rows: %w", err)
+	}
+	return policies, nil
+}
+
+// Get returns a policy by name.
+func (s *RightsizingPolicyStore) Get(ctx context.Context, name string) (*models.RightsizingPolicy, error) {
+	query, args, err := sq.Select(rsPolicySelectColumns...).
+		From(rsPolicyTable).
+		Where(sq.Eq{rsPolicyColName: name}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building get rightsizing policy query: %w", err)
+	}
+
+	p, err := scanRightsizingPolicy(s.db.QueryRowContext(ctx, query, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, srvErrors.NewResourceNotFoundError("rightsizing policy", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("scanning rightsizing policy: %w", err)
+	}
+	return p, nil
+}
+
+// Create inserts a new policy and returns the persisted record.
+func (s *RightsizingPolicyStore) Create(ctx context.Context, p models.RightsizingPolicy) (*models.RightsizingPolicy, error) {
+	query, args, err := sq.Insert(rsPolicyTable).
+		Columns(
+			rsPolicyColName,
+			rsPolicyColDescription,
+			rsPolicyColPercentile,
+			rsPolicyColHeadroomPct,
+			rsPolicyColMinCPUs,
+			rsPolicyColMaxCPUs,
+			rsPolicyColMinMemoryMB,
+			rsPolicyColMaxMemoryMB,
+			rsPolicyColRoundToSockets,
+			rsPolicyColMemoryStepMB,
+		).
+		Values(
+			p.Name, p.Description, p.Percentile, p.HeadroomPct,
+			p.MinCPUs, p.MaxCPUs, p.MinMemoryMB, p.MaxMemoryMB,
+			p.RoundToSockets, p.MemoryStepMB,
+		).
+		Suffix(fmt.Sprintf("RETURNING %s", strings.Join(rsPolicySelectColumns, ", "))).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building create rightsizing policy query: %w", err)
+	}
+
+	created, err := scanRightsizingPolicy(s.db.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return nil, srvErrors.NewDuplicateResourceError("rightsizing policy", "name", p.Name)
+		}
+		return nil, fmt.Errorf("creating rightsizing policy: %w", err)
+	}
+	return created, nil
+}
+
+// Update replaces every field of a policy but its name and creation time.
+func (s *RightsizingPolicyStore) Update(ctx context.Context, p models.RightsizingPolicy) (*models.RightsizingPolicy, error) {
+	query, args, err := sq.Update(rsPolicyTable).
+		Set(rsPolicyColDescription, p.Description).
+		Set(rsPolicyColPercentile, p.Percentile).
+		Set(rsPolicyColHeadroomPct, p.HeadroomPct).
+		Set(rsPolicyColMinCPUs, p.MinCPUs).
+		Set(rsPolicyColMaxCPUs, p.MaxCPUs).
+		Set(rsPolicyColMinMemoryMB, p.MinMemoryMB).
+		Set(rsPolicyColMaxMemoryMB, p.MaxMemoryMB).
+		Set(rsPolicyColRoundToSockets, p.RoundToSockets).
+		Set(rsPolicyColMemoryStepMB, p.MemoryStepMB).
+		Set(rsPolicyColUpdatedAt, time.Now()).
+		Where(sq.Eq{rsPolicyColName: p.Name}).
+		Suffix(fmt.Sprintf("RETURNING %s", strings.Join(rsPolicySelectColumns, ", "))).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building update rightsizing policy query: %w", err)
+	}
+
+	updated, err := scanRightsizingPolicy(s.db.QueryRowContext(ctx, query, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, srvErrors.NewResourceNotFoundError("rightsizing policy", p.Name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("updating rightsizing policy: %w", err)
+	}
+	return updated, nil
+}
+
+// Delete removes a policy.
+func (s *RightsizingPolicyStore) Delete(ctx context.Context, name string) error {
+	query, args, err := sq.Delete(rsPolicyTable).
+		Where(sq.Eq{rsPolicyColName: name}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete rightsizing policy query: %w", err)
+	}
+
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("deleting rightsizing policy: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("checking rows affected: %w", err)
+	}
+	if n == 0 {
+		return srvErrors.NewResourceNotFoundError("rightsizing policy", name)
+	}
+	return nil
+}
+
+// scanRightsizingPolicy scans one row into a *models.RightsizingPolicy.
+// Column order must match rsPolicySelectColumns exactly.
+func scanRightsizingPolicy(row rowScanner) (*models.RightsizingPolicy, error) {
+	var p models.RightsizingPolicy
+	err := row.Scan(
+		&p.Name,
+		&p.Description,
+		&p.Percentile,
+		&p.HeadroomPct,
+		&p.MinCPUs,
+		&p.MaxCPUs,
+		&p.MinMemoryMB,
+		&p.MaxMemoryMB,
+		&p.RoundToSockets,
+		&p.MemoryStepMB,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
@@ -0,0 +1,346 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+)
+
+const (
+	rsSampleTable          = "agent.main.rightsizing_samples"
+	rsSampleColVCenter     = "vcenter"
+	rsSampleColMOID        = "moid"
+	rsSampleColMetricKey   = "metric_key"
+	rsSampleColSampledAt   = "sampled_at"
+	rsSampleColIntervalSec = "interval_sec"
+	rsSampleColValue       = "value"
+
+	rsAccTable                 = "agent.main.rightsizing_accumulation"
+	rsAccColID                 = "id"
+	rsAccColEnabled            = "enabled"
+	rsAccColIntervalSec        = "interval_sec"
+	rsAccColRawRetentionSec    = "raw_retention_sec"
+	rsAccColDownsampleInterval = "downsample_interval_sec"
+	rsAccColDownsample         = "downsample"
+	rsAccColRetentionSec       = "retention_sec"
+	rsAccColLastRunAt          = "last_run_at"
+	rsAccColLastSampleCount    = "last_sample_count"
+	rsAccColLastError          = "last_error"
+
+	// rsSampleInsertChunk caps the rows of a single INSERT statement.
+	rsSampleInsertChunk = 1000
+)
+
+// RightsizingSampleStore persists the rolling rightsizing samples and the configuration of
+// their accumulation in the main database.
+type RightsizingSampleStore struct {
+	db QueryInterceptor
+}
+
+func NewRightsizingSampleStore(db QueryInterceptor) *RightsizingSampleStore {
+	return &RightsizingSampleStore{db: db}
+}
+
+// GetAccumulation returns the configuration and last run of the accumulation, along with the
+// extent of the rolling store.
+func (s *RightsizingSampleStore) GetAccumulation(ctx context.Context) (*models.RightsizingAccumulationStatus, error) {
+	query, args, err := sq.Select(
+		rsAccColEnabled, rsAccColIntervalSec, rsAccColRawRetentionSec, rsAccColDownsampleInterval,
+		rsAccColDownsample, rsAccColRetentionSec, rsAccColLastRunAt, rsAccColLastSampleCount, rsAccColLastError,
+	).
+		From(rsAccTable).
+		Where(sq.Eq{rsAccColID: 1}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building get accumulation query: %w", err)
+	}
+
+	var (
+		st                                                    models.RightsizingAccumulationStatus
+		interval, rawRetention, downsampleInterval, retention int64
+		downsample                                            string
+		lastRunAt                                             sql.NullTime
+		lastError                                             sql.NullString
+	)
+	if err := s.db.QueryRowContext(ctx, query, args...).Scan(
+		&st.Enabled, &interval, &rawRetention, &downsampleInterval, &downsample, &retention,
+		&lastRunAt, &st.LastSampleCount, &lastError,
+	); err != nil {
+		return nil, fmt.Errorf("scanning accumulation: %w", err)
+	}
+	st.Interval = time.Duration(interval) * time.Second
+	st.RawRetention = time.Duration(rawRetention) * time.Second
+	st.DownsampleInterval = time.Duration(downsampleInterval) * time.Second
+	st.Downsample = models.DownsampleFunc(downsample)
+	st.Retention = time.Duration(retention) * time.Second
+	if lastRunAt.Valid {
+		st.LastRunAt = &lastRunAt.Time
+	}
+	st.LastError = lastError.String
+
+	query, args, err = sq.Select("COUNT(*)", "MIN("+rsSampleColSampledAt+")", "MAX("+rsSampleColSampledAt+")").
+		From(rsSampleTable).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building sample extent query: %w", err)
+	}
+	var oldest, newest sql.NullTime
+	if err := s.db.QueryRowContext(ctx, query, args...).Scan(&st.SampleCount, &oldest, &newest); err != nil {
+		return nil, fmt.Errorf("scanning sample extent: %w", err)
+	}
+	if oldest.Valid {
+		st.OldestSampleAt = &oldest.Time
+		st.NewestSampleAt = &newest.Time
+	}
+	return &st, nil
+}
+
+// UpdateAccumulation replaces the configuration of the accumulation.
+func (s *RightsizingSampleStore) UpdateAccumulation(ctx context.Context, a models.RightsizingAccumulation) error {
+	query, args, err := sq.Update(rsAccTable).
+		Set(rsAccColEnabled, a.Enabled).
+		Set(rsAccColIntervalSec, int64(a.Interval/time.Second)).
+		Set(rsAccColRawRetentionSec, int64(a.RawRetention/time.Second)).
+		Set(rsAccColDownsampleInterval, int64(a.DownsampleInterval/time.Second)).
+		Set(rsAccColDownsample, string(a.Downsample)).
+		Set(rsAccColRetentionSec, int64(a.Retention/time.Second)).
+		Where(sq.Eq{rsAccColID: 1}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building update accumulation query: %w", err)
+	}
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("updating accumulation: %w", err)
+	}
+	return nil
+}
+
+// RecordRun records the time, appended sample count and error, empty on success, of an
+// accumulation run.
+func (s *RightsizingSampleStore) RecordRun(ctx context.Context, at time.Time, sampleCount int, runErr string) error {
+	var lastError any
+	if runErr != "" {
+		lastError = runErr
+	}
+	query, args, err := sq.Update(rsAccTable).
+		Set(rsAccColLastRunAt, at).
+		Set(rsAccColLastSampleCount, sampleCount).
+		Set(rsAccColLastError, lastError).
+		Where(sq.Eq{rsAccColID: 1}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building record accumulation run query: %w", err)
+	}
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("recording accumulation run: %w", err)
+	}
+	return nil
+}
+
+// Append inserts samples, ignoring those already stored, and returns how many were inserted.
+func (s *RightsizingSampleStore) Append(ctx context.Context, samples []models.RightsizingSample) (int, error) {
+	inserted := 0
+	for i := 0; i < len(samples); i += rsSampleInsertChunk {
+		builder := sq.Insert(rsSampleTable).
+			Columns(rsSampleColVCenter, rsSampleColMOID, rsSampleColMetricKey, rsSampleColSampledAt, rsSampleColIntervalSec, rsSampleColValue).
+			Suffix("ON CONFLICT DO NOTHING")
+		for _, smp := range samples[i:min(i+rsSampleInsertChunk, len(samples))] {
+			builder = builder.Values(smp.VCenter, smp.MOID, smp.MetricKey, smp.SampledAt.UTC(), int64(smp.Interval/time.Second), smp.Value)
+		}
+		query, args, err := builder.ToSql()
+		if err != nil {
+			return inserted, fmt.Errorf("building append samples query: %w", err)
+		}
+		result, err := s.db.ExecContext(ctx, query, args...)
+		if err != nil {
+			return inserted, fmt.Errorf("appending samples: %w", err)
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return inserted, fmt.Errorf("checking rows affected: %w", err)
+		}
+		inserted += int(n)
+	}
+	return inserted, nil
+}
+
+// NewestSampleAt returns the time of the newest sample of a vCenter, nil without samples.
+func (s *RightsizingSampleStore) NewestSampleAt(ctx context.Context, vcenter string) (*time.Time, error) {
+	query, args, err := sq.Select("MAX(" + rsSampleColSampledAt + ")").
+		From(rsSampleTable).
+		Where(sq.Eq{rsSampleColVCenter: vcenter}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building newest sample query: %w", err)
+	}
+	var newest sql.NullTime
+	if err := s.db.QueryRowContext(ctx, query, args...).Scan(&newest); err != nil {
+		return nil, fmt.Errorf("scanning newest sample: %w", err)
+	}
+	if !newest.Valid {
+		return nil, nil
+	}
+	return &newest.Time, nil
+}
+
+// Downsample aggregates the samples finer than bucket taken before a time into one sample per
+// bucket, then deletes them. Buckets are aligned on the Unix epoch and before must be a
+// bucket boundary, so that no bucket is aggregated twice. Run it in a transaction.
+func (s *RightsizingSampleStore) Downsample(ctx context.Context, before time.Time, bucket time.Duration, fn models.DownsampleFunc) (int64, error) {
+	aggregate := "AVG(" + rsSampleColValue + ")"
+	if fn == models.DownsampleMax {
+		aggregate = "MAX(" + rsSampleColValue + ")"
+	}
+	bucketSec := int64(bucket / time.Second)
+	bucketUs := bucket.Microseconds()
+
+	selectSQL, selectArgs, err := sq.Select(
+		rsSampleColVCenter, rsSampleColMOID, rsSampleColMetricKey,
+		fmt.Sprintf("make_timestamp(epoch_us(%s) // %d * %d)", rsSampleColSampledAt, bucketUs, bucketUs),
+		fmt.Sprintf("%d", bucketSec),
+		aggregate,
+	).
+		From(rsSampleTable).
+		Where(sq.Lt{rsSampleColIntervalSec: bucketSec}).
+		Where(sq.Lt{rsSampleColSampledAt: before.UTC()}).
+		GroupBy("1", "2", "3", "4").
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building downsample query: %w", err)
+	}
+	insertSQL := fmt.Sprintf("INSERT INTO %s (%s, %s, %s, %s, %s, %s) %s ON CONFLICT DO NOTHING",
+		rsSampleTable, rsSampleColVCenter, rsSampleColMOID, rsSampleColMetricKey, rsSampleColSampledAt,
+		rsSampleColIntervalSec, rsSampleColValue, selectSQL)
+	result, err := s.db.ExecContext(ctx, insertSQL, selectArgs...)
+	if err != nil {
+		return 0, fmt.Errorf("downsampling samples: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("checking rows affected: %w", err)
+	}
+
+	deleteSQL, deleteArgs, err := sq.Delete(rsSampleTable).
+		Where(sq.Lt{rsSampleColIntervalSec: bucketSec}).
+		Where(sq.Lt{rsSampleColSampledAt: before.UTC()}).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building delete downsampled query: %w", err)
+	}
+	if _, err := s.db.ExecContext(ctx, deleteSQL, deleteArgs...); err != nil {
+		return 0, fmt.Errorf("deleting downsampled samples: %w", err)
+	}
+	return n, nil
+}
+
+// Prune deletes the samples taken before a time and returns how many were deleted.
+func (s *RightsizingSampleStore) Prune(ctx context.Context, before time.Time) (int64, error) {
+	query, args, err := sq.Delete(rsSampleTable).
+		Where(sq.Lt{rsSampleColSampledAt: before.UTC()}).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building prune samples query: %w", err)
+	}
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("pruning samples: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("checking rows affected: %w", err)
+	}
+	return n, nil
+}
+
+// Stats returns the statistics of the samples of each VM metric of a vCenter taken in
+// [start, end), ordered by VM and metric. Percentiles are nearest-rank, like those of the
+// statistics vCenter reports are computed from. VMName is left empty.
+func (s *RightsizingSampleStore) Stats(ctx context.Context, vcenter string, start, end time.Time) ([]models.RightSizingMetric, error) {
+	inner := sq.Select(
+		rsSampleColMOID, rsSampleColMetricKey,
+		"COUNT(*) AS n",
+		fmt.Sprintf("AVG(%s) AS average", rsSampleColValue),
+		fmt.Sprintf("list_sort(list(%s)) AS sorted", rsSampleColValue),
+		fmt.Sprintf("arg_max(%s, %s) AS latest", rsSampleColValue, rsSampleColSampledAt),
+	).
+		From(rsSampleTable).
+		Where(sq.Eq{rsSampleColVCenter: vcenter}).
+		Where(sq.GtOrEq{rsSampleColSampledAt: start.UTC()}).
+		Where(sq.Lt{rsSampleColSampledAt: end.UTC()}).
+		GroupBy(rsSampleColMOID, rsSampleColMetricKey)
+
+	query, args, err := sq.Select(
+		rsSampleColMOID, rsSampleColMetricKey, "n", "average",
+		"sorted[GREATEST(CAST(ceil(0.95 * n) AS BIGINT), 1)]",
+		"sorted[GREATEST(CAST(ceil(0.99 * n) AS BIGINT), 1)]",
+		"sorted[n]",
+		"latest",
+	).
+		FromSelect(inner, "s").
+		OrderBy(rsSampleColMOID, rsSampleColMetricKey).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building sample stats query: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying sample stats: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	stats := []models.RightSizingMetric{}
+	for rows.Next() {
+		var m models.RightSizingMetric
+		if err := rows.Scan(&m.MOID, &m.MetricKey, &m.SampleCount, &m.Average, &m.P95, &m.P99, &m.Max, &m.Latest); err != nil {
+			return nil, fmt.Errorf("scanning sample stats: %w", err)
+		}
+		stats = append(stats, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating sample stats rows: %w", err)
+	}
+	return stats, nil
+}
+
+// List returns the samples of VMs of a vCenter taken in [start, end), ordered by VM, metric
+// and time.
+func (s *RightsizingSampleStore) List(ctx context.Context, vcenter string, moids []string, start, end time.Time) ([]models.RightsizingSample, error) {
+	query, args, err := sq.Select(rsSampleColMOID, rsSampleColMetricKey, rsSampleColSampledAt, rsSampleColIntervalSec, rsSampleColValue).
+		From(rsSampleTable).
+		Where(sq.Eq{rsSampleColVCenter: vcenter, rsSampleColMOID: moids}).
+		Where(sq.GtOrEq{rsSampleColSampledAt: start.UTC()}).
+		Where(sq.Lt{rsSampleColSampledAt: end.UTC()}).
+		OrderBy(rsSampleColMOID, rsSampleColMetricKey, rsSampleColSampledAt).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building list samples query: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying samples: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	samples := []models.RightsizingSample{}
+	for rows.Next() {
+		smp := models.RightsizingSample{VCenter: vcenter}
+		var intervalSec int64
+		if err := rows.Scan(&smp.MOID, &smp.MetricKey, &smp.SampledAt, &intervalSec, &smp.Value); err != nil {
+			return nil, fmt.Errorf("scanning sample: %w", err)
+		}
+		smp.SampledAt = smp.SampledAt.UTC()
+		smp.Interval = time.Duration(intervalSec) * time.Second
+		samples = append(samples, smp)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating sample rows: %w", err)
+	}
+	return samples, nil
+}
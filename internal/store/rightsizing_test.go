@@ -391,6 +391,106 @@ var _ = Describe("RightSizingStore", func() {
 		})
 	})
 
+	Describe("network and disk I/O utilization", func() {
+		It("should store the I/O metrics of each VM and roll them up to clusters", func() {
+			id, _, _ := s.RightSizing().CreateReport(ctx, testReport(), 2, 1)
+			io := func(name, moid string, iops, latency, net float64) []models.RightSizingMetric {
+				return []models.RightSizingMetric{
+					{VMName: name, MOID: moid, MetricKey: "virtualDisk.iops.average", SampleCount: 10, Average: iops / 2, P95: iops, Max: iops * 2},
+					{VMName: name, MOID: moid, MetricKey: "virtualDisk.totalWriteLatency.average", SampleCount: 10, P95: latency},
+					{VMName: name, MOID: moid, MetricKey: "net.usage.average", SampleCount: 10, Average: net / 2, P95: net, Max: net},
+					{VMName: name, MOID: moid, MetricKey: "net.received.average", SampleCount: 10, P95: net / 4},
+				}
+			}
+			Expect(s.RightSizing().WriteBatch(ctx, id, append(io("vm-a", "vm-100", 3000, 12, 800), io("vm-b", "vm-200", 1000, 30, 200)...))).To(Succeed())
+			Expect(s.RightSizing().IncrementWrittenBatchCount(ctx, id)).To(Succeed())
+			Expect(s.RightSizing().ComputeAndStoreUtilization(ctx, id)).To(Succeed())
+
+			d, err := s.RightSizing().GetVMUtilization(ctx, "vm-100")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(d.IopsAvg).To(Equal(1500.0))
+			Expect(d.IopsP95).To(Equal(3000.0))
+			Expect(d.IopsMax).To(Equal(6000.0))
+			Expect(d.WriteLatencyP95).To(Equal(12.0))
+			Expect(d.ReadLatencyP95).To(BeZero())
+			Expect(d.NetP95).To(Equal(800.0))
+			Expect(d.NetRxP95).To(Equal(200.0))
+
+			// vinfo is empty in the test DB
+			_, err = db.Exec(`UPDATE rightsizing_vm_utilization SET cluster_id = 'domain-c1', cluster_name = 'cluster-1' WHERE report_id = ?`, id)
+			Expect(err).NotTo(HaveOccurred())
+			clusters, err := s.RightSizing().ListClusterUtilization(ctx, id, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusters).To(HaveLen(1))
+			Expect(clusters[0].IopsP95).To(Equal(4000.0))
+			Expect(clusters[0].IopsMax).To(Equal(8000.0))
+			Expect(clusters[0].NetAvg).To(Equal(500.0))
+			Expect(clusters[0].WriteLatencyP95).To(Equal(30.0))
+		})
+	})
+
+	Describe("GetVMSeries", func() {
+		It("should aggregate the samples of a VM into epoch-aligned buckets per metric", func() {
+			id, _, _ := s.RightSizing().CreateReport(ctx, testReport(), 2, 1)
+			start := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+			var samples []models.RightsizingSample
+			for i := range 12 {
+				at := start.Add(time.Duration(i) * 10 * time.Minute)
+				samples = append(samples,
+					models.RightsizingSample{MOID: "vm-100", MetricKey: "cpu.usage.average", SampledAt: at, Interval: 10 * time.Minute, Value: float64(100 * (i + 1))},
+					models.RightsizingSample{MOID: "vm-100", MetricKey: "net.usage.average", SampledAt: at, Interval: 10 * time.Minute, Value: 50},
+					models.RightsizingSample{MOID: "vm-200", MetricKey: "cpu.usage.average", SampledAt: at, Interval: 10 * time.Minute, Value: 1},
+				)
+			}
+			Expect(s.RightSizing().WriteSamples(ctx, id, samples)).To(Succeed())
+			Expect(s.RightSizing().WriteSamples(ctx, id, samples[:3])).To(Succeed()) // duplicates ignored
+
+			series, err := s.RightSizing().GetVMSeries(ctx, id, "vm-100", time.Hour, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(series).To(HaveLen(2))
+			Expect(series[0].MetricKey).To(Equal("cpu.usage.average"))
+			Expect(series[1].MetricKey).To(Equal("net.usage.average"))
+
+			points := series[0].Points
+			Expect(points).To(HaveLen(2))
+			Expect(points[0].Timestamp).To(Equal(start))
+			Expect(points[0].SampleCount).To(Equal(6))
+			Expect(points[0].Average).To(BeNumerically("~", 350.0, 0.001))
+			Expect(points[0].Min).To(Equal(100.0))
+			Expect(points[0].Max).To(Equal(600.0))
+			Expect(points[1].Timestamp).To(Equal(start.Add(time.Hour)))
+			Expect(points[1].Max).To(Equal(1200.0))
+
+			series, err = s.RightSizing().GetVMSeries(ctx, id, "vm-100", 10*time.Minute, []string{"net.usage.average"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(series).To(HaveLen(1))
+			Expect(series[0].Points).To(HaveLen(12))
+		})
+
+		It("should return an empty slice when the report holds no samples of the VM", func() {
+			id, _, _ := s.RightSizing().CreateReport(ctx, testReport(), 1, 1)
+			series, err := s.RightSizing().GetVMSeries(ctx, id, "vm-no-data", time.Hour, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(series).To(BeEmpty())
+		})
+	})
+
+	Describe("GetLatestReportSummary", func() {
+		It("should return the latest completed report", func() {
+			_, err := s.RightSizing().GetLatestReportSummary(ctx)
+			Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+
+			id, _, _ := s.RightSizing().CreateReport(ctx, testReport(), 1, 1)
+			Expect(s.RightSizing().IncrementWrittenBatchCount(ctx, id)).To(Succeed())
+			_, _, _ = s.RightSizing().CreateReport(ctx, testReport(), 1, 1) // not completed
+
+			r, err := s.RightSizing().GetLatestReportSummary(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.ID).To(Equal(id))
+			Expect(r.IntervalID).To(Equal(7200))
+		})
+	})
+
 	Describe("ListClusterUtilization", func() {
 		It("should return empty when no utilization data exists", func() {
 			id, _, _ := s.RightSizing().CreateReport(ctx, testReport(), 0, 1)
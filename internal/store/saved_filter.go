@@ -0,0 +1,185 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+const (
+	savedFilterTable = "agent.main.saved_filters"
+
+	savedFilterColName        = "name"
+	savedFilterColDescription = "description"
+	savedFilterColExpression  = "expression"
+	savedFilterColOwner       = "owner"
+	savedFilterColCreatedAt   = "created_at"
+	savedFilterColUpdatedAt   = "updated_at"
+)
+
+var savedFilterSelectColumns = []string{
+	savedFilterColName,
+	savedFilterColDescription,
+	savedFilterColExpression,
+	savedFilterColOwner,
+	savedFilterColCreatedAt,
+	savedFilterColUpdatedAt,
+}
+
+// SavedFilterStore persists named VM filter expressions in the main database.
+type SavedFilterStore struct {
+	db QueryInterceptor
+}
+
+func NewSavedFilterStore(db QueryInterceptor) *SavedFilterStore {
+	return &SavedFilterStore{db: db}
+}
+
+// List returns all saved filters ordered by name.
+func (s *SavedFilterStore) List(ctx context.Context) ([]models.SavedFilter, error) {
+	query, args, err := sq.Select(savedFilterSelectColumns...).
+		From(savedFilterTable).
+		OrderBy(savedFilterColName + " ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building list saved filters query: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying saved filters: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	filters := []models.SavedFilter{}
+	for rows.Next() {
+		f, err := scanSavedFilter(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning saved filter: %w", err)
+		}
+		filters = append(filters, *f)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating saved filter rows: %w", err)
+	}
+	return filters, nil
+}
+
+// Get returns a saved filter by name.
+func (s *SavedFilterStore) Get(ctx context.Context, name string) (*models.SavedFilter, error) {
+	query, args, err := sq.Select(savedFilterSelectColumns...).
+		From(savedFilterTable).
+		Where(sq.Eq{savedFilterColName: name}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building get saved filter query: %w", err)
+	}
+
+	f, err := scanSavedFilter(s.db.QueryRowContext(ctx, query, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, srvErrors.NewResourceNotFoundError("saved filter", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("scanning saved filter: %w", err)
+	}
+	return f, nil
+}
+
+// Create inserts a new saved filter and returns the persisted record.
+func (s *SavedFilterStore) Create(ctx context.Context, f models.SavedFilter) (*models.SavedFilter, error) {
+	query, args, err := sq.Insert(savedFilterTable).
+		Columns(
+			savedFilterColName,
+			savedFilterColDescription,
+			savedFilterColExpression,
+			savedFilterColOwner,
+		).
+		Values(f.Name, f.Description, f.Expression, f.Owner).
+		Suffix(fmt.Sprintf("RETURNING %s", strings.Join(savedFilterSelectColumns, ", "))).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building create saved filter query: %w", err)
+	}
+
+	created, err := scanSavedFilter(s.db.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return nil, srvErrors.NewDuplicateResourceError("saved filter", "name", f.Name)
+		}
+		return nil, fmt.Errorf("creating saved filter: %w", err)
+	}
+	return created, nil
+}
+
+// Update replaces the description, expression and owner of a saved filter.
+func (s *SavedFilterStore) Update(ctx context.Context, f models.SavedFilter) (*models.SavedFilter, error) {
+	query, args, err := sq.Update(savedFilterTable).
+		Set(savedFilterColDescription, f.Description).
+		Set(savedFilterColExpression, f.Expression).
+		Set(savedFilterColOwner, f.Owner).
+		Set(savedFilterColUpdatedAt, time.Now()).
+		Where(sq.Eq{savedFilterColName: f.Name}).
+		Suffix(fmt.Sprintf("RETURNING %s", strings.Join(savedFilterSelectColumns, ", "))).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building update saved filter query: %w", err)
+	}
+
+	updated, err := scanSavedFilter(s.db.QueryRowContext(ctx, query, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, srvErrors.NewResourceNotFoundError("saved filter", f.Name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("updating saved filter: %w", err)
+	}
+	return updated, nil
+}
+
+// Delete removes a saved filter.
+func (s *SavedFilterStore) Delete(ctx context.Context, name string) error {
+	query, args, err := sq.Delete(savedFilterTable).
+		Where(sq.Eq{savedFilterColName: name}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete saved filter query: %w", err)
+	}
+
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("deleting saved filter: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("checking rows affected: %w", err)
+	}
+	if n == 0 {
+		return srvErrors.NewResourceNotFoundError("saved filter", name)
+	}
+	return nil
+}
+
+// scanSavedFilter scans one row into a *models.SavedFilter.
+// Column order must match savedFilterSelectColumns exactly.
+func scanSavedFilter(row rowScanner) (*models.SavedFilter, error) {
+	var f models.SavedFilter
+	err := row.Scan(
+		&f.Name,
+		&f.Description,
+		&f.Expression,
+		&f.Owner,
+		&f.CreatedAt,
+		&f.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
@@ -0,0 +1,115 @@
+package store_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+	"github.com/kubev2v/assisted-migration-agent/test"
+)
+
+var _ = Describe("SavedFilterStore", func() {
+	var (
+		ctx    context.Context
+		s      *store.Store
+		db     *sql.DB
+		tmpDir string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		var err error
+		tmpDir, err = os.MkdirTemp("", "saved-filter-store-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		db, err = store.NewConnection(nil, filepath.Join(tmpDir, "agent.duckdb"))
+		Expect(err).NotTo(HaveOccurred())
+
+		s = store.NewStore(db, test.NewMockValidator())
+		Expect(s.Migrate(ctx, "")).To(Succeed())
+	})
+
+	AfterEach(func() {
+		if db != nil {
+			_ = db.Close()
+		}
+		if tmpDir != "" {
+			_ = os.RemoveAll(tmpDir)
+		}
+	})
+
+	// Given two saved filters
+	// When we list them
+	// Then they should be returned ordered by name
+	It("should create and list saved filters", func() {
+		// Arrange
+		_, err := s.SavedFilter().Create(ctx, models.SavedFilter{Name: "windows-legacy", Expression: "os ~ /2008/", Owner: "alice"})
+		Expect(err).NotTo(HaveOccurred())
+		created, err := s.SavedFilter().Create(ctx, models.SavedFilter{Name: "prod", Description: "production", Expression: "cluster = 'prod'"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(created.CreatedAt).NotTo(BeZero())
+
+		// Act
+		filters, err := s.SavedFilter().List(ctx)
+
+		// Assert
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(2))
+		Expect(filters[0].Name).To(Equal("prod"))
+		Expect(filters[0].Description).To(Equal("production"))
+		Expect(filters[1].Owner).To(Equal("alice"))
+	})
+
+	// Given a saved filter
+	// When we create another one with the same name
+	// Then a duplicate error should be returned
+	It("should reject duplicate names", func() {
+		// Arrange
+		_, err := s.SavedFilter().Create(ctx, models.SavedFilter{Name: "prod", Expression: "cluster = 'prod'"})
+		Expect(err).NotTo(HaveOccurred())
+
+		// Act
+		_, err = s.SavedFilter().Create(ctx, models.SavedFilter{Name: "prod", Expression: "cluster = 'staging'"})
+
+		// Assert
+		Expect(srvErrors.IsDuplicateResourceError(err)).To(BeTrue())
+	})
+
+	// Given a saved filter
+	// When we update and then delete it
+	// Then the update should be returned and the filter should no longer be found
+	It("should update and delete saved filters", func() {
+		// Arrange
+		_, err := s.SavedFilter().Create(ctx, models.SavedFilter{Name: "prod", Expression: "cluster = 'prod'"})
+		Expect(err).NotTo(HaveOccurred())
+
+		// Act
+		updated, err := s.SavedFilter().Update(ctx, models.SavedFilter{Name: "prod", Expression: "cluster in ['prod', 'prod-2']", Owner: "bob"})
+
+		// Assert
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated.Expression).To(Equal("cluster in ['prod', 'prod-2']"))
+		Expect(updated.Owner).To(Equal("bob"))
+
+		Expect(s.SavedFilter().Delete(ctx, "prod")).To(Succeed())
+		_, err = s.SavedFilter().Get(ctx, "prod")
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+		Expect(srvErrors.IsResourceNotFoundError(s.SavedFilter().Delete(ctx, "prod"))).To(BeTrue())
+	})
+
+	// Given no saved filter
+	// When we update one
+	// Then a not found error should be returned
+	It("should return not found when updating an unknown saved filter", func() {
+		_, err := s.SavedFilter().Update(ctx, models.SavedFilter{Name: "missing", Expression: "cpus > 2"})
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+	})
+})
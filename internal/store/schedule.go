@@ -0,0 +1,359 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+const (
+	scheduleTable        = "agent.main.collection_schedules"
+	scheduleColID        = "id"
+	scheduleColName      = "name"
+	scheduleColCron      = "cron_expr"
+	scheduleColInterval  = "interval_sec"
+	scheduleColCatchUp   = "catch_up"
+	scheduleColProfile   = "profile"
+	scheduleColMode      = "mode"
+	scheduleColPaused    = "paused"
+	scheduleColNextRunAt = "next_run_at"
+	scheduleColLastRunAt = "last_run_at"
+	scheduleColCreatedAt = "created_at"
+
+	scheduleRunTable          = "agent.main.collection_schedule_runs"
+	scheduleRunColID          = "id"
+	scheduleRunColScheduleID  = "schedule_id"
+	scheduleRunColScheduledAt = "scheduled_at"
+	scheduleRunColTriggeredAt = "triggered_at"
+	scheduleRunColStatus      = "status"
+	scheduleRunColCatchUp     = "catch_up"
+	scheduleRunColError       = "error"
+)
+
+var scheduleSelectColumns = []string{
+	scheduleColID,
+	scheduleColName,
+	scheduleColCron,
+	scheduleColInterval,
+	scheduleColCatchUp,
+	scheduleColProfile,
+	scheduleColMode,
+	scheduleColPaused,
+	scheduleColNextRunAt,
+	scheduleColLastRunAt,
+	scheduleColCreatedAt,
+}
+
+var scheduleRunSelectColumns = []string{
+	scheduleRunColID,
+	scheduleRunColScheduleID,
+	scheduleRunColScheduledAt,
+	scheduleRunColTriggeredAt,
+	scheduleRunColStatus,
+	scheduleRunColCatchUp,
+	scheduleRunColError,
+}
+
+// ScheduleStore persists recurring collection schedules and their run history
+// in the main database.
+type ScheduleStore struct {
+	db QueryInterceptor
+}
+
+func NewScheduleStore(db QueryInterceptor) *ScheduleStore {
+	return &ScheduleStore{db: db}
+}
+
+// List returns all schedules ordered by creation time.
+func (s *ScheduleStore) List(ctx context.Context) ([]models.CollectionSchedule, error) {
+	query, args, err := sq.Select(scheduleSelectColumns...).
+		From(scheduleTable).
+		OrderBy(scheduleColCreatedAt+" ASC", scheduleColID+" ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building list schedules query: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying schedules: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var schedules []models.CollectionSchedule
+	for rows.Next() {
+		sch, err := scanSchedule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning schedule: %w", err)
+		}
+		schedules = append(schedules, *sch)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating schedule rows: %w", err)
+	}
+	return schedules, nil
+}
+
+// Get returns a schedule by ID.
+func (s *ScheduleStore) Get(ctx context.Context, id string) (*models.CollectionSchedule, error) {
+	query, args, err := sq.Select(scheduleSelectColumns...).
+		From(scheduleTable).
+		Where(sq.Eq{scheduleColID: id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building get schedule query: %w", err)
+	}
+
+	sch, err := scanSchedule(s.db.QueryRowContext(ctx, query, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, srvErrors.NewResourceNotFoundError("schedule", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("scanning schedule: %w", err)
+	}
+	return sch, nil
+}
+
+// Create inserts a new schedule and returns the persisted record.
+func (s *ScheduleStore) Create(ctx context.Context, sch models.CollectionSchedule) (*models.CollectionSchedule, error) {
+	var cronExpr, interval any
+	if sch.Cron != "" {
+		cronExpr = sch.Cron
+	} else {
+		interval = int64(sch.Interval / time.Second)
+	}
+
+	query, args, err := sq.Insert(scheduleTable).
+		Columns(
+			scheduleColID,
+			scheduleColName,
+			scheduleColCron,
+			scheduleColInterval,
+			scheduleColCatchUp,
+			scheduleColProfile,
+			scheduleColMode,
+			scheduleColPaused,
+			scheduleColNextRunAt,
+		).
+		Values(
+			sch.ID,
+			sch.Name,
+			cronExpr,
+			interval,
+			string(sch.CatchUp),
+			sch.Profile,
+			string(sch.Mode),
+			sch.Paused,
+			sch.NextRunAt,
+		).
+		Suffix(fmt.Sprintf("RETURNING %s", strings.Join(scheduleSelectColumns, ", "))).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building create schedule query: %w", err)
+	}
+
+	created, err := scanSchedule(s.db.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		return nil, fmt.Errorf("creating schedule: %w", err)
+	}
+	return created, nil
+}
+
+// SetPaused pauses or resumes a schedule. nextRunAt replaces the stored next run time.
+func (s *ScheduleStore) SetPaused(ctx context.Context, id string, paused bool, nextRunAt *time.Time) error {
+	query, args, err := sq.Update(scheduleTable).
+		Set(scheduleColPaused, paused).
+		Set(scheduleColNextRunAt, nextRunAt).
+		Where(sq.Eq{scheduleColID: id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building set paused query: %w", err)
+	}
+
+	return s.execOne(ctx, id, query, args)
+}
+
+// UpdateRunTimes records the last trigger time and the next planned run of a schedule.
+func (s *ScheduleStore) UpdateRunTimes(ctx context.Context, id string, lastRunAt time.Time, nextRunAt *time.Time) error {
+	query, args, err := sq.Update(scheduleTable).
+		Set(scheduleColLastRunAt, lastRunAt).
+		Set(scheduleColNextRunAt, nextRunAt).
+		Where(sq.Eq{scheduleColID: id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building update run times query: %w", err)
+	}
+
+	return s.execOne(ctx, id, query, args)
+}
+
+// SetNextRunAt moves the next planned run of a schedule without recording a trigger.
+func (s *ScheduleStore) SetNextRunAt(ctx context.Context, id string, nextRunAt *time.Time) error {
+	query, args, err := sq.Update(scheduleTable).
+		Set(scheduleColNextRunAt, nextRunAt).
+		Where(sq.Eq{scheduleColID: id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building set next run query: %w", err)
+	}
+
+	return s.execOne(ctx, id, query, args)
+}
+
+// Delete removes a schedule and its run history.
+func (s *ScheduleStore) Delete(ctx context.Context, id string) error {
+	query, args, err := sq.Delete(scheduleRunTable).
+		Where(sq.Eq{scheduleRunColScheduleID: id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete schedule runs query: %w", err)
+	}
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("deleting schedule runs: %w", err)
+	}
+
+	query, args, err = sq.Delete(scheduleTable).
+		Where(sq.Eq{scheduleColID: id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete schedule query: %w", err)
+	}
+
+	return s.execOne(ctx, id, query, args)
+}
+
+// InsertRun appends an entry to a schedule's run history.
+func (s *ScheduleStore) InsertRun(ctx context.Context, run models.ScheduleRun) error {
+	query, args, err := sq.Insert(scheduleRunTable).
+		Columns(
+			scheduleRunColScheduleID,
+			scheduleRunColScheduledAt,
+			scheduleRunColTriggeredAt,
+			scheduleRunColStatus,
+			scheduleRunColCatchUp,
+			scheduleRunColError,
+		).
+		Values(
+			run.ScheduleID,
+			run.ScheduledAt,
+			run.TriggeredAt,
+			string(run.Status),
+			run.CatchUp,
+			sql.NullString{String: run.Error, Valid: run.Error != ""},
+		).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert schedule run query: %w", err)
+	}
+
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("inserting schedule run: %w", err)
+	}
+	return nil
+}
+
+// ListRuns returns the run history of a schedule, newest first.
+// A limit of 0 returns all runs.
+func (s *ScheduleStore) ListRuns(ctx context.Context, scheduleID string, limit uint64) ([]models.ScheduleRun, error) {
+	builder := sq.Select(scheduleRunSelectColumns...).
+		From(scheduleRunTable).
+		Where(sq.Eq{scheduleRunColScheduleID: scheduleID}).
+		OrderBy(scheduleRunColTriggeredAt+" DESC", scheduleRunColID+" DESC")
+	if limit > 0 {
+		builder = builder.Limit(limit)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building list schedule runs query: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying schedule runs: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	runs := []models.ScheduleRun{}
+	for rows.Next() {
+		var (
+			run    models.ScheduleRun
+			status string
+			errMsg sql.NullString
+		)
+		if err := rows.Scan(&run.ID, &run.ScheduleID, &run.ScheduledAt, &run.TriggeredAt, &status, &run.CatchUp, &errMsg); err != nil {
+			return nil, fmt.Errorf("scanning schedule run: %w", err)
+		}
+		run.Status = models.ScheduleRunStatus(status)
+		run.Error = errMsg.String
+		runs = append(runs, run)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating schedule run rows: %w", err)
+	}
+	return runs, nil
+}
+
+func (s *ScheduleStore) execOne(ctx context.Context, id, query string, args []any) error {
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("updating schedule: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("checking rows affected: %w", err)
+	}
+	if n == 0 {
+		return srvErrors.NewResourceNotFoundError("schedule", id)
+	}
+	return nil
+}
+
+// scanSchedule scans one row into a *models.CollectionSchedule.
+// Column order must match scheduleSelectColumns exactly.
+func scanSchedule(row rowScanner) (*models.CollectionSchedule, error) {
+	var (
+		sch       models.CollectionSchedule
+		cronExpr  sql.NullString
+		interval  sql.NullInt64
+		catchUp   string
+		mode      string
+		nextRunAt sql.NullTime
+		lastRunAt sql.NullTime
+	)
+	err := row.Scan(
+		&sch.ID,
+		&sch.Name,
+		&cronExpr,
+		&interval,
+		&catchUp,
+		&sch.Profile,
+		&mode,
+		&sch.Paused,
+		&nextRunAt,
+		&lastRunAt,
+		&sch.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	sch.Cron = cronExpr.String
+	sch.Interval = time.Duration(interval.Int64) * time.Second
+	sch.CatchUp = models.CatchUpPolicy(catchUp)
+	sch.Mode = models.CollectionMode(mode)
+	if nextRunAt.Valid {
+		sch.NextRunAt = &nextRunAt.Time
+	}
+	if lastRunAt.Valid {
+		sch.LastRunAt = &lastRunAt.Time
+	}
+	return &sch, nil
+}
@@ -0,0 +1,223 @@
+package store_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+	"github.com/kubev2v/assisted-migration-agent/test"
+)
+
+var _ = Describe("ScheduleStore", func() {
+	var (
+		ctx    context.Context
+		s      *store.Store
+		db     *sql.DB
+		tmpDir string
+		next   time.Time
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		next = time.Date(2026, time.March, 10, 2, 0, 0, 0, time.UTC)
+
+		var err error
+		tmpDir, err = os.MkdirTemp("", "schedule-store-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		db, err = store.NewConnection(nil, filepath.Join(tmpDir, "agent.duckdb"))
+		Expect(err).NotTo(HaveOccurred())
+
+		s = store.NewStore(db, test.NewMockValidator())
+		Expect(s.Migrate(ctx, "")).To(Succeed())
+	})
+
+	AfterEach(func() {
+		if db != nil {
+			_ = db.Close()
+		}
+		if tmpDir != "" {
+			_ = os.RemoveAll(tmpDir)
+		}
+	})
+
+	Context("Create and Get", func() {
+		// Given a cron schedule
+		// When we create it and read it back
+		// Then all fields should round-trip and interval should be zero
+		It("should persist a cron schedule", func() {
+			// Act
+			created, err := s.Schedule().Create(ctx, models.CollectionSchedule{
+				ID:        "sch-1",
+				Name:      "nightly",
+				Cron:      "0 2 * * *",
+				CatchUp:   models.CatchUpOnce,
+				Profile:   "lab",
+				Mode:      models.CollectionModeIncremental,
+				NextRunAt: &next,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			// Assert
+			got, err := s.Schedule().Get(ctx, created.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.Name).To(Equal("nightly"))
+			Expect(got.Cron).To(Equal("0 2 * * *"))
+			Expect(got.Interval).To(BeZero())
+			Expect(got.CatchUp).To(Equal(models.CatchUpOnce))
+			Expect(got.Profile).To(Equal("lab"))
+			Expect(got.Mode).To(Equal(models.CollectionModeIncremental))
+			Expect(got.Paused).To(BeFalse())
+			Expect(got.NextRunAt).NotTo(BeNil())
+			Expect(got.NextRunAt.Equal(next)).To(BeTrue())
+			Expect(got.LastRunAt).To(BeNil())
+			Expect(got.CreatedAt).NotTo(BeZero())
+		})
+
+		// Given an interval schedule
+		// When we create it
+		// Then the interval should round-trip with second precision and cron should be empty
+		It("should persist an interval schedule", func() {
+			// Act
+			_, err := s.Schedule().Create(ctx, models.CollectionSchedule{
+				ID:       "sch-1",
+				Name:     "every 6h",
+				Interval: 6 * time.Hour,
+				CatchUp:  models.CatchUpSkip,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			// Assert
+			got, err := s.Schedule().Get(ctx, "sch-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.Cron).To(BeEmpty())
+			Expect(got.Interval).To(Equal(6 * time.Hour))
+			Expect(got.NextRunAt).To(BeNil())
+		})
+
+		// Given no schedule with the requested ID
+		// When we get it
+		// Then a ResourceNotFoundError is returned
+		It("should return ResourceNotFoundError for unknown schedule", func() {
+			// Act
+			_, err := s.Schedule().Get(ctx, "missing")
+
+			// Assert
+			Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+		})
+	})
+
+	Context("SetPaused and UpdateRunTimes", func() {
+		BeforeEach(func() {
+			_, err := s.Schedule().Create(ctx, models.CollectionSchedule{
+				ID: "sch-1", Name: "nightly", Cron: "0 2 * * *", CatchUp: models.CatchUpSkip, NextRunAt: &next,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		// Given an active schedule
+		// When we pause it with a nil next run
+		// Then it should be paused with no next run
+		It("should pause a schedule and clear its next run", func() {
+			// Act
+			Expect(s.Schedule().SetPaused(ctx, "sch-1", true, nil)).To(Succeed())
+
+			// Assert
+			got, err := s.Schedule().Get(ctx, "sch-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.Paused).To(BeTrue())
+			Expect(got.NextRunAt).To(BeNil())
+		})
+
+		// Given an active schedule
+		// When a run is recorded
+		// Then last and next run times are updated
+		It("should record run times", func() {
+			// Arrange
+			last := next.Add(time.Minute)
+			following := next.Add(24 * time.Hour)
+
+			// Act
+			Expect(s.Schedule().UpdateRunTimes(ctx, "sch-1", last, &following)).To(Succeed())
+
+			// Assert
+			got, err := s.Schedule().Get(ctx, "sch-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.LastRunAt.Equal(last)).To(BeTrue())
+			Expect(got.NextRunAt.Equal(following)).To(BeTrue())
+		})
+
+		// Given no schedule with the requested ID
+		// When we pause it
+		// Then a ResourceNotFoundError is returned
+		It("should return ResourceNotFoundError when pausing unknown schedule", func() {
+			// Act
+			err := s.Schedule().SetPaused(ctx, "missing", true, nil)
+
+			// Assert
+			Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+		})
+	})
+
+	Context("Runs", func() {
+		BeforeEach(func() {
+			_, err := s.Schedule().Create(ctx, models.CollectionSchedule{
+				ID: "sch-1", Name: "nightly", Cron: "0 2 * * *", CatchUp: models.CatchUpSkip, NextRunAt: &next,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		// Given several runs recorded for a schedule
+		// When we list runs
+		// Then they are returned newest first with their status and error
+		It("should list runs newest first", func() {
+			// Arrange
+			Expect(s.Schedule().InsertRun(ctx, models.ScheduleRun{
+				ScheduleID: "sch-1", ScheduledAt: next, TriggeredAt: next, Status: models.ScheduleRunStarted,
+			})).To(Succeed())
+			Expect(s.Schedule().InsertRun(ctx, models.ScheduleRun{
+				ScheduleID: "sch-1", ScheduledAt: next.Add(24 * time.Hour), TriggeredAt: next.Add(24 * time.Hour),
+				Status: models.ScheduleRunSkipped, Error: "collection already in progress",
+			})).To(Succeed())
+
+			// Act
+			runs, err := s.Schedule().ListRuns(ctx, "sch-1", 0)
+
+			// Assert
+			Expect(err).NotTo(HaveOccurred())
+			Expect(runs).To(HaveLen(2))
+			Expect(runs[0].Status).To(Equal(models.ScheduleRunSkipped))
+			Expect(runs[0].Error).To(Equal("collection already in progress"))
+			Expect(runs[1].Status).To(Equal(models.ScheduleRunStarted))
+			Expect(runs[1].Error).To(BeEmpty())
+		})
+
+		// Given a schedule with recorded runs
+		// When we delete the schedule
+		// Then the schedule and its run history are removed
+		It("should delete run history together with the schedule", func() {
+			// Arrange
+			Expect(s.Schedule().InsertRun(ctx, models.ScheduleRun{
+				ScheduleID: "sch-1", ScheduledAt: next, TriggeredAt: next, Status: models.ScheduleRunStarted,
+			})).To(Succeed())
+
+			// Act
+			Expect(s.Schedule().Delete(ctx, "sch-1")).To(Succeed())
+
+			// Assert
+			_, err := s.Schedule().Get(ctx, "sch-1")
+			Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+			runs, err := s.Schedule().ListRuns(ctx, "sch-1", 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(runs).To(BeEmpty())
+		})
+	})
+})
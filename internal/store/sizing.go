@@ -0,0 +1,267 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+const (
+	sizingRunsTable       = "sizing_runs"
+	sizingProfilesTable   = "sizing_profiles"
+	sizingPlacementsTable = "sizing_placements"
+)
+
+// listSizingVMsQuery returns the provisioned resources of the VMs to migrate, templates and
+// excluded VMs aside, with their p95 utilization in the latest rightsizing report.
+const listSizingVMsQuery = `
+SELECT
+    v."VM ID",
+    COALESCE(v."VM", ''),
+    COALESCE(v."CPUs", 0),
+    COALESCE(v."Memory", 0),
+    u.cpu_p95_pct,
+    u.mem_p95_pct
+FROM vinfo v
+LEFT JOIN rightsizing_vm_utilization u
+    ON u.moid = v."VM ID"
+    AND u.report_id = (
+        SELECT id FROM rightsizing_reports
+        WHERE written_batch_count > 0
+        ORDER BY created_at DESC LIMIT 1
+    )
+WHERE v."migration_excluded" IS NOT TRUE
+  AND COALESCE(v."Template", false) = false
+`
+
+// SizingStore persists target cluster sizing simulations in a collection.
+type SizingStore struct {
+	db QueryInterceptor
+}
+
+func NewSizingStore(db QueryInterceptor) *SizingStore {
+	return &SizingStore{db: db}
+}
+
+// ListVMs returns the VMs to size, ordered by VM ID. When vmIDs is not nil, only those VMs
+// are returned.
+func (s *SizingStore) ListVMs(ctx context.Context, vmIDs []string) ([]models.SizingVM, error) {
+	query := listSizingVMsQuery
+	var args []any
+	if vmIDs != nil {
+		query += `  AND v."VM ID" IN (SELECT unnest(?))` + "\n"
+		args = append(args, vmIDs)
+	}
+	query += `ORDER BY v."VM ID"`
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("listing VMs to size: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	vms := []models.SizingVM{}
+	for rows.Next() {
+		var vm models.SizingVM
+		var cpuP95, memP95 sql.NullFloat64
+		if err := rows.Scan(&vm.VMID, &vm.Name, &vm.CPUs, &vm.MemoryMB, &cpuP95, &memP95); err != nil {
+			return nil, fmt.Errorf("scanning VM to size: %w", err)
+		}
+		if cpuP95.Valid {
+			vm.CPUP95Pct = &cpuP95.Float64
+		}
+		if memP95.Valid {
+			vm.MemP95Pct = &memP95.Float64
+		}
+		vms = append(vms, vm)
+	}
+	return vms, rows.Err()
+}
+
+// Save stores a simulation. Callers should run it inside a transaction.
+func (s *SizingStore) Save(ctx context.Context, r models.SizingResult) error {
+	var groupID sql.NullString
+	if r.GroupID != nil {
+		groupID = sql.NullString{String: r.GroupID.String(), Valid: true}
+	}
+	query, args, err := sq.Insert(sizingRunsTable).
+		Columns("id", "source", "headroom_pct", "group_id", "vm_count", "provisioned_fallback_count", "created_at").
+		Values(r.ID, string(r.Source), r.HeadroomPct, groupID, r.VMCount, r.ProvisionedFallbackCount, r.CreatedAt).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert sizing run query: %w", err)
+	}
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("inserting sizing run: %w", err)
+	}
+
+	for i, pr := range r.Profiles {
+		p := pr.Profile
+		query, args, err := sq.Insert(sizingProfilesTable).
+			Columns("run_id", "name", "position", "cores", "memory_mb", "cpu_overcommit", "memory_overcommit",
+				"reserved_cores", "reserved_memory_mb", "allocatable_cpu", "allocatable_memory_mb", "node_count").
+			Values(r.ID, p.Name, i, p.Cores, p.MemoryMB, p.CPUOvercommit, p.MemoryOvercommit,
+				p.ReservedCores, p.ReservedMemoryMB, p.AllocatableCPU(), p.AllocatableMemoryMB(), pr.NodeCount).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("building insert sizing profile query: %w", err)
+		}
+		if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("inserting sizing profile %s: %w", p.Name, err)
+		}
+
+		builder := sq.Insert(sizingPlacementsTable).
+			Columns("run_id", "profile", "node", "vm_id", "vm_name", "cpu", "memory_mb")
+		count := 0
+		for _, n := range pr.Nodes {
+			for _, vm := range n.VMs {
+				builder = builder.Values(r.ID, p.Name, n.Index, vm.VMID, vm.Name, vm.CPU, vm.MemoryMB)
+				count++
+			}
+		}
+		for _, vm := range pr.Unplaced {
+			builder = builder.Values(r.ID, p.Name, nil, vm.VMID, vm.Name, vm.CPU, vm.MemoryMB)
+			count++
+		}
+		if count == 0 {
+			continue
+		}
+		query, args, err = builder.ToSql()
+		if err != nil {
+			return fmt.Errorf("building insert sizing placements query: %w", err)
+		}
+		if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("inserting sizing placements of profile %s: %w", p.Name, err)
+		}
+	}
+	return nil
+}
+
+// GetLatest returns the latest simulation stored in the collection.
+func (s *SizingStore) GetLatest(ctx context.Context) (*models.SizingResult, error) {
+	query, args, err := sq.Select("id", "source", "headroom_pct", "group_id", "vm_count", "provisioned_fallback_count", "created_at").
+		From(sizingRunsTable).
+		OrderBy("created_at DESC").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building get sizing run query: %w", err)
+	}
+
+	var (
+		r       models.SizingResult
+		groupID sql.NullString
+	)
+	err = s.db.QueryRowContext(ctx, query, args...).
+		Scan(&r.ID, &r.Source, &r.HeadroomPct, &groupID, &r.VMCount, &r.ProvisionedFallbackCount, &r.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, srvErrors.NewResourceNotFoundError("sizing", "latest")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("scanning sizing run: %w", err)
+	}
+	if groupID.Valid {
+		id, err := uuid.Parse(groupID.String)
+		if err != nil {
+			return nil, fmt.Errorf("parsing group ID %q: %w", groupID.String, err)
+		}
+		r.GroupID = &id
+	}
+
+	if err := s.appendProfiles(ctx, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// appendProfiles loads the profiles of a simulation and rebuilds their nodes from the placements,
+// with the VMs of each node ordered by ID.
+func (s *SizingStore) appendProfiles(ctx context.Context, r *models.SizingResult) error {
+	query, args, err := sq.Select("name", "cores", "memory_mb", "cpu_overcommit", "memory_overcommit",
+		"reserved_cores", "reserved_memory_mb", "node_count").
+		From(sizingProfilesTable).
+		Where(sq.Eq{"run_id": r.ID}).
+		OrderBy("position").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building list sizing profiles query: %w", err)
+	}
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("querying sizing profiles: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	profiles := make(map[string]int)
+	for rows.Next() {
+		var pr models.SizingProfileResult
+		p := &pr.Profile
+		if err := rows.Scan(&p.Name, &p.Cores, &p.MemoryMB, &p.CPUOvercommit, &p.MemoryOvercommit,
+			&p.ReservedCores, &p.ReservedMemoryMB, &pr.NodeCount); err != nil {
+			return fmt.Errorf("scanning sizing profile: %w", err)
+		}
+		pr.Nodes = make([]models.SizingNode, pr.NodeCount)
+		for i := range pr.Nodes {
+			pr.Nodes[i] = models.SizingNode{Index: i, VMs: []models.SizingPlacement{}}
+		}
+		pr.Unplaced = []models.SizingPlacement{}
+		profiles[p.Name] = len(r.Profiles)
+		r.Profiles = append(r.Profiles, pr)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("iterating sizing profile rows: %w", err)
+	}
+
+	query, args, err = sq.Select("profile", "node", "vm_id", "vm_name", "cpu", "memory_mb").
+		From(sizingPlacementsTable).
+		Where(sq.Eq{"run_id": r.ID}).
+		OrderBy("profile", "node", "vm_id").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building list sizing placements query: %w", err)
+	}
+	placementRows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("querying sizing placements: %w", err)
+	}
+	defer func() { _ = placementRows.Close() }()
+
+	for placementRows.Next() {
+		var (
+			profile string
+			node    sql.NullInt64
+			vm      models.SizingPlacement
+		)
+		if err := placementRows.Scan(&profile, &node, &vm.VMID, &vm.Name, &vm.CPU, &vm.MemoryMB); err != nil {
+			return fmt.Errorf("scanning sizing placement: %w", err)
+		}
+		i, ok := profiles[profile]
+		if !ok {
+			continue
+		}
+		pr := &r.Profiles[i]
+		if !node.Valid {
+			pr.Unplaced = append(pr.Unplaced, vm)
+			continue
+		}
+		if int(node.Int64) >= len(pr.Nodes) {
+			return fmt.Errorf("sizing placement of VM %s on node %d of profile %s with %d nodes", vm.VMID, node.Int64, profile, len(pr.Nodes))
+		}
+		pr.Nodes[node.Int64].VMs = append(pr.Nodes[node.Int64].VMs, vm)
+	}
+	if err := placementRows.Err(); err != nil {
+		return fmt.Errorf("iterating sizing placement rows: %w", err)
+	}
+
+	for i := range r.Profiles {
+		r.Profiles[i].Tally()
+	}
+	return nil
+}
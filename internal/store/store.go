@@ -33,6 +33,20 @@ type Store struct {
 	credentials   *CredentialsStore
 	collection    *CollectionStore
 	export        *ExportStore
+	schedule      *ScheduleStore
+	source        *CollectionSourceStore
+	delta         *CollectionDeltaStore
+	metadata      *CollectionMetadataStore
+	savedFilter   *SavedFilterStore
+	labelRule     *LabelRuleStore
+	label         *LabelStore
+	wave          *WaveStore
+	mtvMapping    *MTVMappingStore
+	sizing        *SizingStore
+	rsPolicy      *RightsizingPolicyStore
+	rsSample      *RightsizingSampleStore
+	waste         *WasteStore
+	wasteThresh   *WasteThresholdsStore
 }
 
 func NewStore(db *sql.DB, validator duckdb_parser.Validator) *Store {
@@ -56,6 +70,20 @@ func NewStore(db *sql.DB, validator duckdb_parser.Validator) *Store {
 		credentials:   NewCredentialsStore(qi),
 		collection:    NewCollectionStore(qi),
 		export:        NewExportStore(qi),
+		schedule:      NewScheduleStore(qi),
+		source:        NewCollectionSourceStore(qi),
+		delta:         NewCollectionDeltaStore(qi),
+		metadata:      NewCollectionMetadataStore(qi),
+		savedFilter:   NewSavedFilterStore(qi),
+		labelRule:     NewLabelRuleStore(qi),
+		label:         NewLabelStore(qi),
+		wave:          NewWaveStore(qi),
+		mtvMapping:    NewMTVMappingStore(qi),
+		sizing:        NewSizingStore(qi),
+		rsPolicy:      NewRightsizingPolicyStore(qi),
+		rsSample:      NewRightsizingSampleStore(qi),
+		waste:         NewWasteStore(qi),
+		wasteThresh:   NewWasteThresholdsStore(qi),
 	}
 }
 
@@ -141,6 +169,62 @@ func (s *Store) Export() *ExportStore {
 	return s.export
 }
 
+func (s *Store) Schedule() *ScheduleStore {
+	return s.schedule
+}
+
+func (s *Store) CollectionSource() *CollectionSourceStore {
+	return s.source
+}
+
+func (s *Store) CollectionDelta() *CollectionDeltaStore {
+	return s.delta
+}
+
+func (s *Store) CollectionMetadata() *CollectionMetadataStore {
+	return s.metadata
+}
+
+func (s *Store) SavedFilter() *SavedFilterStore {
+	return s.savedFilter
+}
+
+func (s *Store) LabelRule() *LabelRuleStore {
+	return s.labelRule
+}
+
+func (s *Store) Label() *LabelStore {
+	return s.label
+}
+
+func (s *Store) Wave() *WaveStore {
+	return s.wave
+}
+
+func (s *Store) MTVMapping() *MTVMappingStore {
+	return s.mtvMapping
+}
+
+func (s *Store) Sizing() *SizingStore {
+	return s.sizing
+}
+
+func (s *Store) RightsizingPolicy() *RightsizingPolicyStore {
+	return s.rsPolicy
+}
+
+func (s *Store) RightsizingSample() *RightsizingSampleStore {
+	return s.rsSample
+}
+
+func (s *Store) Waste() *WasteStore {
+	return s.waste
+}
+
+func (s *Store) WasteThresholds() *WasteThresholdsStore {
+	return s.wasteThresh
+}
+
 func (s *Store) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
 	return s.transactor.WithTx(ctx, fn)
 }
@@ -72,19 +72,35 @@ func (s *Store2) Checkpoint(ctx context.Context) error {
 	return err
 }
 
-func (s *Store2) Configuration() *ConfigurationStore { return NewConfigurationStore(s.qi) }
-func (s *Store2) Inventory() *InventoryStore         { return NewInventoryStore(s.qi) }
-func (s *Store2) VM() *VMStore                       { return NewVMStore(s.qi) }
-func (s *Store2) Inspection() *InspectionStore       { return NewInspectionStore(s.qi) }
-func (s *Store2) Group() *GroupStore                 { return NewGroupStore(s.qi) }
-func (s *Store2) Vddk() *VddkStore                   { return NewVddkStore(s.qi) }
-func (s *Store2) Outbox() *OutboxStore               { return NewOutboxStore(s.qi) }
-func (s *Store2) RightSizing() *RightSizingStore     { return NewRightSizingStore(s.qi) }
-func (s *Store2) Forecast() *ForecastStore           { return NewForecastStore(s.qi) }
-func (s *Store2) Application() *ApplicationStore     { return NewApplicationStore(s.qi) }
-func (s *Store2) Credentials() *CredentialsStore     { return NewCredentialsStore(s.qi) }
-func (s *Store2) Collection() *CollectionStore       { return NewCollectionStore(s.qi) }
-func (s *Store2) Export() *ExportStore               { return NewExportStore(s.qi) }
+func (s *Store2) Configuration() *ConfigurationStore       { return NewConfigurationStore(s.qi) }
+func (s *Store2) Inventory() *InventoryStore               { return NewInventoryStore(s.qi) }
+func (s *Store2) VM() *VMStore                             { return NewVMStore(s.qi) }
+func (s *Store2) Inspection() *InspectionStore             { return NewInspectionStore(s.qi) }
+func (s *Store2) Group() *GroupStore                       { return NewGroupStore(s.qi) }
+func (s *Store2) Vddk() *VddkStore                         { return NewVddkStore(s.qi) }
+func (s *Store2) Outbox() *OutboxStore                     { return NewOutboxStore(s.qi) }
+func (s *Store2) RightSizing() *RightSizingStore           { return NewRightSizingStore(s.qi) }
+func (s *Store2) Forecast() *ForecastStore                 { return NewForecastStore(s.qi) }
+func (s *Store2) Application() *ApplicationStore           { return NewApplicationStore(s.qi) }
+func (s *Store2) Credentials() *CredentialsStore           { return NewCredentialsStore(s.qi) }
+func (s *Store2) Collection() *CollectionStore             { return NewCollectionStore(s.qi) }
+func (s *Store2) Export() *ExportStore                     { return NewExportStore(s.qi) }
+func (s *Store2) Schedule() *ScheduleStore                 { return NewScheduleStore(s.qi) }
+func (s *Store2) CollectionSource() *CollectionSourceStore { return NewCollectionSourceStore(s.qi) }
+func (s *Store2) CollectionDelta() *CollectionDeltaStore   { return NewCollectionDeltaStore(s.qi) }
+func (s *Store2) CollectionMetadata() *CollectionMetadataStore {
+	return NewCollectionMetadataStore(s.qi)
+}
+func (s *Store2) SavedFilter() *SavedFilterStore             { return NewSavedFilterStore(s.qi) }
+func (s *Store2) LabelRule() *LabelRuleStore                 { return NewLabelRuleStore(s.qi) }
+func (s *Store2) Label() *LabelStore                         { return NewLabelStore(s.qi) }
+func (s *Store2) Wave() *WaveStore                           { return NewWaveStore(s.qi) }
+func (s *Store2) MTVMapping() *MTVMappingStore               { return NewMTVMappingStore(s.qi) }
+func (s *Store2) Sizing() *SizingStore                       { return NewSizingStore(s.qi) }
+func (s *Store2) RightsizingPolicy() *RightsizingPolicyStore { return NewRightsizingPolicyStore(s.qi) }
+func (s *Store2) RightsizingSample() *RightsizingSampleStore { return NewRightsizingSampleStore(s.qi) }
+func (s *Store2) Waste() *WasteStore                         { return NewWasteStore(s.qi) }
+func (s *Store2) WasteThresholds() *WasteThresholdsStore     { return NewWasteThresholdsStore(s.qi) }
 
 func (s *Store2) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
 	return s.transactor.WithTx(ctx, fn)
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	duckdb_models "github.com/kubev2v/migration-planner/pkg/duckdb_parser/models"
@@ -146,8 +147,48 @@ func (s *VMStore) Count(ctx context.Context, filter sq.Sqlizer) (int, error) {
 	return count, err
 }
 
-// Get returns full VM details by ID, including utilization data from the latest rightsizing report.
-func (s *VMStore) Get(ctx context.Context, id string) (*models.VM, error) {
+// ListIDs returns the IDs of the VMs matching the filter.
+func (s *VMStore) ListIDs(ctx context.Context, filter sq.Sqlizer) ([]string, error) {
+	query, args, err := vmFilterSubquery.Where(filter).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building list VM IDs query: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying VM IDs: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scanning VM ID: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// vmRow is a row of vmGetQuery.
+type vmRow struct {
+	pvm    duckdb_models.VM
+	groups StringArray
+
+	uMoid                                               sql.NullString
+	uVmName                                             sql.NullString
+	uProvCpus                                           sql.NullInt64
+	uProvMemMb                                          sql.NullInt64
+	uProvDiskKb                                         sql.NullFloat64
+	uCpuAvg, uCpuP95, uCpuMax, uCpuLatest               sql.NullFloat64
+	uMemAvg, uMemP95, uMemMax, uMemLatest               sql.NullFloat64
+	uDisk, uConfidence                                  sql.NullFloat64
+	inspectionState, inspectionDetails, inspectionError string
+}
+
+// getRow returns the vmGetQuery row of a VM.
+func (s *VMStore) getRow(ctx context.Context, id string) (*vmRow, error) {
 	rows, err := s.db.QueryContext(ctx, vmGetQuery, id)
 	if err != nil {
 		return nil, fmt.Errorf("querying VM %s: %w", id, err)
@@ -161,20 +202,8 @@ func (s *VMStore) Get(ctx context.Context, id string) (*models.VM, error) {
 		return nil, srvErrors.NewResourceNotFoundError("vm", id)
 	}
 
-	var pvm duckdb_models.VM
-	var groups StringArray
-	var (
-		uMoid                                               sql.NullString
-		uVmName                                             sql.NullString
-		uProvCpus                                           sql.NullInt64
-		uProvMemMb                                          sql.NullInt64
-		uProvDiskKb                                         sql.NullFloat64
-		uCpuAvg, uCpuP95, uCpuMax, uCpuLatest               sql.NullFloat64
-		uMemAvg, uMemP95, uMemMax, uMemLatest               sql.NullFloat64
-		uDisk, uConfidence                                  sql.NullFloat64
-		inspectionState, inspectionDetails, inspectionError string
-	)
-
+	var r vmRow
+	pvm := &r.pvm
 	if err := rows.Scan(
 		&pvm.ID, &pvm.Name, &pvm.Folder, &pvm.Host, &pvm.UUID,
 		&pvm.Firmware, &pvm.PowerState, &pvm.ConnectionState,
@@ -184,15 +213,15 @@ func (s *VMStore) Get(ctx context.Context, id string) (*models.VM, error) {
 		&pvm.ChangeTrackingEnabled, &pvm.DiskEnableUuid, &pvm.Datacenter,
 		&pvm.Cluster, &pvm.HWVersion, &pvm.TotalDiskCapacityMiB,
 		&pvm.ProvisionedMiB, &pvm.ResourcePool, &pvm.OsDiskComplexity,
-		&pvm.MigrationExcluded, &pvm.Labels, &groups,
+		&pvm.MigrationExcluded, &pvm.Labels, &r.groups,
 		&pvm.CpuHotAddEnabled, &pvm.CpuHotRemoveEnabled, &pvm.CpuSockets,
 		&pvm.CoresPerSocket, &pvm.MemoryHotAddEnabled, &pvm.BalloonedMemory,
 		&pvm.Disks, &pvm.NICs, &pvm.Networks, &pvm.Concerns,
-		&uMoid, &uVmName, &uProvCpus, &uProvMemMb, &uProvDiskKb,
-		&uCpuAvg, &uCpuP95, &uCpuMax, &uCpuLatest,
-		&uMemAvg, &uMemP95, &uMemMax, &uMemLatest,
-		&uDisk, &uConfidence, &pvm.GuestApps,
-		&inspectionState, &inspectionDetails, &inspectionError,
+		&r.uMoid, &r.uVmName, &r.uProvCpus, &r.uProvMemMb, &r.uProvDiskKb,
+		&r.uCpuAvg, &r.uCpuP95, &r.uCpuMax, &r.uCpuLatest,
+		&r.uMemAvg, &r.uMemP95, &r.uMemMax, &r.uMemLatest,
+		&r.uDisk, &r.uConfidence, &pvm.GuestApps,
+		&r.inspectionState, &r.inspectionDetails, &r.inspectionError,
 	); err != nil {
 		return nil, fmt.Errorf("scanning VM %s: %w", id, err)
 	}
@@ -200,32 +229,51 @@ func (s *VMStore) Get(ctx context.Context, id string) (*models.VM, error) {
 	for i := range pvm.Disks {
 		pvm.Disks[i].ChangeTrackingEnabled = pvm.ChangeTrackingEnabled
 	}
+	return &r, nil
+}
+
+// GetParserVM returns a VM as the inventory parser models it, which is what the OPA
+// validator checks.
+func (s *VMStore) GetParserVM(ctx context.Context, id string) (*duckdb_models.VM, error) {
+	r, err := s.getRow(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &r.pvm, nil
+}
+
+// Get returns full VM details by ID, including utilization data from the latest rightsizing report.
+func (s *VMStore) Get(ctx context.Context, id string) (*models.VM, error) {
+	r, err := s.getRow(ctx, id)
+	if err != nil {
+		return nil, err
+	}
 
-	result := fromDB(pvm)
-	result.Groups = groups
-	result.InspectionStatus.State = models.InspectionState(inspectionState)
-	result.InspectionStatus.Details = inspectionDetails
-	if inspectionError != "" {
-		result.InspectionStatus.Error = errors.New(inspectionError)
+	result := fromDB(r.pvm)
+	result.Groups = r.groups
+	result.InspectionStatus.State = models.InspectionState(r.inspectionState)
+	result.InspectionStatus.Details = r.inspectionDetails
+	if r.inspectionError != "" {
+		result.InspectionStatus.Error = errors.New(r.inspectionError)
 	}
 
-	if uMoid.Valid {
+	if r.uMoid.Valid {
 		result.Utilization = &models.VmUtilizationDetails{
-			MOID:                uMoid.String,
-			VMName:              uVmName.String,
-			ProvisionedCpus:     int(uProvCpus.Int64),
-			ProvisionedMemoryMb: int(uProvMemMb.Int64),
-			ProvisionedDiskKb:   uProvDiskKb.Float64,
-			CpuAvg:              uCpuAvg.Float64,
-			CpuP95:              uCpuP95.Float64,
-			CpuMax:              uCpuMax.Float64,
-			CpuLatest:           uCpuLatest.Float64,
-			MemAvg:              uMemAvg.Float64,
-			MemP95:              uMemP95.Float64,
-			MemMax:              uMemMax.Float64,
-			MemLatest:           uMemLatest.Float64,
-			Disk:                uDisk.Float64,
-			Confidence:          uConfidence.Float64,
+			MOID:                r.uMoid.String,
+			VMName:              r.uVmName.String,
+			ProvisionedCpus:     int(r.uProvCpus.Int64),
+			ProvisionedMemoryMb: int(r.uProvMemMb.Int64),
+			ProvisionedDiskKb:   r.uProvDiskKb.Float64,
+			CpuAvg:              r.uCpuAvg.Float64,
+			CpuP95:              r.uCpuP95.Float64,
+			CpuMax:              r.uCpuMax.Float64,
+			CpuLatest:           r.uCpuLatest.Float64,
+			MemAvg:              r.uMemAvg.Float64,
+			MemP95:              r.uMemP95.Float64,
+			MemMax:              r.uMemMax.Float64,
+			MemLatest:           r.uMemLatest.Float64,
+			Disk:                r.uDisk.Float64,
+			Confidence:          r.uConfidence.Float64,
 		}
 	}
 
@@ -250,6 +298,50 @@ func (s *VMStore) GetFilterOptions(ctx context.Context) (models.VMFilterOptions,
 	}, nil
 }
 
+// FilterColumn is a column of the flat filter subquery, as resolved by a filter mapper.
+type FilterColumn struct {
+	SQL string
+	// Array is set on list columns, whose elements are sampled instead of the lists.
+	Array bool
+}
+
+// SampleFilterValues returns up to limit distinct non-null values of each column, sorted,
+// in a single pass over the filter subquery.
+func (s *VMStore) SampleFilterValues(ctx context.Context, columns []FilterColumn, limit int) ([][]string, error) {
+	if len(columns) == 0 {
+		return nil, nil
+	}
+
+	exprs := make([]string, 0, len(columns))
+	for _, c := range columns {
+		values := fmt.Sprintf("array_agg(DISTINCT %s) FILTER (WHERE %s IS NOT NULL)", c.SQL, c.SQL)
+		if c.Array {
+			values = fmt.Sprintf("list_distinct(flatten(array_agg(CAST(%s AS VARCHAR[])) FILTER (WHERE %s IS NOT NULL)))", c.SQL, c.SQL)
+		}
+		exprs = append(exprs, fmt.Sprintf("CAST(list_slice(list_sort(%s), 1, %d) AS VARCHAR[])", values, limit))
+	}
+
+	query, args, err := vmFilterSubquery.RemoveColumns().Columns(exprs...).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building sample filter values query: %w", err)
+	}
+
+	samples := make([]StringArray, len(columns))
+	dest := make([]any, len(columns))
+	for i := range samples {
+		dest[i] = &samples[i]
+	}
+	if err := s.db.QueryRowContext(ctx, query, args...).Scan(dest...); err != nil {
+		return nil, fmt.Errorf("sampling filter values: %w", err)
+	}
+
+	result := make([][]string, len(columns))
+	for i, sample := range samples {
+		result[i] = sample
+	}
+	return result, nil
+}
+
 // GetGuestApps returns all VMs with their guest application names.
 func (s *VMStore) GetGuestApps(ctx context.Context) ([]models.VMGuestApps, error) {
 	query, args, err := sq.Select(
@@ -535,6 +627,7 @@ func (s *VMStore) GetFolders(ctx context.Context) ([]models.Folder, error) {
 }
 
 // UpdateMigrationExcluded sets the migration_excluded flag for a VM in vinfo table.
+// Clearing it also drops the details of the exclusion.
 func (s *VMStore) UpdateMigrationExcluded(ctx context.Context, vmID string, excluded bool) error {
 	query, args, err := sq.Update("vinfo").
 		Set(`"migration_excluded"`, excluded).
@@ -554,6 +647,9 @@ func (s *VMStore) UpdateMigrationExcluded(ctx context.Context, vmID string, excl
 	if rows == 0 {
 		return srvErrors.NewResourceNotFoundError("VM", vmID)
 	}
+	if !excluded {
+		return s.forgetMigrationExclusions(ctx, []string{vmID})
+	}
 	return nil
 }
 
@@ -610,6 +706,7 @@ func (s *VMStore) GetMigrationExcludedStates(ctx context.Context, vmIDs []string
 }
 
 // UpdateMigrationExcludedBatch sets the migration_excluded flag for multiple VMs in a single UPDATE statement.
+// Clearing it also drops the details of the exclusions.
 // Validates all VMs exist only on failure (lazy validation for performance).
 // Returns an error if any VM is not found.
 func (s *VMStore) UpdateMigrationExcludedBatch(ctx context.Context, vmIDs []string, excluded bool) error {
@@ -653,6 +750,9 @@ func (s *VMStore) UpdateMigrationExcludedBatch(ctx context.Context, vmIDs []stri
 		return fmt.Errorf("expected to update %d VMs but only updated %d", len(vmIDs), rowsAffected)
 	}
 
+	if !excluded {
+		return s.forgetMigrationExclusions(ctx, vmIDs)
+	}
 	return nil
 }
 
@@ -672,6 +772,14 @@ func (s *VMStore) UpdateLabels(ctx context.Context, vmID string, labels []string
 		labelsJSON = "[" + strings.Join(escaped, ",") + "]"
 	}
 
+	found, err := s.recordLabelDiff(ctx, vmID, labels)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return srvErrors.NewResourceNotFoundError("VM", vmID)
+	}
+
 	query, args, err := sq.Update("vinfo").
 		Set(`"labels"`, sq.Expr(labelsJSON)).
 		Where(sq.Eq{`"VM ID"`: vmID}).
@@ -693,9 +801,41 @@ func (s *VMStore) UpdateLabels(ctx context.Context, vmID string, labels []string
 		return srvErrors.NewResourceNotFoundError("VM", vmID)
 	}
 
+	// The labels are set by hand now, so rules must no longer manage them.
+	if err := s.forgetRuleLabels(ctx, []string{vmID}, ""); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// ListIdentities returns the ID, name and UUIDs of every VM, ordered by name.
+func (s *VMStore) ListIdentities(ctx context.Context) ([]models.VMIdentity, error) {
+	query, args, err := sq.Select(`"VM ID"`, `"VM"`, `COALESCE("VM UUID", '')`, `COALESCE("SMBIOS UUID", '')`).
+		From("vinfo").
+		OrderBy(`"VM"`, `"VM ID"`).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building list VM identities query: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying VM identities: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	identities := []models.VMIdentity{}
+	for rows.Next() {
+		var id models.VMIdentity
+		if err := rows.Scan(&id.ID, &id.Name, &id.UUID, &id.SMBIOSUUID); err != nil {
+			return nil, fmt.Errorf("scanning VM identity: %w", err)
+		}
+		identities = append(identities, id)
+	}
+	return identities, rows.Err()
+}
+
 // ListLabels returns a map from VM ID to its labels, for all VMs with at least one label.
 func (s *VMStore) ListLabels(ctx context.Context) (map[string][]string, error) {
 	rows, err := s.db.QueryContext(ctx, `SELECT "VM ID", CAST("labels" AS VARCHAR[]) FROM vinfo WHERE "labels" != '[]'`)
@@ -778,7 +918,8 @@ func (s *VMStore) sourceCatalog(ctx context.Context) (string, error) {
 
 // CopyLabelsToAttached copies user-assigned labels from this store's vinfo into the attached
 // database's vinfo, for VMs present in both. Labels whose keys appear in excludeLabels are
-// omitted. VMs absent from the attached database are silently skipped.
+// omitted, and recorded as removed by the system in the attached label history. VMs absent
+// from the attached database are silently skipped.
 func (s *VMStore) CopyLabelsToAttached(ctx context.Context, attachAlias string, excludeLabels map[string]bool) error {
 	cat, err := s.sourceCatalog(ctx)
 	if err != nil {
@@ -786,6 +927,28 @@ func (s *VMStore) CopyLabelsToAttached(ctx context.Context, attachAlias string,
 	}
 	srcVinfo := cat + ".main.vinfo"
 	exclusionArr := buildExclusionArray(excludeLabels)
+
+	dropped := sq.Select().
+		Column(`"VM ID"`).
+		Column("label").
+		Column("CAST(? AS VARCHAR)", string(models.LabelRemoved)).
+		Column("CAST(? AS VARCHAR)", string(models.LabelSourceSystem)).
+		Column("''").
+		Column("CAST(? AS TIMESTAMP)", time.Now()).
+		FromSelect(sq.Select(`"VM ID"`, `unnest(CAST("labels" AS VARCHAR[])) AS label`).From(srcVinfo), "l").
+		Where(sq.Expr(fmt.Sprintf(`list_contains(CAST(%s AS VARCHAR[]), label)`, exclusionArr))).
+		Where(sq.Expr(`"VM ID" IN (SELECT "VM ID" FROM ` + attachAlias + `.vinfo)`))
+	historyQuery, historyArgs, err := sq.Insert(attachAlias + "." + vmLabelHistoryTable).
+		Columns(vmLabelHistoryColumns...).
+		Select(dropped).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	if _, err := s.db.ExecContext(ctx, historyQuery, historyArgs...); err != nil {
+		return fmt.Errorf("recording dropped labels: %w", err)
+	}
+
 	filterExpr := fmt.Sprintf(
 		`list_filter(CAST(%s."labels" AS VARCHAR[]), x -> NOT list_contains(CAST(%s AS VARCHAR[]), x))`,
 		srcVinfo, exclusionArr,
@@ -804,34 +967,63 @@ func (s *VMStore) CopyLabelsToAttached(ctx context.Context, attachAlias string,
 }
 
 // CopyMigrationExclusionToAttached sets migration_excluded=true in the attached schema's vinfo
-// for every VM that is excluded in this store. VMs absent from the attached schema are silently skipped.
-func (s *VMStore) CopyMigrationExclusionToAttached(ctx context.Context, attachAlias string) error {
+// for every VM that is excluded in this store, along with the details of its exclusion.
+// Exclusions that expired at now are lifted by not being copied. VMs absent from the attached
+// schema are silently skipped.
+func (s *VMStore) CopyMigrationExclusionToAttached(ctx context.Context, attachAlias string, now time.Time) error {
 	cat, err := s.sourceCatalog(ctx)
 	if err != nil {
 		return err
 	}
 	srcVinfo := cat + ".main.vinfo"
+	srcExclusions := cat + ".main." + vmExclusionsTable
 	query, args, err := sq.Update(attachAlias+".vinfo").
 		Set(`"migration_excluded"`, true).
 		From(srcVinfo).
 		Where(sq.Expr(srcVinfo + `."VM ID" = ` + attachAlias + `.vinfo."VM ID"`)).
 		Where(sq.Eq{srcVinfo + `."migration_excluded"`: true}).
+		Where(sq.Expr(`NOT EXISTS (SELECT 1 FROM `+srcExclusions+` ex WHERE ex.`+vmExclusionsColVMID+` = `+srcVinfo+`."VM ID" AND ex.`+vmExclusionsColExpiresAt+` <= ?)`, now)).
 		ToSql()
 	if err != nil {
 		return err
 	}
-	_, err = s.db.ExecContext(ctx, query, args...)
-	return err
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return err
+	}
+	return s.copyMigrationExclusionsToAttached(ctx, attachAlias, now)
 }
 
 // LabelNewVMsInAttached adds label to every VM in the attached schema whose VM ID does not
 // appear in this store's vinfo. These are VMs that are new to the current collection run.
+// The additions are recorded as made by the system in the attached label history.
 func (s *VMStore) LabelNewVMsInAttached(ctx context.Context, attachAlias string, label string) error {
 	cat, err := s.sourceCatalog(ctx)
 	if err != nil {
 		return err
 	}
 	srcVinfo := cat + ".main.vinfo"
+
+	added := sq.Select().
+		Column(`"VM ID"`).
+		Column("CAST(? AS VARCHAR)", label).
+		Column("CAST(? AS VARCHAR)", string(models.LabelAdded)).
+		Column("CAST(? AS VARCHAR)", string(models.LabelSourceSystem)).
+		Column("''").
+		Column("CAST(? AS TIMESTAMP)", time.Now()).
+		From(attachAlias + ".vinfo").
+		Where(sq.Expr(`"VM ID" NOT IN (SELECT "VM ID" FROM ` + srcVinfo + `)`)).
+		Where(sq.Expr(`NOT list_contains(CAST("labels" AS VARCHAR[]), ?)`, label))
+	historyQuery, historyArgs, err := sq.Insert(attachAlias + "." + vmLabelHistoryTable).
+		Columns(vmLabelHistoryColumns...).
+		Select(added).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	if _, err := s.db.ExecContext(ctx, historyQuery, historyArgs...); err != nil {
+		return fmt.Errorf("recording new VM labels: %w", err)
+	}
+
 	query, args, err := sq.Update(attachAlias+".vinfo").
 		Set(`"labels"`, sq.Expr(`list_distinct(list_append(CAST("labels" AS VARCHAR[]), ?))`, label)).
 		Where(sq.Expr(`"VM ID" NOT IN (SELECT "VM ID" FROM ` + srcVinfo + `)`)).
@@ -845,6 +1037,10 @@ func (s *VMStore) LabelNewVMsInAttached(ctx context.Context, attachAlias string,
 
 // AddLabel adds a label to a VM's labels array (idempotent - no duplicates).
 func (s *VMStore) AddLabel(ctx context.Context, vmID string, label string) error {
+	if err := s.recordLabelChanges(ctx, []string{vmID}, label, models.LabelAdded); err != nil {
+		return err
+	}
+
 	// Use DuckDB's list functions to add label without creating duplicates
 	// list_append adds the element, list_distinct removes duplicates
 	query, args, err := sq.Update("vinfo").
@@ -868,11 +1064,20 @@ func (s *VMStore) AddLabel(ctx context.Context, vmID string, label string) error
 		return srvErrors.NewResourceNotFoundError("VM", vmID)
 	}
 
+	// A label added by hand is manual, even when a rule applied it first.
+	if err := s.forgetRuleLabels(ctx, []string{vmID}, label); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 // RemoveLabel removes a label from a VM's labels array (idempotent).
 func (s *VMStore) RemoveLabel(ctx context.Context, vmID string, label string) error {
+	if err := s.recordLabelChanges(ctx, []string{vmID}, label, models.LabelRemoved); err != nil {
+		return err
+	}
+
 	// Use DuckDB's list_filter with lambda to remove the specific label
 	// If label doesn't exist, this is a no-op (idempotent)
 	query, args, err := sq.Update("vinfo").
@@ -896,11 +1101,19 @@ func (s *VMStore) RemoveLabel(ctx context.Context, vmID string, label string) er
 		return srvErrors.NewResourceNotFoundError("VM", vmID)
 	}
 
+	if err := s.forgetRuleLabels(ctx, []string{vmID}, label); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 // RemoveLabelGlobally removes a label from all VMs that have it.
 func (s *VMStore) RemoveLabelGlobally(ctx context.Context, label string) (int, error) {
+	if err := s.recordLabelChanges(ctx, nil, label, models.LabelRemoved); err != nil {
+		return 0, err
+	}
+
 	// Update all VMs that have the label
 	// WHERE clause with list_contains optimizes to only update relevant VMs
 	query, args, err := sq.Update("vinfo").
@@ -921,6 +1134,10 @@ func (s *VMStore) RemoveLabelGlobally(ctx context.Context, label string) (int, e
 		return 0, err
 	}
 
+	if err := s.forgetRuleLabels(ctx, nil, label); err != nil {
+		return 0, err
+	}
+
 	return int(rows), nil
 }
 
@@ -966,6 +1183,10 @@ func (s *VMStore) AddLabelBatch(ctx context.Context, vmIDs []string, label strin
 		return nil
 	}
 
+	if err := s.recordLabelChanges(ctx, vmIDs, label, models.LabelAdded); err != nil {
+		return err
+	}
+
 	query, args, err := sq.Update("vinfo").
 		Set(`"labels"`, sq.Expr("list_distinct(list_append(CAST(\"labels\" AS VARCHAR[]), ?))", label)).
 		Where(sq.Expr(`"VM ID" IN (SELECT unnest(?))`, vmIDs)).
@@ -999,6 +1220,11 @@ func (s *VMStore) AddLabelBatch(ctx context.Context, vmIDs []string, label strin
 		return fmt.Errorf("expected to update %d VMs but only updated %d", len(vmIDs), rowsAffected)
 	}
 
+	// A label added by hand is manual, even when a rule applied it first.
+	if err := s.forgetRuleLabels(ctx, vmIDs, label); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -1010,6 +1236,10 @@ func (s *VMStore) RemoveLabelBatch(ctx context.Context, vmIDs []string, label st
 		return nil
 	}
 
+	if err := s.recordLabelChanges(ctx, vmIDs, label, models.LabelRemoved); err != nil {
+		return err
+	}
+
 	query, args, err := sq.Update("vinfo").
 		Set(`"labels"`, sq.Expr("list_filter(CAST(\"labels\" AS VARCHAR[]), x -> x != ?)", label)).
 		Where(sq.Expr(`"VM ID" IN (SELECT unnest(?))`, vmIDs)).
@@ -1043,6 +1273,10 @@ func (s *VMStore) RemoveLabelBatch(ctx context.Context, vmIDs []string, label st
 		return fmt.Errorf("expected to update %d VMs but only updated %d", len(vmIDs), rowsAffected)
 	}
 
+	if err := s.forgetRuleLabels(ctx, vmIDs, label); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -1089,6 +1323,73 @@ WHERE v."migration_excluded" IS NOT TRUE
 ORDER BY v."VM ID"
 `
 
+// listStatesQuery returns the fields tracked by the "changed" comparison dimension.
+// Datastore names are taken from the "[datastore] path" prefix of disk files.
+const listStatesQuery = `
+WITH ds AS (
+    SELECT "VM ID", LIST(DISTINCT regexp_extract(COALESCE("Path", "Disk Path"), '\[([^\]]+)\]', 1)) AS datastores
+    FROM vdisk
+    WHERE regexp_extract(COALESCE("Path", "Disk Path"), '\[([^\]]+)\]', 1) != ''
+    GROUP BY "VM ID"
+),
+nics AS (
+    SELECT "VM ID", LIST(COALESCE("Network", '') || ' (' || COALESCE("Mac Address", '') || ')') AS nics
+    FROM vnetwork
+    GROUP BY "VM ID"
+),
+crit AS (
+    SELECT "VM_ID", LIST(DISTINCT "Concern_ID") AS concerns
+    FROM concerns
+    GROUP BY "VM_ID"
+)
+SELECT
+    v."VM ID",
+    COALESCE(v."VM", ''),
+    COALESCE(v."CPUs", 0),
+    COALESCE(v."Memory", 0),
+    COALESCE(v."Total disk capacity MiB", 0),
+    COALESCE(v."Powerstate", ''),
+    COALESCE(v."Host", ''),
+    COALESCE(v."Cluster", ''),
+    list_sort(COALESCE(ds.datastores, [])),
+    list_sort(COALESCE(nics.nics, [])),
+    list_sort(COALESCE(crit.concerns, [])),
+    list_sort(CAST(COALESCE(v."labels", '[]') AS VARCHAR[]))
+FROM vinfo v
+LEFT JOIN ds ON ds."VM ID" = v."VM ID"
+LEFT JOIN nics ON nics."VM ID" = v."VM ID"
+LEFT JOIN crit ON crit."VM_ID" = v."VM ID"
+WHERE v."migration_excluded" IS NOT TRUE
+ORDER BY v."VM ID"
+`
+
+// listPlacementsQuery returns the distinct networks and datastores of the VMs given as a list.
+// Datastore names are taken from the "[datastore] path" prefix of disk files.
+const listPlacementsQuery = `
+WITH ds AS (
+    SELECT "VM ID", LIST(DISTINCT regexp_extract(COALESCE("Path", "Disk Path"), '\[([^\]]+)\]', 1)) AS datastores
+    FROM vdisk
+    WHERE regexp_extract(COALESCE("Path", "Disk Path"), '\[([^\]]+)\]', 1) != ''
+    GROUP BY "VM ID"
+),
+nets AS (
+    SELECT "VM ID", LIST(DISTINCT "Network") AS networks
+    FROM vnetwork
+    WHERE COALESCE("Network", '') != ''
+    GROUP BY "VM ID"
+)
+SELECT
+    v."VM ID",
+    COALESCE(v."VM", ''),
+    list_sort(COALESCE(nets.networks, [])),
+    list_sort(COALESCE(ds.datastores, []))
+FROM vinfo v
+LEFT JOIN ds ON ds."VM ID" = v."VM ID"
+LEFT JOIN nets ON nets."VM ID" = v."VM ID"
+WHERE v."VM ID" IN (SELECT unnest(?))
+ORDER BY v."VM ID"
+`
+
 const countDistinctClustersQuery = `
 SELECT COUNT(DISTINCT "Cluster")
 FROM vinfo
@@ -1116,6 +1417,71 @@ func (s *VMStore) ListForComparison(ctx context.Context) ([]models.VMComparisonR
 	return result, rows.Err()
 }
 
+// ListStates returns the state of every VM tracked by the "changed" comparison dimension.
+// Like ListForComparison, it skips VMs excluded from migration.
+func (s *VMStore) ListStates(ctx context.Context) ([]models.VMStateRow, error) {
+	rows, err := s.db.QueryContext(ctx, listStatesQuery)
+	if err != nil {
+		return nil, fmt.Errorf("listing VM states: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var result []models.VMStateRow
+	for rows.Next() {
+		var row models.VMStateRow
+		var datastores, nics, concerns, labels StringArray
+		if err := rows.Scan(
+			&row.VMID,
+			&row.Name,
+			&row.CPUs,
+			&row.MemoryMiB,
+			&row.DiskCapacityMiB,
+			&row.PowerState,
+			&row.Host,
+			&row.Cluster,
+			&datastores,
+			&nics,
+			&concerns,
+			&labels,
+		); err != nil {
+			return nil, fmt.Errorf("scanning VM state row: %w", err)
+		}
+		row.Datastores = datastores
+		row.NICs = nics
+		row.Concerns = concerns
+		row.Labels = labels
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
+
+// ListPlacements returns the networks and datastores of the given VMs, ordered by VM ID.
+// VMs missing from the collection are skipped.
+func (s *VMStore) ListPlacements(ctx context.Context, vmIDs []string) ([]models.VMPlacement, error) {
+	result := []models.VMPlacement{}
+	if len(vmIDs) == 0 {
+		return result, nil
+	}
+
+	rows, err := s.db.QueryContext(ctx, listPlacementsQuery, vmIDs)
+	if err != nil {
+		return nil, fmt.Errorf("listing VM placements: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var row models.VMPlacement
+		var networks, datastores StringArray
+		if err := rows.Scan(&row.VMID, &row.Name, &networks, &datastores); err != nil {
+			return nil, fmt.Errorf("scanning VM placement row: %w", err)
+		}
+		row.Networks = networks
+		row.Datastores = datastores
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
+
 // CountDistinctClusters returns the number of distinct non-empty cluster names in the collection.
 func (s *VMStore) CountDistinctClusters(ctx context.Context) (int, error) {
 	var count int
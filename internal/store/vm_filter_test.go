@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"sort"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -94,6 +95,28 @@ var _ = Describe("VMStore cross-table filters", func() {
 		})
 	})
 
+	Context("collection_source (vcenter)", func() {
+		It("should match every VM of a collection built from the default profile", func() {
+			f := store.ByFilter("vcenter = 'default'")
+			vms, err := s.VM().List(ctx, f, store.WithDefaultSort())
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vms).NotTo(BeEmpty())
+		})
+
+		It("should follow the recorded source vCenter", func() {
+			Expect(s.CollectionSource().Save(ctx, models.CollectionSource{VCenter: "vc-east", URL: "https://vc-east.local/sdk"})).To(Succeed())
+
+			vms, err := s.VM().List(ctx, store.ByFilter("vcenter = 'vc-east'"), store.WithDefaultSort())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vms).NotTo(BeEmpty())
+
+			vms, err = s.VM().List(ctx, store.ByFilter("vcenter = 'default'"), store.WithDefaultSort())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vms).To(BeEmpty())
+		})
+	})
+
 	Context("vdisk columns (disk.* prefix)", func() {
 		It("should filter by individual disk capacity", func() {
 			f := store.ByFilter("disk.capacity >= 500")
@@ -290,6 +313,210 @@ var _ = Describe("VMStore cross-table filters", func() {
 		})
 	})
 
+	Context("quantifiers and aggregates over disks and NICs", func() {
+		It("should filter VMs whose disks are all thin", func() {
+			_, err := db.ExecContext(ctx, `UPDATE vdisk SET "Thin" = true WHERE "VM ID" = 'vm-001' OR "Path" LIKE '%vm-003/disk1%'`)
+			Expect(err).NotTo(HaveOccurred())
+
+			vms, err := s.VM().List(ctx, store.ByFilter("all(disk.thin = true)"), store.WithDefaultSort())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vmIDs(vms)).To(Equal([]string{"vm-001"}))
+
+			vms, err = s.VM().List(ctx, store.ByFilter("any(disk.thin = true)"), store.WithDefaultSort())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vmIDs(vms)).To(Equal([]string{"vm-001", "vm-003"}))
+		})
+
+		It("should filter VMs without a matching disk", func() {
+			f := store.ByFilter("none(disk.controller = 'NVME')")
+			vms, err := s.VM().List(ctx, f, store.WithDefaultSort())
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vms).To(HaveLen(8))
+			Expect(vmIDs(vms)).NotTo(ContainElements("vm-008", "vm-009"))
+		})
+
+		It("should filter by disk count", func() {
+			f := store.ByFilter("count(disk) > 1")
+			vms, err := s.VM().List(ctx, f, store.WithDefaultSort())
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vmIDs(vms)).To(Equal([]string{"vm-003"}))
+		})
+
+		It("should filter by total disk capacity", func() {
+			f := store.ByFilter("sum(disk.capacity) >= 1000 and max(disk.capacity) < 1000")
+			vms, err := s.VM().List(ctx, f, store.WithDefaultSort())
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vmIDs(vms)).To(Equal([]string{"vm-003"}))
+		})
+
+		It("should count VMs without NICs as having none", func() {
+			f := store.ByFilter("count(net) = 0")
+			vms, err := s.VM().List(ctx, f, store.WithDefaultSort())
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vmIDs(vms)).To(Equal([]string{"vm-004", "vm-005", "vm-006", "vm-008", "vm-009", "vm-010"}))
+		})
+
+		It("should hold all for VMs without NICs", func() {
+			f := store.ByFilter("all(net.network != 'Staging')")
+			vms, err := s.VM().List(ctx, f, store.WithDefaultSort())
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vms).To(HaveLen(9))
+			Expect(vmIDs(vms)).NotTo(ContainElement("vm-007"))
+		})
+
+		It("should have List count match Count for aggregates", func() {
+			f := store.ByFilter("count(disk) >= 1 and any(net.network = 'Production')")
+
+			vms, err := s.VM().List(ctx, f, store.WithDefaultSort())
+			Expect(err).NotTo(HaveOccurred())
+
+			count, err := s.VM().Count(ctx, f)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(vmIDs(vms)).To(Equal([]string{"vm-003"}))
+			Expect(count).To(Equal(1))
+		})
+	})
+
+	Context("lifecycle and snapshot times", func() {
+		BeforeEach(func() {
+			at := func(days int) *time.Time {
+				t := time.Now().UTC().AddDate(0, 0, -days)
+				return &t
+			}
+			Expect(s.VM().SaveLifecycles(ctx, []models.VMLifecycle{
+				{VMID: "vm-001", CreatedAt: at(400), BootedAt: at(2), Snapshots: []models.VMSnapshot{
+					{ID: "1", Name: "before-upgrade", CreatedAt: *at(90)},
+					{ID: "2", Name: "daily", CreatedAt: *at(1)},
+				}},
+				{VMID: "vm-002", CreatedAt: at(10), Snapshots: []models.VMSnapshot{
+					{ID: "1", Name: "daily", CreatedAt: *at(3)},
+				}},
+				{VMID: "vm-003", CreatedAt: at(40)},
+			})).To(Succeed())
+		})
+
+		It("should filter by creation time", func() {
+			f := store.ByFilter("created_at < now - 30d")
+			vms, err := s.VM().List(ctx, f, store.WithDefaultSort())
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vmIDs(vms)).To(Equal([]string{"vm-001", "vm-003"}))
+		})
+
+		It("should filter by timestamp literal", func() {
+			f := store.ByFilter("booted_at >= " + time.Now().UTC().AddDate(0, 0, -7).Format("2006-01-02"))
+			vms, err := s.VM().List(ctx, f, store.WithDefaultSort())
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vmIDs(vms)).To(Equal([]string{"vm-001"}))
+		})
+
+		It("should filter by snapshot age", func() {
+			f := store.ByFilter("snapshot.age > 30d")
+			vms, err := s.VM().List(ctx, f, store.WithDefaultSort())
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vmIDs(vms)).To(Equal([]string{"vm-001"}))
+		})
+
+		It("should filter by snapshot age range and quantifiers", func() {
+			f := store.ByFilter("snapshot.age between 2d and 1w")
+			vms, err := s.VM().List(ctx, f, store.WithDefaultSort())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vmIDs(vms)).To(Equal([]string{"vm-002"}))
+
+			f = store.ByFilter("count(snapshot) > 0 and all(snapshot.age < 1w)")
+			vms, err = s.VM().List(ctx, f, store.WithDefaultSort())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vmIDs(vms)).To(Equal([]string{"vm-002"}))
+		})
+
+		It("should filter by inspection completion time", func() {
+			Expect(s.Inspection().Update(ctx, "vm-004", models.InspectionStatus{State: models.InspectionStateCompleted})).To(Succeed())
+
+			f := store.ByFilter("inspection.completed_at > now - 1h")
+			vms, err := s.VM().List(ctx, f, store.WithDefaultSort())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vmIDs(vms)).To(Equal([]string{"vm-004"}))
+
+			// a new inspection keeps the time the previous one completed
+			Expect(s.Inspection().Update(ctx, "vm-004", models.InspectionStatus{State: models.InspectionStatePending})).To(Succeed())
+			vms, err = s.VM().List(ctx, f, store.WithDefaultSort())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vmIDs(vms)).To(Equal([]string{"vm-004"}))
+		})
+	})
+
+	Context("SampleFilterValues", func() {
+		It("should return sorted distinct values of each column", func() {
+			samples, err := s.VM().SampleFilterValues(ctx, []store.FilterColumn{
+				{SQL: `v."Firmware"`},
+				{SQL: `v."CPUs"`},
+				{SQL: `dk."Controller"`},
+			}, 10)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(samples).To(Equal([][]string{
+				{"bios", "efi"},
+				{"1", "2", "4", "8"},
+				{"NVME", "SCSI"},
+			}))
+		})
+
+		It("should cap each column at the limit", func() {
+			samples, err := s.VM().SampleFilterValues(ctx, []store.FilterColumn{{SQL: `v."VM"`}}, 3)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(samples[0]).To(Equal([]string{"app-server-1", "app-server-2", "cache-server-1"}))
+		})
+
+		It("should sample the elements of array columns", func() {
+			Expect(s.VM().UpdateLabels(ctx, "vm-001", []string{"web", "prod"})).To(Succeed())
+			Expect(s.VM().UpdateLabels(ctx, "vm-002", []string{"web"})).To(Succeed())
+
+			samples, err := s.VM().SampleFilterValues(ctx, []store.FilterColumn{{SQL: `v."labels"`, Array: true}}, 10)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(samples[0]).To(Equal([]string{"prod", "web"}))
+		})
+
+		It("should return empty samples for columns without values", func() {
+			samples, err := s.VM().SampleFilterValues(ctx, []store.FilterColumn{{SQL: "utilization.cpu_avg_pct"}}, 10)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(samples[0]).To(BeEmpty())
+		})
+	})
+
+	Context("utilization filters", func() {
+		It("should filter VMs by disk IOPS and latency of the latest report", func() {
+			_, err := db.ExecContext(ctx, `
+				INSERT INTO rightsizing_reports
+					(id, vcenter, interval_id, window_start, window_end, expected_sample_count, expected_batch_count, written_batch_count)
+				VALUES ('report-io', 'vcenter-test', 7200, '2024-01-01 00:00:00+00', '2024-01-31 00:00:00+00', 360, 1, 1)`)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = db.ExecContext(ctx, `
+				INSERT INTO rightsizing_vm_utilization (report_id, moid, vm_name, iops_p95, write_latency_p95_ms, net_p95_kbps)
+				VALUES ('report-io', 'vm-001', 'vm-001', 4500, 25, 100),
+				       ('report-io', 'vm-002', 'vm-002', 200, 2, 90000)`)
+			Expect(err).NotTo(HaveOccurred())
+
+			vms, err := s.VM().List(ctx, store.ByFilter("utilization.iops_p95 > 1000"), store.WithDefaultSort())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vmIDs(vms)).To(Equal([]string{"vm-001"}))
+
+			vms, err = s.VM().List(ctx, store.ByFilter("utilization.write_latency_p95 >= 20 or utilization.net_p95 > 50000"), store.WithDefaultSort())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vmIDs(vms)).To(Equal([]string{"vm-001", "vm-002"}))
+		})
+	})
+
 	Context("DISTINCT correctness", func() {
 		It("should return each VM once even with multiple disks", func() {
 			f := store.ByFilter("disk.capacity > 0")
@@ -0,0 +1,124 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+)
+
+// Infrastructure comparison queries follow the shapes of hostsQuery, clustersQuery and
+// datastoresQuery: entities come from the inventory tables and VM membership from vinfo,
+// or from vdisk paths for datastores. Networks have no inventory table and are the distinct
+// networks NICs are attached to. Every query returns name, member VM IDs, host count, CPU
+// cores, memory, capacity and free space.
+
+const infraClustersQuery = `
+SELECT
+    COALESCE(c."Name", ''),
+    COALESCE(vms.vm_ids, []),
+    COALESCE(h.host_count, 0),
+    COALESCE(h.cpu_cores, 0),
+    COALESCE(h.memory_mib, 0),
+    0,
+    0
+FROM vcluster c
+LEFT JOIN (
+    SELECT "Cluster", COUNT(*) AS host_count, SUM("# Cores") AS cpu_cores, SUM("# Memory") AS memory_mib
+    FROM vhost
+    GROUP BY "Cluster"
+) h ON c."Name" = h."Cluster"
+LEFT JOIN (
+    SELECT "Cluster", LIST("VM ID" ORDER BY "VM ID") AS vm_ids
+    FROM vinfo
+    GROUP BY "Cluster"
+) vms ON c."Name" = vms."Cluster"
+ORDER BY 1
+`
+
+const infraHostsQuery = `
+SELECT
+    COALESCE(h."Host", ''),
+    COALESCE(vms.vm_ids, []),
+    0,
+    COALESCE(h."# Cores", 0),
+    COALESCE(h."# Memory", 0),
+    0,
+    0
+FROM vhost h
+LEFT JOIN (
+    SELECT "Host", LIST("VM ID" ORDER BY "VM ID") AS vm_ids
+    FROM vinfo
+    GROUP BY "Host"
+) vms ON h."Host" = vms."Host"
+ORDER BY 1
+`
+
+const infraDatastoresQuery = `
+SELECT
+    COALESCE(ds."Name", ''),
+    COALESCE(vms.vm_ids, []),
+    0,
+    0,
+    0,
+    COALESCE(ds."Capacity MiB", 0),
+    COALESCE(ds."Free MiB", 0)
+FROM vdatastore ds
+LEFT JOIN (
+    SELECT
+        regexp_extract(COALESCE("Path", "Disk Path"), '\[([^\]]+)\]', 1) AS datastore_name,
+        LIST(DISTINCT "VM ID" ORDER BY "VM ID") AS vm_ids
+    FROM vdisk
+    GROUP BY datastore_name
+) vms ON ds."Name" = vms.datastore_name
+ORDER BY 1
+`
+
+const infraNetworksQuery = `
+SELECT
+    "Network",
+    LIST(DISTINCT "VM ID" ORDER BY "VM ID"),
+    0,
+    0,
+    0,
+    0,
+    0
+FROM vnetwork
+WHERE "Network" IS NOT NULL AND "Network" != ''
+GROUP BY "Network"
+ORDER BY 1
+`
+
+var infraQueries = map[models.ComparisonDimension]string{
+	models.DimensionClusters:   infraClustersQuery,
+	models.DimensionHosts:      infraHostsQuery,
+	models.DimensionDatastores: infraDatastoresQuery,
+	models.DimensionNetworks:   infraNetworksQuery,
+}
+
+// ListInfraEntities returns the clusters, hosts, datastores or networks of the collection,
+// sorted by name, for an infrastructure comparison dimension.
+func (s *VMStore) ListInfraEntities(ctx context.Context, dimension models.ComparisonDimension) ([]models.InfraEntity, error) {
+	query, ok := infraQueries[dimension]
+	if !ok {
+		return nil, fmt.Errorf("not an infrastructure dimension: %q", dimension)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("listing %s: %w", dimension, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var result []models.InfraEntity
+	for rows.Next() {
+		var e models.InfraEntity
+		var vmIDs StringArray
+		if err := rows.Scan(&e.Name, &vmIDs, &e.Hosts, &e.CPUCores, &e.MemoryMiB, &e.CapacityMiB, &e.FreeMiB); err != nil {
+			return nil, fmt.Errorf("scanning %s row: %w", dimension, err)
+		}
+		e.VMIDs = vmIDs
+		result = append(result, e)
+	}
+	return result, rows.Err()
+}
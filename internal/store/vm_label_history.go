@@ -0,0 +1,183 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+const (
+	vmLabelHistoryTable = "vm_label_history"
+
+	vmLabelHistoryColVMID   = "vm_id"
+	vmLabelHistoryColLabel  = "label"
+	vmLabelHistoryColAction = "action"
+	vmLabelHistoryColSource = "source"
+	vmLabelHistoryColActor  = "actor"
+	vmLabelHistoryColAt     = "changed_at"
+)
+
+var vmLabelHistoryColumns = []string{
+	vmLabelHistoryColVMID,
+	vmLabelHistoryColLabel,
+	vmLabelHistoryColAction,
+	vmLabelHistoryColSource,
+	vmLabelHistoryColActor,
+	vmLabelHistoryColAt,
+}
+
+type labelActorKey struct{}
+
+// WithLabelActor returns a context whose label changes are recorded in the label history
+// as made by actor. Without it, changes are recorded as manual with no actor.
+func WithLabelActor(ctx context.Context, actor models.LabelActor) context.Context {
+	return context.WithValue(ctx, labelActorKey{}, actor)
+}
+
+func labelActor(ctx context.Context) models.LabelActor {
+	if actor, ok := ctx.Value(labelActorKey{}).(models.LabelActor); ok {
+		return actor
+	}
+	return models.LabelActor{Source: models.LabelSourceManual}
+}
+
+// ListLabelHistory returns the label history of a VM, most recent change first.
+func (s *VMStore) ListLabelHistory(ctx context.Context, vmID string) ([]models.LabelChange, error) {
+	missing, err := s.validateVMsExist(ctx, []string{vmID})
+	if err != nil {
+		return nil, err
+	}
+	if len(missing) > 0 {
+		return nil, srvErrors.NewResourceNotFoundError("VM", vmID)
+	}
+
+	query, args, err := sq.Select(vmLabelHistoryColumns...).
+		From(vmLabelHistoryTable).
+		Where(sq.Eq{vmLabelHistoryColVMID: vmID}).
+		OrderBy(vmLabelHistoryColAt+" DESC", vmLabelHistoryColLabel+" ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building list label history query: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying label history: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	changes := []models.LabelChange{}
+	for rows.Next() {
+		var c models.LabelChange
+		if err := rows.Scan(&c.VMID, &c.Label, &c.Action, &c.Source, &c.Actor, &c.At); err != nil {
+			return nil, fmt.Errorf("scanning label change: %w", err)
+		}
+		changes = append(changes, c)
+	}
+	return changes, rows.Err()
+}
+
+// CopyLabelHistoryToAttached copies the label history into the attached database, for VMs
+// present in both. It goes with CopyLabelsToAttached, so that the history of a VM spans
+// collections.
+func (s *VMStore) CopyLabelHistoryToAttached(ctx context.Context, attachAlias string) error {
+	selectQuery := sq.Select(vmLabelHistoryColumns...).
+		From(vmLabelHistoryTable).
+		Where(sq.Expr(vmLabelHistoryColVMID + ` IN (SELECT "VM ID" FROM ` + attachAlias + `.vinfo)`))
+
+	query, args, err := sq.Insert(attachAlias + "." + vmLabelHistoryTable).
+		Columns(vmLabelHistoryColumns...).
+		Select(selectQuery).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+// recordLabelChanges records label as added to or removed from the given VMs, by the actor
+// of ctx. Only the VMs whose labels actually change are recorded, so it must run before the
+// change is made. Nil vmIDs stand for every VM.
+func (s *VMStore) recordLabelChanges(ctx context.Context, vmIDs []string, label string, action models.LabelChangeAction) error {
+	actor := labelActor(ctx)
+	selectQuery := sq.Select().
+		Column(`"VM ID"`).
+		Column("CAST(? AS VARCHAR)", label).
+		Column("CAST(? AS VARCHAR)", string(action)).
+		Column("CAST(? AS VARCHAR)", string(actor.Source)).
+		Column("CAST(? AS VARCHAR)", actor.Name).
+		Column("CAST(? AS TIMESTAMP)", time.Now()).
+		From("vinfo")
+	if vmIDs != nil {
+		selectQuery = selectQuery.Where(sq.Expr(`"VM ID" IN (SELECT unnest(?))`, vmIDs))
+	}
+	if action == models.LabelAdded {
+		selectQuery = selectQuery.Where(sq.Expr(`NOT list_contains(CAST("labels" AS VARCHAR[]), ?)`, label))
+	} else {
+		selectQuery = selectQuery.Where(sq.Expr(`list_contains(CAST("labels" AS VARCHAR[]), ?)`, label))
+	}
+
+	query, args, err := sq.Insert(vmLabelHistoryTable).
+		Columns(vmLabelHistoryColumns...).
+		Select(selectQuery).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building record label changes query: %w", err)
+	}
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("recording label changes: %w", err)
+	}
+	return nil
+}
+
+// recordLabelDiff records the changes that setting the labels of a VM to labels makes, by
+// the actor of ctx. It returns false when the VM does not exist.
+func (s *VMStore) recordLabelDiff(ctx context.Context, vmID string, labels []string) (bool, error) {
+	var current StringArray
+	err := s.db.QueryRowContext(ctx, `SELECT CAST("labels" AS VARCHAR[]) FROM vinfo WHERE "VM ID" = ?`, vmID).Scan(&current)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("querying labels of VM %s: %w", vmID, err)
+	}
+
+	var changes []models.LabelChange
+	for _, l := range labels {
+		if !slices.Contains(current, l) {
+			changes = append(changes, models.LabelChange{Label: l, Action: models.LabelAdded})
+		}
+	}
+	for _, l := range current {
+		if !slices.Contains(labels, l) {
+			changes = append(changes, models.LabelChange{Label: l, Action: models.LabelRemoved})
+		}
+	}
+	if len(changes) == 0 {
+		return true, nil
+	}
+
+	actor := labelActor(ctx)
+	now := time.Now()
+	builder := sq.Insert(vmLabelHistoryTable).Columns(vmLabelHistoryColumns...)
+	for _, c := range changes {
+		builder = builder.Values(vmID, c.Label, string(c.Action), string(actor.Source), actor.Name, now)
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("building record label changes query: %w", err)
+	}
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return false, fmt.Errorf("recording label changes: %w", err)
+	}
+	return true, nil
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
 	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
 	"github.com/kubev2v/assisted-migration-agent/test"
 )
 
@@ -746,4 +749,130 @@ var _ = Describe("VMStore Labels", func() {
 			Expect(affected).To(Equal(5))
 		})
 	})
+
+	Context("rule labels", func() {
+		labelsOf := func(id string) []string {
+			vms, err := s.VM().List(ctx, sq.Eq{`v."VM ID"`: id})
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			ExpectWithOffset(1, vms).To(HaveLen(1))
+			return vms[0].Labels
+		}
+
+		// Given VMs with and without labels
+		// When rule labels are applied and then removed
+		// Then the labels and their rule records should follow
+		It("should add and remove labels with the rule that applied them", func() {
+			// Arrange
+			insertVM("vm-1", "Test VM 1", "cluster-a")
+			insertVM("vm-2", "Test VM 2", "cluster-a")
+			Expect(s.VM().UpdateLabels(ctx, "vm-1", []string{"critical"})).To(Succeed())
+
+			// Act
+			err := s.VM().ApplyRuleLabels(ctx, []models.VMRuleLabel{
+				{VMID: "vm-1", Label: "legacy", Rule: "legacy-os"},
+				{VMID: "vm-2", Label: "legacy", Rule: "legacy-os"},
+				{VMID: "vm-missing", Label: "legacy", Rule: "legacy-os"},
+			}, nil)
+
+			// Assert
+			Expect(err).NotTo(HaveOccurred())
+			Expect(labelsOf("vm-1")).To(ConsistOf("critical", "legacy"))
+			Expect(labelsOf("vm-2")).To(Equal([]string{"legacy"}))
+			applied, err := s.VM().ListRuleLabels(ctx, "vm-1", "vm-2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(applied).To(Equal([]models.VMRuleLabel{
+				{VMID: "vm-1", Label: "legacy", Rule: "legacy-os"},
+				{VMID: "vm-2", Label: "legacy", Rule: "legacy-os"},
+			}))
+
+			// Act
+			err = s.VM().ApplyRuleLabels(ctx,
+				[]models.VMRuleLabel{{VMID: "vm-2", Label: "legacy", Rule: "windows"}},
+				[]models.VMRuleLabel{{VMID: "vm-1", Label: "legacy", Rule: "legacy-os"}})
+
+			// Assert
+			Expect(err).NotTo(HaveOccurred())
+			Expect(labelsOf("vm-1")).To(Equal([]string{"critical"}))
+			applied, err = s.VM().ListRuleLabels(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(applied).To(Equal([]models.VMRuleLabel{{VMID: "vm-2", Label: "legacy", Rule: "windows"}}))
+		})
+
+		// Given a label applied by a rule
+		// When the label is added by hand
+		// Then it should no longer be recorded as a rule label
+		It("should turn a rule label added by hand into a manual label", func() {
+			// Arrange
+			insertVM("vm-1", "Test VM 1", "cluster-a")
+			insertVM("vm-2", "Test VM 2", "cluster-a")
+			Expect(s.VM().ApplyRuleLabels(ctx, []models.VMRuleLabel{
+				{VMID: "vm-1", Label: "legacy", Rule: "legacy-os"},
+				{VMID: "vm-2", Label: "legacy", Rule: "legacy-os"},
+			}, nil)).To(Succeed())
+
+			// Act
+			Expect(s.VM().AddLabel(ctx, "vm-1", "legacy")).To(Succeed())
+
+			// Assert
+			applied, err := s.VM().ListRuleLabels(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(applied).To(Equal([]models.VMRuleLabel{{VMID: "vm-2", Label: "legacy", Rule: "legacy-os"}}))
+			Expect(labelsOf("vm-1")).To(Equal([]string{"legacy"}))
+		})
+	})
+
+	Context("label history", func() {
+		historyOf := func(id string) []models.LabelChange {
+			changes, err := s.VM().ListLabelHistory(ctx, id)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			for i := range changes {
+				ExpectWithOffset(1, changes[i].At).NotTo(BeZero())
+				changes[i].At = time.Time{}
+			}
+			return changes
+		}
+
+		// Given a VM
+		// When labels are added and removed by hand, in batch and by a rule
+		// Then every actual change should be recorded with its source and actor, newest first
+		It("should record label changes", func() {
+			// Arrange
+			insertVM("vm-1", "Test VM 1", "cluster-a")
+			insertVM("vm-2", "Test VM 2", "cluster-a")
+			alice := store.WithLabelActor(ctx, models.LabelActor{Source: models.LabelSourceManual, Name: "alice"})
+
+			// Act
+			Expect(s.VM().AddLabel(alice, "vm-1", "critical")).To(Succeed())
+			Expect(s.VM().AddLabel(alice, "vm-1", "critical")).To(Succeed())
+			Expect(s.VM().AddLabelBatch(ctx, []string{"vm-1", "vm-2"}, "wave-1")).To(Succeed())
+			Expect(s.VM().ApplyRuleLabels(ctx, []models.VMRuleLabel{{VMID: "vm-1", Label: "legacy", Rule: "legacy-os"}}, nil)).To(Succeed())
+			Expect(s.VM().UpdateLabels(alice, "vm-1", []string{"critical", "legacy", "owner-bob"})).To(Succeed())
+			_, err := s.VM().RemoveLabelGlobally(ctx, "critical")
+			Expect(err).NotTo(HaveOccurred())
+
+			// Assert
+			Expect(historyOf("vm-1")).To(Equal([]models.LabelChange{
+				{VMID: "vm-1", Label: "critical", Action: models.LabelRemoved, Source: models.LabelSourceManual},
+				{VMID: "vm-1", Label: "owner-bob", Action: models.LabelAdded, Source: models.LabelSourceManual, Actor: "alice"},
+				{VMID: "vm-1", Label: "wave-1", Action: models.LabelRemoved, Source: models.LabelSourceManual, Actor: "alice"},
+				{VMID: "vm-1", Label: "legacy", Action: models.LabelAdded, Source: models.LabelSourceRule, Actor: "legacy-os"},
+				{VMID: "vm-1", Label: "wave-1", Action: models.LabelAdded, Source: models.LabelSourceManual},
+				{VMID: "vm-1", Label: "critical", Action: models.LabelAdded, Source: models.LabelSourceManual, Actor: "alice"},
+			}))
+			Expect(historyOf("vm-2")).To(Equal([]models.LabelChange{
+				{VMID: "vm-2", Label: "wave-1", Action: models.LabelAdded, Source: models.LabelSourceManual},
+			}))
+		})
+
+		// Given no VM with the requested ID
+		// When its label history is listed
+		// Then a not found error should be returned
+		It("should return not found for a missing VM", func() {
+			// Act
+			_, err := s.VM().ListLabelHistory(ctx, "vm-missing")
+
+			// Assert
+			Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+		})
+	})
 })
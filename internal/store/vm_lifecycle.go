@@ -0,0 +1,135 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+)
+
+const (
+	vmLifecycleTable = "vm_lifecycle"
+	vmSnapshotsTable = "vm_snapshots"
+
+	vmPoweredOffTable     = "vm_powered_off"
+	vmPoweredOffColVMID   = "vm_id"
+	vmPoweredOffColSince  = "since"
+	poweredOffVMsSubquery = `SELECT "VM ID" FROM %s WHERE "Powerstate" = 'poweredOff'`
+
+	// lifecycleBatchSize bounds the number of rows of a single insert.
+	lifecycleBatchSize = 500
+)
+
+// SaveLifecycles replaces the lifecycle timestamps and snapshots of the given VMs.
+// Callers should run it inside a transaction.
+func (s *VMStore) SaveLifecycles(ctx context.Context, lifecycles []models.VMLifecycle) error {
+	if len(lifecycles) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(lifecycles))
+	for _, l := range lifecycles {
+		ids = append(ids, l.VMID)
+	}
+	for _, table := range []string{vmLifecycleTable, vmSnapshotsTable} {
+		query, args, err := sq.Delete(table).Where(sq.Eq{"vm_id": ids}).ToSql()
+		if err != nil {
+			return fmt.Errorf("building delete %s query: %w", table, err)
+		}
+		if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("clearing %s: %w", table, err)
+		}
+	}
+
+	for batch := range slices.Chunk(lifecycles, lifecycleBatchSize) {
+		builder := sq.Insert(vmLifecycleTable).Columns("vm_id", "created_at", "booted_at")
+		for _, l := range batch {
+			builder = builder.Values(l.VMID, l.CreatedAt, l.BootedAt)
+		}
+		query, args, err := builder.ToSql()
+		if err != nil {
+			return fmt.Errorf("building insert vm_lifecycle query: %w", err)
+		}
+		if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("inserting vm_lifecycle: %w", err)
+		}
+	}
+
+	var snapshots [][]any
+	for _, l := range lifecycles {
+		for _, snap := range l.Snapshots {
+			snapshots = append(snapshots, []any{l.VMID, snap.ID, snap.Name, snap.CreatedAt})
+		}
+	}
+	for batch := range slices.Chunk(snapshots, lifecycleBatchSize) {
+		builder := sq.Insert(vmSnapshotsTable).Columns("vm_id", "snapshot_id", "name", "created_at")
+		for _, values := range batch {
+			builder = builder.Values(values...)
+		}
+		query, args, err := builder.ToSql()
+		if err != nil {
+			return fmt.Errorf("building insert vm_snapshots query: %w", err)
+		}
+		if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("inserting vm_snapshots: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// TrackPoweredOff records now as the power-off time of the VMs powered off without one, which
+// this collection is the first to see powered off, and forgets the VMs powered on again or
+// gone. Callers should run it inside a transaction.
+func (s *VMStore) TrackPoweredOff(ctx context.Context, now time.Time) error {
+	poweredOff := fmt.Sprintf(poweredOffVMsSubquery, "vinfo")
+
+	query, args, err := sq.Delete(vmPoweredOffTable).
+		Where(sq.Expr(vmPoweredOffColVMID + " NOT IN (" + poweredOff + ")")).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete %s query: %w", vmPoweredOffTable, err)
+	}
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("clearing %s: %w", vmPoweredOffTable, err)
+	}
+
+	selectQuery := sq.Select(`"VM ID"`).
+		Column("CAST(? AS TIMESTAMP)", now.UTC()).
+		From("vinfo").
+		Where(`"Powerstate" = 'poweredOff'`).
+		Where(sq.Expr(`"VM ID" NOT IN (SELECT ` + vmPoweredOffColVMID + ` FROM ` + vmPoweredOffTable + `)`))
+	query, args, err = sq.Insert(vmPoweredOffTable).
+		Columns(vmPoweredOffColVMID, vmPoweredOffColSince).
+		Select(selectQuery).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert %s query: %w", vmPoweredOffTable, err)
+	}
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("inserting %s: %w", vmPoweredOffTable, err)
+	}
+	return nil
+}
+
+// CopyPoweredOffToAttached copies the power-off times of the VMs still powered off in the
+// attached schema, so that they keep the collection that first saw them powered off.
+func (s *VMStore) CopyPoweredOffToAttached(ctx context.Context, attachAlias string) error {
+	selectQuery := sq.Select(vmPoweredOffColVMID, vmPoweredOffColSince).
+		From(vmPoweredOffTable).
+		Where(sq.Expr(vmPoweredOffColVMID + " IN (" + fmt.Sprintf(poweredOffVMsSubquery, attachAlias+".vinfo") + ")"))
+
+	query, args, err := sq.Insert(attachAlias+"."+vmPoweredOffTable).
+		Columns(vmPoweredOffColVMID, vmPoweredOffColSince).
+		Select(selectQuery).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, query, args...)
+	return err
+}
@@ -0,0 +1,186 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+)
+
+const (
+	vmExclusionsTable = "vm_migration_exclusions"
+
+	vmExclusionsColVMID          = "vm_id"
+	vmExclusionsColReason        = "reason"
+	vmExclusionsColJustification = "justification"
+	vmExclusionsColExcludedBy    = "excluded_by"
+	vmExclusionsColExcludedAt    = "excluded_at"
+	vmExclusionsColExpiresAt     = "expires_at"
+)
+
+var vmExclusionsColumns = []string{
+	vmExclusionsColVMID,
+	vmExclusionsColReason,
+	vmExclusionsColJustification,
+	vmExclusionsColExcludedBy,
+	vmExclusionsColExcludedAt,
+	vmExclusionsColExpiresAt,
+}
+
+// ListMigrationExclusions returns the details of the migration exclusions, ordered by VM.
+// When vmIDs are given, only the exclusions of those VMs are returned.
+func (s *VMStore) ListMigrationExclusions(ctx context.Context, vmIDs ...string) ([]models.MigrationExclusion, error) {
+	builder := sq.Select(vmExclusionsColumns...).
+		From(vmExclusionsTable).
+		OrderBy(vmExclusionsColVMID)
+	if len(vmIDs) > 0 {
+		builder = builder.Where(sq.Eq{vmExclusionsColVMID: vmIDs})
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building list migration exclusions query: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying migration exclusions: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	exclusions := []models.MigrationExclusion{}
+	for rows.Next() {
+		var e models.MigrationExclusion
+		if err := rows.Scan(&e.VMID, &e.Reason, &e.Justification, &e.ExcludedBy, &e.ExcludedAt, &e.ExpiresAt); err != nil {
+			return nil, fmt.Errorf("scanning migration exclusion: %w", err)
+		}
+		exclusions = append(exclusions, e)
+	}
+	return exclusions, rows.Err()
+}
+
+// SetMigrationExclusion records the details of the migration exclusion of vmIDs, replacing
+// the ones already recorded. e.VMID is ignored and missing VMs are skipped. It does not
+// set the migration_excluded flag: callers should run it inside a transaction along with
+// UpdateMigrationExcludedBatch.
+func (s *VMStore) SetMigrationExclusion(ctx context.Context, vmIDs []string, e models.MigrationExclusion) error {
+	if len(vmIDs) == 0 {
+		return nil
+	}
+
+	var expiresAt any
+	if e.ExpiresAt != nil {
+		expiresAt = *e.ExpiresAt
+	}
+
+	selectQuery := sq.Select().
+		Column(`"VM ID"`).
+		Column("CAST(? AS VARCHAR)", string(e.Reason)).
+		Column("CAST(? AS VARCHAR)", e.Justification).
+		Column("CAST(? AS VARCHAR)", e.ExcludedBy).
+		Column("CAST(? AS TIMESTAMP)", e.ExcludedAt).
+		Column("CAST(? AS TIMESTAMP)", expiresAt).
+		From("vinfo").
+		Where(sq.Expr(`"VM ID" IN (SELECT unnest(?))`, deduplicateVMIDs(vmIDs)))
+
+	query, args, err := sq.Insert(vmExclusionsTable).
+		Columns(vmExclusionsColumns...).
+		Select(selectQuery).
+		Suffix(fmt.Sprintf("ON CONFLICT (%[1]s) DO UPDATE SET %[2]s = EXCLUDED.%[2]s, %[3]s = EXCLUDED.%[3]s, %[4]s = EXCLUDED.%[4]s, %[5]s = EXCLUDED.%[5]s, %[6]s = EXCLUDED.%[6]s",
+			vmExclusionsColVMID, vmExclusionsColReason, vmExclusionsColJustification,
+			vmExclusionsColExcludedBy, vmExclusionsColExcludedAt, vmExclusionsColExpiresAt)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building set migration exclusion query: %w", err)
+	}
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("setting migration exclusion: %w", err)
+	}
+	return nil
+}
+
+// LiftExpiredMigrationExclusions clears the migration_excluded flag and the exclusion details
+// of the VMs whose exclusion expired at now, and returns their IDs.
+func (s *VMStore) LiftExpiredMigrationExclusions(ctx context.Context, now time.Time) ([]string, error) {
+	query, args, err := sq.Select(vmExclusionsColVMID).
+		From(vmExclusionsTable).
+		Where(sq.LtOrEq{vmExclusionsColExpiresAt: now}).
+		OrderBy(vmExclusionsColVMID).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building expired migration exclusions query: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying expired migration exclusions: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var vmIDs []string
+	for rows.Next() {
+		var vmID string
+		if err := rows.Scan(&vmID); err != nil {
+			return nil, fmt.Errorf("scanning expired migration exclusion: %w", err)
+		}
+		vmIDs = append(vmIDs, vmID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(vmIDs) == 0 {
+		return nil, nil
+	}
+
+	query, args, err = sq.Update("vinfo").
+		Set(`"migration_excluded"`, false).
+		Where(sq.Expr(`"VM ID" IN (SELECT unnest(?))`, vmIDs)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building lift migration exclusions query: %w", err)
+	}
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return nil, fmt.Errorf("lifting migration exclusions: %w", err)
+	}
+
+	if err := s.forgetMigrationExclusions(ctx, vmIDs); err != nil {
+		return nil, err
+	}
+	return vmIDs, nil
+}
+
+// copyMigrationExclusionsToAttached copies the details of the migration exclusions that
+// have not expired at now into the attached schema. VMs absent from the attached schema
+// are skipped.
+func (s *VMStore) copyMigrationExclusionsToAttached(ctx context.Context, attachAlias string, now time.Time) error {
+	selectQuery := sq.Select(vmExclusionsColumns...).
+		From(vmExclusionsTable).
+		Where(sq.Expr(vmExclusionsColVMID + ` IN (SELECT "VM ID" FROM ` + attachAlias + `.vinfo)`)).
+		Where(sq.Or{sq.Eq{vmExclusionsColExpiresAt: nil}, sq.Gt{vmExclusionsColExpiresAt: now}})
+
+	query, args, err := sq.Insert(attachAlias + "." + vmExclusionsTable).
+		Columns(vmExclusionsColumns...).
+		Select(selectQuery).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+// forgetMigrationExclusions drops the exclusion details of the given VMs.
+func (s *VMStore) forgetMigrationExclusions(ctx context.Context, vmIDs []string) error {
+	query, args, err := sq.Delete(vmExclusionsTable).
+		Where(sq.Expr(vmExclusionsColVMID+" IN (SELECT unnest(?))", vmIDs)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete migration exclusions query: %w", err)
+	}
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("deleting migration exclusions: %w", err)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package store_test
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	. "github.com/onsi/ginkgo/v2"
@@ -351,6 +352,72 @@ var _ = Describe("VMStore Migration Exclusion", func() {
 			Expect(count).To(Equal(2))
 		})
 	})
+
+	Context("exclusion details", func() {
+		BeforeEach(func() {
+			insertVM("vm-1", "VM 1", "cluster-a")
+			insertVM("vm-2", "VM 2", "cluster-b")
+		})
+
+		It("records the details, filters and exports them, and drops them when the VM is included", func() {
+			expires := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+			Expect(s.VM().UpdateMigrationExcludedBatch(ctx, []string{"vm-1", "vm-2"}, true)).To(Succeed())
+			Expect(s.VM().SetMigrationExclusion(ctx, []string{"vm-1", "vm-2", "vm-missing"}, models.MigrationExclusion{
+				Reason:        models.ExclusionReasonLicense,
+				Justification: "licensed per socket",
+				ExcludedBy:    "alice",
+				ExcludedAt:    time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC),
+				ExpiresAt:     &expires,
+			})).To(Succeed())
+			Expect(s.VM().SetMigrationExclusion(ctx, []string{"vm-2"}, models.MigrationExclusion{
+				Reason:     models.ExclusionReasonOther,
+				ExcludedAt: time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC),
+			})).To(Succeed())
+
+			exclusions, err := s.VM().ListMigrationExclusions(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exclusions).To(HaveLen(2))
+			Expect(exclusions[0].VMID).To(Equal("vm-1"))
+			Expect(exclusions[0].Reason).To(Equal(models.ExclusionReasonLicense))
+			Expect(exclusions[0].Justification).To(Equal("licensed per socket"))
+			Expect(exclusions[0].ExcludedBy).To(Equal("alice"))
+			Expect(exclusions[0].ExpiresAt).NotTo(BeNil())
+			Expect(exclusions[0].ExpiresAt.Equal(expires)).To(BeTrue())
+			Expect(exclusions[1].Reason).To(Equal(models.ExclusionReasonOther))
+			Expect(exclusions[1].ExpiresAt).To(BeNil())
+
+			vms, err := s.VM().List(ctx, store.ByFilter("exclusion.reason = 'license' and exclusion.expires_at < 2028-01-01"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vms).To(HaveLen(1))
+			Expect(vms[0].ID).To(Equal("vm-1"))
+
+			Expect(s.VM().UpdateMigrationExcluded(ctx, "vm-1", false)).To(Succeed())
+			exclusions, err = s.VM().ListMigrationExclusions(ctx, "vm-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exclusions).To(BeEmpty())
+		})
+
+		It("lifts the exclusions that expired", func() {
+			now := time.Now()
+			expired := now.Add(-time.Second)
+			Expect(s.VM().UpdateMigrationExcludedBatch(ctx, []string{"vm-1", "vm-2"}, true)).To(Succeed())
+			Expect(s.VM().SetMigrationExclusion(ctx, []string{"vm-1"}, models.MigrationExclusion{
+				Reason: models.ExclusionReasonDecommission, ExcludedAt: now, ExpiresAt: &expired,
+			})).To(Succeed())
+
+			lifted, err := s.VM().LiftExpiredMigrationExclusions(ctx, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lifted).To(Equal([]string{"vm-1"}))
+
+			states, err := s.VM().GetMigrationExcludedStates(ctx, []string{"vm-1", "vm-2"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(states).To(Equal(map[string]bool{"vm-1": false, "vm-2": true}))
+
+			lifted, err = s.VM().LiftExpiredMigrationExclusions(ctx, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lifted).To(BeEmpty())
+		})
+	})
 })
 
 // Helper function to find a VM by ID in a slice
@@ -0,0 +1,253 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	duckdb_models "github.com/kubev2v/migration-planner/pkg/duckdb_parser/models"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+)
+
+// maxPatchNetworks is the number of "Network #N" columns of vinfo.
+const maxPatchNetworks = 8
+
+// vmDetailTables are the per-VM tables rebuilt from scratch when a VM is patched.
+var vmDetailTables = []string{"vcpu", "vmemory", "vdisk", "vnetwork"}
+
+// ApplyPatches writes the state of changed VMs into the collection. Existing VMs keep the
+// columns a patch does not carry (folder, labels, exclusion flag, ...); new VMs are inserted.
+// Host, cluster and datacenter names are resolved from vhost, so a VM moved to a host that
+// is unknown to the collection keeps its previous placement until the next full collection.
+// Callers should run it inside a transaction.
+func (s *VMStore) ApplyPatches(ctx context.Context, patches []models.VMPatch) error {
+	for _, p := range patches {
+		if err := s.applyPatch(ctx, p); err != nil {
+			return fmt.Errorf("patching VM %s: %w", p.ID, err)
+		}
+	}
+	return nil
+}
+
+func (s *VMStore) applyPatch(ctx context.Context, p models.VMPatch) error {
+	var totalDisk int64
+	for _, d := range p.Disks {
+		totalDisk += d.CapacityMiB
+	}
+
+	cols := []string{
+		`"VM"`, `"Powerstate"`, `"Template"`, `"CPUs"`, `"Memory"`, `"SMBIOS UUID"`, `"VM UUID"`,
+		`"Firmware"`, `"HW version"`, `"OS according to the configuration file"`,
+		`"OS according to the VMware Tools"`, `"DNS Name"`, `"Primary IP Address"`, `"CBT"`,
+		`"Provisioned MiB"`, `"In Use MiB"`, `"Total disk capacity MiB"`,
+	}
+	vals := []any{
+		p.Name, p.PowerState, p.Template, p.CPUs, p.MemoryMiB, p.UUID, p.InstanceUUID,
+		p.Firmware, p.HWVersion, p.GuestOS,
+		p.ToolsGuestOS, p.DNSName, p.IPAddress, p.CBT,
+		p.ProvisionedMiB, p.InUseMiB, totalDisk,
+	}
+	for i := range maxPatchNetworks {
+		cols = append(cols, fmt.Sprintf(`"Network #%d"`, i+1))
+		var network any
+		if i < len(p.NICs) {
+			network = p.NICs[i].Network
+		}
+		vals = append(vals, network)
+	}
+
+	update := sq.Update("vinfo").Where(sq.Eq{`"VM ID"`: p.ID})
+	for i, col := range cols {
+		update = update.Set(col, vals[i])
+	}
+	query, args, err := update.ToSql()
+	if err != nil {
+		return fmt.Errorf("building update vinfo query: %w", err)
+	}
+	res, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("updating vinfo: %w", err)
+	}
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if updated == 0 {
+		query, args, err = sq.Insert("vinfo").
+			Columns(append([]string{`"VM ID"`}, cols...)...).
+			Values(append([]any{p.ID}, vals...)...).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("building insert vinfo query: %w", err)
+		}
+		if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("inserting vinfo: %w", err)
+		}
+	}
+
+	if p.HostID != "" {
+		query, args, err = sq.Update("vinfo").
+			Set(`"Host"`, sq.Expr(`h."Host"`)).
+			Set(`"Cluster"`, sq.Expr(`h."Cluster"`)).
+			Set(`"Datacenter"`, sq.Expr(`h."Datacenter"`)).
+			From("vhost h").
+			Where(sq.Eq{`h."Object ID"`: p.HostID}).
+			Where(sq.Eq{`vinfo."VM ID"`: p.ID}).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("building host placement query: %w", err)
+		}
+		if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("updating host placement: %w", err)
+		}
+	}
+
+	for _, table := range vmDetailTables {
+		query, args, err = sq.Delete(table).Where(sq.Eq{`"VM ID"`: p.ID}).ToSql()
+		if err != nil {
+			return fmt.Errorf("building delete %s query: %w", table, err)
+		}
+		if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("clearing %s: %w", table, err)
+		}
+	}
+
+	sockets := p.CPUs
+	if p.CoresPerSocket > 0 {
+		sockets = p.CPUs / p.CoresPerSocket
+	}
+	query, args, err = sq.Insert("vcpu").
+		Columns(`"VM ID"`, `"Hot Add"`, `"Hot Remove"`, `"Sockets"`, `"Cores p/s"`).
+		Values(p.ID, p.CPUHotAdd, p.CPUHotRemove, sockets, p.CoresPerSocket).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert vcpu query: %w", err)
+	}
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("inserting vcpu: %w", err)
+	}
+
+	query, args, err = sq.Insert("vmemory").
+		Columns(`"VM ID"`, `"Hot Add"`).
+		Values(p.ID, p.MemoryHotAdd).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert vmemory query: %w", err)
+	}
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("inserting vmemory: %w", err)
+	}
+
+	cluster := sq.Expr(`(SELECT "Cluster" FROM vinfo WHERE "VM ID" = ?)`, p.ID)
+
+	if len(p.Disks) > 0 {
+		disks := sq.Insert("vdisk").Columns(
+			`"VM ID"`, `"Disk Key"`, `"Unit #"`, `"Path"`, `"Disk Path"`, `"Capacity MiB"`, `"Sharing mode"`,
+			`"Raw"`, `"Disk Mode"`, `"Disk UUID"`, `"Thin"`, `"Controller"`, `"Label"`, `"Cluster"`,
+		)
+		for _, d := range p.Disks {
+			disks = disks.Values(p.ID, d.Key, d.UnitNumber, d.File, d.File, d.CapacityMiB, d.Sharing,
+				d.RDM, d.Mode, d.UUID, d.Thin, d.Controller, d.Label, cluster)
+		}
+		query, args, err = disks.ToSql()
+		if err != nil {
+			return fmt.Errorf("building insert vdisk query: %w", err)
+		}
+		if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("inserting vdisk: %w", err)
+		}
+	}
+
+	if p.Lifecycle != nil {
+		if err := s.SaveLifecycles(ctx, []models.VMLifecycle{*p.Lifecycle}); err != nil {
+			return err
+		}
+	}
+
+	if len(p.NICs) > 0 {
+		nics := sq.Insert("vnetwork").Columns(
+			`"VM ID"`, `"Network"`, `"Mac Address"`, `"NIC label"`, `"Adapter"`, `"Connected"`,
+			`"Starts Connected"`, `"IPv4 Address"`, `"IPv6 Address"`, `"Cluster"`,
+		)
+		for _, n := range p.NICs {
+			nics = nics.Values(p.ID, n.Network, n.MAC, n.Label, n.Adapter, n.Connected,
+				n.StartConnected, n.IPv4, n.IPv6, cluster)
+		}
+		query, args, err = nics.ToSql()
+		if err != nil {
+			return fmt.Errorf("building insert vnetwork query: %w", err)
+		}
+		if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("inserting vnetwork: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// DeleteVMs removes VMs and every row that references them from the collection.
+// It must not run inside a transaction: DuckDB checks foreign keys against committed
+// data, so deleting vm_inspection_concerns and then vinfo rows in one transaction fails.
+func (s *VMStore) DeleteVMs(ctx context.Context, vmIDs []string) error {
+	if len(vmIDs) == 0 {
+		return nil
+	}
+
+	// Referencing tables first: vm_inspection_concerns has a foreign key on vinfo.
+	tables := []struct{ name, column string }{
+		{"vm_inspection_concerns", `"VM ID"`},
+		{"vm_inspection_status", `"VM ID"`},
+		{"vm_applications", "vm_id"},
+		{"vm_lifecycle", "vm_id"},
+		{"vm_snapshots", "vm_id"},
+		{"vm_rule_labels", "vm_id"},
+		{"vm_label_history", "vm_id"},
+		{"vm_migration_exclusions", "vm_id"},
+		{"concerns", `"VM_ID"`},
+		{"vcpu", `"VM ID"`},
+		{"vmemory", `"VM ID"`},
+		{"vdisk", `"VM ID"`},
+		{"vnetwork", `"VM ID"`},
+		{"vinfo", `"VM ID"`},
+	}
+	for _, t := range tables {
+		query, args, err := sq.Delete(t.name).Where(sq.Eq{t.column: vmIDs}).ToSql()
+		if err != nil {
+			return fmt.Errorf("building delete %s query: %w", t.name, err)
+		}
+		if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("deleting from %s: %w", t.name, err)
+		}
+	}
+	return nil
+}
+
+// ReplaceConcerns replaces the validation concerns of a VM. Callers should run it inside a
+// transaction.
+func (s *VMStore) ReplaceConcerns(ctx context.Context, vmID string, concerns []duckdb_models.Concern) error {
+	query, args, err := sq.Delete("concerns").Where(sq.Eq{`"VM_ID"`: vmID}).ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete concerns query: %w", err)
+	}
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("deleting concerns of VM %s: %w", vmID, err)
+	}
+	if len(concerns) == 0 {
+		return nil
+	}
+
+	insert := sq.Insert("concerns").Columns(`"VM_ID"`, `"Concern_ID"`, `"Label"`, `"Category"`, `"Assessment"`)
+	for _, c := range concerns {
+		insert = insert.Values(vmID, c.Id, c.Label, c.Category, c.Assessment)
+	}
+	query, args, err = insert.ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert concerns query: %w", err)
+	}
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("inserting concerns of VM %s: %w", vmID, err)
+	}
+	return nil
+}
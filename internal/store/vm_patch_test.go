@@ -0,0 +1,139 @@
+package store_test
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	"github.com/kubev2v/assisted-migration-agent/test"
+)
+
+var _ = Describe("VMStore patches", func() {
+	var (
+		ctx context.Context
+		s   *store.Store
+		db  *sql.DB
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		var err error
+		db, err = store.NewConnection(nil, ":memory:")
+		Expect(err).NotTo(HaveOccurred())
+
+		s = store.NewStore(db, test.NewMockValidator())
+		Expect(s.InitCollection(ctx)).To(Succeed())
+
+		_, err = db.ExecContext(ctx, `
+			INSERT INTO vhost ("Object ID", "Host", "Cluster", "Datacenter")
+			VALUES ('host-1', 'esx-1', 'cluster-a', 'dc-1'), ('host-2', 'esx-2', 'cluster-b', 'dc-1')
+		`)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = db.ExecContext(ctx, `
+			INSERT INTO vinfo ("VM ID", "VM", "Powerstate", "Cluster", "Host", "Folder", "Memory", "CPUs", "Template")
+			VALUES ('vm-1', 'web-1', 'poweredOn', 'cluster-a', 'esx-1', 'prod', 4096, 2, false)
+		`)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = db.ExecContext(ctx, `
+			INSERT INTO vdisk ("VM ID", "Disk Key", "Capacity MiB") VALUES ('vm-1', 2000, 10240), ('vm-1', 2001, 20480)
+		`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(s.VM().UpdateLabels(ctx, "vm-1", []string{"production"})).To(Succeed())
+	})
+
+	AfterEach(func() {
+		if db != nil {
+			_ = db.Close()
+		}
+	})
+
+	// Given a VM of the collection that was reconfigured and moved in vCenter
+	// When its patch is applied
+	// Then patched columns, placement and disks are replaced while user data is kept
+	It("should update an existing VM in place", func() {
+		// Arrange
+		_, err := db.ExecContext(ctx, `INSERT INTO vm_inspection_concerns ("VM ID", inspection_id, category) VALUES ('vm-1', 1, 'Warning')`)
+		Expect(err).NotTo(HaveOccurred())
+		patch := models.VMPatch{
+			ID: "vm-1", Name: "web-1", PowerState: "poweredOff", HostID: "host-2",
+			CPUs: 4, CoresPerSocket: 2, MemoryMiB: 8192,
+			Disks: []models.VMPatchDisk{{Key: 2000, File: "[ds1] web-1/web-1.vmdk", CapacityMiB: 40960, Thin: true}},
+			NICs:  []models.VMPatchNIC{{Label: "Network adapter 1", Network: "VM Network", MAC: "00:50:56:aa:bb:cc"}},
+			Lifecycle: &models.VMLifecycle{VMID: "vm-1", Snapshots: []models.VMSnapshot{
+				{ID: "1", Name: "before-upgrade", CreatedAt: time.Now()},
+			}},
+		}
+
+		// Act
+		Expect(s.WithTx(ctx, func(txCtx context.Context) error {
+			return s.VM().ApplyPatches(txCtx, []models.VMPatch{patch})
+		})).To(Succeed())
+
+		// Assert
+		vm, err := s.VM().Get(ctx, "vm-1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vm.PowerState).To(Equal("poweredOff"))
+		Expect(vm.CpuCount).To(BeEquivalentTo(4))
+		Expect(vm.MemoryMB).To(BeEquivalentTo(8192))
+		Expect(vm.Cluster).To(Equal("cluster-b"))
+		Expect(vm.Host).To(Equal("esx-2"))
+		Expect(vm.Folder).To(Equal("prod"))
+
+		var disks, capacity int64
+		Expect(db.QueryRowContext(ctx, `SELECT COUNT(*), SUM("Capacity MiB") FROM vdisk WHERE "VM ID" = 'vm-1'`).Scan(&disks, &capacity)).To(Succeed())
+		Expect(disks).To(BeEquivalentTo(1))
+		Expect(capacity).To(BeEquivalentTo(40960))
+
+		var snapshots int
+		Expect(db.QueryRowContext(ctx, `SELECT COUNT(*) FROM vm_snapshots WHERE vm_id = 'vm-1'`).Scan(&snapshots)).To(Succeed())
+		Expect(snapshots).To(Equal(1))
+
+		var labels string
+		Expect(db.QueryRowContext(ctx, `SELECT CAST("labels" AS VARCHAR) FROM vinfo WHERE "VM ID" = 'vm-1'`).Scan(&labels)).To(Succeed())
+		Expect(labels).To(ContainSubstring("production"))
+	})
+
+	// Given a VM that does not exist in the collection
+	// When its patch is applied
+	// Then it is inserted with its placement resolved from vhost
+	It("should insert a new VM", func() {
+		// Act
+		Expect(s.VM().ApplyPatches(ctx, []models.VMPatch{{
+			ID: "vm-2", Name: "db-1", PowerState: "poweredOn", HostID: "host-1", CPUs: 2, CoresPerSocket: 1, MemoryMiB: 2048,
+		}})).To(Succeed())
+
+		// Assert
+		vm, err := s.VM().Get(ctx, "vm-2")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vm.Name).To(Equal("db-1"))
+		Expect(vm.Cluster).To(Equal("cluster-a"))
+		Expect(vm.Datacenter).To(Equal("dc-1"))
+	})
+
+	// Given a VM removed from vCenter
+	// When it is deleted from the collection
+	// Then the VM and its detail rows are gone
+	It("should delete a VM and its detail rows", func() {
+		// Arrange
+		_, err := db.ExecContext(ctx, `INSERT INTO vm_inspection_concerns ("VM ID", inspection_id, category) VALUES ('vm-1', 1, 'Warning')`)
+		Expect(err).NotTo(HaveOccurred())
+
+		// Act
+		Expect(s.VM().DeleteVMs(ctx, []string{"vm-1"})).To(Succeed())
+
+		// Assert
+		count, err := s.VM().Count(ctx, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(BeZero())
+
+		var disks int
+		Expect(db.QueryRowContext(ctx, `SELECT COUNT(*) FROM vdisk`).Scan(&disks)).To(Succeed())
+		Expect(disks).To(BeZero())
+	})
+})
@@ -1,6 +1,10 @@
 package store
 
-import sq "github.com/Masterminds/squirrel"
+import (
+	sq "github.com/Masterminds/squirrel"
+
+	vmfilter "github.com/kubev2v/assisted-migration-agent/internal/filter"
+)
 
 // vmGetQuery fetches a single VM by ID with full details (disks, NICs, concerns)
 // plus utilization data from the latest rightsizing report.
@@ -206,39 +210,8 @@ var vmOutputQuery = sq.Select(
 		GROUP BY u.vm_id
 	) g ON v."VM ID" = g.vm_id`)
 
-// vmFilterSubquery is the base flat JOIN query for filtering.
+// vmFilterSubquery is the base flat JOIN query for filtering, over vmfilter.VMScope.
 // It joins all tables so WHERE clauses can reference any raw column.
 // Filters should be applied via Where clauses, then use the result to get DISTINCT VM IDs.
-var vmFilterSubquery = sq.Select(`DISTINCT v."VM ID"`).
-	From("vinfo v").
-	LeftJoin(`vdisk dk ON v."VM ID" = dk."VM ID"`).
-	LeftJoin(`concerns c ON v."VM ID" = c."VM_ID"`).
-	LeftJoin(`vm_inspection_status i ON v."VM ID" = i."VM ID"`).
-	LeftJoin(`vcpu cpu ON v."VM ID" = cpu."VM ID"`).
-	LeftJoin(`vmemory mem ON v."VM ID" = mem."VM ID"`).
-	LeftJoin(`vnetwork net ON v."VM ID" = net."VM ID"`).
-	LeftJoin(`(SELECT "VM_ID", COUNT(*) AS issues_count FROM concerns GROUP BY "VM_ID") cc ON v."VM ID" = cc."VM_ID"`).
-	LeftJoin(`(SELECT "VM_ID", COUNT(*) AS critical_count FROM concerns WHERE "Category" = 'Critical' GROUP BY "VM_ID") crit ON v."VM ID" = crit."VM_ID"`).
-	LeftJoin(`(SELECT "VM ID", SUM("Capacity MiB") AS total_disk FROM vdisk GROUP BY "VM ID") d ON v."VM ID" = d."VM ID"`).
-	LeftJoin(`vdatastore ds ON ds."Name" = regexp_extract(COALESCE(dk."Path", dk."Disk Path"), '\[([^\]]+)\]', 1)`).
-	LeftJoin(`vm_inspection_concerns ic ON v."VM ID" = ic."VM ID" AND ic.inspection_id = (SELECT MAX(inspection_id) FROM vm_inspection_concerns imx WHERE imx."VM ID" = v."VM ID")`).
-	LeftJoin(`(
-SELECT moid, vm_name,
-       provisioned_cpus, provisioned_memory_mb, provisioned_disk_kb,
-       cpu_avg_pct, cpu_p95_pct, cpu_max_pct, cpu_latest_pct,
-       mem_avg_pct, mem_p95_pct, mem_max_pct, mem_latest_pct,
-       disk_pct, confidence_pct
-FROM rightsizing_vm_utilization
-WHERE report_id = (
-      SELECT id FROM rightsizing_reports
-      WHERE written_batch_count > 0
-      ORDER BY created_at DESC LIMIT 1
-  )) as utilization ON v."VM ID" = utilization.moid`).
-	LeftJoin(`vm_applications va ON v."VM ID" = va.vm_id`).
-	LeftJoin(`(
-		SELECT u.vm_id, ARRAY_AGG(DISTINCT grp.name) AS groups
-		FROM group_matches gm
-		JOIN groups grp ON gm.group_id = grp.id
-		, UNNEST(gm.vm_ids) AS u(vm_id)
-		GROUP BY u.vm_id
-	) g ON v."VM ID" = g.vm_id`)
+var vmFilterSubquery = sq.Select("DISTINCT " + vmfilter.VMScope.Key).
+	From(vmfilter.VMScope.From)
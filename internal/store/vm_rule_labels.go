@@ -0,0 +1,190 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+)
+
+const (
+	vmRuleLabelsTable = "vm_rule_labels"
+
+	vmRuleLabelsColVMID      = "vm_id"
+	vmRuleLabelsColLabel     = "label"
+	vmRuleLabelsColRule      = "rule"
+	vmRuleLabelsColAppliedAt = "applied_at"
+
+	// ruleLabelBatchSize bounds the number of rows of a single insert.
+	ruleLabelBatchSize = 500
+)
+
+// ListRuleLabels returns the labels applied by label rules, ordered by VM and label.
+// When vmIDs are given, only the labels of those VMs are returned.
+func (s *VMStore) ListRuleLabels(ctx context.Context, vmIDs ...string) ([]models.VMRuleLabel, error) {
+	builder := sq.Select(vmRuleLabelsColVMID, vmRuleLabelsColLabel, vmRuleLabelsColRule).
+		From(vmRuleLabelsTable).
+		OrderBy(vmRuleLabelsColVMID, vmRuleLabelsColLabel)
+	if len(vmIDs) > 0 {
+		builder = builder.Where(sq.Eq{vmRuleLabelsColVMID: vmIDs})
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building list rule labels query: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying rule labels: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	labels := []models.VMRuleLabel{}
+	for rows.Next() {
+		var l models.VMRuleLabel
+		if err := rows.Scan(&l.VMID, &l.Label, &l.Rule); err != nil {
+			return nil, fmt.Errorf("scanning rule label: %w", err)
+		}
+		labels = append(labels, l)
+	}
+	return labels, rows.Err()
+}
+
+// ApplyRuleLabels adds the labels of add to their VMs and records the rule that applied
+// them, replacing the rule recorded for a label already applied by another rule. The labels
+// of remove are removed from their VMs along with their record. Missing VMs are skipped.
+// Callers should run it inside a transaction.
+func (s *VMStore) ApplyRuleLabels(ctx context.Context, add, remove []models.VMRuleLabel) error {
+	if err := s.recordRuleLabelChanges(ctx, add, models.LabelAdded); err != nil {
+		return err
+	}
+	for label, vmIDs := range vmIDsByLabel(add) {
+		query, args, err := sq.Update("vinfo").
+			Set(`"labels"`, sq.Expr("list_distinct(list_append(CAST(\"labels\" AS VARCHAR[]), ?))", label)).
+			Where(sq.Expr(`"VM ID" IN (SELECT unnest(?))`, vmIDs)).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("building add rule label query: %w", err)
+		}
+		if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("adding rule label %s: %w", label, err)
+		}
+	}
+	for batch := range slices.Chunk(add, ruleLabelBatchSize) {
+		builder := sq.Insert(vmRuleLabelsTable).
+			Columns(vmRuleLabelsColVMID, vmRuleLabelsColLabel, vmRuleLabelsColRule).
+			Suffix(fmt.Sprintf("ON CONFLICT (%s, %s) DO UPDATE SET %s = EXCLUDED.%s, %s = now()",
+				vmRuleLabelsColVMID, vmRuleLabelsColLabel, vmRuleLabelsColRule, vmRuleLabelsColRule, vmRuleLabelsColAppliedAt))
+		for _, l := range batch {
+			builder = builder.Values(l.VMID, l.Label, l.Rule)
+		}
+		query, args, err := builder.ToSql()
+		if err != nil {
+			return fmt.Errorf("building insert rule labels query: %w", err)
+		}
+		if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("recording rule labels: %w", err)
+		}
+	}
+	if len(add) > 0 {
+		query, args, err := sq.Delete(vmRuleLabelsTable).
+			Where(sq.Expr(vmRuleLabelsColVMID + ` NOT IN (SELECT "VM ID" FROM vinfo)`)).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("building delete orphan rule labels query: %w", err)
+		}
+		if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("deleting rule labels of missing VMs: %w", err)
+		}
+	}
+
+	if err := s.recordRuleLabelChanges(ctx, remove, models.LabelRemoved); err != nil {
+		return err
+	}
+	for label, vmIDs := range vmIDsByLabel(remove) {
+		query, args, err := sq.Update("vinfo").
+			Set(`"labels"`, sq.Expr("list_filter(CAST(\"labels\" AS VARCHAR[]), x -> x != ?)", label)).
+			Where(sq.Expr(`"VM ID" IN (SELECT unnest(?))`, vmIDs)).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("building remove rule label query: %w", err)
+		}
+		if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("removing rule label %s: %w", label, err)
+		}
+		if err := s.forgetRuleLabels(ctx, vmIDs, label); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CopyRuleLabelsToAttached copies the records of the labels applied by rules into the
+// attached database, for VMs present in both. It goes with CopyLabelsToAttached, so that
+// the rules keep managing the labels they applied in the previous collection.
+func (s *VMStore) CopyRuleLabelsToAttached(ctx context.Context, attachAlias string) error {
+	selectQuery := sq.Select(vmRuleLabelsColVMID, vmRuleLabelsColLabel, vmRuleLabelsColRule, vmRuleLabelsColAppliedAt).
+		From(vmRuleLabelsTable).
+		Where(sq.Expr(vmRuleLabelsColVMID + ` IN (SELECT "VM ID" FROM ` + attachAlias + `.vinfo)`))
+
+	query, args, err := sq.Insert(attachAlias+"."+vmRuleLabelsTable).
+		Columns(vmRuleLabelsColVMID, vmRuleLabelsColLabel, vmRuleLabelsColRule, vmRuleLabelsColAppliedAt).
+		Select(selectQuery).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+// forgetRuleLabels drops the rule records of label on the given VMs, turning a label still
+// present into a manual one. An empty label drops every record of the VMs; nil vmIDs drop
+// the records of label on every VM.
+func (s *VMStore) forgetRuleLabels(ctx context.Context, vmIDs []string, label string) error {
+	builder := sq.Delete(vmRuleLabelsTable)
+	if vmIDs != nil {
+		builder = builder.Where(sq.Eq{vmRuleLabelsColVMID: vmIDs})
+	}
+	if label != "" {
+		builder = builder.Where(sq.Eq{vmRuleLabelsColLabel: label})
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete rule labels query: %w", err)
+	}
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("deleting rule labels: %w", err)
+	}
+	return nil
+}
+
+// recordRuleLabelChanges records the changes of labels in the label history, each as made by
+// its rule.
+func (s *VMStore) recordRuleLabelChanges(ctx context.Context, labels []models.VMRuleLabel, action models.LabelChangeAction) error {
+	byRule := make(map[string][]models.VMRuleLabel)
+	for _, l := range labels {
+		byRule[l.Rule] = append(byRule[l.Rule], l)
+	}
+	for rule, ruleLabels := range byRule {
+		ruleCtx := WithLabelActor(ctx, models.LabelActor{Source: models.LabelSourceRule, Name: rule})
+		for label, vmIDs := range vmIDsByLabel(ruleLabels) {
+			if err := s.recordLabelChanges(ruleCtx, vmIDs, label, action); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// vmIDsByLabel groups the VM IDs of labels by label.
+func vmIDsByLabel(labels []models.VMRuleLabel) map[string][]string {
+	byLabel := make(map[string][]string)
+	for _, l := range labels {
+		byLabel[l.Label] = append(byLabel[l.Label], l.VMID)
+	}
+	return byLabel
+}
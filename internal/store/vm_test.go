@@ -233,6 +233,30 @@ var _ = Describe("VMStore", func() {
 			})
 		})
 
+		Context("ByNegatedDiskFilter", func() {
+			// Given a VM with a small and a large disk
+			// When we filter by the negation of a disk condition
+			// Then it should exclude the VM, since one of its disks matches the condition
+			It("should negate a disk condition for the whole VM", func() {
+				insertDisk("vm-1", 300)
+
+				// Act
+				vms, err := s.VM().List(ctx, store.ByFilter("not disk.capacity > 250"))
+
+				// Assert
+				Expect(err).NotTo(HaveOccurred())
+				ids := make([]string, 0, len(vms))
+				for _, vm := range vms {
+					ids = append(ids, vm.ID)
+				}
+				Expect(ids).To(ConsistOf("vm-2", "vm-4", "vm-5"))
+
+				count, err := s.VM().Count(ctx, store.ByFilter("disk.capacity > 250"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(count).To(Equal(2)) // vm-1 and vm-3
+			})
+		})
+
 		Context("ByMemorySizeRange", func() {
 			// Given VMs with different memory sizes
 			// When we filter by memory size range
@@ -1323,6 +1347,32 @@ var _ = Describe("VMStore", func() {
 		})
 	})
 
+	Context("ListStates", func() {
+		BeforeEach(func() {
+			err := test.InsertVMs(ctx, db)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		// Given vm-007 has a Critical concern and vm-001 is excluded from migration
+		// When we call ListStates
+		// Then vm-007 should carry its concern ID and vm-001 must not appear
+		It("should return the tracked fields of non-excluded VMs", func() {
+			Expect(s.VM().UpdateMigrationExcluded(ctx, "vm-001", true)).To(Succeed())
+
+			rows, err := s.VM().ListStates(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			states := make(map[string]models.VMStateRow)
+			for _, r := range rows {
+				states[r.VMID] = r
+			}
+			Expect(states).NotTo(HaveKey("vm-001"))
+			Expect(states).To(HaveKey("vm-007"))
+			Expect(states["vm-007"].Concerns).NotTo(BeEmpty())
+			Expect(states["vm-007"].Name).NotTo(BeEmpty())
+		})
+	})
+
 	Context("CountDistinctClusters", func() {
 		// Given no VMs in the database
 		// When we call CountDistinctClusters
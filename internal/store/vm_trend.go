@@ -0,0 +1,71 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+)
+
+// TrendMetrics computes the readiness metrics of the collection over the VMs matching filter,
+// or over every VM if filter is nil. VMs excluded from migration are skipped.
+func (s *VMStore) TrendMetrics(ctx context.Context, filter sq.Sqlizer) (models.TrendMetrics, error) {
+	where := sq.And{sq.Expr(`v."migration_excluded" IS NOT TRUE`)}
+	if filter != nil {
+		subSQL, subArgs, err := vmFilterSubquery.Where(filter).ToSql()
+		if err != nil {
+			return models.TrendMetrics{}, fmt.Errorf("building trend filter: %w", err)
+		}
+		where = append(where, sq.Expr(fmt.Sprintf(`v."VM ID" IN (%s)`, subSQL), subArgs...))
+	}
+
+	query, args, err := sq.Select(
+		"COUNT(*)",
+		"COUNT(*) FILTER (WHERE crit.\"VM_ID\" IS NULL)",
+		`COALESCE(SUM(v."CPUs"), 0)`,
+		`COALESCE(SUM(v."Memory"), 0)`,
+		`COALESCE(SUM(v."Provisioned MiB"), 0)`,
+		`COUNT(DISTINCT NULLIF(v."Cluster", ''))`,
+	).
+		From("vinfo v").
+		LeftJoin(`(SELECT DISTINCT "VM_ID" FROM concerns WHERE "Category" = 'Critical') crit ON v."VM ID" = crit."VM_ID"`).
+		Where(where).
+		ToSql()
+	if err != nil {
+		return models.TrendMetrics{}, fmt.Errorf("building trend metrics query: %w", err)
+	}
+
+	var m models.TrendMetrics
+	if err := s.db.QueryRowContext(ctx, query, args...).Scan(&m.TotalVMs, &m.Migratable, &m.CPUs, &m.MemoryMiB, &m.StorageMiB, &m.Clusters); err != nil {
+		return models.TrendMetrics{}, fmt.Errorf("querying trend metrics: %w", err)
+	}
+	m.NonMigratable = m.TotalVMs - m.Migratable
+
+	query, args, err = sq.Select(`COALESCE(c."Category", '')`, "COUNT(*)").
+		From("concerns c").
+		Join(`vinfo v ON v."VM ID" = c."VM_ID"`).
+		Where(where).
+		GroupBy(`1`).
+		ToSql()
+	if err != nil {
+		return models.TrendMetrics{}, fmt.Errorf("building trend concerns query: %w", err)
+	}
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return models.TrendMetrics{}, fmt.Errorf("querying trend concerns: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	m.ConcernsByCategory = make(map[string]int)
+	for rows.Next() {
+		var category string
+		var count int
+		if err := rows.Scan(&category, &count); err != nil {
+			return models.TrendMetrics{}, fmt.Errorf("scanning trend concerns: %w", err)
+		}
+		m.ConcernsByCategory[category] = count
+	}
+	return m, rows.Err()
+}
@@ -0,0 +1,243 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+const (
+	wasteAnalysisTable         = "waste_analysis"
+	wasteAnalysisColID         = "id"
+	wasteAnalysisColReportID   = "report_id"
+	wasteAnalysisColAnalyzedAt = "analyzed_at"
+	wasteAnalysisColUnknown    = "powered_off_unknown"
+	vmWasteTable               = "vm_waste"
+	vmWasteColVMID             = "vm_id"
+	vmWasteColClass            = "class"
+	vmWasteColEvidence         = "evidence"
+)
+
+// listWasteCandidatesQuery returns the VMs to classify, templates aside, with the time a
+// collection first saw them powered off and their utilization in the latest rightsizing report.
+const listWasteCandidatesQuery = `
+SELECT
+    v."VM ID",
+    COALESCE(v."VM", ''),
+    COALESCE(v."Cluster", ''),
+    COALESCE(v."Powerstate", ''),
+    COALESCE(v."CPUs", 0),
+    COALESCE(v."Memory", 0),
+    COALESCE(v."OS according to the VMware Tools", ''),
+    po.since,
+    u.cpu_p95_pct,
+    u.net_p95_kbps,
+    u.iops_p95
+FROM vinfo v
+LEFT JOIN vm_powered_off po ON po.vm_id = v."VM ID" AND v."Powerstate" = 'poweredOff'
+LEFT JOIN rightsizing_vm_utilization u
+    ON u.moid = v."VM ID"
+    AND u.report_id = (
+        SELECT id FROM rightsizing_reports
+        WHERE written_batch_count > 0
+        ORDER BY created_at DESC LIMIT 1
+    )
+WHERE COALESCE(v."Template", false) = false
+ORDER BY v."VM ID"
+`
+
+// WasteStore persists the waste analysis of a collection.
+type WasteStore struct {
+	db QueryInterceptor
+}
+
+func NewWasteStore(db QueryInterceptor) *WasteStore {
+	return &WasteStore{db: db}
+}
+
+// ListCandidates returns the VMs to classify, ordered by VM ID.
+func (s *WasteStore) ListCandidates(ctx context.Context) ([]models.WasteCandidate, error) {
+	rows, err := s.db.QueryContext(ctx, listWasteCandidatesQuery)
+	if err != nil {
+		return nil, fmt.Errorf("listing waste candidates: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	vms := []models.WasteCandidate{}
+	for rows.Next() {
+		var (
+			vm                      models.WasteCandidate
+			poweredOffSince         sql.NullTime
+			cpuP95, netP95, iopsP95 sql.NullFloat64
+		)
+		if err := rows.Scan(
+			&vm.VMID, &vm.Name, &vm.Cluster, &vm.PowerState, &vm.ProvisionedCPUs, &vm.ProvisionedMemoryMB,
+			&vm.ToolsGuestOS, &poweredOffSince, &cpuP95, &netP95, &iopsP95,
+		); err != nil {
+			return nil, fmt.Errorf("scanning waste candidate: %w", err)
+		}
+		if poweredOffSince.Valid {
+			since := poweredOffSince.Time.UTC()
+			vm.PoweredOffSince = &since
+		}
+		if cpuP95.Valid {
+			vm.CPUP95Pct = &cpuP95.Float64
+		}
+		if netP95.Valid {
+			vm.NetP95KBps = &netP95.Float64
+		}
+		if iopsP95.Valid {
+			vm.IOPSP95 = &iopsP95.Float64
+		}
+		vms = append(vms, vm)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating waste candidate rows: %w", err)
+	}
+	return vms, nil
+}
+
+// Save replaces the waste analysis of the collection. Callers should run it inside a
+// transaction.
+func (s *WasteStore) Save(ctx context.Context, a models.WasteAnalysis) error {
+	for _, table := range []string{vmWasteTable, wasteAnalysisTable} {
+		query, args, err := sq.Delete(table).ToSql()
+		if err != nil {
+			return fmt.Errorf("building delete %s query: %w", table, err)
+		}
+		if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("deleting %s: %w", table, err)
+		}
+	}
+
+	var reportID sql.NullString
+	if a.ReportID != "" {
+		reportID = sql.NullString{String: a.ReportID, Valid: true}
+	}
+	t := a.Thresholds
+	query, args, err := sq.Insert(wasteAnalysisTable).
+		Columns(wasteAnalysisColID, wasteAnalysisColReportID, wasteColIdleCPUP95Pct, wasteColIdleNetP95KBps,
+			wasteColPoweredOffDays, wasteColOrphanedIOPSP95, wasteColOversizedSavedPct, wasteAnalysisColAnalyzedAt,
+			wasteAnalysisColUnknown).
+		Values(1, reportID, t.IdleCPUP95Pct, t.IdleNetP95KBps, t.PoweredOffDays, t.OrphanedIOPSP95,
+			t.OversizedSavedPct, a.AnalyzedAt.UTC(), a.PoweredOffUnknown).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert waste analysis query: %w", err)
+	}
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("inserting waste analysis: %w", err)
+	}
+
+	if len(a.VMs) == 0 {
+		return nil
+	}
+	builder := sq.Insert(vmWasteTable).Columns(vmWasteColVMID, vmWasteColClass, vmWasteColEvidence)
+	for _, vm := range a.VMs {
+		evidence, err := json.Marshal(vm.Evidence)
+		if err != nil {
+			return fmt.Errorf("marshaling evidence of VM %s: %w", vm.VMID, err)
+		}
+		builder = builder.Values(vm.VMID, string(vm.Class), string(evidence))
+	}
+	query, args, err = builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert VM waste query: %w", err)
+	}
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("inserting VM waste: %w", err)
+	}
+	return nil
+}
+
+// Get returns the waste analysis of the collection with its VMs, ordered by VM ID and class.
+// Returns a ResourceNotFoundError if the collection was never analyzed.
+func (s *WasteStore) Get(ctx context.Context) (*models.WasteAnalysis, error) {
+	query, args, err := sq.Select(
+		wasteAnalysisColReportID, wasteColIdleCPUP95Pct, wasteColIdleNetP95KBps, wasteColPoweredOffDays,
+		wasteColOrphanedIOPSP95, wasteColOversizedSavedPct, wasteAnalysisColAnalyzedAt,
+		"COALESCE("+wasteAnalysisColUnknown+", 0)",
+	).
+		From(wasteAnalysisTable).
+		Where(sq.Eq{wasteAnalysisColID: 1}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building get waste analysis query: %w", err)
+	}
+
+	var (
+		a        models.WasteAnalysis
+		reportID sql.NullString
+	)
+	err = s.db.QueryRowContext(ctx, query, args...).Scan(
+		&reportID, &a.Thresholds.IdleCPUP95Pct, &a.Thresholds.IdleNetP95KBps, &a.Thresholds.PoweredOffDays,
+		&a.Thresholds.OrphanedIOPSP95, &a.Thresholds.OversizedSavedPct, &a.AnalyzedAt, &a.PoweredOffUnknown,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, srvErrors.NewResourceNotFoundError("waste analysis", "latest")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("scanning waste analysis: %w", err)
+	}
+	a.ReportID = reportID.String
+	a.AnalyzedAt = a.AnalyzedAt.UTC()
+
+	if a.VMs, err = s.listVMs(ctx); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// listVMs returns the waste classes of the VMs with their inventory, ordered by VM ID and class.
+func (s *WasteStore) listVMs(ctx context.Context) ([]models.VMWaste, error) {
+	query, args, err := sq.Select(
+		"w."+vmWasteColVMID,
+		`COALESCE(v."VM", '')`,
+		`COALESCE(v."Cluster", '')`,
+		`COALESCE(v."Powerstate", '')`,
+		`COALESCE(v."CPUs", 0)`,
+		`COALESCE(v."Memory", 0)`,
+		"w."+vmWasteColClass,
+		"w."+vmWasteColEvidence,
+	).
+		From(vmWasteTable+" w").
+		LeftJoin(`vinfo v ON v."VM ID" = w.`+vmWasteColVMID).
+		OrderBy("w."+vmWasteColVMID, "w."+vmWasteColClass).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building list VM waste query: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying VM waste: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	vms := []models.VMWaste{}
+	for rows.Next() {
+		var (
+			vm       models.VMWaste
+			evidence string
+		)
+		if err := rows.Scan(&vm.VMID, &vm.Name, &vm.Cluster, &vm.PowerState, &vm.ProvisionedCPUs,
+			&vm.ProvisionedMemoryMB, &vm.Class, &evidence); err != nil {
+			return nil, fmt.Errorf("scanning VM waste: %w", err)
+		}
+		if err := json.Unmarshal([]byte(evidence), &vm.Evidence); err != nil {
+			return nil, fmt.Errorf("unmarshaling evidence of VM %s: %w", vm.VMID, err)
+		}
+		vms = append(vms, vm)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating VM waste rows: %w", err)
+	}
+	return vms, nil
+}
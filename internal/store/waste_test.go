@@ -0,0 +1,213 @@
+package store_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+	"github.com/kubev2v/assisted-migration-agent/test"
+)
+
+var _ = Describe("WasteStore", func() {
+	var (
+		ctx context.Context
+		s   *store.Store
+		db  *sql.DB
+	)
+
+	vmIDs := func(vms []models.VirtualMachineSummary) []string {
+		ids := make([]string, len(vms))
+		for i, vm := range vms {
+			ids[i] = vm.ID
+		}
+		sort.Strings(ids)
+		return ids
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		var err error
+		db, err = store.NewConnection(nil, ":memory:")
+		Expect(err).NotTo(HaveOccurred())
+
+		s = store.NewStore(db, test.NewMockValidator())
+		Expect(s.InitCollection(ctx)).To(Succeed())
+
+		for _, q := range []string{
+			// vSphere clears the boot time of powered off VMs: only the collections that saw them
+			// powered off tell since when they are. lost-1 was never seen powered off before.
+			`INSERT INTO vinfo ("VM ID", "VM", "Powerstate", "Cluster", "CPUs", "Memory", "Template", "OS according to the VMware Tools", "PowerOn", "Creation date")
+			 VALUES ('vm-1', 'web-1', 'poweredOn', 'prod', 4, 8192, false, 'Red Hat Enterprise Linux 9', '2026-01-01', '2025-01-01'),
+			        ('vm-2', 'old-1', 'poweredOff', 'prod', 2, 4096, false, NULL, NULL, '2024-01-01'),
+			        ('vm-3', 'ghost-1', 'poweredOn', 'dev', 2, 2048, false, NULL, NULL, NULL),
+			        ('vm-4', 'tpl-1', 'poweredOff', 'dev', 2, 2048, true, NULL, NULL, NULL),
+			        ('vm-5', 'lost-1', 'poweredOff', 'dev', 1, 1024, false, NULL, NULL, '2020-01-01')`,
+			`INSERT INTO vm_powered_off (vm_id, since) VALUES ('vm-1', '2025-06-01'), ('vm-2', '2025-01-01')`,
+			`INSERT INTO rightsizing_reports (id, vcenter, interval_id, window_start, window_end, expected_sample_count, expected_batch_count, written_batch_count)
+			 VALUES ('report-1', 'vc', 300, '2026-01-01', '2026-01-08', 10, 1, 1)`,
+			`INSERT INTO rightsizing_vm_utilization (report_id, moid, vm_name, cpu_p95_pct, net_p95_kbps, iops_p95)
+			 VALUES ('report-1', 'vm-1', 'web-1', 2, 0.5, 20),
+			        ('report-1', 'vm-3', 'ghost-1', 40, 80, 0)`,
+		} {
+			_, err = db.ExecContext(ctx, q)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	AfterEach(func() {
+		if db != nil {
+			_ = db.Close()
+		}
+	})
+
+	It("lists the VMs to classify with their power-off time and utilization", func() {
+		vms, err := s.Waste().ListCandidates(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vms).To(HaveLen(4))
+
+		web := vms[0]
+		Expect(web.VMID).To(Equal("vm-1"))
+		Expect(web.PowerState).To(Equal("poweredOn"))
+		Expect(web.ProvisionedCPUs).To(Equal(4))
+		Expect(web.ProvisionedMemoryMB).To(Equal(int64(8192)))
+		Expect(web.ToolsGuestOS).To(Equal("Red Hat Enterprise Linux 9"))
+		// a stale record of a VM powered on again is ignored
+		Expect(web.PoweredOffSince).To(BeNil())
+		Expect(*web.CPUP95Pct).To(Equal(2.0))
+		Expect(*web.NetP95KBps).To(Equal(0.5))
+		Expect(*web.IOPSP95).To(Equal(20.0))
+
+		old := vms[1]
+		Expect(*old.PoweredOffSince).To(Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+		Expect(old.CPUP95Pct).To(BeNil())
+
+		ghost := vms[2]
+		Expect(ghost.ToolsGuestOS).To(BeEmpty())
+		Expect(ghost.PoweredOffSince).To(BeNil())
+		Expect(*ghost.IOPSP95).To(BeZero())
+
+		lost := vms[3]
+		Expect(lost.PowerState).To(Equal("poweredOff"))
+		Expect(lost.PoweredOffSince).To(BeNil())
+	})
+
+	It("records the VMs first seen powered off and forgets those powered on again", func() {
+		now := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+		Expect(s.VM().TrackPoweredOff(ctx, now)).To(Succeed())
+
+		rows, err := db.QueryContext(ctx, `SELECT vm_id, since FROM vm_powered_off ORDER BY vm_id`)
+		Expect(err).NotTo(HaveOccurred())
+		defer func() { _ = rows.Close() }()
+		since := map[string]time.Time{}
+		for rows.Next() {
+			var (
+				id string
+				t  time.Time
+			)
+			Expect(rows.Scan(&id, &t)).To(Succeed())
+			since[id] = t.UTC()
+		}
+		Expect(since).To(Equal(map[string]time.Time{
+			"vm-2": time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			"vm-4": now,
+			"vm-5": now,
+		}))
+	})
+
+	It("returns a not found error before the first analysis", func() {
+		_, err := s.Waste().Get(ctx)
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+	})
+
+	Context("with an analysis", func() {
+		analyzedAt := time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC)
+
+		BeforeEach(func() {
+			analysis := models.WasteAnalysis{
+				ReportID:          "report-1",
+				Thresholds:        models.DefaultWasteThresholds,
+				AnalyzedAt:        analyzedAt,
+				PoweredOffUnknown: 1,
+				VMs: []models.VMWaste{
+					{VMID: "vm-1", Class: models.WasteIdle, Evidence: map[string]any{"cpu_p95_pct": 2.0}},
+					{VMID: "vm-2", Class: models.WastePoweredOff, Evidence: map[string]any{"days_since": 455}},
+					{VMID: "vm-3", Class: models.WasteOrphaned, Evidence: map[string]any{"tools_reporting": false}},
+					{VMID: "vm-3", Class: models.WasteIdle, Evidence: map[string]any{}},
+				},
+			}
+			Expect(s.Waste().Save(ctx, analysis)).To(Succeed())
+		})
+
+		It("returns the analysis with the inventory of its VMs", func() {
+			a, err := s.Waste().Get(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(a.ReportID).To(Equal("report-1"))
+			Expect(a.AnalyzedAt).To(Equal(analyzedAt))
+			Expect(a.Thresholds.PoweredOffDays).To(Equal(models.DefaultWasteThresholds.PoweredOffDays))
+			Expect(a.PoweredOffUnknown).To(Equal(1))
+			Expect(a.VMs).To(HaveLen(4))
+
+			Expect(a.VMs[0].VMID).To(Equal("vm-1"))
+			Expect(a.VMs[0].Name).To(Equal("web-1"))
+			Expect(a.VMs[0].Cluster).To(Equal("prod"))
+			Expect(a.VMs[0].ProvisionedMemoryMB).To(Equal(int64(8192)))
+			Expect(a.VMs[0].Evidence).To(HaveKeyWithValue("cpu_p95_pct", 2.0))
+			Expect(a.VMs[1].Evidence).To(HaveKeyWithValue("days_since", 455.0))
+			Expect(a.VMs[2].Class).To(Equal(models.WasteIdle))
+			Expect(a.VMs[3].Class).To(Equal(models.WasteOrphaned))
+		})
+
+		It("replaces the previous analysis", func() {
+			Expect(s.Waste().Save(ctx, models.WasteAnalysis{AnalyzedAt: analyzedAt.Add(time.Hour)})).To(Succeed())
+
+			a, err := s.Waste().Get(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(a.ReportID).To(BeEmpty())
+			Expect(a.AnalyzedAt).To(Equal(analyzedAt.Add(time.Hour)))
+			Expect(a.VMs).To(BeEmpty())
+		})
+
+		It("filters VMs by waste class", func() {
+			vms, err := s.VM().List(ctx, store.ByFilter("waste.class = 'idle'"), store.WithDefaultSort())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vmIDs(vms)).To(Equal([]string{"vm-1", "vm-3"}))
+
+			vms, err = s.VM().List(ctx, store.ByFilter("waste.class in ['orphaned', 'powered_off']"), store.WithDefaultSort())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vmIDs(vms)).To(Equal([]string{"vm-2", "vm-3"}))
+
+			vms, err = s.VM().List(ctx, store.ByFilter("count(waste) = 0"), store.WithDefaultSort())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vmIDs(vms)).To(Equal([]string{"vm-4", "vm-5"}))
+		})
+
+		It("exports the waste classes of the VMs matching the filter", func() {
+			dir, err := os.MkdirTemp("", "waste-export-*")
+			Expect(err).NotTo(HaveOccurred())
+			defer func() { _ = os.RemoveAll(dir) }()
+
+			path := filepath.Join(dir, "waste.csv")
+			Expect(s.Export().Filtered(store.ByFilter("cluster = 'dev'")).CopyScope(ctx, "waste", path)).To(Succeed())
+
+			content, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+			Expect(lines).To(HaveLen(3))
+			Expect(lines[0]).To(Equal("vm_id,vm_name,cluster,power_state,cpus,memory_mb,class,evidence,analyzed_at"))
+			Expect(lines[1]).To(HavePrefix("vm-3,ghost-1,dev,poweredOn,2,2048,idle,"))
+			Expect(lines[2]).To(ContainSubstring(",orphaned,"))
+			Expect(lines[2]).To(ContainSubstring("tools_reporting"))
+		})
+	})
+})
@@ -0,0 +1,80 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+)
+
+const (
+	wasteThresholdsTable        = "agent.main.waste_thresholds"
+	wasteThresholdsColID        = "id"
+	wasteColIdleCPUP95Pct       = "idle_cpu_p95_pct"
+	wasteColIdleNetP95KBps      = "idle_net_p95_kbps"
+	wasteColPoweredOffDays      = "powered_off_days"
+	wasteColOrphanedIOPSP95     = "orphaned_iops_p95"
+	wasteColOversizedSavedPct   = "oversized_saved_pct"
+	wasteThresholdsColUpdatedAt = "updated_at"
+)
+
+// WasteThresholdsStore persists the thresholds of the waste analysis in the main database.
+type WasteThresholdsStore struct {
+	db QueryInterceptor
+}
+
+func NewWasteThresholdsStore(db QueryInterceptor) *WasteThresholdsStore {
+	return &WasteThresholdsStore{db: db}
+}
+
+// Get returns the thresholds. UpdatedAt is nil until they are first updated.
+func (s *WasteThresholdsStore) Get(ctx context.Context) (*models.WasteThresholds, error) {
+	query, args, err := sq.Select(
+		wasteColIdleCPUP95Pct, wasteColIdleNetP95KBps, wasteColPoweredOffDays,
+		wasteColOrphanedIOPSP95, wasteColOversizedSavedPct, wasteThresholdsColUpdatedAt,
+	).
+		From(wasteThresholdsTable).
+		Where(sq.Eq{wasteThresholdsColID: 1}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building get waste thresholds query: %w", err)
+	}
+
+	var (
+		t         models.WasteThresholds
+		updatedAt sql.NullTime
+	)
+	if err := s.db.QueryRowContext(ctx, query, args...).Scan(
+		&t.IdleCPUP95Pct, &t.IdleNetP95KBps, &t.PoweredOffDays, &t.OrphanedIOPSP95, &t.OversizedSavedPct, &updatedAt,
+	); err != nil {
+		return nil, fmt.Errorf("scanning waste thresholds: %w", err)
+	}
+	if updatedAt.Valid {
+		t.UpdatedAt = &updatedAt.Time
+	}
+	return &t, nil
+}
+
+// Update replaces the thresholds.
+func (s *WasteThresholdsStore) Update(ctx context.Context, t models.WasteThresholds) error {
+	query, args, err := sq.Update(wasteThresholdsTable).
+		Set(wasteColIdleCPUP95Pct, t.IdleCPUP95Pct).
+		Set(wasteColIdleNetP95KBps, t.IdleNetP95KBps).
+		Set(wasteColPoweredOffDays, t.PoweredOffDays).
+		Set(wasteColOrphanedIOPSP95, t.OrphanedIOPSP95).
+		Set(wasteColOversizedSavedPct, t.OversizedSavedPct).
+		Set(wasteThresholdsColUpdatedAt, time.Now().UTC()).
+		Where(sq.Eq{wasteThresholdsColID: 1}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building update waste thresholds query: %w", err)
+	}
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("updating waste thresholds: %w", err)
+	}
+	return nil
+}
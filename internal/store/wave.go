@@ -0,0 +1,253 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+)
+
+const (
+	waveTable = "agent.main.waves"
+
+	waveColName         = "name"
+	waveColDescription  = "description"
+	waveColPosition     = "position"
+	waveColWindowStart  = "window_start"
+	waveColWindowEnd    = "window_end"
+	waveColForecastPair = "forecast_pair"
+	waveColGroupIDs     = "group_ids"
+	waveColVMIDs        = "vm_ids"
+	waveColCreatedAt    = "created_at"
+	waveColUpdatedAt    = "updated_at"
+)
+
+var waveSelectColumns = []string{
+	waveColName,
+	waveColDescription,
+	waveColPosition,
+	waveColWindowStart,
+	waveColWindowEnd,
+	waveColForecastPair,
+	waveColGroupIDs,
+	waveColVMIDs,
+	waveColCreatedAt,
+	waveColUpdatedAt,
+}
+
+// WaveStore persists migration waves in the main database.
+type WaveStore struct {
+	db QueryInterceptor
+}
+
+func NewWaveStore(db QueryInterceptor) *WaveStore {
+	return &WaveStore{db: db}
+}
+
+// List returns all waves, ordered by position and then by name.
+func (s *WaveStore) List(ctx context.Context) ([]models.Wave, error) {
+	query, args, err := sq.Select(waveSelectColumns...).
+		From(waveTable).
+		OrderBy(waveColPosition+" ASC", waveColName+" ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building list waves query: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying waves: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	waves := []models.Wave{}
+	for rows.Next() {
+		w, err := scanWave(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning wave: %w", err)
+		}
+		waves = append(waves, *w)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating wave rows: %w", err)
+	}
+	return waves, nil
+}
+
+// Get returns a wave by name.
+func (s *WaveStore) Get(ctx context.Context, name string) (*models.Wave, error) {
+	query, args, err := sq.Select(waveSelectColumns...).
+		From(waveTable).
+		Where(sq.Eq{waveColName: name}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building get wave query: %w", err)
+	}
+
+	w, err := scanWave(s.db.QueryRowContext(ctx, query, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, srvErrors.NewResourceNotFoundError("wave", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("scanning wave: %w", err)
+	}
+	return w, nil
+}
+
+// Create inserts a new wave and returns the persisted record.
+func (s *WaveStore) Create(ctx context.Context, w models.Wave) (*models.Wave, error) {
+	query, args, err := sq.Insert(waveTable).
+		Columns(
+			waveColName,
+			waveColDescription,
+			waveColPosition,
+			waveColWindowStart,
+			waveColWindowEnd,
+			waveColForecastPair,
+			waveColGroupIDs,
+			waveColVMIDs,
+		).
+		Values(
+			w.Name, w.Description, w.Position,
+			nullTime(w.WindowStart), nullTime(w.WindowEnd), w.ForecastPair,
+			sq.Expr("CAST(? AS VARCHAR[])", groupIDStrings(w.GroupIDs)),
+			sq.Expr("CAST(? AS VARCHAR[])", nonNilStrings(w.VMIDs)),
+		).
+		Suffix(fmt.Sprintf("RETURNING %s", strings.Join(waveSelectColumns, ", "))).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building create wave query: %w", err)
+	}
+
+	created, err := scanWave(s.db.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return nil, srvErrors.NewDuplicateResourceError("wave", "name", w.Name)
+		}
+		return nil, fmt.Errorf("creating wave: %w", err)
+	}
+	return created, nil
+}
+
+// Update replaces every field of a wave but its name and creation time.
+func (s *WaveStore) Update(ctx context.Context, w models.Wave) (*models.Wave, error) {
+	query, args, err := sq.Update(waveTable).
+		Set(waveColDescription, w.Description).
+		Set(waveColPosition, w.Position).
+		Set(waveColWindowStart, nullTime(w.WindowStart)).
+		Set(waveColWindowEnd, nullTime(w.WindowEnd)).
+		Set(waveColForecastPair, w.ForecastPair).
+		Set(waveColGroupIDs, sq.Expr("CAST(? AS VARCHAR[])", groupIDStrings(w.GroupIDs))).
+		Set(waveColVMIDs, sq.Expr("CAST(? AS VARCHAR[])", nonNilStrings(w.VMIDs))).
+		Set(waveColUpdatedAt, time.Now()).
+		Where(sq.Eq{waveColName: w.Name}).
+		Suffix(fmt.Sprintf("RETURNING %s", strings.Join(waveSelectColumns, ", "))).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building update wave query: %w", err)
+	}
+
+	updated, err := scanWave(s.db.QueryRowContext(ctx, query, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, srvErrors.NewResourceNotFoundError("wave", w.Name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("updating wave: %w", err)
+	}
+	return updated, nil
+}
+
+// Delete removes a wave.
+func (s *WaveStore) Delete(ctx context.Context, name string) error {
+	query, args, err := sq.Delete(waveTable).
+		Where(sq.Eq{waveColName: name}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete wave query: %w", err)
+	}
+
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("deleting wave: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("checking rows affected: %w", err)
+	}
+	if n == 0 {
+		return srvErrors.NewResourceNotFoundError("wave", name)
+	}
+	return nil
+}
+
+// scanWave scans one row into a *models.Wave.
+// Column order must match waveSelectColumns exactly.
+func scanWave(row rowScanner) (*models.Wave, error) {
+	var (
+		w                      models.Wave
+		windowStart, windowEnd sql.NullTime
+		groupIDs, vmIDs        StringArray
+	)
+	err := row.Scan(
+		&w.Name,
+		&w.Description,
+		&w.Position,
+		&windowStart,
+		&windowEnd,
+		&w.ForecastPair,
+		&groupIDs,
+		&vmIDs,
+		&w.CreatedAt,
+		&w.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if windowStart.Valid {
+		w.WindowStart = &windowStart.Time
+	}
+	if windowEnd.Valid {
+		w.WindowEnd = &windowEnd.Time
+	}
+	w.GroupIDs = make([]uuid.UUID, 0, len(groupIDs))
+	for _, id := range groupIDs {
+		groupID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("parsing group ID %q: %w", id, err)
+		}
+		w.GroupIDs = append(w.GroupIDs, groupID)
+	}
+	w.VMIDs = vmIDs
+	return &w, nil
+}
+
+// nullTime converts an optional time to a nullable column value.
+func nullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+func groupIDStrings(ids []uuid.UUID) []string {
+	s := make([]string, 0, len(ids))
+	for _, id := range ids {
+		s = append(s, id.String())
+	}
+	return s
+}
+
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -0,0 +1,114 @@
+package store_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/internal/models"
+	"github.com/kubev2v/assisted-migration-agent/internal/store"
+	srvErrors "github.com/kubev2v/assisted-migration-agent/pkg/errors"
+	"github.com/kubev2v/assisted-migration-agent/test"
+)
+
+var _ = Describe("WaveStore", func() {
+	var (
+		ctx    context.Context
+		s      *store.Store
+		db     *sql.DB
+		tmpDir string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		var err error
+		tmpDir, err = os.MkdirTemp("", "wave-store-test-*")
+		Expect(err).NotTo(HaveOccurred())
+
+		db, err = store.NewConnection(nil, filepath.Join(tmpDir, "agent.duckdb"))
+		Expect(err).NotTo(HaveOccurred())
+
+		s = store.NewStore(db, test.NewMockValidator())
+		Expect(s.Migrate(ctx, "")).To(Succeed())
+	})
+
+	AfterEach(func() {
+		if db != nil {
+			_ = db.Close()
+		}
+		if tmpDir != "" {
+			_ = os.RemoveAll(tmpDir)
+		}
+	})
+
+	// Given waves at different positions
+	// When we list them
+	// Then they should be ordered by position with their groups, VMs and window
+	It("should create and list waves by position", func() {
+		// Arrange
+		groupID := uuid.New()
+		start := time.Date(2026, 11, 7, 22, 0, 0, 0, time.UTC)
+		end := start.Add(8 * time.Hour)
+		_, err := s.Wave().Create(ctx, models.Wave{Name: "wave-2", Position: 2})
+		Expect(err).NotTo(HaveOccurred())
+		created, err := s.Wave().Create(ctx, models.Wave{
+			Name:         "wave-1",
+			Description:  "Web tier",
+			Position:     1,
+			WindowStart:  &start,
+			WindowEnd:    &end,
+			ForecastPair: "ds1-ds2",
+			GroupIDs:     []uuid.UUID{groupID},
+			VMIDs:        []string{"vm-1", "vm-2"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(created.CreatedAt).NotTo(BeZero())
+
+		_, err = s.Wave().Create(ctx, models.Wave{Name: "wave-1"})
+		Expect(srvErrors.IsDuplicateResourceError(err)).To(BeTrue())
+
+		// Act
+		waves, err := s.Wave().List(ctx)
+
+		// Assert
+		Expect(err).NotTo(HaveOccurred())
+		Expect(waves).To(HaveLen(2))
+		Expect(waves[0].Name).To(Equal("wave-1"))
+		Expect(waves[0].GroupIDs).To(Equal([]uuid.UUID{groupID}))
+		Expect(waves[0].VMIDs).To(Equal([]string{"vm-1", "vm-2"}))
+		Expect(waves[0].WindowStart.Equal(start)).To(BeTrue())
+		Expect(waves[0].WindowEnd.Equal(end)).To(BeTrue())
+		Expect(waves[1].Name).To(Equal("wave-2"))
+		Expect(waves[1].GroupIDs).To(BeEmpty())
+		Expect(waves[1].WindowStart).To(BeNil())
+	})
+
+	// Given a wave
+	// When we update and then delete it
+	// Then the update should replace its fields and a deleted wave should not be found
+	It("should update and delete a wave", func() {
+		// Arrange
+		_, err := s.Wave().Create(ctx, models.Wave{Name: "wave-1", VMIDs: []string{"vm-1"}})
+		Expect(err).NotTo(HaveOccurred())
+
+		// Act
+		updated, err := s.Wave().Update(ctx, models.Wave{Name: "wave-1", Position: 3, VMIDs: []string{"vm-2"}})
+
+		// Assert
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated.Position).To(Equal(3))
+		Expect(updated.VMIDs).To(Equal([]string{"vm-2"}))
+
+		Expect(s.Wave().Delete(ctx, "wave-1")).To(Succeed())
+		_, err = s.Wave().Get(ctx, "wave-1")
+		Expect(srvErrors.IsResourceNotFoundError(err)).To(BeTrue())
+		Expect(srvErrors.IsResourceNotFoundError(s.Wave().Delete(ctx, "wave-1"))).To(BeTrue())
+	})
+})
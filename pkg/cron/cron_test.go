@@ -0,0 +1,96 @@
+package cron_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubev2v/assisted-migration-agent/pkg/cron"
+)
+
+var _ = Describe("Cron", func() {
+	base := time.Date(2026, time.March, 10, 13, 47, 30, 0, time.UTC) // Tuesday
+
+	Context("Parse", func() {
+		DescribeTable("rejects invalid expressions",
+			func(expr string) {
+				_, err := cron.Parse(expr)
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("empty", ""),
+			Entry("too few fields", "* * * *"),
+			Entry("too many fields", "* * * * * *"),
+			Entry("minute out of range", "60 * * * *"),
+			Entry("hour out of range", "0 24 * * *"),
+			Entry("day of month zero", "0 0 0 * *"),
+			Entry("inverted range", "0 10-5 * * *"),
+			Entry("zero step", "*/0 * * * *"),
+			Entry("garbage", "a b c d e"),
+			Entry("unknown descriptor", "@sometimes"),
+		)
+
+		DescribeTable("accepts valid expressions",
+			func(expr string) {
+				s, err := cron.Parse(expr)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(s.String()).To(Equal(expr))
+			},
+			Entry("every minute", "* * * * *"),
+			Entry("list and range", "0,30 8-18 * * 1-5"),
+			Entry("step over range", "0-30/10 * * * *"),
+			Entry("sunday as 7", "0 0 * * 7"),
+			Entry("descriptor", "@daily"),
+		)
+	})
+
+	Context("Next", func() {
+		DescribeTable("computes the next activation strictly after t",
+			func(expr string, expected time.Time) {
+				s, err := cron.Parse(expr)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(s.Next(base)).To(Equal(expected))
+			},
+			Entry("every minute", "* * * * *", time.Date(2026, time.March, 10, 13, 48, 0, 0, time.UTC)),
+			Entry("every 15 minutes", "*/15 * * * *", time.Date(2026, time.March, 10, 14, 0, 0, 0, time.UTC)),
+			Entry("daily at 02:00", "0 2 * * *", time.Date(2026, time.March, 11, 2, 0, 0, 0, time.UTC)),
+			Entry("@hourly", "@hourly", time.Date(2026, time.March, 10, 14, 0, 0, 0, time.UTC)),
+			Entry("weekly on sunday", "0 0 * * 0", time.Date(2026, time.March, 15, 0, 0, 0, 0, time.UTC)),
+			Entry("sunday as 7", "0 0 * * 7", time.Date(2026, time.March, 15, 0, 0, 0, 0, time.UTC)),
+			Entry("first of month", "0 0 1 * *", time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC)),
+			Entry("leap day", "0 0 29 2 *", time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)),
+			Entry("dom or dow when both restricted", "0 0 20 * 5", time.Date(2026, time.March, 13, 0, 0, 0, 0, time.UTC)),
+		)
+
+		// Given an expression that can never match
+		// When we compute the next activation
+		// Then it should return the zero time instead of looping forever
+		It("should return zero time for impossible expressions", func() {
+			// Arrange
+			s, err := cron.Parse("0 0 30 2 *")
+			Expect(err).NotTo(HaveOccurred())
+
+			// Act
+			next := s.Next(base)
+
+			// Assert
+			Expect(next.IsZero()).To(BeTrue())
+		})
+
+		// Given an instant exactly on an activation boundary
+		// When we compute the next activation
+		// Then it should skip to the following occurrence
+		It("should be strictly after t", func() {
+			// Arrange
+			s, err := cron.Parse("0 * * * *")
+			Expect(err).NotTo(HaveOccurred())
+			onBoundary := time.Date(2026, time.March, 10, 14, 0, 0, 0, time.UTC)
+
+			// Act
+			next := s.Next(onBoundary)
+
+			// Assert
+			Expect(next).To(Equal(time.Date(2026, time.March, 10, 15, 0, 0, 0, time.UTC)))
+		})
+	})
+})
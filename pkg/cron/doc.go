@@ -0,0 +1,16 @@
+// Package cron parses standard five-field cron expressions and computes their
+// next activation time.
+//
+// It is intentionally small: the agent only needs to answer "when is the next
+// run after t?" for recurring collection schedules, so there is no job runner,
+// no seconds field and no timezone handling beyond the location of the time
+// passed to Next.
+//
+// Example:
+//
+//	s, err := cron.Parse("0 2 * * 1-5") // 02:00 on weekdays
+//	if err != nil {
+//		return err
+//	}
+//	next := s.Next(time.Now())
+package cron
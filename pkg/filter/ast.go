@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type QuantityUnit int
@@ -67,6 +68,7 @@ func (e *stringExpression) Type() string { return "string" }
 // varExpression is a variable/identifier like "vm_id" or "primary_ip_address".
 type varExpression struct {
 	Name string
+	Pos  int
 }
 
 func (v *varExpression) String() string {
@@ -137,6 +139,20 @@ func newQuantityExpression(val string) *quantityExpression {
 	return qe
 }
 
+// inMb returns the value normalized to the Mb baseline.
+func (q *quantityExpression) inMb() float64 {
+	switch q.Unit {
+	case KbQuantityUnit:
+		return q.Value / 1024
+	case GbQuantityUnit:
+		return q.Value * 1024
+	case TbQuantityUnit:
+		return q.Value * 1024 * 1024
+	default:
+		return q.Value
+	}
+}
+
 func (q *quantityExpression) String() string {
 	if q.Unit == NoQuantityUnit {
 		return fmt.Sprintf("%.2f", q.Value)
@@ -146,6 +162,93 @@ func (q *quantityExpression) String() string {
 
 func (q *quantityExpression) Type() string { return "numeric" }
 
+// timestampLayouts are the accepted forms of timestamp literals. Timestamps without a zone are UTC.
+var timestampLayouts = []string{
+	"2006-01-02",
+	"2006-01-02T15:04",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04:05Z07:00",
+}
+
+// timeExpression is a point in time like 2026-01-01 or now - 7d, optionally shifted by a
+// duration. A nil Time means now, which is resolved when the SQL is generated.
+type timeExpression struct {
+	Time   *time.Time
+	Op     Token // plus or minus, when Offset is set
+	Offset *durationExpression
+}
+
+func newTimeExpression(pos int, val string) *timeExpression {
+	for _, layout := range timestampLayouts {
+		// fractional seconds are accepted after the seconds of any layout
+		if t, err := time.Parse(layout, strings.ToUpper(val)); err == nil {
+			t = t.UTC()
+			return &timeExpression{Time: &t}
+		}
+	}
+	panic(ParseError{pos, fmt.Sprintf("invalid timestamp %q: expected YYYY-MM-DD[THH:MM[:SS]][Z|±HH:MM]", val)})
+}
+
+// at returns the point in time, now being the given time.
+func (e *timeExpression) at(now time.Time) time.Time {
+	t := now
+	if e.Time != nil {
+		t = *e.Time
+	}
+	if e.Offset == nil {
+		return t
+	}
+	if e.Op == minus {
+		return t.Add(-e.Offset.duration())
+	}
+	return t.Add(e.Offset.duration())
+}
+
+func (e *timeExpression) String() string {
+	base := "now"
+	if e.Time != nil {
+		base = e.Time.Format(time.RFC3339Nano)
+	}
+	if e.Offset == nil {
+		return base
+	}
+	return fmt.Sprintf("(%s %s %s)", base, e.Op, e.Offset)
+}
+
+func (e *timeExpression) Type() string { return "time" }
+
+// durationUnits maps the duration units to their length.
+var durationUnits = map[byte]time.Duration{
+	's': time.Second,
+	'm': time.Minute,
+	'h': time.Hour,
+	'd': 24 * time.Hour,
+	'w': 7 * 24 * time.Hour,
+}
+
+// durationExpression is a duration like 30d or 1.5h.
+type durationExpression struct {
+	Value float64
+	Unit  byte // s, m, h, d or w
+}
+
+func newDurationExpression(val string) *durationExpression {
+	de := &durationExpression{Unit: val[len(val)-1]}
+	de.Value, _ = strconv.ParseFloat(val[:len(val)-1], 64)
+	return de
+}
+
+func (d *durationExpression) duration() time.Duration {
+	return time.Duration(d.Value * float64(durationUnits[d.Unit]))
+}
+
+func (d *durationExpression) String() string {
+	return strconv.FormatFloat(d.Value, 'f', -1, 64) + string(d.Unit)
+}
+
+func (d *durationExpression) Type() string { return "duration" }
+
 // inExpression is an expression like "field IN ['a', 'b']" or "field NOT IN ['a', 'b']".
 type inExpression struct {
 	Left    Expression
@@ -183,3 +286,80 @@ func (e *containsExpression) String() string {
 }
 
 func (e *containsExpression) Type() string { return "contains" }
+
+// notExpression is a negated sub-expression like "not (a = '1' and b = '2')".
+type notExpression struct {
+	Expr Expression
+	// Scope, when set, negates the expression for the entities of the scope rather than
+	// for each of their rows.
+	Scope *Scope
+}
+
+func (e *notExpression) String() string {
+	return fmt.Sprintf("(not %s)", e.Expr.String())
+}
+
+func (e *notExpression) Type() string { return "not" }
+
+// betweenExpression is a range test like "memory between 8GB and 32GB" or
+// "memory not between 8GB and 32GB". Both bounds are inclusive.
+type betweenExpression struct {
+	Left    Expression
+	Low     Expression
+	High    Expression
+	Negated bool
+}
+
+func (e *betweenExpression) String() string {
+	op := "BETWEEN"
+	if e.Negated {
+		op = "NOT BETWEEN"
+	}
+	return fmt.Sprintf("(%s %s %s AND %s)", e.Left.String(), op, e.Low.String(), e.High.String())
+}
+
+func (e *betweenExpression) Type() string { return "between" }
+
+// isExpression is a null or emptiness test like "host is null" or "labels is not empty".
+type isExpression struct {
+	Left      Expression
+	Predicate Token // null or empty
+	Negated   bool
+}
+
+func (e *isExpression) String() string {
+	op := "IS"
+	if e.Negated {
+		op = "IS NOT"
+	}
+	return fmt.Sprintf("(%s %s %s)", e.Left.String(), op, strings.ToUpper(e.Predicate.String()))
+}
+
+func (e *isExpression) Type() string { return "is" }
+
+// aggregateExpression is an aggregate over a collection like "count(disk)" or
+// "sum(disk.capacity)". It is always the left-hand side of a numeric comparison.
+type aggregateExpression struct {
+	Func string // count, sum, min, max or avg
+	Arg  string // collection name for count, collection field otherwise
+	Pos  int
+}
+
+func (e *aggregateExpression) String() string {
+	return fmt.Sprintf("%s(%s)", e.Func, e.Arg)
+}
+
+func (e *aggregateExpression) Type() string { return "aggregate" }
+
+// quantifierExpression tests the rows of a collection like "all(disk.thin = true)".
+type quantifierExpression struct {
+	Quantifier string // all, any or none
+	Expr       Expression
+	Pos        int
+}
+
+func (e *quantifierExpression) String() string {
+	return fmt.Sprintf("%s(%s)", e.Quantifier, e.Expr.String())
+}
+
+func (e *quantifierExpression) Type() string { return "quantifier" }
@@ -8,20 +8,32 @@
 //
 //	expression  : term ( "or" term )* ;
 //	term        : factor ( "and" factor )* ;
-//	factor      : equality | "(" expression ")" ;
+//	factor      : equality | "(" expression ")" | "not" factor ;
 //	equality    : IDENTIFIER ( "=" | "!=" | "<" | "<=" | ">" | ">=" ) value
 //	            | IDENTIFIER ( "~" | "!~" ) REGEX_LITERAL
 //	            | IDENTIFIER "in" "[" STRING ( "," STRING )* "]"
 //	            | IDENTIFIER "not" "in" "[" STRING ( "," STRING )* "]"
 //	            | IDENTIFIER "contains" STRING
-//	            | IDENTIFIER "not" "contains" STRING ;
-//	value       : STRING | QUANTITY | BOOLEAN ;
+//	            | IDENTIFIER "not" "contains" STRING
+//	            | IDENTIFIER [ "not" ] "between" bound "and" bound
+//	            | IDENTIFIER "is" [ "not" ] ( "null" | "empty" )
+//	            | QUANTIFIER "(" expression ")"
+//	            | AGGREGATE "(" IDENTIFIER ")" ( "=" | "!=" | "<" | "<=" | ">" | ">=" ) QUANTITY
+//	            | AGGREGATE "(" IDENTIFIER ")" [ "not" ] "between" QUANTITY "and" QUANTITY ;
+//	value       : STRING | QUANTITY | BOOLEAN | time | DURATION ;
+//	bound       : STRING | QUANTITY | time | DURATION ;
+//	time        : ( TIMESTAMP | "now" ) [ ( "+" | "-" ) DURATION ] ;
+//
+//	QUANTIFIER    : "all" | "any" | "none" ;
+//	AGGREGATE     : "count" | "sum" | "min" | "max" | "avg" ;
 //
 //	IDENTIFIER    : [a-zA-Z_][a-zA-Z0-9_.]* ;
 //	REGEX_LITERAL : '/' ( '\\/' | . )*? '/' ;
 //	STRING        : "'" (.*?) "'" | '"' (.*?) '"' ;
 //	BOOLEAN       : "true" | "false" ;
 //	QUANTITY      : [0-9]+(\.[0-9]+)? ( 'KB' | 'MB' | 'GB' | 'TB' )? ;
+//	TIMESTAMP     : [0-9]{4} '-' [0-9]{2} '-' [0-9]{2} ( 'T' [0-9:.]+ ( 'Z' | [+-] [0-9:]+ )? )? ;
+//	DURATION      : [0-9]+(\.[0-9]+)? ( 's' | 'm' | 'h' | 'd' | 'w' ) ;
 //
 // # Operators
 //
@@ -37,6 +49,13 @@
 //	not in      Exclusion test (SQL NOT IN clause)
 //	contains    Array contains element (for label arrays)
 //	not contains Array does not contain element
+//	between     Inclusive range test (SQL BETWEEN)
+//	not between Outside of an inclusive range
+//	is null     Value is missing
+//	is not null Value is present
+//	is empty    Array is missing or has no element
+//	is not empty Array has at least one element
+//	not         Logical negation of a sub-expression (highest precedence)
 //	and         Logical AND (higher precedence than OR)
 //	or          Logical OR
 //
@@ -64,6 +83,20 @@
 //	memory > 1024KB     // 1 MB
 //	count = 100         // plain number (no conversion)
 //
+// Times: ISO 8601 dates or date-times, or now, optionally shifted by a duration. Times
+// without a zone are UTC, and now is resolved once per generated query.
+//
+//	created_at < 2026-01-01
+//	booted_at >= 2026-03-01T08:00:00Z
+//	inspection.completed_at < now - 7d
+//
+// Durations: Numbers with a unit of seconds (s), minutes (m), hours (h), days (d) or
+// weeks (w). Compared with an age field, they test how long ago its time was; elsewhere
+// they are a number of seconds.
+//
+//	snapshot.age > 30d          // snapshot taken more than 30 days ago
+//	snapshot.age between 1w and 4w
+//
 // Regex: AWK-style patterns between forward slashes.
 //
 //	name ~ /^prod-.*/           // starts with "prod-"
@@ -85,6 +118,55 @@
 //	labels not contains 'test'
 //	labels contains 'wave-1' and labels not contains 'excluded'
 //
+// Ranges: Both bounds are inclusive and must be of the same kind. Quantity bounds
+// are normalized to MB and the lower bound must not exceed the upper one, as must
+// duration and time bounds.
+//
+//	memory between 8GB and 32GB
+//	created_at between 2025-01-01 and now - 1w
+//	cpus not between 2 and 4
+//	name between 'a' and 'm'
+//
+// Null and empty tests: IS NULL works on any field, IS EMPTY only on array fields.
+// A missing array is empty.
+//
+//	host is null
+//	ip_address is not null
+//	labels is empty
+//	groups is not empty
+//
+// # Negation
+//
+// NOT negates the factor that follows it, usually a parenthesized expression. A condition
+// that is unknown because its column is NULL counts as false, so the negation matches it:
+//
+//	not (cluster = 'prod' and memory > 8GB)  // includes VMs without a cluster
+//	not labels contains 'test'
+//
+// With ParseWithScope, where an entity spans several rows (a VM joined with its disks),
+// NOT holds for the entities none of whose rows match, so "not disk.capacity > 100GB"
+// selects the VMs without any disk over 100GB.
+//
+// # Quantifiers and Aggregates
+//
+// Plain fields of a child collection (e.g. the disks of a VM) implicitly mean "any row
+// matches". Quantifiers and aggregates range over a collection explicitly and compile to
+// correlated subqueries. They require ParseWithScope and the Collections of the scope;
+// Parse rejects them.
+//
+// A quantifier holds a predicate over the fields of a single collection:
+//
+//	all(disk.thin = true)              // every disk is thin (true when there are no disks)
+//	any(disk.capacity > 1TB)           // at least one disk is larger than 1TB
+//	none(net.connected = false)        // no NIC is disconnected
+//
+// count takes a collection; sum, min, max and avg take a numeric collection field. A
+// collection without rows has a count and a sum of 0, and no min, max or avg:
+//
+//	count(disk) > 3
+//	sum(disk.capacity) > 2TB
+//	max(disk.capacity) between 100GB and 1TB
+//
 // # Identifiers
 //
 // Identifiers support dotted notation for nested fields:
@@ -95,10 +177,11 @@
 //
 // # Operator Precedence
 //
-// AND binds tighter than OR. Use parentheses to override:
+// NOT binds tighter than AND, and AND binds tighter than OR. Use parentheses to override:
 //
 //	a = '1' or b = '2' and c = '3'       // a OR (b AND c)
 //	(a = '1' or b = '2') and c = '3'     // (a OR b) AND c
+//	not a = '1' and b = '2'              // (NOT a) AND b
 //
 // # Usage with squirrel SelectBuilder
 //
@@ -150,7 +233,7 @@
 // # Default Field Mapping
 //
 // ParseWithDefaultMap uses a built-in MapFunc that maps identifiers to SQL
-// column references in the flat filter subquery (see internal/store VMStore).
+// column references in the flat filter query (see internal/filter VMScope).
 // Flat names reference vinfo columns; dotted names reference joined tables:
 //
 // vinfo (v) — flat names:
@@ -172,7 +255,7 @@
 //
 // vm_inspection_status (i) — inspection.* prefix:
 //
-//	inspection.status, inspection.error
+//	inspection.status, inspection.error, inspection.completed_at
 //
 // vm_inspection_concerns (ic) — inspection_concern.* prefix (latest run only in filter subquery):
 //
@@ -197,6 +280,25 @@
 //	datastore.name, datastore.hosts, datastore.address, datastore.object_id,
 //	datastore.free, datastore.mha, datastore.capacity, datastore.type
 //
+// vm_lifecycle (lc) — flat names:
+//
+//	created_at, booted_at
+//
+// vm_migration_exclusions (ex) — exclusion.* prefix:
+//
+//	exclusion.reason, exclusion.justification, exclusion.by, exclusion.excluded_at,
+//	exclusion.expires_at
+//
+// vm_snapshots (snap) — snapshot.* prefix:
+//
+//	snapshot.name, snapshot.created_at, snapshot.age
+//
+// vm_waste (waste) — waste.* prefix:
+//
+//	waste.class
+//
+// Quantifiers and aggregates range over the disk, net, concern, snapshot and waste collections.
+//
 // # Group Field Mapping
 //
 // ParseWithGroupMap uses a group-specific MapFunc that maps identifiers to
@@ -259,6 +361,18 @@
 //	groups not contains 'test'
 //	groups contains 'critical' and labels contains 'production'
 //
+// Ranges, null tests and negation:
+//
+//	memory between 8GB and 32GB and labels is not empty
+//	dns_name is null or ip_address is null
+//	not (concern.category = 'Critical' or template = true)
+//
+// Times and ages:
+//
+//	snapshot.age > 30d or inspection.completed_at < now - 7d
+//	created_at >= 2026-01-01 and booted_at is not null
+//	count(snapshot) > 0 and all(snapshot.age > 1w)
+//
 // Combined filters:
 //
 //	memory >= 8GB and disk.capacity >= 100GB
@@ -276,4 +390,23 @@
 //
 //	_, err := filter.ParseWithDefaultMap([]byte("unknown_field = 'x'"))
 //	// err: unknown filter field: unknown_field
+//
+// Errors on fields, whether unknown or used with a value of the wrong type, are wrapped in a
+// *FieldError carrying the field and its position in the source.
+//
+// # Saved Filter References
+//
+// An expression can reference a saved filter as @name, names being made of letters, digits,
+// '_' and '-'. References must be inlined with Expand before parsing:
+//
+//	exp, err := filter.Expand([]byte("@prod and memory > 16GB"), lookup)
+//	// exp.Source: (cluster = 'prod') and memory > 16GB
+//
+// Unknown references and reference cycles are reported as a *ReferenceError. Expansion.Position
+// maps the positions of later errors back to the original expression.
+//
+// # Expression Trees
+//
+// ParseTree returns the parsed expression as a Node tree without resolving its fields, to
+// show how an expression was understood.
 package filter
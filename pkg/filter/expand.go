@@ -0,0 +1,181 @@
+package filter
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	// maxReferenceDepth bounds how deeply saved filters may reference each other.
+	maxReferenceDepth = 10
+	// maxExpandedLength bounds the length of an expanded expression, since saved filters
+	// referencing the same filter several times grow exponentially with their depth.
+	maxExpandedLength = 64 << 10
+)
+
+// ReferenceFunc returns the expression of the saved filter with the given name.
+type ReferenceFunc func(name string) (string, error)
+
+// ReferenceError is returned by Expand when a saved filter reference cannot be expanded.
+type ReferenceError struct {
+	// Source column position of the reference in the expression given to Expand.
+	Position int
+	// Name of the referenced saved filter, without the '@'.
+	Name string
+	Err  error
+}
+
+// Error returns a formatted version of the error, including the reference.
+func (e *ReferenceError) Error() string {
+	return fmt.Sprintf("saved filter @%s: %v", e.Name, e.Err)
+}
+
+func (e *ReferenceError) Unwrap() error {
+	return e.Err
+}
+
+var errExpansionTooLong = fmt.Errorf("expanded expression is longer than %d bytes", maxExpandedLength)
+
+// Expansion is an expression whose saved filter references have been inlined.
+type Expansion struct {
+	// Source is the expanded expression.
+	Source []byte
+	spans  []expansionSpan
+}
+
+// expansionSpan records where a reference of the original expression was inlined.
+type expansionSpan struct {
+	start, end int // span of the inlined expression in Source
+	origin     int // position of the reference in the original expression
+	shift      int // length difference between the inlined expression and the reference
+}
+
+// Position maps a position of the expanded expression back to the original one. Positions
+// inside an inlined saved filter map to the reference it came from.
+func (e *Expansion) Position(pos int) int {
+	shift := 0
+	for _, s := range e.spans {
+		if pos < s.start {
+			break
+		}
+		if pos < s.end {
+			return s.origin
+		}
+		shift += s.shift
+	}
+	return pos - shift
+}
+
+// Expand inlines the saved filters referenced as @name in src, each wrapped in parentheses,
+// resolving nested references through rf. Unknown references, reference cycles, references
+// nested deeper than maxReferenceDepth and expansions longer than maxExpandedLength are
+// reported as a *ReferenceError positioned at the reference of src they stem from. Syntax
+// errors are left for the parser.
+func Expand(src []byte, rf ReferenceFunc) (*Expansion, error) {
+	exp := &Expansion{}
+	var out strings.Builder
+
+	last := 0
+	for _, ref := range scanReferences(src) {
+		inlined, err := expandReference(ref.name, rf, nil)
+		if err != nil {
+			return nil, &ReferenceError{Position: ref.pos, Name: ref.name, Err: err}
+		}
+
+		out.Write(src[last:ref.pos])
+		start := out.Len()
+		out.WriteString(inlined)
+		if out.Len() > maxExpandedLength {
+			return nil, &ReferenceError{Position: ref.pos, Name: ref.name, Err: errExpansionTooLong}
+		}
+		exp.spans = append(exp.spans, expansionSpan{
+			start:  start,
+			end:    out.Len(),
+			origin: ref.pos,
+			shift:  len(inlined) - (ref.end - ref.pos),
+		})
+		last = ref.end
+	}
+	if len(exp.spans) == 0 {
+		exp.Source = src
+		return exp, nil
+	}
+	out.Write(src[last:])
+	exp.Source = []byte(out.String())
+
+	return exp, nil
+}
+
+// References returns the names of the saved filters referenced directly by src, in order
+// of first appearance.
+func References(src []byte) []string {
+	var names []string
+	for _, ref := range scanReferences(src) {
+		if !slices.Contains(names, ref.name) {
+			names = append(names, ref.name)
+		}
+	}
+	return names
+}
+
+// expandReference returns the parenthesized expression of the saved filter name with its own
+// references inlined. stack holds the saved filters being expanded, to detect cycles.
+func expandReference(name string, rf ReferenceFunc, stack []string) (string, error) {
+	if slices.Contains(stack, name) {
+		return "", fmt.Errorf("reference cycle: @%s -> @%s", strings.Join(stack, " -> @"), name)
+	}
+	if len(stack) >= maxReferenceDepth {
+		return "", fmt.Errorf("maximum level of nested saved filters reached: %d", maxReferenceDepth)
+	}
+
+	expr, err := rf(name)
+	if err != nil {
+		return "", err
+	}
+	src := []byte(expr)
+	stack = append(stack, name)
+
+	var out strings.Builder
+	out.WriteByte('(')
+	last := 0
+	for _, ref := range scanReferences(src) {
+		inlined, err := expandReference(ref.name, rf, stack)
+		if err != nil {
+			return "", err
+		}
+		out.Write(src[last:ref.pos])
+		out.WriteString(inlined)
+		// Every inlined reference adds at least its parentheses, so this also bounds the
+		// number of references expanded.
+		if out.Len() > maxExpandedLength {
+			return "", errExpansionTooLong
+		}
+		last = ref.end
+	}
+	out.Write(src[last:])
+	out.WriteByte(')')
+
+	return out.String(), nil
+}
+
+type referenceToken struct {
+	pos, end int
+	name     string
+}
+
+// scanReferences lexes src and returns its references. Scanning stops at the first illegal
+// token since the rest of src cannot be tokenized reliably.
+func scanReferences(src []byte) []referenceToken {
+	var refs []referenceToken
+	l := newLexer(src)
+	for {
+		pos, tok, val := l.Scan()
+		switch tok {
+		case eol, illegal:
+			return refs
+		case reference:
+			refs = append(refs, referenceToken{pos: pos, end: pos + 1 + len(val), name: val})
+		}
+	}
+}
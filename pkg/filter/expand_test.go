@@ -0,0 +1,116 @@
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Expand", func() {
+	savedFilters := map[string]string{
+		"prod":     "cluster = 'prod'",
+		"big":      "memory > 16GB",
+		"big-prod": "@prod and @big",
+		"loop-a":   "@loop-b",
+		"loop-b":   "name = 'x' or @loop-a",
+	}
+	rf := func(name string) (string, error) {
+		expr, ok := savedFilters[name]
+		if !ok {
+			return "", fmt.Errorf("not found")
+		}
+		return expr, nil
+	}
+
+	mf := MapFunc(func(name string) (string, FieldType, error) {
+		switch name {
+		case "cluster", "name":
+			return fmt.Sprintf(`"%s"`, name), StringField, nil
+		case "memory", "cpus":
+			return fmt.Sprintf(`"%s"`, name), NumericField, nil
+		default:
+			return "", 0, fmt.Errorf("unknown filter field: %s", name)
+		}
+	})
+
+	It("should leave expressions without references unchanged", func() {
+		exp, err := Expand([]byte("name = '@prod'"), rf)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(exp.Source)).To(Equal("name = '@prod'"))
+		Expect(exp.Position(7)).To(Equal(7))
+	})
+
+	It("should inline references in parentheses", func() {
+		exp, err := Expand([]byte("@prod and cpus > 2"), rf)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(exp.Source)).To(Equal("(cluster = 'prod') and cpus > 2"))
+
+		_, err = Parse(exp.Source, mf)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should inline nested references", func() {
+		exp, err := Expand([]byte("not @big-prod"), rf)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(exp.Source)).To(Equal("not ((cluster = 'prod') and (memory > 16GB))"))
+	})
+
+	It("should map positions back to the original expression", func() {
+		exp, err := Expand([]byte("@prod and cpus > 'x'"), rf)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = Parse(exp.Source, mf)
+		var fe *FieldError
+		Expect(errors.As(err, &fe)).To(BeTrue())
+		Expect(exp.Position(fe.Position)).To(Equal(10))
+		Expect(exp.Position(3)).To(Equal(0))
+	})
+
+	It("should report unknown references with their position", func() {
+		_, err := Expand([]byte("cpus > 2 and @missing"), rf)
+		var re *ReferenceError
+		Expect(errors.As(err, &re)).To(BeTrue())
+		Expect(re.Name).To(Equal("missing"))
+		Expect(re.Position).To(Equal(13))
+	})
+
+	It("should detect reference cycles", func() {
+		_, err := Expand([]byte("@loop-a"), rf)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("reference cycle: @loop-a -> @loop-b -> @loop-a"))
+	})
+
+	It("should bound the length of the expansion", func() {
+		fanOut := map[string]string{"f0": "cpus > 2"}
+		for i := 1; i < maxReferenceDepth; i++ {
+			prev := fmt.Sprintf("@f%d", i-1)
+			fanOut[fmt.Sprintf("f%d", i)] = strings.Join([]string{prev, prev, prev, prev}, " or ")
+		}
+		calls := 0
+		frf := func(name string) (string, error) {
+			calls++
+			return fanOut[name], nil
+		}
+
+		_, err := Expand([]byte("name = 'x' and @f9"), frf)
+		var re *ReferenceError
+		Expect(errors.As(err, &re)).To(BeTrue())
+		Expect(re.Name).To(Equal("f9"))
+		Expect(re.Position).To(Equal(15))
+		Expect(errors.Is(err, errExpansionTooLong)).To(BeTrue())
+		Expect(calls).To(BeNumerically("<", maxExpandedLength))
+	})
+
+	It("should list direct references once", func() {
+		Expect(References([]byte("@prod or (@big and @prod) or name = '@other'"))).To(Equal([]string{"prod", "big"}))
+		Expect(References([]byte("cpus > 2"))).To(BeEmpty())
+	})
+
+	It("should refuse to parse unexpanded references", func() {
+		_, err := Parse([]byte("@prod and cpus > 2"), mf)
+		Expect(err).To(MatchError(ContainSubstring("@prod must be expanded")))
+	})
+})
@@ -1,6 +1,7 @@
 package filter_test
 
 import (
+	"errors"
 	"regexp"
 	"strings"
 	"testing"
@@ -23,6 +24,29 @@ func FuzzParse(f *testing.F) {
 	f.Add([]byte("status in []"))
 	f.Add([]byte("name like 'prod'"))
 	f.Add([]byte("name like 'web' and active = true"))
+	f.Add([]byte("not (a = '1' and b = '2')"))
+	f.Add([]byte("not not a = '1' or not (b = '2')"))
+	f.Add([]byte("memory between 8GB and 32GB"))
+	f.Add([]byte("cpus not between 2 and 4 and name between 'a' and 'm'"))
+	f.Add([]byte("host is null or host is not null"))
+	f.Add([]byte("labels is empty and not labels is not empty"))
+	f.Add([]byte("memory between 32GB and 8GB"))
+	f.Add([]byte("host is"))
+	f.Add([]byte("not"))
+	f.Add([]byte("all(a.b = '1') and count(a) > 3"))
+	f.Add([]byte("none(a.b = true or a.c != 'x') or sum(a.b) between 1GB and 2GB"))
+	f.Add([]byte("any(any(a.b = '1'))"))
+	f.Add([]byte("count(a"))
+	f.Add([]byte("@prod and cpus > 2"))
+	f.Add([]byte("not @a or @b-c"))
+	f.Add([]byte("@"))
+	f.Add([]byte("created_at < 2026-01-01 and booted_at >= 2026-03-01T08:00:00Z"))
+	f.Add([]byte("inspection.completed_at < now - 7d or a > now + 1.5h"))
+	f.Add([]byte("snapshot.age > 30d and snapshot.age between 1w and 4w"))
+	f.Add([]byte("created_at between 2025-01-01 and now - 1w"))
+	f.Add([]byte("a = 2026-13-45T99:99:99+99:99"))
+	f.Add([]byte("a < now -"))
+	f.Add([]byte("a > 7x"))
 	f.Add([]byte(""))
 	f.Add([]byte("((("))
 	f.Add([]byte("name = ''"))
@@ -47,6 +71,52 @@ func FuzzParse(f *testing.F) {
 	})
 }
 
+// FuzzExpand verifies that expanding saved filter references never panics and that
+// positions reported by Expand stay within the original expression.
+func FuzzExpand(f *testing.F) {
+	f.Add([]byte("@prod and cpus > 2"))
+	f.Add([]byte("not @a or @b-c"))
+	f.Add([]byte("@loop"))
+	f.Add([]byte("name = '@prod' and @"))
+	f.Add([]byte("@@prod"))
+
+	saved := map[string]string{
+		"prod": "cluster = 'prod'",
+		"a":    "@prod and memory > 8GB",
+		"b-c":  "not @a",
+		"loop": "@loop or cpus = 1",
+	}
+	rf := func(name string) (string, error) {
+		if expr, ok := saved[name]; ok {
+			return expr, nil
+		}
+		return "", errors.New("not found")
+	}
+	mf := filter.MapFunc(func(name string) (string, filter.FieldType, error) {
+		return `"` + name + `"`, filter.AnyField, nil
+	})
+
+	f.Fuzz(func(t *testing.T, input []byte) {
+		exp, err := filter.Expand(input, rf)
+		if err != nil {
+			var refErr *filter.ReferenceError
+			if !errors.As(err, &refErr) {
+				t.Fatalf("Expand returned %T, want *ReferenceError: %v", err, err)
+			}
+			if refErr.Position < 0 || refErr.Position > len(input) {
+				t.Fatalf("reference position %d outside of input of length %d", refErr.Position, len(input))
+			}
+			return
+		}
+		for pos := range exp.Source {
+			if p := exp.Position(pos); p < 0 || p > len(input) {
+				t.Fatalf("position %d maps to %d, outside of input of length %d", pos, p, len(input))
+			}
+		}
+		_, _ = filter.Parse(exp.Source, mf)
+	})
+}
+
 // FuzzParseSecurityProperties verifies that parsing produces safe, parameterized SQL.
 // This fuzz test checks security invariants:
 // 1. User-provided string values must be parameterized (not embedded in SQL)
@@ -99,6 +169,26 @@ func FuzzParseSecurityProperties(f *testing.F) {
 	f.Add([]byte("cluster in ['a', 'b; DROP TABLE--']"))
 	f.Add([]byte("cluster in ['normal', '\\'; DELETE FROM x--']"))
 
+	// BETWEEN, NOT and IS injection
+	f.Add([]byte("name between 'a\\' OR 1=1--' and 'z'"))
+	f.Add([]byte("not (name = '; DROP TABLE vms; --')"))
+	f.Add([]byte("labels is empty or name = 'x; DELETE FROM users'"))
+	f.Add([]byte("memory between 1GB and 2GB and host is not null"))
+
+	// Quantifier and aggregate injection
+	f.Add([]byte("any(disk.label = '; DROP TABLE vdisk; --')"))
+	f.Add([]byte("all(net.network ~ /'; DELETE/) and count(disk) > 1"))
+	f.Add([]byte("sum(disk.capacity) > 1TB or none(concern.label = 'x UNION SELECT 1')"))
+
+	// Saved filter references
+	f.Add([]byte("@prod and name = '; DROP TABLE vms; --'"))
+	f.Add([]byte("not @prod or cluster = 'x'"))
+
+	// Times, durations and ages
+	f.Add([]byte("snapshot.age > 30d and name = '; DROP TABLE vm_snapshots; --'"))
+	f.Add([]byte("created_at < now - 7d or booted_at between 2026-01-01 and 2026-02-01T00:00:00Z"))
+	f.Add([]byte("all(snapshot.age > 1w) and inspection.completed_at is null"))
+
 	// Long strings
 	f.Add([]byte("name = '" + strings.Repeat("a", 1000) + "'"))
 
@@ -147,6 +237,19 @@ func FuzzIdentifierWhitelist(f *testing.F) {
 	// LIKE operator
 	f.Add([]byte("name like 'prod'"))
 
+	// Ranges and null tests
+	f.Add([]byte("memory between 1GB and 2GB"))
+	f.Add([]byte("secret between 'a' and 'z'"))
+	f.Add([]byte("labels is not empty"))
+	f.Add([]byte("password is null"))
+	f.Add([]byte("not (hidden_column = 'x')"))
+
+	// Quantifiers and aggregates
+	f.Add([]byte("all(disk.thin = true)"))
+	f.Add([]byte("count(secret) > 1"))
+	f.Add([]byte("sum(disk.password) > 1"))
+	f.Add([]byte("any(internal.field = 'x')"))
+
 	// Mixed valid and invalid
 	f.Add([]byte("name = 'test' and invalid_field = 'x'"))
 
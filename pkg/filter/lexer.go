@@ -36,10 +36,11 @@ func (l *lexer) Scan() (int, Token, string) {
 	ch := l.ch
 	l.next()
 
-	// keywords and identifiers
+	// keywords and identifiers; each dotted segment starts with a letter or '_', so that
+	// names like iops_p95 are identifiers while 30days stays a malformed duration
 	if isIdentifierStart(ch) {
 		start := l.tokenStart()
-		for isIdentifierStart(l.ch) || isDot(l.ch) {
+		for isIdentifierStart(l.ch) || isDigit(l.ch) || isDot(l.ch) {
 			if isDot(l.ch) {
 				l.next()
 				if !isIdentifierStart(l.ch) {
@@ -60,11 +61,21 @@ func (l *lexer) Scan() (int, Token, string) {
 			tok = in
 		case "contains":
 			tok = contains
+		case "between":
+			tok = between
+		case "is":
+			tok = is
+		case "null":
+			tok = null
+		case "empty":
+			tok = empty
 		case "true", "false":
 			tok = boolean
 			val = name
 		case "like":
 			tok = like2
+		case "now":
+			tok = now
 		default:
 			tok = identifier
 			val = name
@@ -85,6 +96,23 @@ func (l *lexer) Scan() (int, Token, string) {
 			l.next()
 		}
 
+		// a four digit year followed by '-' starts a timestamp
+		if l.ch == '-' && !hasDot && l.tokenEnd()-start == 4 {
+			if msg := l.scanTimestamp(); msg != "" {
+				return pos, illegal, msg
+			}
+			return pos, timestamp, string(l.src[start:l.tokenEnd()])
+		}
+
+		// 'm' is a duration in minutes unless it starts MB
+		if isDurationUnit(l.ch) && !(l.ch == 'm' && (l.peek() == 'b' || l.peek() == 'B')) {
+			l.next()
+			if isIdentifierStart(l.ch) || isDigit(l.ch) {
+				return pos, illegal, "duration unit is malformed"
+			}
+			return pos, duration, string(l.src[start:l.tokenEnd()])
+		}
+
 		if isUnitStart(l.ch) {
 			l.next()
 			if l.ch != 'b' && l.ch != 'B' {
@@ -108,6 +136,10 @@ func (l *lexer) Scan() (int, Token, string) {
 		tok = rSquareBracket
 	case ',':
 		tok = comma
+	case '+':
+		tok = plus
+	case '-':
+		tok = minus
 	case '=':
 		tok = equal
 	case '~':
@@ -175,6 +207,16 @@ func (l *lexer) Scan() (int, Token, string) {
 		l.next()
 		tok = regexLit
 		val = string(chars)
+	case '@':
+		start := l.tokenStart() + 1
+		for isReferenceChar(l.ch) {
+			l.next()
+		}
+		if l.tokenEnd() == start {
+			return pos, illegal, "expected saved filter name after '@'"
+		}
+		tok = reference
+		val = string(l.src[start:l.tokenEnd()])
 	default:
 		tok = illegal
 		val = fmt.Sprintf("unexpected character '%c'", ch)
@@ -183,6 +225,59 @@ func (l *lexer) Scan() (int, Token, string) {
 	return pos, tok, val
 }
 
+// scanTimestamp scans the rest of a timestamp after its year, starting at the '-':
+// -MM-DD, then an optional time (THH:MM[:SS[.fraction]]) and zone (Z or ±HH:MM).
+// It returns a message describing the error, if any. The timestamp itself is validated
+// by the parser.
+func (l *lexer) scanTimestamp() string {
+	for range 2 {
+		if l.ch != '-' {
+			return "timestamp date is malformed, expected YYYY-MM-DD"
+		}
+		l.next()
+		if !isDigit(l.ch) || !isDigit(l.peek()) {
+			return "timestamp date is malformed, expected YYYY-MM-DD"
+		}
+		l.next()
+		l.next()
+	}
+
+	if l.ch != 'T' && l.ch != 't' {
+		return l.checkTimestampEnd()
+	}
+	l.next()
+	for isDigit(l.ch) || l.ch == ':' || isDot(l.ch) {
+		l.next()
+	}
+
+	switch {
+	case l.ch == 'Z' || l.ch == 'z':
+		l.next()
+	case (l.ch == '+' || l.ch == '-') && isDigit(l.peek()):
+		l.next()
+		for isDigit(l.ch) || l.ch == ':' {
+			l.next()
+		}
+	}
+	return l.checkTimestampEnd()
+}
+
+// checkTimestampEnd reports a timestamp running into an identifier or a number.
+func (l *lexer) checkTimestampEnd() string {
+	if isIdentifierStart(l.ch) || isDigit(l.ch) || isDot(l.ch) {
+		return "timestamp is malformed"
+	}
+	return ""
+}
+
+// peek returns the character following l.ch (or 0 on end of input) without consuming it.
+func (l *lexer) peek() byte {
+	if l.offset < len(l.src) {
+		return l.src[l.offset]
+	}
+	return 0
+}
+
 // Load the next character into l.ch (or 0 on end of input) and update line position.
 func (l *lexer) next() {
 	l.pos = l.nextPos
@@ -210,6 +305,11 @@ func isDot(ch byte) bool {
 	return ch == '.'
 }
 
+// isReferenceChar reports whether ch can appear in the name of a saved filter reference.
+func isReferenceChar(ch byte) bool {
+	return isIdentifierStart(ch) || isDigit(ch) || ch == '-'
+}
+
 func isDigit(ch byte) bool {
 	return ch >= '0' && ch <= '9'
 }
@@ -238,3 +338,13 @@ func isUnitStart(ch byte) bool {
 		return false
 	}
 }
+
+// isDurationUnit reports whether ch is a duration unit: seconds, minutes, hours, days or weeks.
+func isDurationUnit(ch byte) bool {
+	switch ch {
+	case 's', 'm', 'h', 'd', 'w':
+		return true
+	default:
+		return false
+	}
+}
@@ -132,6 +132,27 @@ var _ = Describe("Lexer", func() {
 			{input: "0.5", output: "quantity eol"},
 			{input: "100.25", output: "quantity eol"},
 
+			// ===== TIMESTAMPS =====
+			{input: "2026-01-01", output: "timestamp eol"},
+			{input: "2026-01-01T10:30", output: "timestamp eol"},
+			{input: "2026-01-01T10:30:15.5Z", output: "timestamp eol"},
+			{input: "2026-01-01T10:30:15+02:00", output: "timestamp eol"},
+			{input: "2026-01-01T10:30-05:00", output: "timestamp eol"},
+			{input: "2026-1-01", output: "illegal quantity - quantity eol"},
+			{input: "2026-01-01x", output: "illegal identifier eol"},
+
+			// ===== DURATIONS AND NOW =====
+			{input: "30s", output: "duration eol"},
+			{input: "15m", output: "duration eol"},
+			{input: "1.5h", output: "duration eol"},
+			{input: "30d", output: "duration eol"},
+			{input: "2w", output: "duration eol"},
+			{input: "15mb", output: "quantity eol"},
+			{input: "30days", output: "illegal identifier eol"},
+			{input: "now", output: "now eol"},
+			{input: "NOW - 7d", output: "now - duration eol"},
+			{input: "2026-01-01 + 12h", output: "timestamp + duration eol"},
+
 			// ===== IDENTIFIERS / VARIABLES =====
 			// Simple identifiers
 			{input: "name", output: "identifier eol"},
@@ -143,6 +164,8 @@ var _ = Describe("Lexer", func() {
 			{input: "user.name", output: "identifier eol"},
 			{input: "vm.host.datacenter", output: "identifier eol"},
 			{input: "a.b.c.d.e", output: "identifier eol"},
+			{input: "utilization.iops_p95", output: "identifier eol"},
+			{input: "disk2.size", output: "identifier eol"},
 
 			// Multiple identifiers
 			{input: "name description", output: "identifier identifier eol"},
@@ -181,6 +204,12 @@ var _ = Describe("Lexer", func() {
 			// Complex nested field expressions
 			{input: "vm.host.name = 'host1' and vm.status = 'running'", output: "identifier equal stringLit and identifier equal stringLit eol"},
 
+			// ===== SAVED FILTER REFERENCES =====
+			{input: "@prod", output: "reference eol"},
+			{input: "@windows-legacy", output: "reference eol"},
+			{input: "@prod and memory > 16GB", output: "reference and identifier greater quantity eol"},
+			{input: "not (@a or @b_2)", output: "not lbracket reference or reference rbracket eol"},
+
 			// ===== EDGE CASES =====
 			// Operators without spaces
 			{input: "name='test'", output: "identifier equal stringLit eol"},
@@ -209,7 +238,7 @@ var _ = Describe("Lexer", func() {
 
 			// ===== ILLEGAL TOKENS =====
 			{input: "!", output: "illegal eol"},  // incomplete != or !~
-			{input: "@", output: "illegal eol"},  // unsupported character
+			{input: "@", output: "illegal eol"},  // reference without a name
 			{input: "#", output: "illegal eol"},  // unsupported character
 			{input: "$", output: "illegal eol"},  // unsupported character
 			{input: "%", output: "illegal eol"},  // unsupported character
@@ -272,6 +301,26 @@ var _ = Describe("Lexer", func() {
 				input:  "name ~ /prod/ and enabled = true and count > '10'",
 				output: "identifier like regexLit and identifier equal boolean and identifier greater stringLit eol",
 			},
+
+			// Ranges, null and empty tests
+			{
+				input:  "memory between 8GB and 32GB",
+				output: "identifier between quantity and quantity eol",
+			},
+			{
+				input:  "host IS NOT NULL",
+				output: "identifier is not null eol",
+			},
+			{
+				input:  "not (labels is empty)",
+				output: "not lbracket identifier is empty rbracket eol",
+			},
+
+			// Times and durations
+			{
+				input:  "snapshot.age > 30d or inspection.completed_at < now - 7d",
+				output: "identifier greater duration or identifier less now - duration eol",
+			},
 		}
 
 		for _, test := range tests {
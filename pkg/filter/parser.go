@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 )
@@ -25,6 +26,25 @@ func (e ParseError) Error() string {
 	return fmt.Sprintf("parse error at %d: %s", e.Position, e.Message)
 }
 
+// FieldError is returned when a field of an expression cannot be resolved or is used
+// with a value or operator it does not support.
+type FieldError struct {
+	// Source column position of the field.
+	Position int
+	// Field as written in the expression. For aggregates, the aggregated collection or field.
+	Field string
+	Err   error
+}
+
+// Error returns the message of the underlying error.
+func (e *FieldError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
 type parser struct {
 	lexer           *lexer
 	pos             int    // position of last token (tok)
@@ -42,6 +62,32 @@ func Parse(src []byte, mf MapFunc) (sq.Sqlizer, error) {
 	return toSql(expr, mf)
 }
 
+// ParseWithScope is like Parse for a filter evaluated on scope, where an entity spans
+// several rows. The negation of an expression then holds for the entities none of whose
+// rows match it, and quantifiers (all, any, none) and aggregates (count, sum, min, max,
+// avg) range over the collections of the scope.
+func ParseWithScope(src []byte, mf MapFunc, scope Scope) (sq.Sqlizer, error) {
+	expr, err := parse(src)
+	if err != nil {
+		return nil, err
+	}
+	setScope(expr, &scope)
+	return (&sqlGenerator{mf: mf, cf: scope.Collections}).toSql(expr)
+}
+
+// setScope sets the scope of every negation in expr. Negations inside a quantifier apply
+// to the rows of its collection and are left unscoped.
+func setScope(expr Expression, scope *Scope) {
+	switch e := expr.(type) {
+	case *binaryExpression:
+		setScope(e.Left, scope)
+		setScope(e.Right, scope)
+	case *notExpression:
+		e.Scope = scope
+		setScope(e.Expr, scope)
+	}
+}
+
 // parse uses panic/recover internally so recursive-descent methods can
 // signal errors without threading (Expression, error) through every call.
 // ParseError panics are caught here and returned as normal errors;
@@ -72,11 +118,7 @@ func parse(src []byte) (expr Expression, err error) {
 //
 // term ( "or" term )*
 func (p *parser) expression() Expression {
-	p.countExpression++
-	if p.countExpression > maxNestedLevels {
-		panic(p.errorf(p.pos, "maximum level of nested expression reached: %d", maxNestedLevels))
-	}
-	defer func() { p.countExpression-- }()
+	defer p.nest()()
 
 	expr := p.term()
 
@@ -106,10 +148,18 @@ func (p *parser) term() Expression {
 	return expr
 }
 
-// factor parses a single comparison or grouped expression.
+// factor parses a single comparison, a grouped expression or a negation.
+//
+// equality | "(" expression ")" | "not" factor
 //
-// equality | "(" expression ")"
+// Saved filter references (@name) are rejected: Expand inlines them first.
 func (p *parser) factor() Expression {
+	if p.matches(not) {
+		defer p.nest()()
+		p.next()
+		return &notExpression{Expr: p.factor()}
+	}
+
 	if p.matches(lbracket) {
 		p.next()
 		expr := p.expression()
@@ -118,6 +168,10 @@ func (p *parser) factor() Expression {
 		return expr
 	}
 
+	if p.matches(reference) {
+		panic(p.errorf(p.pos, "saved filter reference @%s must be expanded before parsing", p.val))
+	}
+
 	return p.equality()
 }
 
@@ -128,11 +182,26 @@ func (p *parser) factor() Expression {
 // IDENTIFIER "not" "in" "[" STRING ( "," STRING )* "]"
 // IDENTIFIER "contains" STRING
 // IDENTIFIER "not" "contains" STRING
+// IDENTIFIER [ "not" ] "between" bound "and" bound
+// IDENTIFIER "is" [ "not" ] ( "null" | "empty" )
+// call
 func (p *parser) equality() Expression {
 	p.expect(identifier)
-	left := &varExpression{Name: p.val}
+	pos, name := p.pos, p.val
 	p.next()
 
+	if p.tok == lbracket {
+		return p.call(pos, name)
+	}
+	left := &varExpression{Name: name, Pos: pos}
+
+	if p.tok == between {
+		return p.between(left, false)
+	}
+	if p.tok == is {
+		return p.is(left)
+	}
+
 	// Handle IN and NOT IN operators
 	if p.tok == in {
 		p.next()
@@ -153,7 +222,10 @@ func (p *parser) equality() Expression {
 			p.next()
 			return &containsExpression{Left: left, Value: value, Negated: true}
 		}
-		panic(p.errorf(p.pos, "expected 'in' or 'contains' after 'not'"))
+		if p.tok == between {
+			return p.between(left, true)
+		}
+		panic(p.errorf(p.pos, "expected 'in', 'contains' or 'between' after 'not'"))
 	}
 
 	// Handle CONTAINS operator
@@ -187,6 +259,116 @@ func (p *parser) equality() Expression {
 	return &binaryExpression{Left: left, Op: op, Right: right}
 }
 
+// call parses a quantifier or an aggregate comparison, starting at the "(" token.
+//
+// ( "all" | "any" | "none" ) "(" expression ")"
+// "count" "(" IDENTIFIER ")" comparison
+// ( "sum" | "min" | "max" | "avg" ) "(" IDENTIFIER ")" comparison
+//
+// where comparison is ( "=" | "!=" | "<" | "<=" | ">" | ">=" ) QUANTITY or a range test.
+func (p *parser) call(pos int, name string) Expression {
+	fn := strings.ToLower(name)
+	switch fn {
+	case "all", "any", "none":
+		p.next()
+		expr := p.expression()
+		p.expect(rbracket)
+		p.next()
+		return &quantifierExpression{Quantifier: fn, Expr: expr, Pos: pos}
+	case "count", "sum", "min", "max", "avg":
+	default:
+		panic(p.errorf(pos, "unknown function %q", name))
+	}
+
+	p.next()
+	p.expect(identifier)
+	arg := p.val
+	if fn == "count" && strings.Contains(arg, ".") {
+		panic(p.errorf(p.pos, "count expects a collection instead of field %q", arg))
+	}
+	if fn != "count" && !strings.Contains(arg, ".") {
+		panic(p.errorf(p.pos, "%s expects a collection field instead of %q", fn, arg))
+	}
+	p.next()
+	p.expect(rbracket)
+	p.next()
+
+	left := &aggregateExpression{Func: fn, Arg: arg, Pos: pos}
+	switch p.tok {
+	case between:
+		return p.between(left, false)
+	case not:
+		p.next()
+		p.expect(between)
+		return p.between(left, true)
+	case equal, notEqual, greater, gte, less, lte:
+		op := p.tok
+		p.next()
+		p.expect(quantity)
+		return &binaryExpression{Left: left, Op: op, Right: p.value()}
+	default:
+		panic(p.errorf(p.pos, "expected comparison after %s instead of %s", left, p.tok))
+	}
+}
+
+// between parses the bounds of a range test, starting at the "between" token.
+// Both bounds must be of the same kind and quantity, duration and time bounds must be in order.
+func (p *parser) between(left Expression, negated bool) Expression {
+	pos := p.pos
+	p.next()
+
+	low := p.bound()
+	p.expect(and)
+	p.next()
+	high := p.bound()
+
+	if low.Type() != high.Type() {
+		panic(p.errorf(pos, "between bounds must have the same type, got %s and %s", low.Type(), high.Type()))
+	}
+	outOfOrder := false
+	switch l := low.(type) {
+	case *quantityExpression:
+		outOfOrder = l.inMb() > high.(*quantityExpression).inMb()
+	case *durationExpression:
+		outOfOrder = l.duration() > high.(*durationExpression).duration()
+	case *timeExpression:
+		now := time.Now()
+		outOfOrder = l.at(now).After(high.(*timeExpression).at(now))
+	}
+	if outOfOrder {
+		panic(p.errorf(pos, "between lower bound %s is greater than upper bound %s", low, high))
+	}
+
+	return &betweenExpression{Left: left, Low: low, High: high, Negated: negated}
+}
+
+// bound parses a range bound (string, quantity, time or duration).
+func (p *parser) bound() Expression {
+	if !p.matches(stringLit, quantity, timestamp, now, duration) {
+		panic(p.errorf(p.pos, "expected string, quantity, time or duration bound instead of %s", p.tok))
+	}
+	return p.value()
+}
+
+// is parses a null or emptiness test, starting at the "is" token.
+func (p *parser) is(left Expression) Expression {
+	p.next()
+
+	negated := false
+	if p.tok == not {
+		negated = true
+		p.next()
+	}
+
+	if !p.matches(null, empty) {
+		panic(p.errorf(p.pos, "expected 'null' or 'empty' instead of %s", p.tok))
+	}
+	predicate := p.tok
+	p.next()
+
+	return &isExpression{Left: left, Predicate: predicate, Negated: negated}
+}
+
 // list parses a list of strings: "[" STRING ( "," STRING )* "]"
 func (p *parser) list() []string {
 	p.expect(lSquareBracket)
@@ -219,11 +401,15 @@ func (p *parser) list() []string {
 	return values
 }
 
-// value parses a value (string, quantity, boolean, or regex).
+// value parses a value (string, quantity, boolean, regex, time or duration).
 func (p *parser) value() Expression {
 	var expr Expression
 
 	switch p.tok {
+	case timestamp, now:
+		return p.time()
+	case duration:
+		expr = newDurationExpression(p.val)
 	case stringLit:
 		expr = &stringExpression{Value: p.val}
 	case quantity:
@@ -240,6 +426,36 @@ func (p *parser) value() Expression {
 	return expr
 }
 
+// time parses a point in time, optionally shifted by a duration.
+//
+// ( TIMESTAMP | "now" ) [ ( "+" | "-" ) DURATION ]
+func (p *parser) time() Expression {
+	expr := &timeExpression{}
+	if p.tok == timestamp {
+		expr = newTimeExpression(p.pos, p.val)
+	}
+	p.next()
+
+	if p.matches(plus, minus) {
+		expr.Op = p.tok
+		p.next()
+		p.expect(duration)
+		expr.Offset = newDurationExpression(p.val)
+		p.next()
+	}
+	return expr
+}
+
+// nest enters a nested expression and returns the function leaving it. It panics
+// once maxNestedLevels is exceeded so that deeply nested input cannot exhaust the stack.
+func (p *parser) nest() func() {
+	p.countExpression++
+	if p.countExpression > maxNestedLevels {
+		panic(p.errorf(p.pos, "maximum level of nested expression reached: %d", maxNestedLevels))
+	}
+	return func() { p.countExpression-- }
+}
+
 // next parses the next token into p.tok.
 func (p *parser) next() {
 	pos, tok, val := p.lexer.Scan()
@@ -3,6 +3,7 @@ package filter
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -172,6 +173,49 @@ var _ = Describe("Parser", func() {
 			{input: "name like 'test'", output: `(name like2 "test")`},
 			{input: "name like 'prod-db'", output: `(name like2 "prod-db")`},
 			{input: "name like 'test' and active = true", output: `((name like2 "test") and (active equal true))`},
+
+			// ===== NOT OPERATOR =====
+			{input: "not a = '1'", output: `(not (a equal "1"))`},
+			{input: "not (a = '1' and b = '2')", output: `(not ((a equal "1") and (b equal "2")))`},
+			{input: "NOT (a = '1' or b = '2')", output: `(not ((a equal "1") or (b equal "2")))`},
+			{input: "not a = '1' and b = '2'", output: `((not (a equal "1")) and (b equal "2"))`},
+			{input: "a = '1' or not b = '2' and c = '3'", output: `((a equal "1") or ((not (b equal "2")) and (c equal "3")))`},
+			{input: "not not a = '1'", output: `(not (not (a equal "1")))`},
+			{input: "not labels contains 'x'", output: `(not (labels CONTAINS "x"))`},
+
+			// ===== BETWEEN OPERATOR =====
+			{input: "memory between 8GB and 32GB", output: "(memory BETWEEN 8.00Gb AND 32.00Gb)"},
+			{input: "memory not between 512MB and 1GB", output: "(memory NOT BETWEEN 512.00Mb AND 1.00Gb)"},
+			{input: "name between 'a' and 'm'", output: `(name BETWEEN "a" AND "m")`},
+			{input: "cpus between 2 and 4 and active = true", output: "((cpus BETWEEN 2.00 AND 4.00) and (active equal true))"},
+
+			// ===== TIMES AND DURATIONS =====
+			{input: "created_at >= 2026-01-01", output: "(created_at gte 2026-01-01T00:00:00Z)"},
+			{input: "booted_at < 2026-03-01T10:30:00+02:00", output: "(booted_at less 2026-03-01T08:30:00Z)"},
+			{input: "inspection.completed_at < now - 7d", output: "(inspection.completed_at less (now - 7d))"},
+			{input: "created_at > 2026-01-01 + 1.5h", output: "(created_at greater (2026-01-01T00:00:00Z + 1.5h))"},
+			{input: "snapshot.age > 30d", output: "(snapshot.age greater 30d)"},
+			{input: "snapshot.age between 1w and 30d", output: "(snapshot.age BETWEEN 1w AND 30d)"},
+			{input: "created_at between 2025-01-01 and now", output: "(created_at BETWEEN 2025-01-01T00:00:00Z AND now)"},
+
+			// ===== IS NULL / IS EMPTY =====
+			{input: "host is null", output: "(host IS NULL)"},
+			{input: "host is not null", output: "(host IS NOT NULL)"},
+			{input: "labels IS EMPTY", output: "(labels IS EMPTY)"},
+			{input: "labels is not empty or host is null", output: "((labels IS NOT EMPTY) or (host IS NULL))"},
+
+			// ===== QUANTIFIERS =====
+			{input: "all(disk.thin = true)", output: "all((disk.thin equal true))"},
+			{input: "ANY(disk.capacity > 1TB)", output: "any((disk.capacity greater 1.00Tb))"},
+			{input: "none(net.connected = false or net.type = 'E1000')", output: `none(((net.connected equal false) or (net.type equal "E1000")))`},
+			{input: "not all(disk.thin = true) and name = 'x'", output: `((not all((disk.thin equal true))) and (name equal "x"))`},
+
+			// ===== AGGREGATES =====
+			{input: "count(disk) > 3", output: "(count(disk) greater 3.00)"},
+			{input: "sum(disk.capacity) >= 2TB", output: "(sum(disk.capacity) gte 2.00Tb)"},
+			{input: "max(disk.capacity) between 100GB and 1TB", output: "(max(disk.capacity) BETWEEN 100.00Gb AND 1.00Tb)"},
+			{input: "avg(disk.capacity) not between 1GB and 2GB", output: "(avg(disk.capacity) NOT BETWEEN 1.00Gb AND 2.00Gb)"},
+			{input: "count(net) = 0 or min(disk.capacity) < 1GB", output: "((count(net) equal 0.00) or (min(disk.capacity) less 1.00Gb))"},
 		}
 
 		for _, test := range tests {
@@ -204,6 +248,15 @@ var _ = Describe("Parser", func() {
 			Expect(expr).ToNot(BeNil())
 		})
 
+		It("should count negations towards the nesting limit", func() {
+			input := strings.Repeat("not ", 100) + "a = '1'"
+			_, err := parse([]byte(input))
+			Expect(err).To(HaveOccurred())
+			var pe ParseError
+			Expect(errors.As(err, &pe)).To(BeTrue())
+			Expect(pe.Message).To(ContainSubstring("maximum level of nested expression"))
+		})
+
 		It("should return ParseError when nesting exceeds the limit", func() {
 			input := nestParens(100)
 			_, err := parse(input)
@@ -248,6 +301,36 @@ var _ = Describe("Parser", func() {
 			"name ~ 'string'",
 			"name !~ 'string'",
 			"name like /pattern/",
+			"not",
+			"not (a = '1'",
+			"memory between 8GB",
+			"memory between 8GB or 32GB",
+			"memory between 32GB and 8GB",
+			"memory between 1GB and 'x'",
+			"memory between true and false",
+			"name between /a/ and /b/",
+			"host is",
+			"host is not",
+			"host is 'x'",
+			"host not is null",
+			"median(disk.capacity) > 1",
+			"all(disk.thin = true",
+			"all()",
+			"count(disk.capacity) > 1",
+			"sum(disk) > 1",
+			"count(disk)",
+			"count(disk) > 'x'",
+			"count(disk) in ['1']",
+			"sum(disk.capacity) ~ /1/",
+			"created_at > 2026-13-01",
+			"created_at > 2026-02-30",
+			"created_at > now -",
+			"created_at > now - 7",
+			"created_at > now + 2026-01-01",
+			"created_at between now and now - 1d",
+			"snapshot.age between 30d and 1w",
+			"snapshot.age between 1d and 2026-01-01",
+			"sum(disk.capacity) > 30d",
 		}
 
 		for _, input := range inputs {
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 )
@@ -13,27 +14,73 @@ import (
 // The function should return an error for unknown identifiers.
 type MapFunc func(name string) (string, FieldType, error)
 
+// Scope describes a query in which an entity spans several rows, such as a VM joined
+// with its disks and NICs. A filter selects the entities having a matching row.
+type Scope struct {
+	// Key is the column identifying the entity of a row (e.g. v."VM ID").
+	Key string
+	// From is the FROM clause of the query, joins included, using the aliases MapFunc
+	// refers to.
+	From string
+	// Collections resolves the child collections of the entity that quantifiers and
+	// aggregates range over. When nil, they are rejected.
+	Collections CollectionFunc
+}
+
+// Collection describes a child table of the filtered entity (e.g. the disks of a VM)
+// that quantifiers and aggregates range over.
+type Collection struct {
+	// From is the table and alias the collection is read from (e.g. vdisk dk). The alias
+	// must be the one MapFunc uses for the collection fields: inside the correlated
+	// subquery it shadows the outer alias, so the fields resolve to the subquery rows.
+	From string
+	// Correlation ties a row of the collection to the outer row (e.g. dk."VM ID" = v."VM ID").
+	Correlation string
+}
+
+// CollectionFunc resolves a collection name (e.g. "disk") to its Collection. Fields of
+// a collection are the identifiers prefixed by its name (e.g. disk.capacity).
+// The function should return an error for unknown collections.
+type CollectionFunc func(name string) (Collection, error)
+
+// sqlGenerator translates an expression tree to SQL. cf may be nil, in which case
+// quantifiers and aggregates are rejected.
+type sqlGenerator struct {
+	mf MapFunc
+	cf CollectionFunc
+	// now is the time "now" and ages refer to, fixed on first use so that every
+	// occurrence in an expression means the same instant.
+	now time.Time
+}
+
 func toSql(expr Expression, mf MapFunc) (sq.Sqlizer, error) {
+	return (&sqlGenerator{mf: mf}).toSql(expr)
+}
+
+func (g *sqlGenerator) toSql(expr Expression) (sq.Sqlizer, error) {
 	switch e := expr.(type) {
 	case *binaryExpression:
 		if e.Op != and && e.Op != or {
 			if v, ok := e.Left.(*varExpression); ok {
-				_, fieldType, err := mf(strings.ToLower(v.Name))
+				col, fieldType, err := g.mf(strings.ToLower(v.Name))
 				if err != nil {
-					return nil, err
+					return nil, fieldError(v, err)
 				}
 				if err := checkValueType(fieldType, e.Right); err != nil {
-					return nil, fmt.Errorf("field %q is %s, but got %s value", v.Name, fieldType, e.Right.Type())
+					return nil, fieldError(v, fmt.Errorf("field %q is %s, but got %s value", v.Name, fieldType, e.Right.Type()))
+				}
+				if fieldType == AgeField {
+					return g.age(col, e.Op, e.Right.(*durationExpression)), nil
 				}
 			}
 		}
 
-		left, err := toSql(e.Left, mf)
+		left, err := g.toSql(e.Left)
 		if err != nil {
 			return nil, err
 		}
 
-		right, err := toSql(e.Right, mf)
+		right, err := g.toSql(e.Right)
 		if err != nil {
 			return nil, err
 		}
@@ -65,9 +112,9 @@ func toSql(expr Expression, mf MapFunc) (sq.Sqlizer, error) {
 			return sq.Expr(fmt.Sprintf("(%s %s %s)", leftSQL, e.Op.Sql(), rightSQL), args...), nil
 		}
 	case *varExpression:
-		col, _, err := mf(strings.ToLower(e.Name))
+		col, _, err := g.mf(strings.ToLower(e.Name))
 		if err != nil {
-			return nil, err
+			return nil, fieldError(e, err)
 		}
 		return sq.Expr(col), nil
 	case *stringExpression:
@@ -80,39 +127,30 @@ func toSql(expr Expression, mf MapFunc) (sq.Sqlizer, error) {
 	case *regexExpression:
 		return sq.Expr("?", e.Pattern), nil
 	case *quantityExpression:
-		var valueInMb float64
-		switch e.Unit {
-		case KbQuantityUnit:
-			valueInMb = e.Value / 1024
-		case MbQuantityUnit:
-			valueInMb = e.Value
-		case GbQuantityUnit:
-			valueInMb = e.Value * 1024
-		case TbQuantityUnit:
-			valueInMb = e.Value * 1024 * 1024
-		default:
-			valueInMb = e.Value
-		}
-		return sq.Expr("?", valueInMb), nil
+		return sq.Expr("?", e.inMb()), nil
+	case *timeExpression:
+		return sq.Expr("CAST(? AS TIMESTAMP)", sqlTimestamp(e.at(g.clock()))), nil
+	case *durationExpression:
+		return sq.Expr("?", e.duration().Seconds()), nil
 	case *inExpression:
-		col, ft, err := mf(strings.ToLower(e.Left.(*varExpression).Name))
+		col, ft, err := g.mf(strings.ToLower(e.Left.(*varExpression).Name))
 		if err != nil {
-			return nil, err
+			return nil, fieldError(e.Left, err)
 		}
 		if ft != StringField && ft != AnyField {
-			return nil, fmt.Errorf("field %q is %s, but in/not in requires a string field", e.Left.(*varExpression).Name, ft)
+			return nil, fieldError(e.Left, fmt.Errorf("field %q is %s, but in/not in requires a string field", e.Left.(*varExpression).Name, ft))
 		}
 		if e.Negated {
 			return sq.NotEq{col: e.Values}, nil
 		}
 		return sq.Eq{col: e.Values}, nil
 	case *containsExpression:
-		col, ft, err := mf(strings.ToLower(e.Left.(*varExpression).Name))
+		col, ft, err := g.mf(strings.ToLower(e.Left.(*varExpression).Name))
 		if err != nil {
-			return nil, err
+			return nil, fieldError(e.Left, err)
 		}
 		if ft != ArrayField && ft != AnyField {
-			return nil, fmt.Errorf("field %q is %s, but contains/!contains requires an array field", e.Left.(*varExpression).Name, ft)
+			return nil, fieldError(e.Left, fmt.Errorf("field %q is %s, but contains/!contains requires an array field", e.Left.(*varExpression).Name, ft))
 		}
 		// Cast VARCHAR to VARCHAR[] for DuckDB's list_contains function
 		castedCol := fmt.Sprintf("CAST(%s AS VARCHAR[])", col)
@@ -121,11 +159,261 @@ func toSql(expr Expression, mf MapFunc) (sq.Sqlizer, error) {
 			return sq.Expr(fmt.Sprintf("(%s IS NULL OR NOT list_contains(%s, ?))", col, castedCol), e.Value), nil
 		}
 		return sq.Expr(fmt.Sprintf("list_contains(%s, ?)", castedCol), e.Value), nil
+	case *notExpression:
+		inner, err := g.toSql(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		innerSQL, args, err := inner.ToSql()
+		if err != nil {
+			return nil, err
+		}
+		if e.Scope != nil {
+			// An entity matches when none of its rows matches the expression, rather than
+			// when one of them does not: "not disk.capacity > 100GB" keeps the VMs without
+			// any large disk, not those with a small one.
+			return sq.Expr(fmt.Sprintf("(%s NOT IN (SELECT %s FROM %s WHERE %s))", e.Scope.Key, e.Scope.Key, e.Scope.From, innerSQL), args...), nil
+		}
+		// A comparison against NULL is unknown rather than false. Treat it as false so that
+		// "not (cluster = 'prod')" keeps VMs without a cluster, like "not contains" does.
+		return sq.Expr(fmt.Sprintf("NOT COALESCE(%s, FALSE)", innerSQL), args...), nil
+	case *betweenExpression:
+		name := e.Left.String()
+		col, ft, err := g.operand(e.Left)
+		if err != nil {
+			return nil, fieldError(e.Left, err)
+		}
+		if ft == BooleanField || ft == ArrayField {
+			return nil, fieldError(e.Left, fmt.Errorf("field %q is %s, but between requires a numeric, string, time or age field", name, ft))
+		}
+		for _, bound := range []Expression{e.Low, e.High} {
+			if err := checkValueType(ft, bound); err != nil {
+				return nil, fieldError(e.Left, fmt.Errorf("field %q is %s, but got %s value", name, ft, bound.Type()))
+			}
+		}
+		lowBound, highBound := e.Low, e.High
+		if ft == AgeField {
+			// an age between 7d and 30d is a timestamp between now - 30d and now - 7d
+			lowBound = &timeExpression{Op: minus, Offset: e.High.(*durationExpression)}
+			highBound = &timeExpression{Op: minus, Offset: e.Low.(*durationExpression)}
+		}
+		low, err := g.toSql(lowBound)
+		if err != nil {
+			return nil, err
+		}
+		high, err := g.toSql(highBound)
+		if err != nil {
+			return nil, err
+		}
+		lowSQL, lowArgs, err := low.ToSql()
+		if err != nil {
+			return nil, err
+		}
+		highSQL, highArgs, err := high.ToSql()
+		if err != nil {
+			return nil, err
+		}
+		op := "BETWEEN"
+		if e.Negated {
+			op = "NOT BETWEEN"
+		}
+		return sq.Expr(fmt.Sprintf("(%s %s %s AND %s)", col, op, lowSQL, highSQL), append(lowArgs, highArgs...)...), nil
+	case *isExpression:
+		name := e.Left.(*varExpression).Name
+		col, ft, err := g.mf(strings.ToLower(name))
+		if err != nil {
+			return nil, fieldError(e.Left, err)
+		}
+		if e.Predicate == null {
+			if e.Negated {
+				return sq.Expr(fmt.Sprintf("(%s IS NOT NULL)", col)), nil
+			}
+			return sq.Expr(fmt.Sprintf("(%s IS NULL)", col)), nil
+		}
+		if ft != ArrayField && ft != AnyField {
+			return nil, fieldError(e.Left, fmt.Errorf("field %q is %s, but is empty/is not empty requires an array field", name, ft))
+		}
+		// A missing array (VM without any groups) counts as empty.
+		castedCol := fmt.Sprintf("CAST(%s AS VARCHAR[])", col)
+		if e.Negated {
+			return sq.Expr(fmt.Sprintf("(%s IS NOT NULL AND len(%s) > 0)", col, castedCol)), nil
+		}
+		return sq.Expr(fmt.Sprintf("(%s IS NULL OR len(%s) = 0)", col, castedCol)), nil
+	case *aggregateExpression:
+		col, _, err := g.operand(e)
+		if err != nil {
+			return nil, fieldError(e, err)
+		}
+		return sq.Expr(col), nil
+	case *quantifierExpression:
+		name, err := collectionOf(e.Expr)
+		if err != nil {
+			return nil, fieldError(e, err)
+		}
+		coll, err := g.collection(name)
+		if err != nil {
+			return nil, &FieldError{Position: e.Pos, Field: name, Err: err}
+		}
+		inner, err := g.toSql(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		innerSQL, args, err := inner.ToSql()
+		if err != nil {
+			return nil, err
+		}
+		var format string
+		switch e.Quantifier {
+		case "any":
+			format = "EXISTS (SELECT 1 FROM %s WHERE %s AND %s)"
+		case "none":
+			format = "NOT EXISTS (SELECT 1 FROM %s WHERE %s AND %s)"
+		default:
+			// every row matches: no row for which the predicate is false or unknown
+			format = "NOT EXISTS (SELECT 1 FROM %s WHERE %s AND NOT COALESCE(%s, FALSE))"
+		}
+		return sq.Expr(fmt.Sprintf(format, coll.From, coll.Correlation, innerSQL), args...), nil
 	default:
 		return nil, fmt.Errorf("unknown expression type: %T", expr)
 	}
 }
 
+// age compares the time elapsed since the timestamp col with d. An age greater than d
+// is a timestamp before now - d, so ordering operators are flipped.
+func (g *sqlGenerator) age(col string, op Token, d *durationExpression) sq.Sqlizer {
+	switch op {
+	case greater:
+		op = less
+	case gte:
+		op = lte
+	case less:
+		op = greater
+	case lte:
+		op = gte
+	}
+	at := g.clock().Add(-d.duration())
+	return sq.Expr(fmt.Sprintf("(%s %s CAST(? AS TIMESTAMP))", col, op.Sql()), sqlTimestamp(at))
+}
+
+// clock returns the time now refers to.
+func (g *sqlGenerator) clock() time.Time {
+	if g.now.IsZero() {
+		g.now = time.Now().UTC()
+	}
+	return g.now
+}
+
+// sqlTimestamp formats t as a UTC timestamp literal.
+func sqlTimestamp(t time.Time) string {
+	return t.UTC().Format("2006-01-02 15:04:05.999999")
+}
+
+// operand resolves the left-hand side of a predicate (a field or an aggregate) to its
+// SQL and field type.
+func (g *sqlGenerator) operand(expr Expression) (string, FieldType, error) {
+	switch e := expr.(type) {
+	case *varExpression:
+		return g.mf(strings.ToLower(e.Name))
+	case *aggregateExpression:
+		name, _, _ := strings.Cut(strings.ToLower(e.Arg), ".")
+		coll, err := g.collection(name)
+		if err != nil {
+			return "", 0, err
+		}
+		if e.Func == "count" {
+			return fmt.Sprintf("(SELECT COUNT(*) FROM %s WHERE %s)", coll.From, coll.Correlation), NumericField, nil
+		}
+		col, ft, err := g.mf(strings.ToLower(e.Arg))
+		if err != nil {
+			return "", 0, err
+		}
+		if ft != NumericField && ft != AnyField {
+			return "", 0, fmt.Errorf("field %q is %s, but %s requires a numeric field", e.Arg, ft, e.Func)
+		}
+		agg := fmt.Sprintf("%s(%s)", strings.ToUpper(e.Func), col)
+		if e.Func == "sum" {
+			// a VM without disks has a total of 0, not an unknown one
+			agg = fmt.Sprintf("COALESCE(SUM(%s), 0)", col)
+		}
+		return fmt.Sprintf("(SELECT %s FROM %s WHERE %s)", agg, coll.From, coll.Correlation), NumericField, nil
+	default:
+		return "", 0, fmt.Errorf("unexpected operand type: %T", expr)
+	}
+}
+
+// fieldError attaches the position of the field (or aggregate) expr to err, unless err
+// already carries one.
+func fieldError(expr Expression, err error) error {
+	var fe *FieldError
+	if errors.As(err, &fe) {
+		return err
+	}
+	fe = &FieldError{Field: expr.String(), Err: err}
+	switch e := expr.(type) {
+	case *varExpression:
+		fe.Position, fe.Field = e.Pos, e.Name
+	case *aggregateExpression:
+		fe.Position, fe.Field = e.Pos, e.Arg
+	case *quantifierExpression:
+		fe.Position = e.Pos
+	}
+	return fe
+}
+
+func (g *sqlGenerator) collection(name string) (Collection, error) {
+	if g.cf == nil {
+		return Collection{}, fmt.Errorf("unknown filter collection: %s", name)
+	}
+	return g.cf(name)
+}
+
+// collectionOf returns the collection every field of a quantified expression belongs to.
+// Quantifiers range over a single collection and cannot be nested.
+func collectionOf(expr Expression) (string, error) {
+	var fields []*varExpression
+	var walk func(Expression) error
+	walk = func(expr Expression) error {
+		switch e := expr.(type) {
+		case *binaryExpression:
+			if err := walk(e.Left); err != nil {
+				return err
+			}
+			return walk(e.Right)
+		case *notExpression:
+			return walk(e.Expr)
+		case *inExpression:
+			return walk(e.Left)
+		case *containsExpression:
+			return walk(e.Left)
+		case *betweenExpression:
+			return walk(e.Left)
+		case *isExpression:
+			return walk(e.Left)
+		case *varExpression:
+			fields = append(fields, e)
+		case *quantifierExpression, *aggregateExpression:
+			return fieldError(e, fmt.Errorf("%s cannot be used inside a quantifier", e))
+		}
+		return nil
+	}
+	if err := walk(expr); err != nil {
+		return "", err
+	}
+
+	var collection string
+	for _, field := range fields {
+		prefix, _, found := strings.Cut(strings.ToLower(field.Name), ".")
+		if !found {
+			return "", fieldError(field, fmt.Errorf("field %q does not belong to a collection", field.Name))
+		}
+		if collection != "" && prefix != collection {
+			return "", fieldError(field, fmt.Errorf("field %q does not belong to collection %q", field.Name, collection))
+		}
+		collection = prefix
+	}
+	return collection, nil
+}
+
 // FieldType describes the expected value type for a filter field.
 type FieldType int
 
@@ -136,6 +424,10 @@ const (
 	NumericField
 	BooleanField
 	ArrayField
+	// TimeField is a timestamp compared with times (2026-01-01, now - 7d).
+	TimeField
+	// AgeField is a timestamp compared, as the time elapsed since then, with durations (30d).
+	AgeField
 )
 
 func (f FieldType) String() string {
@@ -150,6 +442,10 @@ func (f FieldType) String() string {
 		return "boolean"
 	case ArrayField:
 		return "array"
+	case TimeField:
+		return "time"
+	case AgeField:
+		return "age"
 	default:
 		return "unknown"
 	}
@@ -172,6 +468,14 @@ func checkValueType(ft FieldType, value Expression) error {
 		if _, ok := value.(*booleanExpression); ok {
 			return nil
 		}
+	case TimeField:
+		if _, ok := value.(*timeExpression); ok {
+			return nil
+		}
+	case AgeField:
+		if _, ok := value.(*durationExpression); ok {
+			return nil
+		}
 	}
 	return errors.New("type mismatched")
 }
@@ -1,8 +1,12 @@
 package filter
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -19,6 +23,11 @@ func toSqlString(expr Expression, mf MapFunc) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	return sqlToString(sqlizer)
+}
+
+// sqlToString interpolates the args of a Sqlizer into its SQL.
+func sqlToString(sqlizer sq.Sqlizer) (string, error) {
 	sql, args, err := sqlizer.ToSql()
 	if err != nil {
 		return "", err
@@ -782,4 +791,356 @@ var _ = Describe("SQL Generation", func() {
 		})
 	})
 
+	Context("NOT operator", func() {
+		type testCase struct {
+			input  string
+			output string
+		}
+
+		tests := []testCase{
+			{input: "not name = 'test'", output: `NOT COALESCE(("name" = 'test'), FALSE)`},
+			{input: "not (a = '1' and b = '2')", output: `NOT COALESCE((("a" = '1') AND ("b" = '2')), FALSE)`},
+			{input: "not (a = '1' or b = '2') and c = '3'", output: `(NOT COALESCE((("a" = '1') OR ("b" = '2')), FALSE) AND ("c" = '3'))`},
+			{input: "not memory > 8GB", output: `NOT COALESCE(("memory" > 8192.00), FALSE)`},
+			{input: "not status in ['a', 'b']", output: `NOT COALESCE("status" IN ('a','b'), FALSE)`},
+		}
+
+		for _, test := range tests {
+			test := test
+			It("should generate SQL for: "+test.input, func() {
+				expr, err := parse([]byte(test.input))
+				Expect(err).ToNot(HaveOccurred())
+				sql, err := toSqlString(expr, sqlTestMapper)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sql).To(Equal(test.output))
+			})
+		}
+
+		It("should propagate mapping errors from the negated expression", func() {
+			mf := MapFunc(func(name string) (string, FieldType, error) {
+				return "", 0, fmt.Errorf("unknown filter field: %s", name)
+			})
+			expr, err := parse([]byte("not (unknown = 'x')"))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = toSql(expr, mf)
+			Expect(err).To(MatchError(ContainSubstring("unknown filter field")))
+		})
+
+		It("should negate for the entity of a scope", func() {
+			scope := Scope{Key: "v.id", From: "vms v LEFT JOIN disks d ON d.vm = v.id"}
+			sqlizer, err := ParseWithScope([]byte("name = 'a' and not (disk > 1GB and not thin = true)"), sqlTestMapper, scope)
+			Expect(err).ToNot(HaveOccurred())
+			sql, args, err := sqlizer.ToSql()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sql).To(Equal(`(("name" = ?) AND (v.id NOT IN (SELECT v.id FROM vms v LEFT JOIN disks d ON d.vm = v.id WHERE (("disk" > ?) AND (v.id NOT IN (SELECT v.id FROM vms v LEFT JOIN disks d ON d.vm = v.id WHERE ("thin" = TRUE)))))))`))
+			Expect(args).To(Equal([]interface{}{"a", float64(1024)}))
+		})
+	})
+
+	Context("BETWEEN operator", func() {
+		It("should normalize quantity bounds to MB", func() {
+			expr, err := parse([]byte("memory between 8GB and 32GB"))
+			Expect(err).ToNot(HaveOccurred())
+			sqlizer, err := toSql(expr, sqlTestMapper)
+			Expect(err).ToNot(HaveOccurred())
+			sql, args, err := sqlizer.ToSql()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sql).To(Equal(`("memory" BETWEEN ? AND ?)`))
+			Expect(args).To(Equal([]interface{}{float64(8192), float64(32768)}))
+		})
+
+		It("should generate NOT BETWEEN", func() {
+			expr, err := parse([]byte("cpus not between 2 and 4"))
+			Expect(err).ToNot(HaveOccurred())
+			sql, err := toSqlString(expr, sqlTestMapper)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sql).To(Equal(`("cpus" NOT BETWEEN 2.00 AND 4.00)`))
+		})
+
+		It("should parameterize string bounds", func() {
+			expr, err := parse([]byte("name between 'a' and 'm'"))
+			Expect(err).ToNot(HaveOccurred())
+			sqlizer, err := toSql(expr, sqlTestMapper)
+			Expect(err).ToNot(HaveOccurred())
+			_, args, err := sqlizer.ToSql()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(args).To(Equal([]interface{}{"a", "m"}))
+		})
+
+		It("should reject bounds that do not match the field type", func() {
+			mf := MapFunc(func(name string) (string, FieldType, error) {
+				return `"memory"`, NumericField, nil
+			})
+			expr, err := parse([]byte("memory between 'a' and 'b'"))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = toSql(expr, mf)
+			Expect(err).To(MatchError(ContainSubstring("is numeric, but got string value")))
+		})
+
+		It("should reject boolean and array fields", func() {
+			mf := MapFunc(func(name string) (string, FieldType, error) {
+				return `"labels"`, ArrayField, nil
+			})
+			expr, err := parse([]byte("labels between 'a' and 'b'"))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = toSql(expr, mf)
+			Expect(err).To(MatchError(ContainSubstring("between requires a numeric, string, time or age field")))
+		})
+	})
+
+	Context("Times and ages", func() {
+		timeMapper := MapFunc(func(name string) (string, FieldType, error) {
+			switch name {
+			case "created_at":
+				return `"created_at"`, TimeField, nil
+			case "snapshot.age":
+				return `"snapshot_created_at"`, AgeField, nil
+			default:
+				return `"name"`, StringField, nil
+			}
+		})
+		now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+		generate := func(input string) (string, error) {
+			expr, err := parse([]byte(input))
+			Expect(err).ToNot(HaveOccurred())
+			sqlizer, err := (&sqlGenerator{mf: timeMapper, now: now}).toSql(expr)
+			if err != nil {
+				return "", err
+			}
+			return sqlToString(sqlizer)
+		}
+
+		DescribeTable("should generate SQL",
+			func(input, output string) {
+				sql, err := generate(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sql).To(Equal(output))
+			},
+			Entry("timestamp", "created_at >= 2026-01-01",
+				`("created_at" >= CAST('2026-01-01 00:00:00' AS TIMESTAMP))`),
+			Entry("zoned timestamp in UTC", "created_at < 2026-03-01T10:30:15.25+02:00",
+				`("created_at" < CAST('2026-03-01 08:30:15.25' AS TIMESTAMP))`),
+			Entry("relative to now", "created_at < now - 7d",
+				`("created_at" < CAST('2026-10-10 12:00:00' AS TIMESTAMP))`),
+			Entry("shifted timestamp", "created_at > 2026-01-01 + 36h",
+				`("created_at" > CAST('2026-01-02 12:00:00' AS TIMESTAMP))`),
+			Entry("older than", "snapshot.age > 30d",
+				`("snapshot_created_at" < CAST('2026-09-17 12:00:00' AS TIMESTAMP))`),
+			Entry("at most as old as", "snapshot.age <= 12h",
+				`("snapshot_created_at" >= CAST('2026-10-17 00:00:00' AS TIMESTAMP))`),
+			Entry("age range", "snapshot.age between 1w and 2w",
+				`("snapshot_created_at" BETWEEN CAST('2026-10-03 12:00:00' AS TIMESTAMP) AND CAST('2026-10-10 12:00:00' AS TIMESTAMP))`),
+			Entry("time range", "created_at not between 2026-01-01 and now",
+				`("created_at" NOT BETWEEN CAST('2026-01-01 00:00:00' AS TIMESTAMP) AND CAST('2026-10-17 12:00:00' AS TIMESTAMP))`),
+		)
+
+		DescribeTable("should reject values that do not match the field type",
+			func(input, message string) {
+				_, err := generate(input)
+				Expect(err).To(MatchError(ContainSubstring(message)))
+			},
+			Entry("duration on a time field", "created_at > 30d", "is time, but got duration value"),
+			Entry("time on an age field", "snapshot.age > now", "is age, but got time value"),
+			Entry("quantity on an age field", "snapshot.age > 30", "is age, but got numeric value"),
+			Entry("time on a string field", "name = 2026-01-01", "is string, but got time value"),
+		)
+
+		It("should pass durations in seconds to untyped fields", func() {
+			expr, err := parse([]byte("uptime > 2m"))
+			Expect(err).ToNot(HaveOccurred())
+			sql, err := toSqlString(expr, sqlTestMapper)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sql).To(Equal(`("uptime" > 120.00)`))
+		})
+	})
+
+	Context("IS NULL and IS EMPTY predicates", func() {
+		type testCase struct {
+			input  string
+			output string
+		}
+
+		tests := []testCase{
+			{input: "host is null", output: `("host" IS NULL)`},
+			{input: "host is not null", output: `("host" IS NOT NULL)`},
+			{input: "labels is empty", output: `("labels" IS NULL OR len(CAST("labels" AS VARCHAR[])) = 0)`},
+			{input: "labels is not empty", output: `("labels" IS NOT NULL AND len(CAST("labels" AS VARCHAR[])) > 0)`},
+			{input: "host is null and labels is not empty", output: `(("host" IS NULL) AND ("labels" IS NOT NULL AND len(CAST("labels" AS VARCHAR[])) > 0))`},
+		}
+
+		for _, test := range tests {
+			test := test
+			It("should generate SQL for: "+test.input, func() {
+				expr, err := parse([]byte(test.input))
+				Expect(err).ToNot(HaveOccurred())
+				sql, err := toSqlString(expr, sqlTestMapper)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sql).To(Equal(test.output))
+			})
+		}
+
+		It("should reject is empty on a non-array field", func() {
+			mf := MapFunc(func(name string) (string, FieldType, error) {
+				return `"host"`, StringField, nil
+			})
+			expr, err := parse([]byte("host is empty"))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = toSql(expr, mf)
+			Expect(err).To(MatchError(ContainSubstring("requires an array field")))
+		})
+	})
+
+	Context("Quantifiers and aggregates", func() {
+		testCollections := CollectionFunc(func(name string) (Collection, error) {
+			switch name {
+			case "disk":
+				return Collection{From: "vdisk dk", Correlation: `dk."VM ID" = v."VM ID"`}, nil
+			case "net":
+				return Collection{From: "vnetwork net", Correlation: `net."VM ID" = v."VM ID"`}, nil
+			default:
+				return Collection{}, fmt.Errorf("unknown filter collection: %s", name)
+			}
+		})
+		testMapper := MapFunc(func(name string) (string, FieldType, error) {
+			switch name {
+			case "disk.thin":
+				return `dk."Thin"`, BooleanField, nil
+			case "disk.capacity":
+				return `dk."Capacity MiB"`, NumericField, nil
+			case "disk.label":
+				return `dk."Label"`, StringField, nil
+			case "net.connected":
+				return `net."Connected"`, BooleanField, nil
+			case "name":
+				return `v."VM"`, StringField, nil
+			default:
+				return "", 0, fmt.Errorf("unknown filter field: %s", name)
+			}
+		})
+
+		type testCase struct {
+			input  string
+			output string
+		}
+
+		tests := []testCase{
+			{
+				input:  "all(disk.thin = true)",
+				output: `NOT EXISTS (SELECT 1 FROM vdisk dk WHERE dk."VM ID" = v."VM ID" AND NOT COALESCE((dk."Thin" = TRUE), FALSE))`,
+			},
+			{
+				input:  "any(disk.capacity > 1TB)",
+				output: `EXISTS (SELECT 1 FROM vdisk dk WHERE dk."VM ID" = v."VM ID" AND (dk."Capacity MiB" > 1048576.00))`,
+			},
+			{
+				input:  "none(net.connected = false)",
+				output: `NOT EXISTS (SELECT 1 FROM vnetwork net WHERE net."VM ID" = v."VM ID" AND (net."Connected" = FALSE))`,
+			},
+			{
+				input:  "count(disk) > 3",
+				output: `((SELECT COUNT(*) FROM vdisk dk WHERE dk."VM ID" = v."VM ID") > 3.00)`,
+			},
+			{
+				input:  "sum(disk.capacity) > 2TB",
+				output: `((SELECT COALESCE(SUM(dk."Capacity MiB"), 0) FROM vdisk dk WHERE dk."VM ID" = v."VM ID") > 2097152.00)`,
+			},
+			{
+				input:  "max(disk.capacity) between 1GB and 2GB",
+				output: `((SELECT MAX(dk."Capacity MiB") FROM vdisk dk WHERE dk."VM ID" = v."VM ID") BETWEEN 1024.00 AND 2048.00)`,
+			},
+			{
+				input:  "name = 'x' and any(disk.label ~ /Hard/)",
+				output: `((v."VM" = 'x') AND EXISTS (SELECT 1 FROM vdisk dk WHERE dk."VM ID" = v."VM ID" AND regexp_matches(dk."Label", 'Hard')))`,
+			},
+		}
+
+		for _, test := range tests {
+			test := test
+			It("should generate SQL for: "+test.input, func() {
+				expr, err := parse([]byte(test.input))
+				Expect(err).ToNot(HaveOccurred())
+				sqlizer, err := (&sqlGenerator{mf: testMapper, cf: testCollections}).toSql(expr)
+				Expect(err).ToNot(HaveOccurred())
+				sql, err := sqlToString(sqlizer)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sql).To(Equal(test.output))
+			})
+		}
+
+		type errorCase struct {
+			input string
+			err   string
+		}
+
+		errorTests := []errorCase{
+			{input: "all(disk.thin = true and net.connected = true)", err: `does not belong to collection "disk"`},
+			{input: "all(name = 'x')", err: "does not belong to a collection"},
+			{input: "all(any(disk.thin = true))", err: "cannot be used inside a quantifier"},
+			{input: "any(count(disk) > 1)", err: "cannot be used inside a quantifier"},
+			{input: "count(datastore) > 1", err: "unknown filter collection: datastore"},
+			{input: "sum(disk.label) > 1", err: "requires a numeric field"},
+			{input: "sum(disk.unknown) > 1", err: "unknown filter field"},
+			{input: "count(disk) between 'a' and 'b'", err: "but got string value"},
+		}
+
+		for _, test := range errorTests {
+			test := test
+			It("should reject: "+test.input, func() {
+				expr, err := parse([]byte(test.input))
+				Expect(err).ToNot(HaveOccurred())
+				_, err = (&sqlGenerator{mf: testMapper, cf: testCollections}).toSql(expr)
+				Expect(err).To(MatchError(ContainSubstring(test.err)))
+			})
+		}
+
+		It("should reject collections without a CollectionFunc", func() {
+			_, err := Parse([]byte("count(disk) > 1"), testMapper)
+			Expect(err).To(MatchError(ContainSubstring("unknown filter collection: disk")))
+		})
+
+		It("should parse collections with ParseWithScope", func() {
+			sqlizer, err := ParseWithScope([]byte("count(disk) > 1"), testMapper, Scope{Key: "v.id", From: "vms v", Collections: testCollections})
+			Expect(err).ToNot(HaveOccurred())
+			_, args, err := sqlizer.ToSql()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(args).To(Equal([]interface{}{float64(1)}))
+		})
+	})
+
+	Context("Field errors", func() {
+		typedMapper := MapFunc(func(name string) (string, FieldType, error) {
+			switch name {
+			case "name":
+				return `"name"`, StringField, nil
+			case "memory":
+				return `"memory"`, NumericField, nil
+			default:
+				return "", 0, fmt.Errorf("unknown filter field: %s", name)
+			}
+		})
+
+		DescribeTable("should report the position of the offending field",
+			func(input string, position int, field string) {
+				_, err := Parse([]byte(input), typedMapper)
+				Expect(err).To(HaveOccurred())
+
+				var fe *FieldError
+				Expect(errors.As(err, &fe)).To(BeTrue())
+				Expect(fe.Position).To(Equal(position))
+				Expect(fe.Field).To(Equal(field))
+			},
+			Entry("unknown field", "nmae = 'a'", 0, "nmae"),
+			Entry("unknown field on the right of and", "name = 'a' and clustr = 'b'", 15, "clustr"),
+			Entry("type mismatch", "name = 'a' or memory = 'big'", 14, "memory"),
+			Entry("unknown field in in", "name = 'a' and host in ['x']", 15, "host"),
+			Entry("unknown collection in quantifier", "name = 'a' and any(disk.thin = true)", 15, "disk"),
+			Entry("unknown aggregate", "sum(disk.capacity) > 1", 0, "disk.capacity"),
+		)
+
+		It("should keep the message of the mapper error", func() {
+			_, err := Parse([]byte("nmae = 'a'"), typedMapper)
+			Expect(err).To(MatchError("unknown filter field: nmae"))
+		})
+	})
 })
@@ -29,6 +29,16 @@ const (
 	rSquareBracket
 	like2
 	contains
+	between
+	is
+	null
+	empty
+	reference
+	timestamp
+	duration
+	now
+	plus
+	minus
 )
 
 var tokenNames = map[Token]string{
@@ -58,6 +68,16 @@ var tokenNames = map[Token]string{
 	rSquareBracket: "]",
 	like2:          "like2",
 	contains:       "contains",
+	between:        "between",
+	is:             "is",
+	null:           "null",
+	empty:          "empty",
+	reference:      "reference",
+	timestamp:      "timestamp",
+	duration:       "duration",
+	now:            "now",
+	plus:           "+",
+	minus:          "-",
 }
 
 func (t Token) String() string {
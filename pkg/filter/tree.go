@@ -0,0 +1,94 @@
+package filter
+
+import "strings"
+
+// Node is a serializable view of a parsed expression, used to explain how an expression
+// was understood.
+type Node struct {
+	// Type is the kind of the node: binary, not, in, contains, between, is, aggregate,
+	// quantifier, variable, string, numeric, boolean, regex, time or duration.
+	Type string `json:"type"`
+	// Operator as written in the DSL (e.g. "and", ">=", "not in", "is not null", "count").
+	Operator string `json:"operator,omitempty"`
+	// Field is set on variables and aggregates.
+	Field string `json:"field,omitempty"`
+	// Value is set on literals and contains tests.
+	Value string `json:"value,omitempty"`
+	// Values is set on in tests.
+	Values   []string `json:"values,omitempty"`
+	Children []Node   `json:"children,omitempty"`
+}
+
+var tokenOperators = map[Token]string{
+	and:      "and",
+	or:       "or",
+	equal:    "=",
+	notEqual: "!=",
+	greater:  ">",
+	gte:      ">=",
+	less:     "<",
+	lte:      "<=",
+	like:     "~",
+	notLike:  "!~",
+	like2:    "like",
+}
+
+// ParseTree parses a filter expression and returns its tree without resolving fields.
+func ParseTree(src []byte) (Node, error) {
+	expr, err := parse(src)
+	if err != nil {
+		return Node{}, err
+	}
+	return newNode(expr), nil
+}
+
+func newNode(expr Expression) Node {
+	n := Node{Type: expr.Type()}
+	switch e := expr.(type) {
+	case *binaryExpression:
+		n.Operator = tokenOperators[e.Op]
+		n.Children = []Node{newNode(e.Left), newNode(e.Right)}
+	case *notExpression:
+		n.Operator = "not"
+		n.Children = []Node{newNode(e.Expr)}
+	case *inExpression:
+		n.Operator = negate("in", e.Negated)
+		n.Values = e.Values
+		n.Children = []Node{newNode(e.Left)}
+	case *containsExpression:
+		n.Operator = negate("contains", e.Negated)
+		n.Value = e.Value
+		n.Children = []Node{newNode(e.Left)}
+	case *betweenExpression:
+		n.Operator = negate("between", e.Negated)
+		n.Children = []Node{newNode(e.Left), newNode(e.Low), newNode(e.High)}
+	case *isExpression:
+		n.Operator = "is " + e.Predicate.String()
+		if e.Negated {
+			n.Operator = "is not " + e.Predicate.String()
+		}
+		n.Children = []Node{newNode(e.Left)}
+	case *aggregateExpression:
+		n.Operator = e.Func
+		n.Field = e.Arg
+	case *quantifierExpression:
+		n.Operator = e.Quantifier
+		n.Children = []Node{newNode(e.Expr)}
+	case *varExpression:
+		n.Field = strings.ToLower(e.Name)
+	case *stringExpression:
+		n.Value = e.Value
+	case *regexExpression:
+		n.Value = e.Pattern
+	case *booleanExpression, *quantityExpression, *timeExpression, *durationExpression:
+		n.Value = e.String()
+	}
+	return n
+}
+
+func negate(op string, negated bool) string {
+	if negated {
+		return "not " + op
+	}
+	return op
+}
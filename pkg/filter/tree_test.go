@@ -0,0 +1,65 @@
+package filter
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseTree", func() {
+	It("should build the tree of a comparison", func() {
+		node, err := ParseTree([]byte("memory >= 8GB"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(node).To(Equal(Node{
+			Type:     "binary",
+			Operator: ">=",
+			Children: []Node{
+				{Type: "variable", Field: "memory"},
+				{Type: "numeric", Value: "8.00Gb"},
+			},
+		}))
+	})
+
+	It("should keep precedence in the tree", func() {
+		node, err := ParseTree([]byte("name = 'a' or name = 'b' and cpus > 2"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(node.Operator).To(Equal("or"))
+		Expect(node.Children[1].Operator).To(Equal("and"))
+	})
+
+	It("should describe negated tests", func() {
+		node, err := ParseTree([]byte("cluster not in ['a', 'b']"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(node.Type).To(Equal("in"))
+		Expect(node.Operator).To(Equal("not in"))
+		Expect(node.Values).To(Equal([]string{"a", "b"}))
+
+		node, err = ParseTree([]byte("labels is not empty"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(node.Operator).To(Equal("is not empty"))
+	})
+
+	It("should describe quantifiers and aggregates", func() {
+		node, err := ParseTree([]byte("any(disk.thin = true) and count(disk) > 2"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(node.Children[0].Type).To(Equal("quantifier"))
+		Expect(node.Children[0].Operator).To(Equal("any"))
+		Expect(node.Children[1].Children[0]).To(Equal(Node{Type: "aggregate", Operator: "count", Field: "disk"}))
+	})
+
+	It("should describe times and durations", func() {
+		node, err := ParseTree([]byte("created_at < now - 7d and snapshot.age > 30d"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(node.Children[0].Children[1]).To(Equal(Node{Type: "time", Value: "(now - 7d)"}))
+		Expect(node.Children[1].Children[1]).To(Equal(Node{Type: "duration", Value: "30d"}))
+	})
+
+	It("should not resolve fields", func() {
+		_, err := ParseTree([]byte("unknown_field = 'x'"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should return parse errors", func() {
+		_, err := ParseTree([]byte("name = "))
+		Expect(err).To(BeAssignableToTypeOf(ParseError{}))
+	})
+})
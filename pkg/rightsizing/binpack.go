@@ -0,0 +1,77 @@
+package rightsizing
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Demand is the CPU, in cores, and memory, in MB, a VM needs on a node.
+type Demand struct {
+	ID       string
+	CPU      float64
+	MemoryMB float64
+}
+
+// Capacity is the CPU, in cores, and memory, in MB, a node can allocate to VMs.
+type Capacity struct {
+	CPU      float64
+	MemoryMB float64
+}
+
+// Node is a node of a packing and the demands placed on it.
+type Node struct {
+	Demands []Demand
+	// Used is the sum of the demands placed on the node.
+	Used Capacity
+}
+
+// Free returns the capacity left on the node.
+func (n Node) Free(c Capacity) Capacity {
+	return Capacity{CPU: c.CPU - n.Used.CPU, MemoryMB: c.MemoryMB - n.Used.MemoryMB}
+}
+
+// RightsizedDemand returns the share of a provisioned resource a VM needs when sized to its
+// p95 utilization, in percent, plus headroom, in percent. It never exceeds provisioned.
+func RightsizedDemand(provisioned, p95Pct, headroomPct float64) float64 {
+	return min(provisioned, provisioned*p95Pct/100*(1+headroomPct/100))
+}
+
+// Pack places demands on as few nodes of capacity c as it can, first-fit decreasing: the
+// demands are placed largest first, by the larger of their CPU and memory share of a node,
+// each on the first node it fits on. Demands that do not fit on an empty node are returned
+// unplaced. The packing only depends on the demands, not on their order.
+func Pack(demands []Demand, c Capacity) (nodes []Node, unplaced []Demand) {
+	share := func(d Demand) float64 {
+		return max(d.CPU/c.CPU, d.MemoryMB/c.MemoryMB)
+	}
+
+	sorted := slices.Clone(demands)
+	slices.SortStableFunc(sorted, func(a, b Demand) int {
+		if n := cmp.Compare(share(b), share(a)); n != 0 {
+			return n
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	for _, d := range sorted {
+		if d.CPU > c.CPU || d.MemoryMB > c.MemoryMB {
+			unplaced = append(unplaced, d)
+			continue
+		}
+		placed := false
+		for i := range nodes {
+			free := nodes[i].Free(c)
+			if d.CPU <= free.CPU && d.MemoryMB <= free.MemoryMB {
+				nodes[i].Demands = append(nodes[i].Demands, d)
+				nodes[i].Used.CPU += d.CPU
+				nodes[i].Used.MemoryMB += d.MemoryMB
+				placed = true
+				break
+			}
+		}
+		if !placed {
+			nodes = append(nodes, Node{Demands: []Demand{d}, Used: Capacity{CPU: d.CPU, MemoryMB: d.MemoryMB}})
+		}
+	}
+	return nodes, unplaced
+}
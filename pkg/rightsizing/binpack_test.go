@@ -0,0 +1,63 @@
+package rightsizing
+
+import "testing"
+
+func TestPack_FirstFitDecreasing(t *testing.T) {
+	c := Capacity{CPU: 8, MemoryMB: 32768}
+	demands := []Demand{
+		{ID: "small-1", CPU: 2, MemoryMB: 4096},
+		{ID: "large", CPU: 6, MemoryMB: 8192},
+		{ID: "memory", CPU: 1, MemoryMB: 24576},
+		{ID: "small-2", CPU: 2, MemoryMB: 4096},
+	}
+
+	nodes, unplaced := Pack(demands, c)
+	if len(unplaced) != 0 {
+		t.Fatalf("unplaced: got %v, want none", unplaced)
+	}
+	if len(nodes) != 2 {
+		t.Fatalf("node count: got %d, want 2", len(nodes))
+	}
+	// large (0.75 of the CPU) and memory (0.75 of the memory) go first, on the same node,
+	// and the small demands fill the rest.
+	want := [][]string{{"large", "memory"}, {"small-1", "small-2"}}
+	for i, node := range nodes {
+		if len(node.Demands) != len(want[i]) {
+			t.Fatalf("node %d: got %v, want %v", i, node.Demands, want[i])
+		}
+		for j, d := range node.Demands {
+			if d.ID != want[i][j] {
+				t.Errorf("node %d demand %d: got %s, want %s", i, j, d.ID, want[i][j])
+			}
+		}
+	}
+	if free := nodes[0].Free(c); free.CPU != 1 || free.MemoryMB != 0 {
+		t.Errorf("free capacity of node 0: got %+v, want 1 core and 0 MB", free)
+	}
+}
+
+func TestPack_Unplaced(t *testing.T) {
+	nodes, unplaced := Pack([]Demand{{ID: "huge", CPU: 16, MemoryMB: 1024}}, Capacity{CPU: 8, MemoryMB: 32768})
+	if len(nodes) != 0 {
+		t.Errorf("node count: got %d, want 0", len(nodes))
+	}
+	if len(unplaced) != 1 || unplaced[0].ID != "huge" {
+		t.Errorf("unplaced: got %v, want huge", unplaced)
+	}
+}
+
+func TestPack_Empty(t *testing.T) {
+	nodes, unplaced := Pack(nil, Capacity{CPU: 8, MemoryMB: 32768})
+	if len(nodes) != 0 || len(unplaced) != 0 {
+		t.Errorf("got %d nodes and %d unplaced, want none", len(nodes), len(unplaced))
+	}
+}
+
+func TestRightsizedDemand(t *testing.T) {
+	if got := RightsizedDemand(8, 25, 20); got != 2.4 {
+		t.Errorf("got %v, want 2.4", got)
+	}
+	if got := RightsizedDemand(8, 95, 20); got != 8 {
+		t.Errorf("got %v, want the provisioned 8", got)
+	}
+}
@@ -0,0 +1,98 @@
+package rightsizing
+
+import "math"
+
+// Percentile names the statistic of a metric a policy sizes VMs by.
+const (
+	PercentileAverage = "average"
+	PercentileP95     = "p95"
+	PercentileP99     = "p99"
+	PercentileMax     = "max"
+)
+
+// Confidence grades of a recommendation, from the share of the expected samples collected.
+const (
+	ConfidenceHigh   = "high"
+	ConfidenceMedium = "medium"
+	ConfidenceLow    = "low"
+)
+
+// Policy is how VMs are sized from their utilization.
+type Policy struct {
+	// Percentile is the statistic VMs are sized by: average, p95, p99 or max.
+	Percentile  string
+	HeadroomPct float64
+	// MinCPUs, MaxCPUs, MinMemoryMB and MaxMemoryMB bound recommendations; zero is unbounded.
+	MinCPUs     int
+	MaxCPUs     int
+	MinMemoryMB int64
+	MaxMemoryMB int64
+	// RoundToSockets rounds vCPUs up to whole sockets of the VM's cores per socket.
+	RoundToSockets bool
+	// MemoryStepMB rounds memory up to a multiple of it; zero rounds to the MB.
+	MemoryStepMB int64
+}
+
+// Pick returns the statistic of s the policy sizes by.
+func (p Policy) Pick(s MetricStats) float64 {
+	switch p.Percentile {
+	case PercentileAverage:
+		return s.Average
+	case PercentileP99:
+		return s.P99
+	case PercentileMax:
+		return s.Max
+	default:
+		return s.P95
+	}
+}
+
+// RecommendCPUs returns the vCPUs a VM needs from its cpu.usage.average statistics, in
+// hundredths of a percent of its provisioned vCPUs, plus headroom. The result is at least one
+// vCPU, rounded up to whole sockets if the policy says so, and within the policy bounds.
+func RecommendCPUs(p Policy, provisioned, coresPerSocket int, usage MetricStats) int {
+	demand := float64(provisioned) * p.Pick(usage) / 10000 * (1 + p.HeadroomPct/100)
+	cpus := max(int(math.Ceil(demand-1e-9)), 1)
+	if p.RoundToSockets && coresPerSocket > 1 {
+		cpus = (cpus + coresPerSocket - 1) / coresPerSocket * coresPerSocket
+	}
+	cpus = max(cpus, p.MinCPUs)
+	if p.MaxCPUs > 0 {
+		cpus = min(cpus, p.MaxCPUs)
+	}
+	return cpus
+}
+
+// RecommendMemoryMB returns the memory, in MB, a VM needs from its mem.consumed.average
+// statistics, in KB, plus headroom. The result is rounded up to the policy step and within
+// the policy bounds.
+func RecommendMemoryMB(p Policy, usage MetricStats) int64 {
+	demand := p.Pick(usage) / 1024 * (1 + p.HeadroomPct/100)
+	step := max(p.MemoryStepMB, 1)
+	mb := max(int64(math.Ceil(demand/float64(step)-1e-9))*step, step)
+	mb = max(mb, p.MinMemoryMB)
+	if p.MaxMemoryMB > 0 {
+		mb = min(mb, p.MaxMemoryMB)
+	}
+	return mb
+}
+
+// ConfidencePct returns the share, in percent, of the expected samples collected.
+func ConfidencePct(samples, expected int) float64 {
+	if expected <= 0 {
+		return 0
+	}
+	return min(float64(samples)*100/float64(expected), 100)
+}
+
+// ConfidenceGrade grades a confidence percentage: high from 80%, medium from 50%, low below.
+func ConfidenceGrade(pct float64) string {
+	switch {
+	case pct >= 80:
+		return ConfidenceHigh
+	case pct >= 50:
+		return ConfidenceMedium
+	default:
+		return ConfidenceLow
+	}
+}
@@ -0,0 +1,75 @@
+package rightsizing
+
+import "testing"
+
+func TestRecommendCPUs(t *testing.T) {
+	// 8 vCPUs at 30% p95 and 60% max
+	usage := MetricStats{SampleCount: 10, Average: 1500, P95: 3000, P99: 4000, Max: 6000}
+
+	tests := []struct {
+		name           string
+		policy         Policy
+		coresPerSocket int
+		want           int
+	}{
+		{"p95 plus headroom", Policy{Percentile: PercentileP95, HeadroomPct: 20}, 1, 3},
+		{"max", Policy{Percentile: PercentileMax}, 1, 5},
+		{"rounded to sockets", Policy{Percentile: PercentileP95, HeadroomPct: 20, RoundToSockets: true}, 2, 4},
+		{"sockets ignored", Policy{Percentile: PercentileP95, HeadroomPct: 20}, 2, 3},
+		{"min bound", Policy{Percentile: PercentileAverage, MinCPUs: 4}, 1, 4},
+		{"max bound", Policy{Percentile: PercentileMax, HeadroomPct: 100, MaxCPUs: 6}, 1, 6},
+	}
+	for _, tt := range tests {
+		if got := RecommendCPUs(tt.policy, 8, tt.coresPerSocket, usage); got != tt.want {
+			t.Errorf("%s: got %d vCPUs, want %d", tt.name, got, tt.want)
+		}
+	}
+
+	if got := RecommendCPUs(Policy{}, 8, 1, MetricStats{}); got != 1 {
+		t.Errorf("idle VM: got %d vCPUs, want 1", got)
+	}
+}
+
+func TestRecommendMemoryMB(t *testing.T) {
+	// 3000 MB consumed at p95
+	usage := MetricStats{SampleCount: 10, P95: 3000 * 1024}
+
+	tests := []struct {
+		name   string
+		policy Policy
+		want   int64
+	}{
+		{"to the MB", Policy{Percentile: PercentileP95}, 3000},
+		{"headroom and step", Policy{Percentile: PercentileP95, HeadroomPct: 20, MemoryStepMB: 1024}, 4096},
+		{"min bound", Policy{Percentile: PercentileP95, MinMemoryMB: 8192}, 8192},
+		{"max bound", Policy{Percentile: PercentileP95, MaxMemoryMB: 2048}, 2048},
+	}
+	for _, tt := range tests {
+		if got := RecommendMemoryMB(tt.policy, usage); got != tt.want {
+			t.Errorf("%s: got %d MB, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestConfidence(t *testing.T) {
+	tests := []struct {
+		samples, expected int
+		pct               float64
+		grade             string
+	}{
+		{360, 360, 100, ConfidenceHigh},
+		{400, 360, 100, ConfidenceHigh},
+		{180, 360, 50, ConfidenceMedium},
+		{36, 360, 10, ConfidenceLow},
+		{10, 0, 0, ConfidenceLow},
+	}
+	for _, tt := range tests {
+		pct := ConfidencePct(tt.samples, tt.expected)
+		if pct != tt.pct {
+			t.Errorf("ConfidencePct(%d, %d): got %v, want %v", tt.samples, tt.expected, pct, tt.pct)
+		}
+		if grade := ConfidenceGrade(pct); grade != tt.grade {
+			t.Errorf("ConfidenceGrade(%v): got %s, want %s", pct, grade, tt.grade)
+		}
+	}
+}
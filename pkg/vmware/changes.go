@@ -0,0 +1,281 @@
+package vmware
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/session/keepalive"
+	"github.com/vmware/govmomi/view"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// trackedVMProperties are the VirtualMachine properties whose changes mark a VM as modified.
+// config.changeVersion moves on every reconfiguration, so the hardware itself does not need
+// to be watched.
+var trackedVMProperties = []string{
+	"name",
+	"config.changeVersion",
+	"runtime.powerState",
+	"runtime.host",
+	"guest.ipAddress",
+	"guest.hostName",
+	"snapshot",
+}
+
+// ChangedVMProperties are the VirtualMachine properties retrieved for every changed VM.
+var ChangedVMProperties = []string{
+	"name",
+	"config",
+	"runtime.powerState",
+	"runtime.host",
+	"guest",
+	"summary.storage",
+	"network",
+	"runtime.bootTime",
+	"snapshot",
+}
+
+// TrackerKeepAliveInterval is how often a VMChangeTracker touches its session. vCenter ends
+// sessions idle for 30 minutes by default, which collections scheduled further apart would
+// otherwise always hit.
+var TrackerKeepAliveInterval = 5 * time.Minute
+
+// VMChangeSet lists the VMs that changed since a VMChangeTracker was last committed.
+type VMChangeSet struct {
+	Added    []string
+	Modified []string
+	Removed  []string
+}
+
+// Empty reports whether no VM changed.
+func (s *VMChangeSet) Empty() bool {
+	return len(s.Added) == 0 && len(s.Modified) == 0 && len(s.Removed) == 0
+}
+
+// Changed returns the added and modified VM IDs.
+func (s *VMChangeSet) Changed() []string {
+	return append(slices.Clone(s.Added), s.Modified...)
+}
+
+// VMChangeTracker follows VirtualMachine changes on a vCenter through a private property
+// collector. Property collector versions are only meaningful for the filter that produced
+// them, so a tracker must stay alive between collections: it keeps its session alive while
+// idle, and once the session is lost anyway the tracker is useless and a full collection is
+// needed to establish a new one.
+//
+// Updates read from vCenter are consumed, so the tracker keeps them pending until the
+// caller has applied them and calls Commit.
+type VMChangeTracker struct {
+	client    *govmomi.Client
+	keepAlive *keepalive.HandlerSOAP
+	pc        *property.Collector
+	view      *view.ContainerView
+	version   string
+	pending   map[string]types.ObjectUpdateKind
+	order     []string
+}
+
+// NewVMChangeTracker creates a tracker over every VM of the vCenter and drains the initial
+// update set, so that the first call to Changes only reports what changed after creation.
+// The tracker takes ownership of client and logs it out on Close.
+func NewVMChangeTracker(ctx context.Context, client *govmomi.Client) (*VMChangeTracker, error) {
+	// The client is already logged in, so the keepalive is started explicitly. Logging out
+	// stops it.
+	keepAlive := keepalive.NewHandlerSOAP(client.Client.RoundTripper, TrackerKeepAliveInterval, nil)
+	client.Client.RoundTripper = keepAlive
+	keepAlive.Start()
+
+	pc, err := property.DefaultCollector(client.Client).Create(ctx)
+	if err != nil {
+		keepAlive.Stop()
+		return nil, fmt.Errorf("creating property collector: %w", err)
+	}
+
+	v, err := view.NewManager(client.Client).CreateContainerView(ctx, client.ServiceContent.RootFolder, []string{"VirtualMachine"}, true)
+	if err != nil {
+		_ = pc.Destroy(ctx)
+		keepAlive.Stop()
+		return nil, fmt.Errorf("creating container view: %w", err)
+	}
+
+	t := &VMChangeTracker{client: client, keepAlive: keepAlive, pc: pc, view: v}
+
+	_, err = pc.CreateFilter(ctx, types.CreateFilter{
+		Spec: types.PropertyFilterSpec{
+			ObjectSet: []types.ObjectSpec{{
+				Obj:  v.Reference(),
+				Skip: types.NewBool(true),
+				SelectSet: []types.BaseSelectionSpec{
+					&types.TraversalSpec{Type: "ContainerView", Path: "view"},
+				},
+			}},
+			PropSet: []types.PropertySpec{{Type: "VirtualMachine", PathSet: trackedVMProperties}},
+		},
+		PartialUpdates: true,
+	})
+	if err != nil {
+		t.Close(ctx)
+		return nil, fmt.Errorf("creating property filter: %w", err)
+	}
+
+	if _, err := t.Changes(ctx); err != nil {
+		t.Close(ctx)
+		return nil, fmt.Errorf("reading initial update set: %w", err)
+	}
+	t.Commit()
+
+	return t, nil
+}
+
+// Changes reads the updates published since the last call without waiting for new ones and
+// returns every change still pending since the last Commit.
+func (t *VMChangeTracker) Changes(ctx context.Context) (*VMChangeSet, error) {
+	if t.pending == nil {
+		t.pending = make(map[string]types.ObjectUpdateKind)
+	}
+
+	for {
+		res, err := methods.WaitForUpdatesEx(ctx, t.client, &types.WaitForUpdatesEx{
+			This:    t.pc.Reference(),
+			Version: t.version,
+			Options: &types.WaitOptions{MaxWaitSeconds: types.NewInt32(0)},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("waiting for updates: %w", err)
+		}
+
+		set := res.Returnval
+		if set == nil {
+			break
+		}
+		t.version = set.Version
+
+		for _, fs := range set.FilterSet {
+			for _, obj := range fs.ObjectSet {
+				id := obj.Obj.Value
+				prev, seen := t.pending[id]
+				if !seen {
+					t.order = append(t.order, id)
+				}
+				t.pending[id] = mergeUpdateKind(prev, seen, obj.Kind)
+			}
+		}
+
+		if set.Truncated == nil || !*set.Truncated {
+			break
+		}
+	}
+
+	changes := &VMChangeSet{}
+	for _, id := range t.order {
+		switch t.pending[id] {
+		case types.ObjectUpdateKindEnter:
+			changes.Added = append(changes.Added, id)
+		case types.ObjectUpdateKindModify:
+			changes.Modified = append(changes.Modified, id)
+		case types.ObjectUpdateKindLeave:
+			changes.Removed = append(changes.Removed, id)
+		}
+	}
+
+	return changes, nil
+}
+
+// Commit drops the pending changes once the caller has applied them.
+func (t *VMChangeTracker) Commit() {
+	t.pending = nil
+	t.order = nil
+}
+
+// RetrieveVMs fetches ChangedVMProperties for the given VM IDs.
+func (t *VMChangeTracker) RetrieveVMs(ctx context.Context, ids []string) ([]mo.VirtualMachine, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	refs := make([]types.ManagedObjectReference, 0, len(ids))
+	for _, id := range ids {
+		refs = append(refs, types.ManagedObjectReference{Type: "VirtualMachine", Value: id})
+	}
+
+	var vms []mo.VirtualMachine
+	if err := t.pc.Retrieve(ctx, refs, ChangedVMProperties, &vms); err != nil {
+		return nil, fmt.Errorf("retrieving changed VMs: %w", err)
+	}
+	return vms, nil
+}
+
+// RetrieveNetworkNames returns the names of the given networks keyed by their ID.
+// Distributed port groups are also keyed by their port group key, which is what
+// distributed virtual port NIC backings reference.
+func (t *VMChangeTracker) RetrieveNetworkNames(ctx context.Context, refs []types.ManagedObjectReference) (map[string]string, error) {
+	names := make(map[string]string, len(refs))
+	if len(refs) == 0 {
+		return names, nil
+	}
+
+	var networks []mo.Network
+	if err := t.pc.Retrieve(ctx, refs, []string{"name"}, &networks); err != nil {
+		return nil, fmt.Errorf("retrieving networks: %w", err)
+	}
+	for _, n := range networks {
+		names[n.Self.Value] = n.Name
+	}
+
+	var portgroups []types.ManagedObjectReference
+	for _, ref := range refs {
+		if ref.Type == "DistributedVirtualPortgroup" {
+			portgroups = append(portgroups, ref)
+		}
+	}
+	if len(portgroups) > 0 {
+		var pgs []mo.DistributedVirtualPortgroup
+		if err := t.pc.Retrieve(ctx, portgroups, []string{"name", "key"}, &pgs); err != nil {
+			return nil, fmt.Errorf("retrieving distributed port groups: %w", err)
+		}
+		for _, pg := range pgs {
+			names[pg.Key] = pg.Name
+		}
+	}
+
+	return names, nil
+}
+
+// Close destroys the property collector and container view and logs out the session.
+func (t *VMChangeTracker) Close(ctx context.Context) {
+	if t.view != nil {
+		_ = t.view.Destroy(ctx)
+	}
+	if t.pc != nil {
+		_ = t.pc.Destroy(ctx)
+	}
+	t.keepAlive.Stop()
+	_ = t.client.Logout(ctx)
+}
+
+// mergeUpdateKind folds a new update of an object into the kind accumulated so far:
+// an object that entered and was then modified is still new, and one that entered and
+// left again never existed as far as the caller is concerned.
+func mergeUpdateKind(prev types.ObjectUpdateKind, seen bool, next types.ObjectUpdateKind) types.ObjectUpdateKind {
+	if !seen {
+		return next
+	}
+	switch {
+	case prev == types.ObjectUpdateKindEnter && next == types.ObjectUpdateKindModify:
+		return types.ObjectUpdateKindEnter
+	case prev == types.ObjectUpdateKindEnter && next == types.ObjectUpdateKindLeave:
+		return ""
+	case prev == types.ObjectUpdateKindLeave && next == types.ObjectUpdateKindEnter:
+		return types.ObjectUpdateKindModify
+	case prev == "" && next == types.ObjectUpdateKindModify:
+		return ""
+	default:
+		return next
+	}
+}
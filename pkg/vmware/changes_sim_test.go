@@ -0,0 +1,224 @@
+package vmware_test
+
+import (
+	"context"
+	"crypto/tls"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25/types"
+
+	"github.com/kubev2v/assisted-migration-agent/pkg/vmware"
+)
+
+func TestVMChangeTracker(t *testing.T) {
+	model := simulator.VPX()
+	if err := model.Create(); err != nil {
+		t.Fatal(err)
+	}
+	model.Service.TLS = new(tls.Config)
+	s := model.Service.NewServer()
+	defer s.Close()
+
+	ctx := context.Background()
+	gc, err := govmomi.NewClient(ctx, s.URL, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tracker, err := vmware.NewVMChangeTracker(ctx, gc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tracker.Close(ctx)
+
+	finder := find.NewFinder(gc.Client)
+	dc, err := finder.DefaultDatacenter(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	finder.SetDatacenter(dc)
+	vms, err := finder.VirtualMachineList(ctx, "*")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("reports nothing right after creation", func(t *testing.T) {
+		changes, err := tracker.Changes(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !changes.Empty() {
+			t.Fatalf("expected no changes, got %+v", changes)
+		}
+	})
+
+	t.Run("reports a powered off VM as modified until committed", func(t *testing.T) {
+		task, err := vms[0].PowerOff(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		changes, err := tracker.Changes(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !slices.Equal(changes.Modified, []string{vms[0].Reference().Value}) {
+			t.Fatalf("expected %s modified, got %+v", vms[0].Reference().Value, changes)
+		}
+
+		again, err := tracker.Changes(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !slices.Equal(again.Modified, changes.Modified) {
+			t.Fatalf("expected changes to be reported again before Commit, got %+v", again)
+		}
+
+		tracker.Commit()
+		after, err := tracker.Changes(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !after.Empty() {
+			t.Fatalf("expected no changes after Commit, got %+v", after)
+		}
+	})
+
+	t.Run("reports a destroyed VM as removed", func(t *testing.T) {
+		task, err := vms[0].Destroy(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		changes, err := tracker.Changes(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !slices.Equal(changes.Removed, []string{vms[0].Reference().Value}) {
+			t.Fatalf("expected %s removed, got %+v", vms[0].Reference().Value, changes)
+		}
+		tracker.Commit()
+	})
+
+	t.Run("retrieves changed VM properties", func(t *testing.T) {
+		got, err := tracker.RetrieveVMs(ctx, []string{vms[1].Reference().Value})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(got) != 1 || got[0].Config == nil || got[0].Name == "" {
+			t.Fatalf("expected VM with config, got %+v", got)
+		}
+	})
+}
+
+func TestVMChangeTracker_keepsSessionAlive(t *testing.T) {
+	model := simulator.VPX()
+	if err := model.Create(); err != nil {
+		t.Fatal(err)
+	}
+	model.Service.TLS = new(tls.Config)
+	s := model.Service.NewServer()
+	defer s.Close()
+
+	ctx := context.Background()
+	admin, err := govmomi.NewClient(ctx, s.URL, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Sessions opened from now on expire after 300ms of inactivity.
+	setting := object.NewOptionManager(admin.Client, *admin.ServiceContent.Setting)
+	if err := setting.Update(ctx, []types.BaseOptionValue{
+		&types.OptionValue{Key: "config.vmacore.soap.sessionTimeout", Value: "300ms"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	prev := vmware.TrackerKeepAliveInterval
+	vmware.TrackerKeepAliveInterval = 100 * time.Millisecond
+	defer func() { vmware.TrackerKeepAliveInterval = prev }()
+
+	gc, err := govmomi.NewClient(ctx, s.URL, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tracker, err := vmware.NewVMChangeTracker(ctx, gc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tracker.Close(ctx)
+
+	time.Sleep(time.Second)
+
+	if _, err := tracker.Changes(ctx); err != nil {
+		t.Fatalf("expected the tracker session to be kept alive, got %v", err)
+	}
+}
+
+func TestRetrieveVMLifecycles(t *testing.T) {
+	model := simulator.VPX()
+	if err := model.Create(); err != nil {
+		t.Fatal(err)
+	}
+	model.Service.TLS = new(tls.Config)
+	s := model.Service.NewServer()
+	defer s.Close()
+
+	ctx := context.Background()
+	gc, err := govmomi.NewClient(ctx, s.URL, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	finder := find.NewFinder(gc.Client)
+	dc, err := finder.DefaultDatacenter(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	finder.SetDatacenter(dc)
+	all, err := finder.VirtualMachineList(ctx, "*")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	task, err := all[0].CreateSnapshot(ctx, "before-upgrade", "", false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := task.Wait(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	vms, err := vmware.RetrieveVMLifecycles(ctx, gc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(vms) != len(all) {
+		t.Fatalf("expected %d VMs, got %d", len(all), len(vms))
+	}
+
+	for _, vm := range vms {
+		if vm.Self.Value != all[0].Reference().Value {
+			continue
+		}
+		if vm.Snapshot == nil || len(vm.Snapshot.RootSnapshotList) != 1 || vm.Snapshot.RootSnapshotList[0].Name != "before-upgrade" {
+			t.Fatalf("expected the before-upgrade snapshot, got %+v", vm.Snapshot)
+		}
+		if vm.Config == nil || vm.Config.CreateDate == nil {
+			t.Fatalf("expected a creation date, got %+v", vm.Config)
+		}
+		return
+	}
+	t.Fatalf("VM %s not retrieved", all[0].Reference().Value)
+}
@@ -0,0 +1,33 @@
+package vmware
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/view"
+	"github.com/vmware/govmomi/vim25/mo"
+)
+
+// VMLifecycleProperties are the VirtualMachine properties describing the lifecycle of a VM:
+// its creation and last boot times and its snapshot tree.
+var VMLifecycleProperties = []string{
+	"config.createDate",
+	"runtime.bootTime",
+	"snapshot",
+}
+
+// RetrieveVMLifecycles fetches VMLifecycleProperties for every VM of the vCenter.
+func RetrieveVMLifecycles(ctx context.Context, client *govmomi.Client) ([]mo.VirtualMachine, error) {
+	v, err := view.NewManager(client.Client).CreateContainerView(ctx, client.ServiceContent.RootFolder, []string{"VirtualMachine"}, true)
+	if err != nil {
+		return nil, fmt.Errorf("creating container view: %w", err)
+	}
+	defer func() { _ = v.Destroy(context.Background()) }()
+
+	var vms []mo.VirtualMachine
+	if err := v.Retrieve(ctx, []string{"VirtualMachine"}, VMLifecycleProperties, &vms); err != nil {
+		return nil, fmt.Errorf("retrieving VM lifecycles: %w", err)
+	}
+	return vms, nil
+}